@@ -12,8 +12,13 @@ import (
 
 func main() {
 	runtime.GOMAXPROCS(runtime.NumCPU())
+	prune := flag.String("prune", "", "Remove the data in storage that is not referenced by any image and exit, one of 'check' (report only) or 'delete'")
 	flag.Parse()
 
+	if *prune != "" && *prune != "check" && *prune != "delete" {
+		log.Fatalf("Invalid value for -prune: %q, must be 'check' or 'delete'", *prune)
+	}
+
 	// TODO convert to flags instead of a config file?
 	configurationPath := ""
 	if flag.NArg() > 0 {
@@ -35,5 +40,10 @@ func main() {
 		log.Fatalf("Unable to open configuration file: %s", err)
 	}
 
+	if *prune != "" {
+		dockerregistry.ExecutePrune(configFile, *prune == "check", os.Stdout)
+		return
+	}
+
 	dockerregistry.Execute(configFile)
 }
@@ -294,6 +294,14 @@ func deepCopy_api_LocalSubjectAccessReview(in api.LocalSubjectAccessReview, out
 	} else {
 		out.Groups = nil
 	}
+	if in.Scopes != nil {
+		out.Scopes = make([]string, len(in.Scopes))
+		for i := range in.Scopes {
+			out.Scopes[i] = in.Scopes[i]
+		}
+	} else {
+		out.Scopes = nil
+	}
 	return nil
 }
 
@@ -648,6 +656,14 @@ func deepCopy_api_SubjectAccessReview(in api.SubjectAccessReview, out *api.Subje
 	} else {
 		out.Groups = nil
 	}
+	if in.Scopes != nil {
+		out.Scopes = make([]string, len(in.Scopes))
+		for i := range in.Scopes {
+			out.Scopes[i] = in.Scopes[i]
+		}
+	} else {
+		out.Scopes = nil
+	}
 	return nil
 }
 
@@ -764,9 +780,22 @@ func deepCopy_api_BuildConfigSpec(in buildapi.BuildConfigSpec, out *buildapi.Bui
 	} else {
 		out.Triggers = nil
 	}
+	out.RunPolicy = in.RunPolicy
 	if err := deepCopy_api_BuildSpec(in.BuildSpec, &out.BuildSpec, c); err != nil {
 		return err
 	}
+	if in.SuccessfulBuildsHistoryLimit != nil {
+		out.SuccessfulBuildsHistoryLimit = new(int32)
+		*out.SuccessfulBuildsHistoryLimit = *in.SuccessfulBuildsHistoryLimit
+	} else {
+		out.SuccessfulBuildsHistoryLimit = nil
+	}
+	if in.FailedBuildsHistoryLimit != nil {
+		out.FailedBuildsHistoryLimit = new(int32)
+		*out.FailedBuildsHistoryLimit = *in.FailedBuildsHistoryLimit
+	} else {
+		out.FailedBuildsHistoryLimit = nil
+	}
 	return nil
 }
 
@@ -874,6 +903,34 @@ func deepCopy_api_BuildOutput(in buildapi.BuildOutput, out *buildapi.BuildOutput
 	} else {
 		out.PushSecret = nil
 	}
+	if in.AdditionalTargets != nil {
+		out.AdditionalTargets = make([]buildapi.BuildOutputTarget, len(in.AdditionalTargets))
+		for i := range in.AdditionalTargets {
+			if err := deepCopy_api_BuildOutputTarget(in.AdditionalTargets[i], &out.AdditionalTargets[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.AdditionalTargets = nil
+	}
+	return nil
+}
+
+func deepCopy_api_BuildOutputTarget(in buildapi.BuildOutputTarget, out *buildapi.BuildOutputTarget, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.To); err != nil {
+		return err
+	} else {
+		out.To = newVal.(pkgapi.ObjectReference)
+	}
+	if in.PushSecret != nil {
+		if newVal, err := c.DeepCopy(in.PushSecret); err != nil {
+			return err
+		} else {
+			out.PushSecret = newVal.(*pkgapi.LocalObjectReference)
+		}
+	} else {
+		out.PushSecret = nil
+	}
 	return nil
 }
 
@@ -1066,6 +1123,24 @@ func deepCopy_api_BuildStatus(in buildapi.BuildStatus, out *buildapi.BuildStatus
 	} else {
 		out.Config = nil
 	}
+	if in.Stages != nil {
+		out.Stages = make([]buildapi.StageInfo, len(in.Stages))
+		for i := range in.Stages {
+			if err := deepCopy_api_StageInfo(in.Stages[i], &out.Stages[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Stages = nil
+	}
+	if in.OutputDockerImageReferences != nil {
+		out.OutputDockerImageReferences = make([]string, len(in.OutputDockerImageReferences))
+		for i := range in.OutputDockerImageReferences {
+			out.OutputDockerImageReferences[i] = in.OutputDockerImageReferences[i]
+		}
+	} else {
+		out.OutputDockerImageReferences = nil
+	}
 	return nil
 }
 
@@ -1094,6 +1169,14 @@ func deepCopy_api_BuildStrategy(in buildapi.BuildStrategy, out *buildapi.BuildSt
 	} else {
 		out.CustomStrategy = nil
 	}
+	if in.PipelineStrategy != nil {
+		out.PipelineStrategy = new(buildapi.PipelineBuildStrategy)
+		if err := deepCopy_api_PipelineBuildStrategy(*in.PipelineStrategy, out.PipelineStrategy, c); err != nil {
+			return err
+		}
+	} else {
+		out.PipelineStrategy = nil
+	}
 	return nil
 }
 
@@ -1115,6 +1198,22 @@ func deepCopy_api_BuildTriggerPolicy(in buildapi.BuildTriggerPolicy, out *builda
 	} else {
 		out.GenericWebHook = nil
 	}
+	if in.GitLabWebHook != nil {
+		out.GitLabWebHook = new(buildapi.WebHookTrigger)
+		if err := deepCopy_api_WebHookTrigger(*in.GitLabWebHook, out.GitLabWebHook, c); err != nil {
+			return err
+		}
+	} else {
+		out.GitLabWebHook = nil
+	}
+	if in.BitbucketWebHook != nil {
+		out.BitbucketWebHook = new(buildapi.WebHookTrigger)
+		if err := deepCopy_api_WebHookTrigger(*in.BitbucketWebHook, out.BitbucketWebHook, c); err != nil {
+			return err
+		}
+	} else {
+		out.BitbucketWebHook = nil
+	}
 	if in.ImageChange != nil {
 		out.ImageChange = new(buildapi.ImageChangeTrigger)
 		if err := deepCopy_api_ImageChangeTrigger(*in.ImageChange, out.ImageChange, c); err != nil {
@@ -1284,6 +1383,24 @@ func deepCopy_api_ImageSourcePath(in buildapi.ImageSourcePath, out *buildapi.Ima
 	return nil
 }
 
+func deepCopy_api_PipelineBuildStrategy(in buildapi.PipelineBuildStrategy, out *buildapi.PipelineBuildStrategy, c *conversion.Cloner) error {
+	out.Jenkinsfile = in.Jenkinsfile
+	out.JenkinsfilePath = in.JenkinsfilePath
+	if in.Env != nil {
+		out.Env = make([]pkgapi.EnvVar, len(in.Env))
+		for i := range in.Env {
+			if newVal, err := c.DeepCopy(in.Env[i]); err != nil {
+				return err
+			} else {
+				out.Env[i] = newVal.(pkgapi.EnvVar)
+			}
+		}
+	} else {
+		out.Env = nil
+	}
+	return nil
+}
+
 func deepCopy_api_SecretBuildSource(in buildapi.SecretBuildSource, out *buildapi.SecretBuildSource, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.Secret); err != nil {
 		return err
@@ -1355,11 +1472,68 @@ func deepCopy_api_SourceRevision(in buildapi.SourceRevision, out *buildapi.Sourc
 	return nil
 }
 
+func deepCopy_api_StageInfo(in buildapi.StageInfo, out *buildapi.StageInfo, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.Phase = in.Phase
+	if in.StartTimestamp != nil {
+		if newVal, err := c.DeepCopy(in.StartTimestamp); err != nil {
+			return err
+		} else {
+			out.StartTimestamp = newVal.(*unversioned.Time)
+		}
+	} else {
+		out.StartTimestamp = nil
+	}
+	if in.CompletionTimestamp != nil {
+		if newVal, err := c.DeepCopy(in.CompletionTimestamp); err != nil {
+			return err
+		} else {
+			out.CompletionTimestamp = newVal.(*unversioned.Time)
+		}
+	} else {
+		out.CompletionTimestamp = nil
+	}
+	return nil
+}
+
 func deepCopy_api_WebHookTrigger(in buildapi.WebHookTrigger, out *buildapi.WebHookTrigger, c *conversion.Cloner) error {
 	out.Secret = in.Secret
 	return nil
 }
 
+func deepCopy_api_BlueGreenDeploymentStrategyParams(in deployapi.BlueGreenDeploymentStrategyParams, out *deployapi.BlueGreenDeploymentStrategyParams, c *conversion.Cloner) error {
+	out.ServiceName = in.ServiceName
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	if in.VerificationSeconds != nil {
+		out.VerificationSeconds = new(int64)
+		*out.VerificationSeconds = *in.VerificationSeconds
+	} else {
+		out.VerificationSeconds = nil
+	}
+	if in.Pre != nil {
+		out.Pre = new(deployapi.LifecycleHook)
+		if err := deepCopy_api_LifecycleHook(*in.Pre, out.Pre, c); err != nil {
+			return err
+		}
+	} else {
+		out.Pre = nil
+	}
+	if in.Post != nil {
+		out.Post = new(deployapi.LifecycleHook)
+		if err := deepCopy_api_LifecycleHook(*in.Post, out.Post, c); err != nil {
+			return err
+		}
+	} else {
+		out.Post = nil
+	}
+	return nil
+}
+
 func deepCopy_api_CustomDeploymentStrategyParams(in deployapi.CustomDeploymentStrategyParams, out *deployapi.CustomDeploymentStrategyParams, c *conversion.Cloner) error {
 	out.Image = in.Image
 	if in.Environment != nil {
@@ -1626,6 +1800,14 @@ func deepCopy_api_DeploymentStrategy(in deployapi.DeploymentStrategy, out *deplo
 	} else {
 		out.RollingParams = nil
 	}
+	if in.BlueGreenParams != nil {
+		out.BlueGreenParams = new(deployapi.BlueGreenDeploymentStrategyParams)
+		if err := deepCopy_api_BlueGreenDeploymentStrategyParams(*in.BlueGreenParams, out.BlueGreenParams, c); err != nil {
+			return err
+		}
+	} else {
+		out.BlueGreenParams = nil
+	}
 	if newVal, err := c.DeepCopy(in.Resources); err != nil {
 		return err
 	} else {
@@ -1797,6 +1979,18 @@ func deepCopy_api_RollingDeploymentStrategyParams(in deployapi.RollingDeployment
 	} else {
 		out.UpdatePercent = nil
 	}
+	if in.PausePoints != nil {
+		out.PausePoints = make([]intstr.IntOrString, len(in.PausePoints))
+		for i := range in.PausePoints {
+			if newVal, err := c.DeepCopy(in.PausePoints[i]); err != nil {
+				return err
+			} else {
+				out.PausePoints[i] = newVal.(intstr.IntOrString)
+			}
+		}
+	} else {
+		out.PausePoints = nil
+	}
 	if in.Pre != nil {
 		out.Pre = new(deployapi.LifecycleHook)
 		if err := deepCopy_api_LifecycleHook(*in.Pre, out.Pre, c); err != nil {
@@ -1985,6 +2179,16 @@ func deepCopy_api_Image(in imageapi.Image, out *imageapi.Image, c *conversion.Cl
 	} else {
 		out.DockerImageLayers = nil
 	}
+	if in.Signatures != nil {
+		out.Signatures = make([]imageapi.ImageSignature, len(in.Signatures))
+		for i := range in.Signatures {
+			if err := deepCopy_api_ImageSignature(in.Signatures[i], &out.Signatures[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Signatures = nil
+	}
 	return nil
 }
 
@@ -2058,6 +2262,32 @@ func deepCopy_api_ImageList(in imageapi.ImageList, out *imageapi.ImageList, c *c
 	return nil
 }
 
+func deepCopy_api_ImageSignature(in imageapi.ImageSignature, out *imageapi.ImageSignature, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.Type = in.Type
+	if in.Content != nil {
+		out.Content = make([]uint8, len(in.Content))
+		for i := range in.Content {
+			out.Content[i] = in.Content[i]
+		}
+	} else {
+		out.Content = nil
+	}
+	out.Issuer = in.Issuer
+	out.Identity = in.Identity
+	if in.Conditions != nil {
+		out.Conditions = make([]imageapi.SignatureCondition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := deepCopy_api_SignatureCondition(in.Conditions[i], &out.Conditions[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
+	return nil
+}
+
 func deepCopy_api_ImageStream(in imageapi.ImageStream, out *imageapi.ImageStream, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -2326,6 +2556,24 @@ func deepCopy_api_RepositoryImportStatus(in imageapi.RepositoryImportStatus, out
 	return nil
 }
 
+func deepCopy_api_SignatureCondition(in imageapi.SignatureCondition, out *imageapi.SignatureCondition, c *conversion.Cloner) error {
+	out.Type = in.Type
+	out.Status = in.Status
+	if newVal, err := c.DeepCopy(in.LastProbeTime); err != nil {
+		return err
+	} else {
+		out.LastProbeTime = newVal.(unversioned.Time)
+	}
+	if newVal, err := c.DeepCopy(in.LastTransitionTime); err != nil {
+		return err
+	} else {
+		out.LastTransitionTime = newVal.(unversioned.Time)
+	}
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
 func deepCopy_api_TagEvent(in imageapi.TagEvent, out *imageapi.TagEvent, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.Created); err != nil {
 		return err
@@ -2411,6 +2659,14 @@ func deepCopy_api_TagReference(in imageapi.TagReference, out *imageapi.TagRefere
 	if err := deepCopy_api_TagImportPolicy(in.ImportPolicy, &out.ImportPolicy, c); err != nil {
 		return err
 	}
+	if err := deepCopy_api_TagReferencePolicy(in.ReferencePolicy, &out.ReferencePolicy, c); err != nil {
+		return err
+	}
+	return nil
+}
+
+func deepCopy_api_TagReferencePolicy(in imageapi.TagReferencePolicy, out *imageapi.TagReferencePolicy, c *conversion.Cloner) error {
+	out.Type = in.Type
 	return nil
 }
 
@@ -2713,6 +2969,39 @@ func deepCopy_api_Route(in routeapi.Route, out *routeapi.Route, c *conversion.Cl
 	return nil
 }
 
+func deepCopy_api_RouteIngress(in routeapi.RouteIngress, out *routeapi.RouteIngress, c *conversion.Cloner) error {
+	out.Host = in.Host
+	out.RouterName = in.RouterName
+	if in.Conditions != nil {
+		out.Conditions = make([]routeapi.RouteIngressCondition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := deepCopy_api_RouteIngressCondition(in.Conditions[i], &out.Conditions[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
+	return nil
+}
+
+func deepCopy_api_RouteIngressCondition(in routeapi.RouteIngressCondition, out *routeapi.RouteIngressCondition, c *conversion.Cloner) error {
+	out.Type = in.Type
+	out.Status = in.Status
+	out.Reason = in.Reason
+	out.Message = in.Message
+	if in.LastTransitionTime != nil {
+		if newVal, err := c.DeepCopy(in.LastTransitionTime); err != nil {
+			return err
+		} else {
+			out.LastTransitionTime = newVal.(*unversioned.Time)
+		}
+	} else {
+		out.LastTransitionTime = nil
+	}
+	return nil
+}
+
 func deepCopy_api_RouteList(in routeapi.RouteList, out *routeapi.RouteList, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -2749,10 +3038,18 @@ func deepCopy_api_RoutePort(in routeapi.RoutePort, out *routeapi.RoutePort, c *c
 func deepCopy_api_RouteSpec(in routeapi.RouteSpec, out *routeapi.RouteSpec, c *conversion.Cloner) error {
 	out.Host = in.Host
 	out.Path = in.Path
-	if newVal, err := c.DeepCopy(in.To); err != nil {
+	if err := deepCopy_api_RouteTargetReference(in.To, &out.To, c); err != nil {
 		return err
+	}
+	if in.AlternateBackends != nil {
+		out.AlternateBackends = make([]routeapi.RouteTargetReference, len(in.AlternateBackends))
+		for i := range in.AlternateBackends {
+			if err := deepCopy_api_RouteTargetReference(in.AlternateBackends[i], &out.AlternateBackends[i], c); err != nil {
+				return err
+			}
+		}
 	} else {
-		out.To = newVal.(pkgapi.ObjectReference)
+		out.AlternateBackends = nil
 	}
 	if in.Port != nil {
 		out.Port = new(routeapi.RoutePort)
@@ -2770,10 +3067,33 @@ func deepCopy_api_RouteSpec(in routeapi.RouteSpec, out *routeapi.RouteSpec, c *c
 	} else {
 		out.TLS = nil
 	}
+	out.WildcardPolicy = in.WildcardPolicy
 	return nil
 }
 
 func deepCopy_api_RouteStatus(in routeapi.RouteStatus, out *routeapi.RouteStatus, c *conversion.Cloner) error {
+	if in.Ingress != nil {
+		out.Ingress = make([]routeapi.RouteIngress, len(in.Ingress))
+		for i := range in.Ingress {
+			if err := deepCopy_api_RouteIngress(in.Ingress[i], &out.Ingress[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Ingress = nil
+	}
+	return nil
+}
+
+func deepCopy_api_RouteTargetReference(in routeapi.RouteTargetReference, out *routeapi.RouteTargetReference, c *conversion.Cloner) error {
+	out.Kind = in.Kind
+	out.Name = in.Name
+	if in.Weight != nil {
+		out.Weight = new(int32)
+		*out.Weight = *in.Weight
+	} else {
+		out.Weight = nil
+	}
 	return nil
 }
 
@@ -3206,6 +3526,7 @@ func init() {
 		deepCopy_api_BuildLog,
 		deepCopy_api_BuildLogOptions,
 		deepCopy_api_BuildOutput,
+		deepCopy_api_BuildOutputTarget,
 		deepCopy_api_BuildRequest,
 		deepCopy_api_BuildSource,
 		deepCopy_api_BuildSpec,
@@ -3219,12 +3540,15 @@ func init() {
 		deepCopy_api_ImageChangeTrigger,
 		deepCopy_api_ImageSource,
 		deepCopy_api_ImageSourcePath,
+		deepCopy_api_PipelineBuildStrategy,
 		deepCopy_api_SecretBuildSource,
 		deepCopy_api_SecretSpec,
 		deepCopy_api_SourceBuildStrategy,
 		deepCopy_api_SourceControlUser,
 		deepCopy_api_SourceRevision,
+		deepCopy_api_StageInfo,
 		deepCopy_api_WebHookTrigger,
+		deepCopy_api_BlueGreenDeploymentStrategyParams,
 		deepCopy_api_CustomDeploymentStrategyParams,
 		deepCopy_api_DeploymentCause,
 		deepCopy_api_DeploymentCauseImageTrigger,
@@ -3251,6 +3575,7 @@ func init() {
 		deepCopy_api_ImageImportStatus,
 		deepCopy_api_ImageLayer,
 		deepCopy_api_ImageList,
+		deepCopy_api_ImageSignature,
 		deepCopy_api_ImageStream,
 		deepCopy_api_ImageStreamImage,
 		deepCopy_api_ImageStreamImport,
@@ -3264,11 +3589,13 @@ func init() {
 		deepCopy_api_ImageStreamTagList,
 		deepCopy_api_RepositoryImportSpec,
 		deepCopy_api_RepositoryImportStatus,
+		deepCopy_api_SignatureCondition,
 		deepCopy_api_TagEvent,
 		deepCopy_api_TagEventCondition,
 		deepCopy_api_TagEventList,
 		deepCopy_api_TagImportPolicy,
 		deepCopy_api_TagReference,
+		deepCopy_api_TagReferencePolicy,
 		deepCopy_api_OAuthAccessToken,
 		deepCopy_api_OAuthAccessTokenList,
 		deepCopy_api_OAuthAuthorizeToken,
@@ -3283,10 +3610,13 @@ func init() {
 		deepCopy_api_ProjectSpec,
 		deepCopy_api_ProjectStatus,
 		deepCopy_api_Route,
+		deepCopy_api_RouteIngress,
+		deepCopy_api_RouteIngressCondition,
 		deepCopy_api_RouteList,
 		deepCopy_api_RoutePort,
 		deepCopy_api_RouteSpec,
 		deepCopy_api_RouteStatus,
+		deepCopy_api_RouteTargetReference,
 		deepCopy_api_TLSConfig,
 		deepCopy_api_ClusterNetwork,
 		deepCopy_api_ClusterNetworkList,
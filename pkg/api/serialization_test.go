@@ -138,6 +138,11 @@ func fuzzInternalObject(t *testing.T, forVersion string, item runtime.Object, se
 			j.DockerImageMetadata.Kind = ""
 			j.DockerImageMetadataVersion = []string{"pre012", "1.0"}[c.Rand.Intn(2)]
 			j.DockerImageReference = c.RandString()
+			if forVersion != "v1beta3" {
+				c.Fuzz(&j.Signatures)
+			} else {
+				j.Signatures = nil
+			}
 		},
 		func(j *image.ImageStreamMapping, c fuzz.Continue) {
 			c.FuzzNoCustom(j)
@@ -189,6 +194,8 @@ func fuzzInternalObject(t *testing.T, forVersion string, item runtime.Object, se
 				specs := []string{"", "ImageStreamTag", "ImageStreamImage"}
 				j.From.Kind = specs[c.Intn(len(specs))]
 			}
+			policies := []image.TagReferencePolicyType{"", image.SourceTagReferencePolicy, image.LocalTagReferencePolicy}
+			j.ReferencePolicy.Type = policies[c.Intn(len(policies))]
 		},
 		func(j *build.SourceBuildStrategy, c fuzz.Continue) {
 			c.FuzzNoCustom(j)
@@ -223,11 +230,29 @@ func fuzzInternalObject(t *testing.T, forVersion string, item runtime.Object, se
 				j.To.Name = strings.Replace(j.To.Name, ":", "-", -1)
 			}
 		},
+		func(j *build.BuildConfigSpec, c fuzz.Continue) {
+			c.FuzzNoCustom(j)
+			policies := []build.BuildRunPolicy{build.BuildRunPolicyParallel, build.BuildRunPolicySerial, build.BuildRunPolicySerialLatestOnly}
+			j.RunPolicy = policies[c.Intn(len(policies))]
+		},
 		func(j *route.RouteSpec, c fuzz.Continue) {
 			c.FuzzNoCustom(j)
-			j.To = kapi.ObjectReference{
-				Kind: "Service",
-				Name: j.To.Name,
+			j.To.Kind = "Service"
+			if len(j.WildcardPolicy) == 0 {
+				j.WildcardPolicy = route.WildcardPolicyNone
+			}
+			if j.To.Weight == nil {
+				weight := int32(c.Intn(257))
+				j.To.Weight = &weight
+			}
+			for i := range j.AlternateBackends {
+				if len(j.AlternateBackends[i].Kind) == 0 {
+					j.AlternateBackends[i].Kind = "Service"
+				}
+				if j.AlternateBackends[i].Weight == nil {
+					weight := int32(c.Intn(257))
+					j.AlternateBackends[i].Weight = &weight
+				}
 			}
 		},
 		func(j *route.TLSConfig, c fuzz.Continue) {
@@ -242,8 +267,8 @@ func fuzzInternalObject(t *testing.T, forVersion string, item runtime.Object, se
 		},
 		func(j *deploy.DeploymentStrategy, c fuzz.Continue) {
 			c.FuzzNoCustom(j)
-			j.RecreateParams, j.RollingParams, j.CustomParams = nil, nil, nil
-			strategyTypes := []deploy.DeploymentStrategyType{deploy.DeploymentStrategyTypeRecreate, deploy.DeploymentStrategyTypeRolling, deploy.DeploymentStrategyTypeCustom}
+			j.RecreateParams, j.RollingParams, j.BlueGreenParams, j.CustomParams = nil, nil, nil, nil
+			strategyTypes := []deploy.DeploymentStrategyType{deploy.DeploymentStrategyTypeRecreate, deploy.DeploymentStrategyTypeRolling, deploy.DeploymentStrategyTypeBlueGreen, deploy.DeploymentStrategyTypeCustom}
 			j.Type = strategyTypes[c.Rand.Intn(len(strategyTypes))]
 			switch j.Type {
 			case deploy.DeploymentStrategyTypeRecreate:
@@ -254,6 +279,18 @@ func fuzzInternalObject(t *testing.T, forVersion string, item runtime.Object, se
 					params.TimeoutSeconds = &s
 				}
 				j.RecreateParams = params
+			case deploy.DeploymentStrategyTypeBlueGreen:
+				params := &deploy.BlueGreenDeploymentStrategyParams{}
+				c.Fuzz(params)
+				if params.TimeoutSeconds == nil {
+					s := int64(120)
+					params.TimeoutSeconds = &s
+				}
+				if params.VerificationSeconds == nil {
+					s := int64(60)
+					params.VerificationSeconds = &s
+				}
+				j.BlueGreenParams = params
 			case deploy.DeploymentStrategyTypeRolling:
 				params := &deploy.RollingDeploymentStrategyParams{}
 				randInt64 := func() *int64 {
@@ -292,6 +329,9 @@ func fuzzInternalObject(t *testing.T, forVersion string, item runtime.Object, se
 					params.MaxSurge = intstr.FromString(fmt.Sprintf("%d%%", c.RandUint64()))
 					params.MaxUnavailable = intstr.FromString(fmt.Sprintf("%d%%", c.RandUint64()))
 				}
+				if c.RandBool() {
+					params.PausePoints = []intstr.IntOrString{intstr.FromInt(int(c.RandUint64())), intstr.FromString(fmt.Sprintf("%d%%", c.RandUint64()))}
+				}
 				j.RollingParams = params
 			}
 		},
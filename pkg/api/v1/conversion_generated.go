@@ -26,6 +26,7 @@ import (
 	resource "k8s.io/kubernetes/pkg/api/resource"
 	pkgapiv1 "k8s.io/kubernetes/pkg/api/v1"
 	conversion "k8s.io/kubernetes/pkg/conversion"
+	intstr "k8s.io/kubernetes/pkg/util/intstr"
 	reflect "reflect"
 )
 
@@ -266,6 +267,14 @@ func autoconvert_api_LocalSubjectAccessReview_To_v1_LocalSubjectAccessReview(in
 	// in.Action has no peer in out
 	out.User = in.User
 	// in.Groups has no peer in out
+	if in.Scopes != nil {
+		out.Scopes = make([]string, len(in.Scopes))
+		for i := range in.Scopes {
+			out.Scopes[i] = in.Scopes[i]
+		}
+	} else {
+		out.Scopes = nil
+	}
 	return nil
 }
 
@@ -527,6 +536,14 @@ func autoconvert_api_SubjectAccessReview_To_v1_SubjectAccessReview(in *api.Subje
 	// in.Action has no peer in out
 	out.User = in.User
 	// in.Groups has no peer in out
+	if in.Scopes != nil {
+		out.Scopes = make([]string, len(in.Scopes))
+		for i := range in.Scopes {
+			out.Scopes[i] = in.Scopes[i]
+		}
+	} else {
+		out.Scopes = nil
+	}
 	return nil
 }
 
@@ -786,6 +803,14 @@ func autoconvert_v1_LocalSubjectAccessReview_To_api_LocalSubjectAccessReview(in
 	// in.AuthorizationAttributes has no peer in out
 	out.User = in.User
 	// in.GroupsSlice has no peer in out
+	if in.Scopes != nil {
+		out.Scopes = make([]string, len(in.Scopes))
+		for i := range in.Scopes {
+			out.Scopes[i] = in.Scopes[i]
+		}
+	} else {
+		out.Scopes = nil
+	}
 	return nil
 }
 
@@ -1049,6 +1074,14 @@ func autoconvert_v1_SubjectAccessReview_To_api_SubjectAccessReview(in *v1.Subjec
 	// in.AuthorizationAttributes has no peer in out
 	out.User = in.User
 	// in.GroupsSlice has no peer in out
+	if in.Scopes != nil {
+		out.Scopes = make([]string, len(in.Scopes))
+		for i := range in.Scopes {
+			out.Scopes[i] = in.Scopes[i]
+		}
+	} else {
+		out.Scopes = nil
+	}
 	return nil
 }
 
@@ -1192,9 +1225,22 @@ func autoconvert_api_BuildConfigSpec_To_v1_BuildConfigSpec(in *buildapi.BuildCon
 	} else {
 		out.Triggers = nil
 	}
+	out.RunPolicy = apiv1.BuildRunPolicy(in.RunPolicy)
 	if err := convert_api_BuildSpec_To_v1_BuildSpec(&in.BuildSpec, &out.BuildSpec, s); err != nil {
 		return err
 	}
+	if in.SuccessfulBuildsHistoryLimit != nil {
+		out.SuccessfulBuildsHistoryLimit = new(int32)
+		*out.SuccessfulBuildsHistoryLimit = *in.SuccessfulBuildsHistoryLimit
+	} else {
+		out.SuccessfulBuildsHistoryLimit = nil
+	}
+	if in.FailedBuildsHistoryLimit != nil {
+		out.FailedBuildsHistoryLimit = new(int32)
+		*out.FailedBuildsHistoryLimit = *in.FailedBuildsHistoryLimit
+	} else {
+		out.FailedBuildsHistoryLimit = nil
+	}
 	return nil
 }
 
@@ -1325,9 +1371,41 @@ func autoconvert_api_BuildOutput_To_v1_BuildOutput(in *buildapi.BuildOutput, out
 	} else {
 		out.PushSecret = nil
 	}
+	if in.AdditionalTargets != nil {
+		out.AdditionalTargets = make([]apiv1.BuildOutputTarget, len(in.AdditionalTargets))
+		for i := range in.AdditionalTargets {
+			if err := convert_api_BuildOutputTarget_To_v1_BuildOutputTarget(&in.AdditionalTargets[i], &out.AdditionalTargets[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.AdditionalTargets = nil
+	}
+	return nil
+}
+
+func autoconvert_api_BuildOutputTarget_To_v1_BuildOutputTarget(in *buildapi.BuildOutputTarget, out *apiv1.BuildOutputTarget, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.BuildOutputTarget))(in)
+	}
+	if err := convert_api_ObjectReference_To_v1_ObjectReference(&in.To, &out.To, s); err != nil {
+		return err
+	}
+	if in.PushSecret != nil {
+		out.PushSecret = new(pkgapiv1.LocalObjectReference)
+		if err := convert_api_LocalObjectReference_To_v1_LocalObjectReference(in.PushSecret, out.PushSecret, s); err != nil {
+			return err
+		}
+	} else {
+		out.PushSecret = nil
+	}
 	return nil
 }
 
+func convert_api_BuildOutputTarget_To_v1_BuildOutputTarget(in *buildapi.BuildOutputTarget, out *apiv1.BuildOutputTarget, s conversion.Scope) error {
+	return autoconvert_api_BuildOutputTarget_To_v1_BuildOutputTarget(in, out, s)
+}
+
 func autoconvert_api_BuildRequest_To_v1_BuildRequest(in *buildapi.BuildRequest, out *apiv1.BuildRequest, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.BuildRequest))(in)
@@ -1519,6 +1597,24 @@ func autoconvert_api_BuildStatus_To_v1_BuildStatus(in *buildapi.BuildStatus, out
 	} else {
 		out.Config = nil
 	}
+	if in.Stages != nil {
+		out.Stages = make([]apiv1.StageInfo, len(in.Stages))
+		for i := range in.Stages {
+			if err := convert_api_StageInfo_To_v1_StageInfo(&in.Stages[i], &out.Stages[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Stages = nil
+	}
+	if in.OutputDockerImageReferences != nil {
+		out.OutputDockerImageReferences = make([]string, len(in.OutputDockerImageReferences))
+		for i := range in.OutputDockerImageReferences {
+			out.OutputDockerImageReferences[i] = in.OutputDockerImageReferences[i]
+		}
+	} else {
+		out.OutputDockerImageReferences = nil
+	}
 	return nil
 }
 
@@ -1551,6 +1647,14 @@ func autoconvert_api_BuildStrategy_To_v1_BuildStrategy(in *buildapi.BuildStrateg
 	} else {
 		out.CustomStrategy = nil
 	}
+	if in.PipelineStrategy != nil {
+		out.PipelineStrategy = new(apiv1.PipelineBuildStrategy)
+		if err := convert_api_PipelineBuildStrategy_To_v1_PipelineBuildStrategy(in.PipelineStrategy, out.PipelineStrategy, s); err != nil {
+			return err
+		}
+	} else {
+		out.PipelineStrategy = nil
+	}
 	return nil
 }
 
@@ -1575,6 +1679,22 @@ func autoconvert_api_BuildTriggerPolicy_To_v1_BuildTriggerPolicy(in *buildapi.Bu
 	} else {
 		out.GenericWebHook = nil
 	}
+	if in.GitLabWebHook != nil {
+		out.GitLabWebHook = new(apiv1.WebHookTrigger)
+		if err := convert_api_WebHookTrigger_To_v1_WebHookTrigger(in.GitLabWebHook, out.GitLabWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.GitLabWebHook = nil
+	}
+	if in.BitbucketWebHook != nil {
+		out.BitbucketWebHook = new(apiv1.WebHookTrigger)
+		if err := convert_api_WebHookTrigger_To_v1_WebHookTrigger(in.BitbucketWebHook, out.BitbucketWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.BitbucketWebHook = nil
+	}
 	if in.ImageChange != nil {
 		out.ImageChange = new(apiv1.ImageChangeTrigger)
 		if err := convert_api_ImageChangeTrigger_To_v1_ImageChangeTrigger(in.ImageChange, out.ImageChange, s); err != nil {
@@ -1772,6 +1892,29 @@ func convert_api_ImageSourcePath_To_v1_ImageSourcePath(in *buildapi.ImageSourceP
 	return autoconvert_api_ImageSourcePath_To_v1_ImageSourcePath(in, out, s)
 }
 
+func autoconvert_api_PipelineBuildStrategy_To_v1_PipelineBuildStrategy(in *buildapi.PipelineBuildStrategy, out *apiv1.PipelineBuildStrategy, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.PipelineBuildStrategy))(in)
+	}
+	out.Jenkinsfile = in.Jenkinsfile
+	out.JenkinsfilePath = in.JenkinsfilePath
+	if in.Env != nil {
+		out.Env = make([]pkgapiv1.EnvVar, len(in.Env))
+		for i := range in.Env {
+			if err := convert_api_EnvVar_To_v1_EnvVar(&in.Env[i], &out.Env[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Env = nil
+	}
+	return nil
+}
+
+func convert_api_PipelineBuildStrategy_To_v1_PipelineBuildStrategy(in *buildapi.PipelineBuildStrategy, out *apiv1.PipelineBuildStrategy, s conversion.Scope) error {
+	return autoconvert_api_PipelineBuildStrategy_To_v1_PipelineBuildStrategy(in, out, s)
+}
+
 func autoconvert_api_SecretBuildSource_To_v1_SecretBuildSource(in *buildapi.SecretBuildSource, out *apiv1.SecretBuildSource, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.SecretBuildSource))(in)
@@ -1861,6 +2004,33 @@ func autoconvert_api_SourceRevision_To_v1_SourceRevision(in *buildapi.SourceRevi
 	return nil
 }
 
+func autoconvert_api_StageInfo_To_v1_StageInfo(in *buildapi.StageInfo, out *apiv1.StageInfo, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.StageInfo))(in)
+	}
+	out.Name = in.Name
+	out.Phase = apiv1.BuildPhase(in.Phase)
+	if in.StartTimestamp != nil {
+		if err := s.Convert(&in.StartTimestamp, &out.StartTimestamp, 0); err != nil {
+			return err
+		}
+	} else {
+		out.StartTimestamp = nil
+	}
+	if in.CompletionTimestamp != nil {
+		if err := s.Convert(&in.CompletionTimestamp, &out.CompletionTimestamp, 0); err != nil {
+			return err
+		}
+	} else {
+		out.CompletionTimestamp = nil
+	}
+	return nil
+}
+
+func convert_api_StageInfo_To_v1_StageInfo(in *buildapi.StageInfo, out *apiv1.StageInfo, s conversion.Scope) error {
+	return autoconvert_api_StageInfo_To_v1_StageInfo(in, out, s)
+}
+
 func autoconvert_api_WebHookTrigger_To_v1_WebHookTrigger(in *buildapi.WebHookTrigger, out *apiv1.WebHookTrigger, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.WebHookTrigger))(in)
@@ -1996,9 +2166,22 @@ func autoconvert_v1_BuildConfigSpec_To_api_BuildConfigSpec(in *apiv1.BuildConfig
 	} else {
 		out.Triggers = nil
 	}
+	out.RunPolicy = buildapi.BuildRunPolicy(in.RunPolicy)
 	if err := convert_v1_BuildSpec_To_api_BuildSpec(&in.BuildSpec, &out.BuildSpec, s); err != nil {
 		return err
 	}
+	if in.SuccessfulBuildsHistoryLimit != nil {
+		out.SuccessfulBuildsHistoryLimit = new(int32)
+		*out.SuccessfulBuildsHistoryLimit = *in.SuccessfulBuildsHistoryLimit
+	} else {
+		out.SuccessfulBuildsHistoryLimit = nil
+	}
+	if in.FailedBuildsHistoryLimit != nil {
+		out.FailedBuildsHistoryLimit = new(int32)
+		*out.FailedBuildsHistoryLimit = *in.FailedBuildsHistoryLimit
+	} else {
+		out.FailedBuildsHistoryLimit = nil
+	}
 	return nil
 }
 
@@ -2129,9 +2312,41 @@ func autoconvert_v1_BuildOutput_To_api_BuildOutput(in *apiv1.BuildOutput, out *b
 	} else {
 		out.PushSecret = nil
 	}
+	if in.AdditionalTargets != nil {
+		out.AdditionalTargets = make([]buildapi.BuildOutputTarget, len(in.AdditionalTargets))
+		for i := range in.AdditionalTargets {
+			if err := convert_v1_BuildOutputTarget_To_api_BuildOutputTarget(&in.AdditionalTargets[i], &out.AdditionalTargets[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.AdditionalTargets = nil
+	}
+	return nil
+}
+
+func autoconvert_v1_BuildOutputTarget_To_api_BuildOutputTarget(in *apiv1.BuildOutputTarget, out *buildapi.BuildOutputTarget, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1.BuildOutputTarget))(in)
+	}
+	if err := convert_v1_ObjectReference_To_api_ObjectReference(&in.To, &out.To, s); err != nil {
+		return err
+	}
+	if in.PushSecret != nil {
+		out.PushSecret = new(pkgapi.LocalObjectReference)
+		if err := convert_v1_LocalObjectReference_To_api_LocalObjectReference(in.PushSecret, out.PushSecret, s); err != nil {
+			return err
+		}
+	} else {
+		out.PushSecret = nil
+	}
 	return nil
 }
 
+func convert_v1_BuildOutputTarget_To_api_BuildOutputTarget(in *apiv1.BuildOutputTarget, out *buildapi.BuildOutputTarget, s conversion.Scope) error {
+	return autoconvert_v1_BuildOutputTarget_To_api_BuildOutputTarget(in, out, s)
+}
+
 func autoconvert_v1_BuildRequest_To_api_BuildRequest(in *apiv1.BuildRequest, out *buildapi.BuildRequest, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*apiv1.BuildRequest))(in)
@@ -2324,6 +2539,24 @@ func autoconvert_v1_BuildStatus_To_api_BuildStatus(in *apiv1.BuildStatus, out *b
 	} else {
 		out.Config = nil
 	}
+	if in.Stages != nil {
+		out.Stages = make([]buildapi.StageInfo, len(in.Stages))
+		for i := range in.Stages {
+			if err := convert_v1_StageInfo_To_api_StageInfo(&in.Stages[i], &out.Stages[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Stages = nil
+	}
+	if in.OutputDockerImageReferences != nil {
+		out.OutputDockerImageReferences = make([]string, len(in.OutputDockerImageReferences))
+		for i := range in.OutputDockerImageReferences {
+			out.OutputDockerImageReferences[i] = in.OutputDockerImageReferences[i]
+		}
+	} else {
+		out.OutputDockerImageReferences = nil
+	}
 	return nil
 }
 
@@ -2357,6 +2590,14 @@ func autoconvert_v1_BuildStrategy_To_api_BuildStrategy(in *apiv1.BuildStrategy,
 	} else {
 		out.CustomStrategy = nil
 	}
+	if in.PipelineStrategy != nil {
+		out.PipelineStrategy = new(buildapi.PipelineBuildStrategy)
+		if err := convert_v1_PipelineBuildStrategy_To_api_PipelineBuildStrategy(in.PipelineStrategy, out.PipelineStrategy, s); err != nil {
+			return err
+		}
+	} else {
+		out.PipelineStrategy = nil
+	}
 	return nil
 }
 
@@ -2381,6 +2622,22 @@ func autoconvert_v1_BuildTriggerPolicy_To_api_BuildTriggerPolicy(in *apiv1.Build
 	} else {
 		out.GenericWebHook = nil
 	}
+	if in.GitLabWebHook != nil {
+		out.GitLabWebHook = new(buildapi.WebHookTrigger)
+		if err := convert_v1_WebHookTrigger_To_api_WebHookTrigger(in.GitLabWebHook, out.GitLabWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.GitLabWebHook = nil
+	}
+	if in.BitbucketWebHook != nil {
+		out.BitbucketWebHook = new(buildapi.WebHookTrigger)
+		if err := convert_v1_WebHookTrigger_To_api_WebHookTrigger(in.BitbucketWebHook, out.BitbucketWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.BitbucketWebHook = nil
+	}
 	if in.ImageChange != nil {
 		out.ImageChange = new(buildapi.ImageChangeTrigger)
 		if err := convert_v1_ImageChangeTrigger_To_api_ImageChangeTrigger(in.ImageChange, out.ImageChange, s); err != nil {
@@ -2578,6 +2835,29 @@ func convert_v1_ImageSourcePath_To_api_ImageSourcePath(in *apiv1.ImageSourcePath
 	return autoconvert_v1_ImageSourcePath_To_api_ImageSourcePath(in, out, s)
 }
 
+func autoconvert_v1_PipelineBuildStrategy_To_api_PipelineBuildStrategy(in *apiv1.PipelineBuildStrategy, out *buildapi.PipelineBuildStrategy, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1.PipelineBuildStrategy))(in)
+	}
+	out.Jenkinsfile = in.Jenkinsfile
+	out.JenkinsfilePath = in.JenkinsfilePath
+	if in.Env != nil {
+		out.Env = make([]pkgapi.EnvVar, len(in.Env))
+		for i := range in.Env {
+			if err := convert_v1_EnvVar_To_api_EnvVar(&in.Env[i], &out.Env[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Env = nil
+	}
+	return nil
+}
+
+func convert_v1_PipelineBuildStrategy_To_api_PipelineBuildStrategy(in *apiv1.PipelineBuildStrategy, out *buildapi.PipelineBuildStrategy, s conversion.Scope) error {
+	return autoconvert_v1_PipelineBuildStrategy_To_api_PipelineBuildStrategy(in, out, s)
+}
+
 func autoconvert_v1_SecretBuildSource_To_api_SecretBuildSource(in *apiv1.SecretBuildSource, out *buildapi.SecretBuildSource, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*apiv1.SecretBuildSource))(in)
@@ -2668,6 +2948,33 @@ func autoconvert_v1_SourceRevision_To_api_SourceRevision(in *apiv1.SourceRevisio
 	return nil
 }
 
+func autoconvert_v1_StageInfo_To_api_StageInfo(in *apiv1.StageInfo, out *buildapi.StageInfo, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1.StageInfo))(in)
+	}
+	out.Name = in.Name
+	out.Phase = buildapi.BuildPhase(in.Phase)
+	if in.StartTimestamp != nil {
+		if err := s.Convert(&in.StartTimestamp, &out.StartTimestamp, 0); err != nil {
+			return err
+		}
+	} else {
+		out.StartTimestamp = nil
+	}
+	if in.CompletionTimestamp != nil {
+		if err := s.Convert(&in.CompletionTimestamp, &out.CompletionTimestamp, 0); err != nil {
+			return err
+		}
+	} else {
+		out.CompletionTimestamp = nil
+	}
+	return nil
+}
+
+func convert_v1_StageInfo_To_api_StageInfo(in *apiv1.StageInfo, out *buildapi.StageInfo, s conversion.Scope) error {
+	return autoconvert_v1_StageInfo_To_api_StageInfo(in, out, s)
+}
+
 func autoconvert_v1_WebHookTrigger_To_api_WebHookTrigger(in *apiv1.WebHookTrigger, out *buildapi.WebHookTrigger, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*apiv1.WebHookTrigger))(in)
@@ -2680,6 +2987,46 @@ func convert_v1_WebHookTrigger_To_api_WebHookTrigger(in *apiv1.WebHookTrigger, o
 	return autoconvert_v1_WebHookTrigger_To_api_WebHookTrigger(in, out, s)
 }
 
+func autoconvert_api_BlueGreenDeploymentStrategyParams_To_v1_BlueGreenDeploymentStrategyParams(in *deployapi.BlueGreenDeploymentStrategyParams, out *deployapiv1.BlueGreenDeploymentStrategyParams, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.BlueGreenDeploymentStrategyParams))(in)
+	}
+	out.ServiceName = in.ServiceName
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	if in.VerificationSeconds != nil {
+		out.VerificationSeconds = new(int64)
+		*out.VerificationSeconds = *in.VerificationSeconds
+	} else {
+		out.VerificationSeconds = nil
+	}
+	if in.Pre != nil {
+		out.Pre = new(deployapiv1.LifecycleHook)
+		if err := convert_api_LifecycleHook_To_v1_LifecycleHook(in.Pre, out.Pre, s); err != nil {
+			return err
+		}
+	} else {
+		out.Pre = nil
+	}
+	if in.Post != nil {
+		out.Post = new(deployapiv1.LifecycleHook)
+		if err := convert_api_LifecycleHook_To_v1_LifecycleHook(in.Post, out.Post, s); err != nil {
+			return err
+		}
+	} else {
+		out.Post = nil
+	}
+	return nil
+}
+
+func convert_api_BlueGreenDeploymentStrategyParams_To_v1_BlueGreenDeploymentStrategyParams(in *deployapi.BlueGreenDeploymentStrategyParams, out *deployapiv1.BlueGreenDeploymentStrategyParams, s conversion.Scope) error {
+	return autoconvert_api_BlueGreenDeploymentStrategyParams_To_v1_BlueGreenDeploymentStrategyParams(in, out, s)
+}
+
 func autoconvert_api_CustomDeploymentStrategyParams_To_v1_CustomDeploymentStrategyParams(in *deployapi.CustomDeploymentStrategyParams, out *deployapiv1.CustomDeploymentStrategyParams, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapi.CustomDeploymentStrategyParams))(in)
@@ -3005,6 +3352,14 @@ func autoconvert_api_DeploymentStrategy_To_v1_DeploymentStrategy(in *deployapi.D
 	} else {
 		out.RollingParams = nil
 	}
+	if in.BlueGreenParams != nil {
+		out.BlueGreenParams = new(deployapiv1.BlueGreenDeploymentStrategyParams)
+		if err := convert_api_BlueGreenDeploymentStrategyParams_To_v1_BlueGreenDeploymentStrategyParams(in.BlueGreenParams, out.BlueGreenParams, s); err != nil {
+			return err
+		}
+	} else {
+		out.BlueGreenParams = nil
+	}
 	if err := convert_api_ResourceRequirements_To_v1_ResourceRequirements(&in.Resources, &out.Resources, s); err != nil {
 		return err
 	}
@@ -3203,6 +3558,16 @@ func autoconvert_api_RollingDeploymentStrategyParams_To_v1_RollingDeploymentStra
 	} else {
 		out.UpdatePercent = nil
 	}
+	if in.PausePoints != nil {
+		out.PausePoints = make([]intstr.IntOrString, len(in.PausePoints))
+		for i := range in.PausePoints {
+			if err := s.Convert(&in.PausePoints[i], &out.PausePoints[i], 0); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.PausePoints = nil
+	}
 	if in.Pre != nil {
 		out.Pre = new(deployapiv1.LifecycleHook)
 		if err := convert_api_LifecycleHook_To_v1_LifecycleHook(in.Pre, out.Pre, s); err != nil {
@@ -3222,6 +3587,46 @@ func autoconvert_api_RollingDeploymentStrategyParams_To_v1_RollingDeploymentStra
 	return nil
 }
 
+func autoconvert_v1_BlueGreenDeploymentStrategyParams_To_api_BlueGreenDeploymentStrategyParams(in *deployapiv1.BlueGreenDeploymentStrategyParams, out *deployapi.BlueGreenDeploymentStrategyParams, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1.BlueGreenDeploymentStrategyParams))(in)
+	}
+	out.ServiceName = in.ServiceName
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	if in.VerificationSeconds != nil {
+		out.VerificationSeconds = new(int64)
+		*out.VerificationSeconds = *in.VerificationSeconds
+	} else {
+		out.VerificationSeconds = nil
+	}
+	if in.Pre != nil {
+		out.Pre = new(deployapi.LifecycleHook)
+		if err := convert_v1_LifecycleHook_To_api_LifecycleHook(in.Pre, out.Pre, s); err != nil {
+			return err
+		}
+	} else {
+		out.Pre = nil
+	}
+	if in.Post != nil {
+		out.Post = new(deployapi.LifecycleHook)
+		if err := convert_v1_LifecycleHook_To_api_LifecycleHook(in.Post, out.Post, s); err != nil {
+			return err
+		}
+	} else {
+		out.Post = nil
+	}
+	return nil
+}
+
+func convert_v1_BlueGreenDeploymentStrategyParams_To_api_BlueGreenDeploymentStrategyParams(in *deployapiv1.BlueGreenDeploymentStrategyParams, out *deployapi.BlueGreenDeploymentStrategyParams, s conversion.Scope) error {
+	return autoconvert_v1_BlueGreenDeploymentStrategyParams_To_api_BlueGreenDeploymentStrategyParams(in, out, s)
+}
+
 func autoconvert_v1_CustomDeploymentStrategyParams_To_api_CustomDeploymentStrategyParams(in *deployapiv1.CustomDeploymentStrategyParams, out *deployapi.CustomDeploymentStrategyParams, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapiv1.CustomDeploymentStrategyParams))(in)
@@ -3547,6 +3952,14 @@ func autoconvert_v1_DeploymentStrategy_To_api_DeploymentStrategy(in *deployapiv1
 	} else {
 		out.RollingParams = nil
 	}
+	if in.BlueGreenParams != nil {
+		out.BlueGreenParams = new(deployapi.BlueGreenDeploymentStrategyParams)
+		if err := convert_v1_BlueGreenDeploymentStrategyParams_To_api_BlueGreenDeploymentStrategyParams(in.BlueGreenParams, out.BlueGreenParams, s); err != nil {
+			return err
+		}
+	} else {
+		out.BlueGreenParams = nil
+	}
 	if err := convert_v1_ResourceRequirements_To_api_ResourceRequirements(&in.Resources, &out.Resources, s); err != nil {
 		return err
 	}
@@ -3741,6 +4154,16 @@ func autoconvert_v1_RollingDeploymentStrategyParams_To_api_RollingDeploymentStra
 	} else {
 		out.UpdatePercent = nil
 	}
+	if in.PausePoints != nil {
+		out.PausePoints = make([]intstr.IntOrString, len(in.PausePoints))
+		for i := range in.PausePoints {
+			if err := s.Convert(&in.PausePoints[i], &out.PausePoints[i], 0); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.PausePoints = nil
+	}
 	if in.Pre != nil {
 		out.Pre = new(deployapi.LifecycleHook)
 		if err := convert_v1_LifecycleHook_To_api_LifecycleHook(in.Pre, out.Pre, s); err != nil {
@@ -3786,6 +4209,16 @@ func autoconvert_api_Image_To_v1_Image(in *imageapi.Image, out *imageapiv1.Image
 	} else {
 		out.DockerImageLayers = nil
 	}
+	if in.Signatures != nil {
+		out.Signatures = make([]imageapiv1.ImageSignature, len(in.Signatures))
+		for i := range in.Signatures {
+			if err := s.Convert(&in.Signatures[i], &out.Signatures[i], 0); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Signatures = nil
+	}
 	return nil
 }
 
@@ -4200,6 +4633,16 @@ func autoconvert_v1_Image_To_api_Image(in *imageapiv1.Image, out *imageapi.Image
 	} else {
 		out.DockerImageLayers = nil
 	}
+	if in.Signatures != nil {
+		out.Signatures = make([]imageapi.ImageSignature, len(in.Signatures))
+		for i := range in.Signatures {
+			if err := s.Convert(&in.Signatures[i], &out.Signatures[i], 0); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Signatures = nil
+	}
 	return nil
 }
 
@@ -5262,6 +5705,51 @@ func convert_api_Route_To_v1_Route(in *routeapi.Route, out *routeapiv1.Route, s
 	return autoconvert_api_Route_To_v1_Route(in, out, s)
 }
 
+func autoconvert_api_RouteIngress_To_v1_RouteIngress(in *routeapi.RouteIngress, out *routeapiv1.RouteIngress, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*routeapi.RouteIngress))(in)
+	}
+	out.Host = in.Host
+	out.RouterName = in.RouterName
+	if in.Conditions != nil {
+		out.Conditions = make([]routeapiv1.RouteIngressCondition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := convert_api_RouteIngressCondition_To_v1_RouteIngressCondition(&in.Conditions[i], &out.Conditions[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
+	return nil
+}
+
+func convert_api_RouteIngress_To_v1_RouteIngress(in *routeapi.RouteIngress, out *routeapiv1.RouteIngress, s conversion.Scope) error {
+	return autoconvert_api_RouteIngress_To_v1_RouteIngress(in, out, s)
+}
+
+func autoconvert_api_RouteIngressCondition_To_v1_RouteIngressCondition(in *routeapi.RouteIngressCondition, out *routeapiv1.RouteIngressCondition, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*routeapi.RouteIngressCondition))(in)
+	}
+	out.Type = routeapiv1.RouteIngressConditionType(in.Type)
+	out.Status = pkgapiv1.ConditionStatus(in.Status)
+	out.Reason = in.Reason
+	out.Message = in.Message
+	if in.LastTransitionTime != nil {
+		if err := s.Convert(&in.LastTransitionTime, &out.LastTransitionTime, 0); err != nil {
+			return err
+		}
+	} else {
+		out.LastTransitionTime = nil
+	}
+	return nil
+}
+
+func convert_api_RouteIngressCondition_To_v1_RouteIngressCondition(in *routeapi.RouteIngressCondition, out *routeapiv1.RouteIngressCondition, s conversion.Scope) error {
+	return autoconvert_api_RouteIngressCondition_To_v1_RouteIngressCondition(in, out, s)
+}
+
 func autoconvert_api_RouteList_To_v1_RouteList(in *routeapi.RouteList, out *routeapiv1.RouteList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*routeapi.RouteList))(in)
@@ -5309,9 +5797,19 @@ func autoconvert_api_RouteSpec_To_v1_RouteSpec(in *routeapi.RouteSpec, out *rout
 	}
 	out.Host = in.Host
 	out.Path = in.Path
-	if err := convert_api_ObjectReference_To_v1_ObjectReference(&in.To, &out.To, s); err != nil {
+	if err := convert_api_RouteTargetReference_To_v1_RouteTargetReference(&in.To, &out.To, s); err != nil {
 		return err
 	}
+	if in.AlternateBackends != nil {
+		out.AlternateBackends = make([]routeapiv1.RouteTargetReference, len(in.AlternateBackends))
+		for i := range in.AlternateBackends {
+			if err := convert_api_RouteTargetReference_To_v1_RouteTargetReference(&in.AlternateBackends[i], &out.AlternateBackends[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.AlternateBackends = nil
+	}
 	if in.Port != nil {
 		out.Port = new(routeapiv1.RoutePort)
 		if err := convert_api_RoutePort_To_v1_RoutePort(in.Port, out.Port, s); err != nil {
@@ -5328,6 +5826,7 @@ func autoconvert_api_RouteSpec_To_v1_RouteSpec(in *routeapi.RouteSpec, out *rout
 	} else {
 		out.TLS = nil
 	}
+	out.WildcardPolicy = routeapiv1.WildcardPolicyType(in.WildcardPolicy)
 	return nil
 }
 
@@ -5339,6 +5838,16 @@ func autoconvert_api_RouteStatus_To_v1_RouteStatus(in *routeapi.RouteStatus, out
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*routeapi.RouteStatus))(in)
 	}
+	if in.Ingress != nil {
+		out.Ingress = make([]routeapiv1.RouteIngress, len(in.Ingress))
+		for i := range in.Ingress {
+			if err := convert_api_RouteIngress_To_v1_RouteIngress(&in.Ingress[i], &out.Ingress[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Ingress = nil
+	}
 	return nil
 }
 
@@ -5346,6 +5855,25 @@ func convert_api_RouteStatus_To_v1_RouteStatus(in *routeapi.RouteStatus, out *ro
 	return autoconvert_api_RouteStatus_To_v1_RouteStatus(in, out, s)
 }
 
+func autoconvert_api_RouteTargetReference_To_v1_RouteTargetReference(in *routeapi.RouteTargetReference, out *routeapiv1.RouteTargetReference, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*routeapi.RouteTargetReference))(in)
+	}
+	out.Kind = in.Kind
+	out.Name = in.Name
+	if in.Weight != nil {
+		out.Weight = new(int32)
+		*out.Weight = *in.Weight
+	} else {
+		out.Weight = nil
+	}
+	return nil
+}
+
+func convert_api_RouteTargetReference_To_v1_RouteTargetReference(in *routeapi.RouteTargetReference, out *routeapiv1.RouteTargetReference, s conversion.Scope) error {
+	return autoconvert_api_RouteTargetReference_To_v1_RouteTargetReference(in, out, s)
+}
+
 func autoconvert_api_TLSConfig_To_v1_TLSConfig(in *routeapi.TLSConfig, out *routeapiv1.TLSConfig, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*routeapi.TLSConfig))(in)
@@ -5386,6 +5914,51 @@ func convert_v1_Route_To_api_Route(in *routeapiv1.Route, out *routeapi.Route, s
 	return autoconvert_v1_Route_To_api_Route(in, out, s)
 }
 
+func autoconvert_v1_RouteIngress_To_api_RouteIngress(in *routeapiv1.RouteIngress, out *routeapi.RouteIngress, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*routeapiv1.RouteIngress))(in)
+	}
+	out.Host = in.Host
+	out.RouterName = in.RouterName
+	if in.Conditions != nil {
+		out.Conditions = make([]routeapi.RouteIngressCondition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := convert_v1_RouteIngressCondition_To_api_RouteIngressCondition(&in.Conditions[i], &out.Conditions[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
+	return nil
+}
+
+func convert_v1_RouteIngress_To_api_RouteIngress(in *routeapiv1.RouteIngress, out *routeapi.RouteIngress, s conversion.Scope) error {
+	return autoconvert_v1_RouteIngress_To_api_RouteIngress(in, out, s)
+}
+
+func autoconvert_v1_RouteIngressCondition_To_api_RouteIngressCondition(in *routeapiv1.RouteIngressCondition, out *routeapi.RouteIngressCondition, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*routeapiv1.RouteIngressCondition))(in)
+	}
+	out.Type = routeapi.RouteIngressConditionType(in.Type)
+	out.Status = pkgapi.ConditionStatus(in.Status)
+	out.Reason = in.Reason
+	out.Message = in.Message
+	if in.LastTransitionTime != nil {
+		if err := s.Convert(&in.LastTransitionTime, &out.LastTransitionTime, 0); err != nil {
+			return err
+		}
+	} else {
+		out.LastTransitionTime = nil
+	}
+	return nil
+}
+
+func convert_v1_RouteIngressCondition_To_api_RouteIngressCondition(in *routeapiv1.RouteIngressCondition, out *routeapi.RouteIngressCondition, s conversion.Scope) error {
+	return autoconvert_v1_RouteIngressCondition_To_api_RouteIngressCondition(in, out, s)
+}
+
 func autoconvert_v1_RouteList_To_api_RouteList(in *routeapiv1.RouteList, out *routeapi.RouteList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*routeapiv1.RouteList))(in)
@@ -5433,9 +6006,19 @@ func autoconvert_v1_RouteSpec_To_api_RouteSpec(in *routeapiv1.RouteSpec, out *ro
 	}
 	out.Host = in.Host
 	out.Path = in.Path
-	if err := convert_v1_ObjectReference_To_api_ObjectReference(&in.To, &out.To, s); err != nil {
+	if err := convert_v1_RouteTargetReference_To_api_RouteTargetReference(&in.To, &out.To, s); err != nil {
 		return err
 	}
+	if in.AlternateBackends != nil {
+		out.AlternateBackends = make([]routeapi.RouteTargetReference, len(in.AlternateBackends))
+		for i := range in.AlternateBackends {
+			if err := convert_v1_RouteTargetReference_To_api_RouteTargetReference(&in.AlternateBackends[i], &out.AlternateBackends[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.AlternateBackends = nil
+	}
 	if in.Port != nil {
 		out.Port = new(routeapi.RoutePort)
 		if err := convert_v1_RoutePort_To_api_RoutePort(in.Port, out.Port, s); err != nil {
@@ -5452,6 +6035,7 @@ func autoconvert_v1_RouteSpec_To_api_RouteSpec(in *routeapiv1.RouteSpec, out *ro
 	} else {
 		out.TLS = nil
 	}
+	out.WildcardPolicy = routeapi.WildcardPolicyType(in.WildcardPolicy)
 	return nil
 }
 
@@ -5463,6 +6047,16 @@ func autoconvert_v1_RouteStatus_To_api_RouteStatus(in *routeapiv1.RouteStatus, o
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*routeapiv1.RouteStatus))(in)
 	}
+	if in.Ingress != nil {
+		out.Ingress = make([]routeapi.RouteIngress, len(in.Ingress))
+		for i := range in.Ingress {
+			if err := convert_v1_RouteIngress_To_api_RouteIngress(&in.Ingress[i], &out.Ingress[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Ingress = nil
+	}
 	return nil
 }
 
@@ -5470,6 +6064,25 @@ func convert_v1_RouteStatus_To_api_RouteStatus(in *routeapiv1.RouteStatus, out *
 	return autoconvert_v1_RouteStatus_To_api_RouteStatus(in, out, s)
 }
 
+func autoconvert_v1_RouteTargetReference_To_api_RouteTargetReference(in *routeapiv1.RouteTargetReference, out *routeapi.RouteTargetReference, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*routeapiv1.RouteTargetReference))(in)
+	}
+	out.Kind = in.Kind
+	out.Name = in.Name
+	if in.Weight != nil {
+		out.Weight = new(int32)
+		*out.Weight = *in.Weight
+	} else {
+		out.Weight = nil
+	}
+	return nil
+}
+
+func convert_v1_RouteTargetReference_To_api_RouteTargetReference(in *routeapiv1.RouteTargetReference, out *routeapi.RouteTargetReference, s conversion.Scope) error {
+	return autoconvert_v1_RouteTargetReference_To_api_RouteTargetReference(in, out, s)
+}
+
 func autoconvert_v1_TLSConfig_To_api_TLSConfig(in *routeapiv1.TLSConfig, out *routeapi.TLSConfig, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*routeapiv1.TLSConfig))(in)
@@ -8397,6 +9010,7 @@ func init() {
 		autoconvert_api_AWSElasticBlockStoreVolumeSource_To_v1_AWSElasticBlockStoreVolumeSource,
 		autoconvert_api_BinaryBuildRequestOptions_To_v1_BinaryBuildRequestOptions,
 		autoconvert_api_BinaryBuildSource_To_v1_BinaryBuildSource,
+		autoconvert_api_BlueGreenDeploymentStrategyParams_To_v1_BlueGreenDeploymentStrategyParams,
 		autoconvert_api_BuildConfigList_To_v1_BuildConfigList,
 		autoconvert_api_BuildConfigSpec_To_v1_BuildConfigSpec,
 		autoconvert_api_BuildConfigStatus_To_v1_BuildConfigStatus,
@@ -8404,6 +9018,7 @@ func init() {
 		autoconvert_api_BuildList_To_v1_BuildList,
 		autoconvert_api_BuildLogOptions_To_v1_BuildLogOptions,
 		autoconvert_api_BuildLog_To_v1_BuildLog,
+		autoconvert_api_BuildOutputTarget_To_v1_BuildOutputTarget,
 		autoconvert_api_BuildOutput_To_v1_BuildOutput,
 		autoconvert_api_BuildRequest_To_v1_BuildRequest,
 		autoconvert_api_BuildSource_To_v1_BuildSource,
@@ -8508,6 +9123,7 @@ func init() {
 		autoconvert_api_ObjectReference_To_v1_ObjectReference,
 		autoconvert_api_Parameter_To_v1_Parameter,
 		autoconvert_api_PersistentVolumeClaimVolumeSource_To_v1_PersistentVolumeClaimVolumeSource,
+		autoconvert_api_PipelineBuildStrategy_To_v1_PipelineBuildStrategy,
 		autoconvert_api_PodSpec_To_v1_PodSpec,
 		autoconvert_api_PodTemplateSpec_To_v1_PodTemplateSpec,
 		autoconvert_api_PolicyBindingList_To_v1_PolicyBindingList,
@@ -8533,10 +9149,13 @@ func init() {
 		autoconvert_api_RoleList_To_v1_RoleList,
 		autoconvert_api_Role_To_v1_Role,
 		autoconvert_api_RollingDeploymentStrategyParams_To_v1_RollingDeploymentStrategyParams,
+		autoconvert_api_RouteIngressCondition_To_v1_RouteIngressCondition,
+		autoconvert_api_RouteIngress_To_v1_RouteIngress,
 		autoconvert_api_RouteList_To_v1_RouteList,
 		autoconvert_api_RoutePort_To_v1_RoutePort,
 		autoconvert_api_RouteSpec_To_v1_RouteSpec,
 		autoconvert_api_RouteStatus_To_v1_RouteStatus,
+		autoconvert_api_RouteTargetReference_To_v1_RouteTargetReference,
 		autoconvert_api_Route_To_v1_Route,
 		autoconvert_api_SELinuxOptions_To_v1_SELinuxOptions,
 		autoconvert_api_SecretBuildSource_To_v1_SecretBuildSource,
@@ -8546,6 +9165,7 @@ func init() {
 		autoconvert_api_SourceBuildStrategy_To_v1_SourceBuildStrategy,
 		autoconvert_api_SourceControlUser_To_v1_SourceControlUser,
 		autoconvert_api_SourceRevision_To_v1_SourceRevision,
+		autoconvert_api_StageInfo_To_v1_StageInfo,
 		autoconvert_api_SubjectAccessReviewResponse_To_v1_SubjectAccessReviewResponse,
 		autoconvert_api_SubjectAccessReview_To_v1_SubjectAccessReview,
 		autoconvert_api_TCPSocketAction_To_v1_TCPSocketAction,
@@ -8563,6 +9183,7 @@ func init() {
 		autoconvert_v1_AWSElasticBlockStoreVolumeSource_To_api_AWSElasticBlockStoreVolumeSource,
 		autoconvert_v1_BinaryBuildRequestOptions_To_api_BinaryBuildRequestOptions,
 		autoconvert_v1_BinaryBuildSource_To_api_BinaryBuildSource,
+		autoconvert_v1_BlueGreenDeploymentStrategyParams_To_api_BlueGreenDeploymentStrategyParams,
 		autoconvert_v1_BuildConfigList_To_api_BuildConfigList,
 		autoconvert_v1_BuildConfigSpec_To_api_BuildConfigSpec,
 		autoconvert_v1_BuildConfigStatus_To_api_BuildConfigStatus,
@@ -8570,6 +9191,7 @@ func init() {
 		autoconvert_v1_BuildList_To_api_BuildList,
 		autoconvert_v1_BuildLogOptions_To_api_BuildLogOptions,
 		autoconvert_v1_BuildLog_To_api_BuildLog,
+		autoconvert_v1_BuildOutputTarget_To_api_BuildOutputTarget,
 		autoconvert_v1_BuildOutput_To_api_BuildOutput,
 		autoconvert_v1_BuildRequest_To_api_BuildRequest,
 		autoconvert_v1_BuildSource_To_api_BuildSource,
@@ -8674,6 +9296,7 @@ func init() {
 		autoconvert_v1_ObjectReference_To_api_ObjectReference,
 		autoconvert_v1_Parameter_To_api_Parameter,
 		autoconvert_v1_PersistentVolumeClaimVolumeSource_To_api_PersistentVolumeClaimVolumeSource,
+		autoconvert_v1_PipelineBuildStrategy_To_api_PipelineBuildStrategy,
 		autoconvert_v1_PodSpec_To_api_PodSpec,
 		autoconvert_v1_PodTemplateSpec_To_api_PodTemplateSpec,
 		autoconvert_v1_PolicyBindingList_To_api_PolicyBindingList,
@@ -8699,10 +9322,13 @@ func init() {
 		autoconvert_v1_RoleList_To_api_RoleList,
 		autoconvert_v1_Role_To_api_Role,
 		autoconvert_v1_RollingDeploymentStrategyParams_To_api_RollingDeploymentStrategyParams,
+		autoconvert_v1_RouteIngressCondition_To_api_RouteIngressCondition,
+		autoconvert_v1_RouteIngress_To_api_RouteIngress,
 		autoconvert_v1_RouteList_To_api_RouteList,
 		autoconvert_v1_RoutePort_To_api_RoutePort,
 		autoconvert_v1_RouteSpec_To_api_RouteSpec,
 		autoconvert_v1_RouteStatus_To_api_RouteStatus,
+		autoconvert_v1_RouteTargetReference_To_api_RouteTargetReference,
 		autoconvert_v1_Route_To_api_Route,
 		autoconvert_v1_SELinuxOptions_To_api_SELinuxOptions,
 		autoconvert_v1_SecretBuildSource_To_api_SecretBuildSource,
@@ -8712,6 +9338,7 @@ func init() {
 		autoconvert_v1_SourceBuildStrategy_To_api_SourceBuildStrategy,
 		autoconvert_v1_SourceControlUser_To_api_SourceControlUser,
 		autoconvert_v1_SourceRevision_To_api_SourceRevision,
+		autoconvert_v1_StageInfo_To_api_StageInfo,
 		autoconvert_v1_SubjectAccessReviewResponse_To_api_SubjectAccessReviewResponse,
 		autoconvert_v1_SubjectAccessReview_To_api_SubjectAccessReview,
 		autoconvert_v1_TCPSocketAction_To_api_TCPSocketAction,
@@ -302,6 +302,14 @@ func deepCopy_v1_LocalSubjectAccessReview(in v1.LocalSubjectAccessReview, out *v
 	} else {
 		out.GroupsSlice = nil
 	}
+	if in.Scopes != nil {
+		out.Scopes = make([]string, len(in.Scopes))
+		for i := range in.Scopes {
+			out.Scopes[i] = in.Scopes[i]
+		}
+	} else {
+		out.Scopes = nil
+	}
 	return nil
 }
 
@@ -672,6 +680,14 @@ func deepCopy_v1_SubjectAccessReview(in v1.SubjectAccessReview, out *v1.SubjectA
 	} else {
 		out.GroupsSlice = nil
 	}
+	if in.Scopes != nil {
+		out.Scopes = make([]string, len(in.Scopes))
+		for i := range in.Scopes {
+			out.Scopes[i] = in.Scopes[i]
+		}
+	} else {
+		out.Scopes = nil
+	}
 	return nil
 }
 
@@ -788,9 +804,22 @@ func deepCopy_v1_BuildConfigSpec(in apiv1.BuildConfigSpec, out *apiv1.BuildConfi
 	} else {
 		out.Triggers = nil
 	}
+	out.RunPolicy = in.RunPolicy
 	if err := deepCopy_v1_BuildSpec(in.BuildSpec, &out.BuildSpec, c); err != nil {
 		return err
 	}
+	if in.SuccessfulBuildsHistoryLimit != nil {
+		out.SuccessfulBuildsHistoryLimit = new(int32)
+		*out.SuccessfulBuildsHistoryLimit = *in.SuccessfulBuildsHistoryLimit
+	} else {
+		out.SuccessfulBuildsHistoryLimit = nil
+	}
+	if in.FailedBuildsHistoryLimit != nil {
+		out.FailedBuildsHistoryLimit = new(int32)
+		*out.FailedBuildsHistoryLimit = *in.FailedBuildsHistoryLimit
+	} else {
+		out.FailedBuildsHistoryLimit = nil
+	}
 	return nil
 }
 
@@ -898,6 +927,34 @@ func deepCopy_v1_BuildOutput(in apiv1.BuildOutput, out *apiv1.BuildOutput, c *co
 	} else {
 		out.PushSecret = nil
 	}
+	if in.AdditionalTargets != nil {
+		out.AdditionalTargets = make([]apiv1.BuildOutputTarget, len(in.AdditionalTargets))
+		for i := range in.AdditionalTargets {
+			if err := deepCopy_v1_BuildOutputTarget(in.AdditionalTargets[i], &out.AdditionalTargets[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.AdditionalTargets = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_BuildOutputTarget(in apiv1.BuildOutputTarget, out *apiv1.BuildOutputTarget, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.To); err != nil {
+		return err
+	} else {
+		out.To = newVal.(pkgapiv1.ObjectReference)
+	}
+	if in.PushSecret != nil {
+		if newVal, err := c.DeepCopy(in.PushSecret); err != nil {
+			return err
+		} else {
+			out.PushSecret = newVal.(*pkgapiv1.LocalObjectReference)
+		}
+	} else {
+		out.PushSecret = nil
+	}
 	return nil
 }
 
@@ -1091,6 +1148,24 @@ func deepCopy_v1_BuildStatus(in apiv1.BuildStatus, out *apiv1.BuildStatus, c *co
 	} else {
 		out.Config = nil
 	}
+	if in.Stages != nil {
+		out.Stages = make([]apiv1.StageInfo, len(in.Stages))
+		for i := range in.Stages {
+			if err := deepCopy_v1_StageInfo(in.Stages[i], &out.Stages[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Stages = nil
+	}
+	if in.OutputDockerImageReferences != nil {
+		out.OutputDockerImageReferences = make([]string, len(in.OutputDockerImageReferences))
+		for i := range in.OutputDockerImageReferences {
+			out.OutputDockerImageReferences[i] = in.OutputDockerImageReferences[i]
+		}
+	} else {
+		out.OutputDockerImageReferences = nil
+	}
 	return nil
 }
 
@@ -1120,6 +1195,14 @@ func deepCopy_v1_BuildStrategy(in apiv1.BuildStrategy, out *apiv1.BuildStrategy,
 	} else {
 		out.CustomStrategy = nil
 	}
+	if in.PipelineStrategy != nil {
+		out.PipelineStrategy = new(apiv1.PipelineBuildStrategy)
+		if err := deepCopy_v1_PipelineBuildStrategy(*in.PipelineStrategy, out.PipelineStrategy, c); err != nil {
+			return err
+		}
+	} else {
+		out.PipelineStrategy = nil
+	}
 	return nil
 }
 
@@ -1141,6 +1224,22 @@ func deepCopy_v1_BuildTriggerPolicy(in apiv1.BuildTriggerPolicy, out *apiv1.Buil
 	} else {
 		out.GenericWebHook = nil
 	}
+	if in.GitLabWebHook != nil {
+		out.GitLabWebHook = new(apiv1.WebHookTrigger)
+		if err := deepCopy_v1_WebHookTrigger(*in.GitLabWebHook, out.GitLabWebHook, c); err != nil {
+			return err
+		}
+	} else {
+		out.GitLabWebHook = nil
+	}
+	if in.BitbucketWebHook != nil {
+		out.BitbucketWebHook = new(apiv1.WebHookTrigger)
+		if err := deepCopy_v1_WebHookTrigger(*in.BitbucketWebHook, out.BitbucketWebHook, c); err != nil {
+			return err
+		}
+	} else {
+		out.BitbucketWebHook = nil
+	}
 	if in.ImageChange != nil {
 		out.ImageChange = new(apiv1.ImageChangeTrigger)
 		if err := deepCopy_v1_ImageChangeTrigger(*in.ImageChange, out.ImageChange, c); err != nil {
@@ -1310,6 +1409,24 @@ func deepCopy_v1_ImageSourcePath(in apiv1.ImageSourcePath, out *apiv1.ImageSourc
 	return nil
 }
 
+func deepCopy_v1_PipelineBuildStrategy(in apiv1.PipelineBuildStrategy, out *apiv1.PipelineBuildStrategy, c *conversion.Cloner) error {
+	out.Jenkinsfile = in.Jenkinsfile
+	out.JenkinsfilePath = in.JenkinsfilePath
+	if in.Env != nil {
+		out.Env = make([]pkgapiv1.EnvVar, len(in.Env))
+		for i := range in.Env {
+			if newVal, err := c.DeepCopy(in.Env[i]); err != nil {
+				return err
+			} else {
+				out.Env[i] = newVal.(pkgapiv1.EnvVar)
+			}
+		}
+	} else {
+		out.Env = nil
+	}
+	return nil
+}
+
 func deepCopy_v1_SecretBuildSource(in apiv1.SecretBuildSource, out *apiv1.SecretBuildSource, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.Secret); err != nil {
 		return err
@@ -1382,11 +1499,68 @@ func deepCopy_v1_SourceRevision(in apiv1.SourceRevision, out *apiv1.SourceRevisi
 	return nil
 }
 
+func deepCopy_v1_StageInfo(in apiv1.StageInfo, out *apiv1.StageInfo, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.Phase = in.Phase
+	if in.StartTimestamp != nil {
+		if newVal, err := c.DeepCopy(in.StartTimestamp); err != nil {
+			return err
+		} else {
+			out.StartTimestamp = newVal.(*unversioned.Time)
+		}
+	} else {
+		out.StartTimestamp = nil
+	}
+	if in.CompletionTimestamp != nil {
+		if newVal, err := c.DeepCopy(in.CompletionTimestamp); err != nil {
+			return err
+		} else {
+			out.CompletionTimestamp = newVal.(*unversioned.Time)
+		}
+	} else {
+		out.CompletionTimestamp = nil
+	}
+	return nil
+}
+
 func deepCopy_v1_WebHookTrigger(in apiv1.WebHookTrigger, out *apiv1.WebHookTrigger, c *conversion.Cloner) error {
 	out.Secret = in.Secret
 	return nil
 }
 
+func deepCopy_v1_BlueGreenDeploymentStrategyParams(in deployapiv1.BlueGreenDeploymentStrategyParams, out *deployapiv1.BlueGreenDeploymentStrategyParams, c *conversion.Cloner) error {
+	out.ServiceName = in.ServiceName
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	if in.VerificationSeconds != nil {
+		out.VerificationSeconds = new(int64)
+		*out.VerificationSeconds = *in.VerificationSeconds
+	} else {
+		out.VerificationSeconds = nil
+	}
+	if in.Pre != nil {
+		out.Pre = new(deployapiv1.LifecycleHook)
+		if err := deepCopy_v1_LifecycleHook(*in.Pre, out.Pre, c); err != nil {
+			return err
+		}
+	} else {
+		out.Pre = nil
+	}
+	if in.Post != nil {
+		out.Post = new(deployapiv1.LifecycleHook)
+		if err := deepCopy_v1_LifecycleHook(*in.Post, out.Post, c); err != nil {
+			return err
+		}
+	} else {
+		out.Post = nil
+	}
+	return nil
+}
+
 func deepCopy_v1_CustomDeploymentStrategyParams(in deployapiv1.CustomDeploymentStrategyParams, out *deployapiv1.CustomDeploymentStrategyParams, c *conversion.Cloner) error {
 	out.Image = in.Image
 	if in.Environment != nil {
@@ -1653,6 +1827,14 @@ func deepCopy_v1_DeploymentStrategy(in deployapiv1.DeploymentStrategy, out *depl
 	} else {
 		out.RollingParams = nil
 	}
+	if in.BlueGreenParams != nil {
+		out.BlueGreenParams = new(deployapiv1.BlueGreenDeploymentStrategyParams)
+		if err := deepCopy_v1_BlueGreenDeploymentStrategyParams(*in.BlueGreenParams, out.BlueGreenParams, c); err != nil {
+			return err
+		}
+	} else {
+		out.BlueGreenParams = nil
+	}
 	if newVal, err := c.DeepCopy(in.Resources); err != nil {
 		return err
 	} else {
@@ -1832,6 +2014,18 @@ func deepCopy_v1_RollingDeploymentStrategyParams(in deployapiv1.RollingDeploymen
 	} else {
 		out.UpdatePercent = nil
 	}
+	if in.PausePoints != nil {
+		out.PausePoints = make([]intstr.IntOrString, len(in.PausePoints))
+		for i := range in.PausePoints {
+			if newVal, err := c.DeepCopy(in.PausePoints[i]); err != nil {
+				return err
+			} else {
+				out.PausePoints[i] = newVal.(intstr.IntOrString)
+			}
+		}
+	} else {
+		out.PausePoints = nil
+	}
 	if in.Pre != nil {
 		out.Pre = new(deployapiv1.LifecycleHook)
 		if err := deepCopy_v1_LifecycleHook(*in.Pre, out.Pre, c); err != nil {
@@ -1880,6 +2074,16 @@ func deepCopy_v1_Image(in imageapiv1.Image, out *imageapiv1.Image, c *conversion
 	} else {
 		out.DockerImageLayers = nil
 	}
+	if in.Signatures != nil {
+		out.Signatures = make([]imageapiv1.ImageSignature, len(in.Signatures))
+		for i := range in.Signatures {
+			if err := deepCopy_v1_ImageSignature(in.Signatures[i], &out.Signatures[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Signatures = nil
+	}
 	return nil
 }
 
@@ -1953,6 +2157,32 @@ func deepCopy_v1_ImageList(in imageapiv1.ImageList, out *imageapiv1.ImageList, c
 	return nil
 }
 
+func deepCopy_v1_ImageSignature(in imageapiv1.ImageSignature, out *imageapiv1.ImageSignature, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.Type = in.Type
+	if in.Content != nil {
+		out.Content = make([]uint8, len(in.Content))
+		for i := range in.Content {
+			out.Content[i] = in.Content[i]
+		}
+	} else {
+		out.Content = nil
+	}
+	out.Issuer = in.Issuer
+	out.Identity = in.Identity
+	if in.Conditions != nil {
+		out.Conditions = make([]imageapiv1.SignatureCondition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := deepCopy_v1_SignatureCondition(in.Conditions[i], &out.Conditions[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
+	return nil
+}
+
 func deepCopy_v1_ImageStream(in imageapiv1.ImageStream, out *imageapiv1.ImageStream, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -2241,6 +2471,24 @@ func deepCopy_v1_RepositoryImportStatus(in imageapiv1.RepositoryImportStatus, ou
 	return nil
 }
 
+func deepCopy_v1_SignatureCondition(in imageapiv1.SignatureCondition, out *imageapiv1.SignatureCondition, c *conversion.Cloner) error {
+	out.Type = in.Type
+	out.Status = in.Status
+	if newVal, err := c.DeepCopy(in.LastProbeTime); err != nil {
+		return err
+	} else {
+		out.LastProbeTime = newVal.(unversioned.Time)
+	}
+	if newVal, err := c.DeepCopy(in.LastTransitionTime); err != nil {
+		return err
+	} else {
+		out.LastTransitionTime = newVal.(unversioned.Time)
+	}
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
 func deepCopy_v1_TagEvent(in imageapiv1.TagEvent, out *imageapiv1.TagEvent, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.Created); err != nil {
 		return err
@@ -2302,6 +2550,14 @@ func deepCopy_v1_TagReference(in imageapiv1.TagReference, out *imageapiv1.TagRef
 	if err := deepCopy_v1_TagImportPolicy(in.ImportPolicy, &out.ImportPolicy, c); err != nil {
 		return err
 	}
+	if err := deepCopy_v1_TagReferencePolicy(in.ReferencePolicy, &out.ReferencePolicy, c); err != nil {
+		return err
+	}
+	return nil
+}
+
+func deepCopy_v1_TagReferencePolicy(in imageapiv1.TagReferencePolicy, out *imageapiv1.TagReferencePolicy, c *conversion.Cloner) error {
+	out.Type = in.Type
 	return nil
 }
 
@@ -2604,6 +2860,39 @@ func deepCopy_v1_Route(in routeapiv1.Route, out *routeapiv1.Route, c *conversion
 	return nil
 }
 
+func deepCopy_v1_RouteIngress(in routeapiv1.RouteIngress, out *routeapiv1.RouteIngress, c *conversion.Cloner) error {
+	out.Host = in.Host
+	out.RouterName = in.RouterName
+	if in.Conditions != nil {
+		out.Conditions = make([]routeapiv1.RouteIngressCondition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := deepCopy_v1_RouteIngressCondition(in.Conditions[i], &out.Conditions[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_RouteIngressCondition(in routeapiv1.RouteIngressCondition, out *routeapiv1.RouteIngressCondition, c *conversion.Cloner) error {
+	out.Type = in.Type
+	out.Status = in.Status
+	out.Reason = in.Reason
+	out.Message = in.Message
+	if in.LastTransitionTime != nil {
+		if newVal, err := c.DeepCopy(in.LastTransitionTime); err != nil {
+			return err
+		} else {
+			out.LastTransitionTime = newVal.(*unversioned.Time)
+		}
+	} else {
+		out.LastTransitionTime = nil
+	}
+	return nil
+}
+
 func deepCopy_v1_RouteList(in routeapiv1.RouteList, out *routeapiv1.RouteList, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -2640,10 +2929,18 @@ func deepCopy_v1_RoutePort(in routeapiv1.RoutePort, out *routeapiv1.RoutePort, c
 func deepCopy_v1_RouteSpec(in routeapiv1.RouteSpec, out *routeapiv1.RouteSpec, c *conversion.Cloner) error {
 	out.Host = in.Host
 	out.Path = in.Path
-	if newVal, err := c.DeepCopy(in.To); err != nil {
+	if err := deepCopy_v1_RouteTargetReference(in.To, &out.To, c); err != nil {
 		return err
+	}
+	if in.AlternateBackends != nil {
+		out.AlternateBackends = make([]routeapiv1.RouteTargetReference, len(in.AlternateBackends))
+		for i := range in.AlternateBackends {
+			if err := deepCopy_v1_RouteTargetReference(in.AlternateBackends[i], &out.AlternateBackends[i], c); err != nil {
+				return err
+			}
+		}
 	} else {
-		out.To = newVal.(pkgapiv1.ObjectReference)
+		out.AlternateBackends = nil
 	}
 	if in.Port != nil {
 		out.Port = new(routeapiv1.RoutePort)
@@ -2661,10 +2958,33 @@ func deepCopy_v1_RouteSpec(in routeapiv1.RouteSpec, out *routeapiv1.RouteSpec, c
 	} else {
 		out.TLS = nil
 	}
+	out.WildcardPolicy = in.WildcardPolicy
 	return nil
 }
 
 func deepCopy_v1_RouteStatus(in routeapiv1.RouteStatus, out *routeapiv1.RouteStatus, c *conversion.Cloner) error {
+	if in.Ingress != nil {
+		out.Ingress = make([]routeapiv1.RouteIngress, len(in.Ingress))
+		for i := range in.Ingress {
+			if err := deepCopy_v1_RouteIngress(in.Ingress[i], &out.Ingress[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Ingress = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_RouteTargetReference(in routeapiv1.RouteTargetReference, out *routeapiv1.RouteTargetReference, c *conversion.Cloner) error {
+	out.Kind = in.Kind
+	out.Name = in.Name
+	if in.Weight != nil {
+		out.Weight = new(int32)
+		*out.Weight = *in.Weight
+	} else {
+		out.Weight = nil
+	}
 	return nil
 }
 
@@ -3099,6 +3419,7 @@ func init() {
 		deepCopy_v1_BuildLog,
 		deepCopy_v1_BuildLogOptions,
 		deepCopy_v1_BuildOutput,
+		deepCopy_v1_BuildOutputTarget,
 		deepCopy_v1_BuildRequest,
 		deepCopy_v1_BuildSource,
 		deepCopy_v1_BuildSpec,
@@ -3112,12 +3433,15 @@ func init() {
 		deepCopy_v1_ImageChangeTrigger,
 		deepCopy_v1_ImageSource,
 		deepCopy_v1_ImageSourcePath,
+		deepCopy_v1_PipelineBuildStrategy,
 		deepCopy_v1_SecretBuildSource,
 		deepCopy_v1_SecretSpec,
 		deepCopy_v1_SourceBuildStrategy,
 		deepCopy_v1_SourceControlUser,
 		deepCopy_v1_SourceRevision,
+		deepCopy_v1_StageInfo,
 		deepCopy_v1_WebHookTrigger,
+		deepCopy_v1_BlueGreenDeploymentStrategyParams,
 		deepCopy_v1_CustomDeploymentStrategyParams,
 		deepCopy_v1_DeploymentCause,
 		deepCopy_v1_DeploymentCauseImageTrigger,
@@ -3142,6 +3466,7 @@ func init() {
 		deepCopy_v1_ImageImportStatus,
 		deepCopy_v1_ImageLayer,
 		deepCopy_v1_ImageList,
+		deepCopy_v1_ImageSignature,
 		deepCopy_v1_ImageStream,
 		deepCopy_v1_ImageStreamImage,
 		deepCopy_v1_ImageStreamImport,
@@ -3156,10 +3481,12 @@ func init() {
 		deepCopy_v1_NamedTagEventList,
 		deepCopy_v1_RepositoryImportSpec,
 		deepCopy_v1_RepositoryImportStatus,
+		deepCopy_v1_SignatureCondition,
 		deepCopy_v1_TagEvent,
 		deepCopy_v1_TagEventCondition,
 		deepCopy_v1_TagImportPolicy,
 		deepCopy_v1_TagReference,
+		deepCopy_v1_TagReferencePolicy,
 		deepCopy_v1_OAuthAccessToken,
 		deepCopy_v1_OAuthAccessTokenList,
 		deepCopy_v1_OAuthAuthorizeToken,
@@ -3174,10 +3501,13 @@ func init() {
 		deepCopy_v1_ProjectSpec,
 		deepCopy_v1_ProjectStatus,
 		deepCopy_v1_Route,
+		deepCopy_v1_RouteIngress,
+		deepCopy_v1_RouteIngressCondition,
 		deepCopy_v1_RouteList,
 		deepCopy_v1_RoutePort,
 		deepCopy_v1_RouteSpec,
 		deepCopy_v1_RouteStatus,
+		deepCopy_v1_RouteTargetReference,
 		deepCopy_v1_TLSConfig,
 		deepCopy_v1_ClusterNetwork,
 		deepCopy_v1_ClusterNetworkList,
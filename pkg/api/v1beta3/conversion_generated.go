@@ -26,6 +26,7 @@ import (
 	resource "k8s.io/kubernetes/pkg/api/resource"
 	pkgapiv1beta3 "k8s.io/kubernetes/pkg/api/v1beta3"
 	conversion "k8s.io/kubernetes/pkg/conversion"
+	intstr "k8s.io/kubernetes/pkg/util/intstr"
 	reflect "reflect"
 )
 
@@ -270,6 +271,14 @@ func autoconvert_api_LocalSubjectAccessReview_To_v1beta3_LocalSubjectAccessRevie
 	// in.Action has no peer in out
 	out.User = in.User
 	// in.Groups has no peer in out
+	if in.Scopes != nil {
+		out.Scopes = make([]string, len(in.Scopes))
+		for i := range in.Scopes {
+			out.Scopes[i] = in.Scopes[i]
+		}
+	} else {
+		out.Scopes = nil
+	}
 	return nil
 }
 
@@ -531,6 +540,14 @@ func autoconvert_api_SubjectAccessReview_To_v1beta3_SubjectAccessReview(in *api.
 	// in.Action has no peer in out
 	out.User = in.User
 	// in.Groups has no peer in out
+	if in.Scopes != nil {
+		out.Scopes = make([]string, len(in.Scopes))
+		for i := range in.Scopes {
+			out.Scopes[i] = in.Scopes[i]
+		}
+	} else {
+		out.Scopes = nil
+	}
 	return nil
 }
 
@@ -794,6 +811,14 @@ func autoconvert_v1beta3_LocalSubjectAccessReview_To_api_LocalSubjectAccessRevie
 	// in.AuthorizationAttributes has no peer in out
 	out.User = in.User
 	// in.GroupsSlice has no peer in out
+	if in.Scopes != nil {
+		out.Scopes = make([]string, len(in.Scopes))
+		for i := range in.Scopes {
+			out.Scopes[i] = in.Scopes[i]
+		}
+	} else {
+		out.Scopes = nil
+	}
 	return nil
 }
 
@@ -1058,6 +1083,14 @@ func autoconvert_v1beta3_SubjectAccessReview_To_api_SubjectAccessReview(in *v1be
 	// in.AuthorizationAttributes has no peer in out
 	out.User = in.User
 	// in.GroupsSlice has no peer in out
+	if in.Scopes != nil {
+		out.Scopes = make([]string, len(in.Scopes))
+		for i := range in.Scopes {
+			out.Scopes[i] = in.Scopes[i]
+		}
+	} else {
+		out.Scopes = nil
+	}
 	return nil
 }
 
@@ -1201,9 +1234,22 @@ func autoconvert_api_BuildConfigSpec_To_v1beta3_BuildConfigSpec(in *buildapi.Bui
 	} else {
 		out.Triggers = nil
 	}
+	out.RunPolicy = apiv1beta3.BuildRunPolicy(in.RunPolicy)
 	if err := convert_api_BuildSpec_To_v1beta3_BuildSpec(&in.BuildSpec, &out.BuildSpec, s); err != nil {
 		return err
 	}
+	if in.SuccessfulBuildsHistoryLimit != nil {
+		out.SuccessfulBuildsHistoryLimit = new(int32)
+		*out.SuccessfulBuildsHistoryLimit = *in.SuccessfulBuildsHistoryLimit
+	} else {
+		out.SuccessfulBuildsHistoryLimit = nil
+	}
+	if in.FailedBuildsHistoryLimit != nil {
+		out.FailedBuildsHistoryLimit = new(int32)
+		*out.FailedBuildsHistoryLimit = *in.FailedBuildsHistoryLimit
+	} else {
+		out.FailedBuildsHistoryLimit = nil
+	}
 	return nil
 }
 
@@ -1334,9 +1380,41 @@ func autoconvert_api_BuildOutput_To_v1beta3_BuildOutput(in *buildapi.BuildOutput
 	} else {
 		out.PushSecret = nil
 	}
+	if in.AdditionalTargets != nil {
+		out.AdditionalTargets = make([]apiv1beta3.BuildOutputTarget, len(in.AdditionalTargets))
+		for i := range in.AdditionalTargets {
+			if err := convert_api_BuildOutputTarget_To_v1beta3_BuildOutputTarget(&in.AdditionalTargets[i], &out.AdditionalTargets[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.AdditionalTargets = nil
+	}
+	return nil
+}
+
+func autoconvert_api_BuildOutputTarget_To_v1beta3_BuildOutputTarget(in *buildapi.BuildOutputTarget, out *apiv1beta3.BuildOutputTarget, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.BuildOutputTarget))(in)
+	}
+	if err := convert_api_ObjectReference_To_v1beta3_ObjectReference(&in.To, &out.To, s); err != nil {
+		return err
+	}
+	if in.PushSecret != nil {
+		out.PushSecret = new(pkgapiv1beta3.LocalObjectReference)
+		if err := convert_api_LocalObjectReference_To_v1beta3_LocalObjectReference(in.PushSecret, out.PushSecret, s); err != nil {
+			return err
+		}
+	} else {
+		out.PushSecret = nil
+	}
 	return nil
 }
 
+func convert_api_BuildOutputTarget_To_v1beta3_BuildOutputTarget(in *buildapi.BuildOutputTarget, out *apiv1beta3.BuildOutputTarget, s conversion.Scope) error {
+	return autoconvert_api_BuildOutputTarget_To_v1beta3_BuildOutputTarget(in, out, s)
+}
+
 func autoconvert_api_BuildRequest_To_v1beta3_BuildRequest(in *buildapi.BuildRequest, out *apiv1beta3.BuildRequest, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.BuildRequest))(in)
@@ -1528,6 +1606,24 @@ func autoconvert_api_BuildStatus_To_v1beta3_BuildStatus(in *buildapi.BuildStatus
 	} else {
 		out.Config = nil
 	}
+	if in.Stages != nil {
+		out.Stages = make([]apiv1beta3.StageInfo, len(in.Stages))
+		for i := range in.Stages {
+			if err := convert_api_StageInfo_To_v1beta3_StageInfo(&in.Stages[i], &out.Stages[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Stages = nil
+	}
+	if in.OutputDockerImageReferences != nil {
+		out.OutputDockerImageReferences = make([]string, len(in.OutputDockerImageReferences))
+		for i := range in.OutputDockerImageReferences {
+			out.OutputDockerImageReferences[i] = in.OutputDockerImageReferences[i]
+		}
+	} else {
+		out.OutputDockerImageReferences = nil
+	}
 	return nil
 }
 
@@ -1560,6 +1656,14 @@ func autoconvert_api_BuildStrategy_To_v1beta3_BuildStrategy(in *buildapi.BuildSt
 	} else {
 		out.CustomStrategy = nil
 	}
+	if in.PipelineStrategy != nil {
+		out.PipelineStrategy = new(apiv1beta3.PipelineBuildStrategy)
+		if err := convert_api_PipelineBuildStrategy_To_v1beta3_PipelineBuildStrategy(in.PipelineStrategy, out.PipelineStrategy, s); err != nil {
+			return err
+		}
+	} else {
+		out.PipelineStrategy = nil
+	}
 	return nil
 }
 
@@ -1584,6 +1688,22 @@ func autoconvert_api_BuildTriggerPolicy_To_v1beta3_BuildTriggerPolicy(in *builda
 	} else {
 		out.GenericWebHook = nil
 	}
+	if in.GitLabWebHook != nil {
+		out.GitLabWebHook = new(apiv1beta3.WebHookTrigger)
+		if err := convert_api_WebHookTrigger_To_v1beta3_WebHookTrigger(in.GitLabWebHook, out.GitLabWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.GitLabWebHook = nil
+	}
+	if in.BitbucketWebHook != nil {
+		out.BitbucketWebHook = new(apiv1beta3.WebHookTrigger)
+		if err := convert_api_WebHookTrigger_To_v1beta3_WebHookTrigger(in.BitbucketWebHook, out.BitbucketWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.BitbucketWebHook = nil
+	}
 	if in.ImageChange != nil {
 		out.ImageChange = new(apiv1beta3.ImageChangeTrigger)
 		if err := convert_api_ImageChangeTrigger_To_v1beta3_ImageChangeTrigger(in.ImageChange, out.ImageChange, s); err != nil {
@@ -1781,6 +1901,29 @@ func convert_api_ImageSourcePath_To_v1beta3_ImageSourcePath(in *buildapi.ImageSo
 	return autoconvert_api_ImageSourcePath_To_v1beta3_ImageSourcePath(in, out, s)
 }
 
+func autoconvert_api_PipelineBuildStrategy_To_v1beta3_PipelineBuildStrategy(in *buildapi.PipelineBuildStrategy, out *apiv1beta3.PipelineBuildStrategy, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.PipelineBuildStrategy))(in)
+	}
+	out.Jenkinsfile = in.Jenkinsfile
+	out.JenkinsfilePath = in.JenkinsfilePath
+	if in.Env != nil {
+		out.Env = make([]pkgapiv1beta3.EnvVar, len(in.Env))
+		for i := range in.Env {
+			if err := convert_api_EnvVar_To_v1beta3_EnvVar(&in.Env[i], &out.Env[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Env = nil
+	}
+	return nil
+}
+
+func convert_api_PipelineBuildStrategy_To_v1beta3_PipelineBuildStrategy(in *buildapi.PipelineBuildStrategy, out *apiv1beta3.PipelineBuildStrategy, s conversion.Scope) error {
+	return autoconvert_api_PipelineBuildStrategy_To_v1beta3_PipelineBuildStrategy(in, out, s)
+}
+
 func autoconvert_api_SecretBuildSource_To_v1beta3_SecretBuildSource(in *buildapi.SecretBuildSource, out *apiv1beta3.SecretBuildSource, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.SecretBuildSource))(in)
@@ -1870,6 +2013,33 @@ func autoconvert_api_SourceRevision_To_v1beta3_SourceRevision(in *buildapi.Sourc
 	return nil
 }
 
+func autoconvert_api_StageInfo_To_v1beta3_StageInfo(in *buildapi.StageInfo, out *apiv1beta3.StageInfo, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.StageInfo))(in)
+	}
+	out.Name = in.Name
+	out.Phase = apiv1beta3.BuildPhase(in.Phase)
+	if in.StartTimestamp != nil {
+		if err := s.Convert(&in.StartTimestamp, &out.StartTimestamp, 0); err != nil {
+			return err
+		}
+	} else {
+		out.StartTimestamp = nil
+	}
+	if in.CompletionTimestamp != nil {
+		if err := s.Convert(&in.CompletionTimestamp, &out.CompletionTimestamp, 0); err != nil {
+			return err
+		}
+	} else {
+		out.CompletionTimestamp = nil
+	}
+	return nil
+}
+
+func convert_api_StageInfo_To_v1beta3_StageInfo(in *buildapi.StageInfo, out *apiv1beta3.StageInfo, s conversion.Scope) error {
+	return autoconvert_api_StageInfo_To_v1beta3_StageInfo(in, out, s)
+}
+
 func autoconvert_api_WebHookTrigger_To_v1beta3_WebHookTrigger(in *buildapi.WebHookTrigger, out *apiv1beta3.WebHookTrigger, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.WebHookTrigger))(in)
@@ -2005,9 +2175,22 @@ func autoconvert_v1beta3_BuildConfigSpec_To_api_BuildConfigSpec(in *apiv1beta3.B
 	} else {
 		out.Triggers = nil
 	}
+	out.RunPolicy = buildapi.BuildRunPolicy(in.RunPolicy)
 	if err := convert_v1beta3_BuildSpec_To_api_BuildSpec(&in.BuildSpec, &out.BuildSpec, s); err != nil {
 		return err
 	}
+	if in.SuccessfulBuildsHistoryLimit != nil {
+		out.SuccessfulBuildsHistoryLimit = new(int32)
+		*out.SuccessfulBuildsHistoryLimit = *in.SuccessfulBuildsHistoryLimit
+	} else {
+		out.SuccessfulBuildsHistoryLimit = nil
+	}
+	if in.FailedBuildsHistoryLimit != nil {
+		out.FailedBuildsHistoryLimit = new(int32)
+		*out.FailedBuildsHistoryLimit = *in.FailedBuildsHistoryLimit
+	} else {
+		out.FailedBuildsHistoryLimit = nil
+	}
 	return nil
 }
 
@@ -2138,9 +2321,41 @@ func autoconvert_v1beta3_BuildOutput_To_api_BuildOutput(in *apiv1beta3.BuildOutp
 	} else {
 		out.PushSecret = nil
 	}
+	if in.AdditionalTargets != nil {
+		out.AdditionalTargets = make([]buildapi.BuildOutputTarget, len(in.AdditionalTargets))
+		for i := range in.AdditionalTargets {
+			if err := convert_v1beta3_BuildOutputTarget_To_api_BuildOutputTarget(&in.AdditionalTargets[i], &out.AdditionalTargets[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.AdditionalTargets = nil
+	}
+	return nil
+}
+
+func autoconvert_v1beta3_BuildOutputTarget_To_api_BuildOutputTarget(in *apiv1beta3.BuildOutputTarget, out *buildapi.BuildOutputTarget, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1beta3.BuildOutputTarget))(in)
+	}
+	if err := convert_v1beta3_ObjectReference_To_api_ObjectReference(&in.To, &out.To, s); err != nil {
+		return err
+	}
+	if in.PushSecret != nil {
+		out.PushSecret = new(pkgapi.LocalObjectReference)
+		if err := convert_v1beta3_LocalObjectReference_To_api_LocalObjectReference(in.PushSecret, out.PushSecret, s); err != nil {
+			return err
+		}
+	} else {
+		out.PushSecret = nil
+	}
 	return nil
 }
 
+func convert_v1beta3_BuildOutputTarget_To_api_BuildOutputTarget(in *apiv1beta3.BuildOutputTarget, out *buildapi.BuildOutputTarget, s conversion.Scope) error {
+	return autoconvert_v1beta3_BuildOutputTarget_To_api_BuildOutputTarget(in, out, s)
+}
+
 func autoconvert_v1beta3_BuildRequest_To_api_BuildRequest(in *apiv1beta3.BuildRequest, out *buildapi.BuildRequest, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*apiv1beta3.BuildRequest))(in)
@@ -2333,6 +2548,24 @@ func autoconvert_v1beta3_BuildStatus_To_api_BuildStatus(in *apiv1beta3.BuildStat
 	} else {
 		out.Config = nil
 	}
+	if in.Stages != nil {
+		out.Stages = make([]buildapi.StageInfo, len(in.Stages))
+		for i := range in.Stages {
+			if err := convert_v1beta3_StageInfo_To_api_StageInfo(&in.Stages[i], &out.Stages[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Stages = nil
+	}
+	if in.OutputDockerImageReferences != nil {
+		out.OutputDockerImageReferences = make([]string, len(in.OutputDockerImageReferences))
+		for i := range in.OutputDockerImageReferences {
+			out.OutputDockerImageReferences[i] = in.OutputDockerImageReferences[i]
+		}
+	} else {
+		out.OutputDockerImageReferences = nil
+	}
 	return nil
 }
 
@@ -2366,6 +2599,14 @@ func autoconvert_v1beta3_BuildStrategy_To_api_BuildStrategy(in *apiv1beta3.Build
 	} else {
 		out.CustomStrategy = nil
 	}
+	if in.PipelineStrategy != nil {
+		out.PipelineStrategy = new(buildapi.PipelineBuildStrategy)
+		if err := convert_v1beta3_PipelineBuildStrategy_To_api_PipelineBuildStrategy(in.PipelineStrategy, out.PipelineStrategy, s); err != nil {
+			return err
+		}
+	} else {
+		out.PipelineStrategy = nil
+	}
 	return nil
 }
 
@@ -2390,6 +2631,22 @@ func autoconvert_v1beta3_BuildTriggerPolicy_To_api_BuildTriggerPolicy(in *apiv1b
 	} else {
 		out.GenericWebHook = nil
 	}
+	if in.GitLabWebHook != nil {
+		out.GitLabWebHook = new(buildapi.WebHookTrigger)
+		if err := convert_v1beta3_WebHookTrigger_To_api_WebHookTrigger(in.GitLabWebHook, out.GitLabWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.GitLabWebHook = nil
+	}
+	if in.BitbucketWebHook != nil {
+		out.BitbucketWebHook = new(buildapi.WebHookTrigger)
+		if err := convert_v1beta3_WebHookTrigger_To_api_WebHookTrigger(in.BitbucketWebHook, out.BitbucketWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.BitbucketWebHook = nil
+	}
 	if in.ImageChange != nil {
 		out.ImageChange = new(buildapi.ImageChangeTrigger)
 		if err := convert_v1beta3_ImageChangeTrigger_To_api_ImageChangeTrigger(in.ImageChange, out.ImageChange, s); err != nil {
@@ -2587,6 +2844,29 @@ func convert_v1beta3_ImageSourcePath_To_api_ImageSourcePath(in *apiv1beta3.Image
 	return autoconvert_v1beta3_ImageSourcePath_To_api_ImageSourcePath(in, out, s)
 }
 
+func autoconvert_v1beta3_PipelineBuildStrategy_To_api_PipelineBuildStrategy(in *apiv1beta3.PipelineBuildStrategy, out *buildapi.PipelineBuildStrategy, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1beta3.PipelineBuildStrategy))(in)
+	}
+	out.Jenkinsfile = in.Jenkinsfile
+	out.JenkinsfilePath = in.JenkinsfilePath
+	if in.Env != nil {
+		out.Env = make([]pkgapi.EnvVar, len(in.Env))
+		for i := range in.Env {
+			if err := convert_v1beta3_EnvVar_To_api_EnvVar(&in.Env[i], &out.Env[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Env = nil
+	}
+	return nil
+}
+
+func convert_v1beta3_PipelineBuildStrategy_To_api_PipelineBuildStrategy(in *apiv1beta3.PipelineBuildStrategy, out *buildapi.PipelineBuildStrategy, s conversion.Scope) error {
+	return autoconvert_v1beta3_PipelineBuildStrategy_To_api_PipelineBuildStrategy(in, out, s)
+}
+
 func autoconvert_v1beta3_SecretBuildSource_To_api_SecretBuildSource(in *apiv1beta3.SecretBuildSource, out *buildapi.SecretBuildSource, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*apiv1beta3.SecretBuildSource))(in)
@@ -2677,6 +2957,33 @@ func autoconvert_v1beta3_SourceRevision_To_api_SourceRevision(in *apiv1beta3.Sou
 	return nil
 }
 
+func autoconvert_v1beta3_StageInfo_To_api_StageInfo(in *apiv1beta3.StageInfo, out *buildapi.StageInfo, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1beta3.StageInfo))(in)
+	}
+	out.Name = in.Name
+	out.Phase = buildapi.BuildPhase(in.Phase)
+	if in.StartTimestamp != nil {
+		if err := s.Convert(&in.StartTimestamp, &out.StartTimestamp, 0); err != nil {
+			return err
+		}
+	} else {
+		out.StartTimestamp = nil
+	}
+	if in.CompletionTimestamp != nil {
+		if err := s.Convert(&in.CompletionTimestamp, &out.CompletionTimestamp, 0); err != nil {
+			return err
+		}
+	} else {
+		out.CompletionTimestamp = nil
+	}
+	return nil
+}
+
+func convert_v1beta3_StageInfo_To_api_StageInfo(in *apiv1beta3.StageInfo, out *buildapi.StageInfo, s conversion.Scope) error {
+	return autoconvert_v1beta3_StageInfo_To_api_StageInfo(in, out, s)
+}
+
 func autoconvert_v1beta3_WebHookTrigger_To_api_WebHookTrigger(in *apiv1beta3.WebHookTrigger, out *buildapi.WebHookTrigger, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*apiv1beta3.WebHookTrigger))(in)
@@ -2689,6 +2996,46 @@ func convert_v1beta3_WebHookTrigger_To_api_WebHookTrigger(in *apiv1beta3.WebHook
 	return autoconvert_v1beta3_WebHookTrigger_To_api_WebHookTrigger(in, out, s)
 }
 
+func autoconvert_api_BlueGreenDeploymentStrategyParams_To_v1beta3_BlueGreenDeploymentStrategyParams(in *deployapi.BlueGreenDeploymentStrategyParams, out *deployapiv1beta3.BlueGreenDeploymentStrategyParams, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.BlueGreenDeploymentStrategyParams))(in)
+	}
+	out.ServiceName = in.ServiceName
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	if in.VerificationSeconds != nil {
+		out.VerificationSeconds = new(int64)
+		*out.VerificationSeconds = *in.VerificationSeconds
+	} else {
+		out.VerificationSeconds = nil
+	}
+	if in.Pre != nil {
+		out.Pre = new(deployapiv1beta3.LifecycleHook)
+		if err := convert_api_LifecycleHook_To_v1beta3_LifecycleHook(in.Pre, out.Pre, s); err != nil {
+			return err
+		}
+	} else {
+		out.Pre = nil
+	}
+	if in.Post != nil {
+		out.Post = new(deployapiv1beta3.LifecycleHook)
+		if err := convert_api_LifecycleHook_To_v1beta3_LifecycleHook(in.Post, out.Post, s); err != nil {
+			return err
+		}
+	} else {
+		out.Post = nil
+	}
+	return nil
+}
+
+func convert_api_BlueGreenDeploymentStrategyParams_To_v1beta3_BlueGreenDeploymentStrategyParams(in *deployapi.BlueGreenDeploymentStrategyParams, out *deployapiv1beta3.BlueGreenDeploymentStrategyParams, s conversion.Scope) error {
+	return autoconvert_api_BlueGreenDeploymentStrategyParams_To_v1beta3_BlueGreenDeploymentStrategyParams(in, out, s)
+}
+
 func autoconvert_api_CustomDeploymentStrategyParams_To_v1beta3_CustomDeploymentStrategyParams(in *deployapi.CustomDeploymentStrategyParams, out *deployapiv1beta3.CustomDeploymentStrategyParams, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapi.CustomDeploymentStrategyParams))(in)
@@ -3014,6 +3361,14 @@ func autoconvert_api_DeploymentStrategy_To_v1beta3_DeploymentStrategy(in *deploy
 	} else {
 		out.RollingParams = nil
 	}
+	if in.BlueGreenParams != nil {
+		out.BlueGreenParams = new(deployapiv1beta3.BlueGreenDeploymentStrategyParams)
+		if err := convert_api_BlueGreenDeploymentStrategyParams_To_v1beta3_BlueGreenDeploymentStrategyParams(in.BlueGreenParams, out.BlueGreenParams, s); err != nil {
+			return err
+		}
+	} else {
+		out.BlueGreenParams = nil
+	}
 	if err := convert_api_ResourceRequirements_To_v1beta3_ResourceRequirements(&in.Resources, &out.Resources, s); err != nil {
 		return err
 	}
@@ -3212,6 +3567,16 @@ func autoconvert_api_RollingDeploymentStrategyParams_To_v1beta3_RollingDeploymen
 	} else {
 		out.UpdatePercent = nil
 	}
+	if in.PausePoints != nil {
+		out.PausePoints = make([]intstr.IntOrString, len(in.PausePoints))
+		for i := range in.PausePoints {
+			if err := s.Convert(&in.PausePoints[i], &out.PausePoints[i], 0); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.PausePoints = nil
+	}
 	if in.Pre != nil {
 		out.Pre = new(deployapiv1beta3.LifecycleHook)
 		if err := convert_api_LifecycleHook_To_v1beta3_LifecycleHook(in.Pre, out.Pre, s); err != nil {
@@ -3231,6 +3596,46 @@ func autoconvert_api_RollingDeploymentStrategyParams_To_v1beta3_RollingDeploymen
 	return nil
 }
 
+func autoconvert_v1beta3_BlueGreenDeploymentStrategyParams_To_api_BlueGreenDeploymentStrategyParams(in *deployapiv1beta3.BlueGreenDeploymentStrategyParams, out *deployapi.BlueGreenDeploymentStrategyParams, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1beta3.BlueGreenDeploymentStrategyParams))(in)
+	}
+	out.ServiceName = in.ServiceName
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	if in.VerificationSeconds != nil {
+		out.VerificationSeconds = new(int64)
+		*out.VerificationSeconds = *in.VerificationSeconds
+	} else {
+		out.VerificationSeconds = nil
+	}
+	if in.Pre != nil {
+		out.Pre = new(deployapi.LifecycleHook)
+		if err := convert_v1beta3_LifecycleHook_To_api_LifecycleHook(in.Pre, out.Pre, s); err != nil {
+			return err
+		}
+	} else {
+		out.Pre = nil
+	}
+	if in.Post != nil {
+		out.Post = new(deployapi.LifecycleHook)
+		if err := convert_v1beta3_LifecycleHook_To_api_LifecycleHook(in.Post, out.Post, s); err != nil {
+			return err
+		}
+	} else {
+		out.Post = nil
+	}
+	return nil
+}
+
+func convert_v1beta3_BlueGreenDeploymentStrategyParams_To_api_BlueGreenDeploymentStrategyParams(in *deployapiv1beta3.BlueGreenDeploymentStrategyParams, out *deployapi.BlueGreenDeploymentStrategyParams, s conversion.Scope) error {
+	return autoconvert_v1beta3_BlueGreenDeploymentStrategyParams_To_api_BlueGreenDeploymentStrategyParams(in, out, s)
+}
+
 func autoconvert_v1beta3_CustomDeploymentStrategyParams_To_api_CustomDeploymentStrategyParams(in *deployapiv1beta3.CustomDeploymentStrategyParams, out *deployapi.CustomDeploymentStrategyParams, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapiv1beta3.CustomDeploymentStrategyParams))(in)
@@ -3556,6 +3961,14 @@ func autoconvert_v1beta3_DeploymentStrategy_To_api_DeploymentStrategy(in *deploy
 	} else {
 		out.RollingParams = nil
 	}
+	if in.BlueGreenParams != nil {
+		out.BlueGreenParams = new(deployapi.BlueGreenDeploymentStrategyParams)
+		if err := convert_v1beta3_BlueGreenDeploymentStrategyParams_To_api_BlueGreenDeploymentStrategyParams(in.BlueGreenParams, out.BlueGreenParams, s); err != nil {
+			return err
+		}
+	} else {
+		out.BlueGreenParams = nil
+	}
 	if err := convert_v1beta3_ResourceRequirements_To_api_ResourceRequirements(&in.Resources, &out.Resources, s); err != nil {
 		return err
 	}
@@ -3750,6 +4163,16 @@ func autoconvert_v1beta3_RollingDeploymentStrategyParams_To_api_RollingDeploymen
 	} else {
 		out.UpdatePercent = nil
 	}
+	if in.PausePoints != nil {
+		out.PausePoints = make([]intstr.IntOrString, len(in.PausePoints))
+		for i := range in.PausePoints {
+			if err := s.Convert(&in.PausePoints[i], &out.PausePoints[i], 0); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.PausePoints = nil
+	}
 	if in.Pre != nil {
 		out.Pre = new(deployapi.LifecycleHook)
 		if err := convert_v1beta3_LifecycleHook_To_api_LifecycleHook(in.Pre, out.Pre, s); err != nil {
@@ -3795,6 +4218,7 @@ func autoconvert_api_Image_To_v1beta3_Image(in *imageapi.Image, out *imageapiv1b
 	} else {
 		out.DockerImageLayers = nil
 	}
+	// in.Signatures has no peer in out
 	return nil
 }
 
@@ -4835,6 +5259,51 @@ func convert_api_Route_To_v1beta3_Route(in *routeapi.Route, out *routeapiv1beta3
 	return autoconvert_api_Route_To_v1beta3_Route(in, out, s)
 }
 
+func autoconvert_api_RouteIngress_To_v1beta3_RouteIngress(in *routeapi.RouteIngress, out *routeapiv1beta3.RouteIngress, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*routeapi.RouteIngress))(in)
+	}
+	out.Host = in.Host
+	out.RouterName = in.RouterName
+	if in.Conditions != nil {
+		out.Conditions = make([]routeapiv1beta3.RouteIngressCondition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := convert_api_RouteIngressCondition_To_v1beta3_RouteIngressCondition(&in.Conditions[i], &out.Conditions[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
+	return nil
+}
+
+func convert_api_RouteIngress_To_v1beta3_RouteIngress(in *routeapi.RouteIngress, out *routeapiv1beta3.RouteIngress, s conversion.Scope) error {
+	return autoconvert_api_RouteIngress_To_v1beta3_RouteIngress(in, out, s)
+}
+
+func autoconvert_api_RouteIngressCondition_To_v1beta3_RouteIngressCondition(in *routeapi.RouteIngressCondition, out *routeapiv1beta3.RouteIngressCondition, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*routeapi.RouteIngressCondition))(in)
+	}
+	out.Type = routeapiv1beta3.RouteIngressConditionType(in.Type)
+	out.Status = pkgapiv1beta3.ConditionStatus(in.Status)
+	out.Reason = in.Reason
+	out.Message = in.Message
+	if in.LastTransitionTime != nil {
+		if err := s.Convert(&in.LastTransitionTime, &out.LastTransitionTime, 0); err != nil {
+			return err
+		}
+	} else {
+		out.LastTransitionTime = nil
+	}
+	return nil
+}
+
+func convert_api_RouteIngressCondition_To_v1beta3_RouteIngressCondition(in *routeapi.RouteIngressCondition, out *routeapiv1beta3.RouteIngressCondition, s conversion.Scope) error {
+	return autoconvert_api_RouteIngressCondition_To_v1beta3_RouteIngressCondition(in, out, s)
+}
+
 func autoconvert_api_RouteList_To_v1beta3_RouteList(in *routeapi.RouteList, out *routeapiv1beta3.RouteList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*routeapi.RouteList))(in)
@@ -4882,9 +5351,19 @@ func autoconvert_api_RouteSpec_To_v1beta3_RouteSpec(in *routeapi.RouteSpec, out
 	}
 	out.Host = in.Host
 	out.Path = in.Path
-	if err := convert_api_ObjectReference_To_v1beta3_ObjectReference(&in.To, &out.To, s); err != nil {
+	if err := convert_api_RouteTargetReference_To_v1beta3_RouteTargetReference(&in.To, &out.To, s); err != nil {
 		return err
 	}
+	if in.AlternateBackends != nil {
+		out.AlternateBackends = make([]routeapiv1beta3.RouteTargetReference, len(in.AlternateBackends))
+		for i := range in.AlternateBackends {
+			if err := convert_api_RouteTargetReference_To_v1beta3_RouteTargetReference(&in.AlternateBackends[i], &out.AlternateBackends[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.AlternateBackends = nil
+	}
 	if in.Port != nil {
 		out.Port = new(routeapiv1beta3.RoutePort)
 		if err := convert_api_RoutePort_To_v1beta3_RoutePort(in.Port, out.Port, s); err != nil {
@@ -4901,6 +5380,7 @@ func autoconvert_api_RouteSpec_To_v1beta3_RouteSpec(in *routeapi.RouteSpec, out
 	} else {
 		out.TLS = nil
 	}
+	out.WildcardPolicy = routeapiv1beta3.WildcardPolicyType(in.WildcardPolicy)
 	return nil
 }
 
@@ -4912,6 +5392,16 @@ func autoconvert_api_RouteStatus_To_v1beta3_RouteStatus(in *routeapi.RouteStatus
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*routeapi.RouteStatus))(in)
 	}
+	if in.Ingress != nil {
+		out.Ingress = make([]routeapiv1beta3.RouteIngress, len(in.Ingress))
+		for i := range in.Ingress {
+			if err := convert_api_RouteIngress_To_v1beta3_RouteIngress(&in.Ingress[i], &out.Ingress[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Ingress = nil
+	}
 	return nil
 }
 
@@ -4919,6 +5409,25 @@ func convert_api_RouteStatus_To_v1beta3_RouteStatus(in *routeapi.RouteStatus, ou
 	return autoconvert_api_RouteStatus_To_v1beta3_RouteStatus(in, out, s)
 }
 
+func autoconvert_api_RouteTargetReference_To_v1beta3_RouteTargetReference(in *routeapi.RouteTargetReference, out *routeapiv1beta3.RouteTargetReference, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*routeapi.RouteTargetReference))(in)
+	}
+	out.Kind = in.Kind
+	out.Name = in.Name
+	if in.Weight != nil {
+		out.Weight = new(int32)
+		*out.Weight = *in.Weight
+	} else {
+		out.Weight = nil
+	}
+	return nil
+}
+
+func convert_api_RouteTargetReference_To_v1beta3_RouteTargetReference(in *routeapi.RouteTargetReference, out *routeapiv1beta3.RouteTargetReference, s conversion.Scope) error {
+	return autoconvert_api_RouteTargetReference_To_v1beta3_RouteTargetReference(in, out, s)
+}
+
 func autoconvert_api_TLSConfig_To_v1beta3_TLSConfig(in *routeapi.TLSConfig, out *routeapiv1beta3.TLSConfig, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*routeapi.TLSConfig))(in)
@@ -4959,6 +5468,51 @@ func convert_v1beta3_Route_To_api_Route(in *routeapiv1beta3.Route, out *routeapi
 	return autoconvert_v1beta3_Route_To_api_Route(in, out, s)
 }
 
+func autoconvert_v1beta3_RouteIngress_To_api_RouteIngress(in *routeapiv1beta3.RouteIngress, out *routeapi.RouteIngress, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*routeapiv1beta3.RouteIngress))(in)
+	}
+	out.Host = in.Host
+	out.RouterName = in.RouterName
+	if in.Conditions != nil {
+		out.Conditions = make([]routeapi.RouteIngressCondition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := convert_v1beta3_RouteIngressCondition_To_api_RouteIngressCondition(&in.Conditions[i], &out.Conditions[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
+	return nil
+}
+
+func convert_v1beta3_RouteIngress_To_api_RouteIngress(in *routeapiv1beta3.RouteIngress, out *routeapi.RouteIngress, s conversion.Scope) error {
+	return autoconvert_v1beta3_RouteIngress_To_api_RouteIngress(in, out, s)
+}
+
+func autoconvert_v1beta3_RouteIngressCondition_To_api_RouteIngressCondition(in *routeapiv1beta3.RouteIngressCondition, out *routeapi.RouteIngressCondition, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*routeapiv1beta3.RouteIngressCondition))(in)
+	}
+	out.Type = routeapi.RouteIngressConditionType(in.Type)
+	out.Status = pkgapi.ConditionStatus(in.Status)
+	out.Reason = in.Reason
+	out.Message = in.Message
+	if in.LastTransitionTime != nil {
+		if err := s.Convert(&in.LastTransitionTime, &out.LastTransitionTime, 0); err != nil {
+			return err
+		}
+	} else {
+		out.LastTransitionTime = nil
+	}
+	return nil
+}
+
+func convert_v1beta3_RouteIngressCondition_To_api_RouteIngressCondition(in *routeapiv1beta3.RouteIngressCondition, out *routeapi.RouteIngressCondition, s conversion.Scope) error {
+	return autoconvert_v1beta3_RouteIngressCondition_To_api_RouteIngressCondition(in, out, s)
+}
+
 func autoconvert_v1beta3_RouteList_To_api_RouteList(in *routeapiv1beta3.RouteList, out *routeapi.RouteList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*routeapiv1beta3.RouteList))(in)
@@ -5006,9 +5560,19 @@ func autoconvert_v1beta3_RouteSpec_To_api_RouteSpec(in *routeapiv1beta3.RouteSpe
 	}
 	out.Host = in.Host
 	out.Path = in.Path
-	if err := convert_v1beta3_ObjectReference_To_api_ObjectReference(&in.To, &out.To, s); err != nil {
+	if err := convert_v1beta3_RouteTargetReference_To_api_RouteTargetReference(&in.To, &out.To, s); err != nil {
 		return err
 	}
+	if in.AlternateBackends != nil {
+		out.AlternateBackends = make([]routeapi.RouteTargetReference, len(in.AlternateBackends))
+		for i := range in.AlternateBackends {
+			if err := convert_v1beta3_RouteTargetReference_To_api_RouteTargetReference(&in.AlternateBackends[i], &out.AlternateBackends[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.AlternateBackends = nil
+	}
 	if in.Port != nil {
 		out.Port = new(routeapi.RoutePort)
 		if err := convert_v1beta3_RoutePort_To_api_RoutePort(in.Port, out.Port, s); err != nil {
@@ -5025,6 +5589,7 @@ func autoconvert_v1beta3_RouteSpec_To_api_RouteSpec(in *routeapiv1beta3.RouteSpe
 	} else {
 		out.TLS = nil
 	}
+	out.WildcardPolicy = routeapi.WildcardPolicyType(in.WildcardPolicy)
 	return nil
 }
 
@@ -5036,6 +5601,16 @@ func autoconvert_v1beta3_RouteStatus_To_api_RouteStatus(in *routeapiv1beta3.Rout
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*routeapiv1beta3.RouteStatus))(in)
 	}
+	if in.Ingress != nil {
+		out.Ingress = make([]routeapi.RouteIngress, len(in.Ingress))
+		for i := range in.Ingress {
+			if err := convert_v1beta3_RouteIngress_To_api_RouteIngress(&in.Ingress[i], &out.Ingress[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Ingress = nil
+	}
 	return nil
 }
 
@@ -5043,6 +5618,25 @@ func convert_v1beta3_RouteStatus_To_api_RouteStatus(in *routeapiv1beta3.RouteSta
 	return autoconvert_v1beta3_RouteStatus_To_api_RouteStatus(in, out, s)
 }
 
+func autoconvert_v1beta3_RouteTargetReference_To_api_RouteTargetReference(in *routeapiv1beta3.RouteTargetReference, out *routeapi.RouteTargetReference, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*routeapiv1beta3.RouteTargetReference))(in)
+	}
+	out.Kind = in.Kind
+	out.Name = in.Name
+	if in.Weight != nil {
+		out.Weight = new(int32)
+		*out.Weight = *in.Weight
+	} else {
+		out.Weight = nil
+	}
+	return nil
+}
+
+func convert_v1beta3_RouteTargetReference_To_api_RouteTargetReference(in *routeapiv1beta3.RouteTargetReference, out *routeapi.RouteTargetReference, s conversion.Scope) error {
+	return autoconvert_v1beta3_RouteTargetReference_To_api_RouteTargetReference(in, out, s)
+}
+
 func autoconvert_v1beta3_TLSConfig_To_api_TLSConfig(in *routeapiv1beta3.TLSConfig, out *routeapi.TLSConfig, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*routeapiv1beta3.TLSConfig))(in)
@@ -7678,6 +8272,7 @@ func init() {
 		autoconvert_api_AWSElasticBlockStoreVolumeSource_To_v1beta3_AWSElasticBlockStoreVolumeSource,
 		autoconvert_api_BinaryBuildRequestOptions_To_v1beta3_BinaryBuildRequestOptions,
 		autoconvert_api_BinaryBuildSource_To_v1beta3_BinaryBuildSource,
+		autoconvert_api_BlueGreenDeploymentStrategyParams_To_v1beta3_BlueGreenDeploymentStrategyParams,
 		autoconvert_api_BuildConfigList_To_v1beta3_BuildConfigList,
 		autoconvert_api_BuildConfigSpec_To_v1beta3_BuildConfigSpec,
 		autoconvert_api_BuildConfigStatus_To_v1beta3_BuildConfigStatus,
@@ -7685,6 +8280,7 @@ func init() {
 		autoconvert_api_BuildList_To_v1beta3_BuildList,
 		autoconvert_api_BuildLogOptions_To_v1beta3_BuildLogOptions,
 		autoconvert_api_BuildLog_To_v1beta3_BuildLog,
+		autoconvert_api_BuildOutputTarget_To_v1beta3_BuildOutputTarget,
 		autoconvert_api_BuildOutput_To_v1beta3_BuildOutput,
 		autoconvert_api_BuildRequest_To_v1beta3_BuildRequest,
 		autoconvert_api_BuildSource_To_v1beta3_BuildSource,
@@ -7781,6 +8377,7 @@ func init() {
 		autoconvert_api_ObjectReference_To_v1beta3_ObjectReference,
 		autoconvert_api_Parameter_To_v1beta3_Parameter,
 		autoconvert_api_PersistentVolumeClaimVolumeSource_To_v1beta3_PersistentVolumeClaimVolumeSource,
+		autoconvert_api_PipelineBuildStrategy_To_v1beta3_PipelineBuildStrategy,
 		autoconvert_api_PodSpec_To_v1beta3_PodSpec,
 		autoconvert_api_PodTemplateSpec_To_v1beta3_PodTemplateSpec,
 		autoconvert_api_PolicyBindingList_To_v1beta3_PolicyBindingList,
@@ -7803,10 +8400,13 @@ func init() {
 		autoconvert_api_RoleList_To_v1beta3_RoleList,
 		autoconvert_api_Role_To_v1beta3_Role,
 		autoconvert_api_RollingDeploymentStrategyParams_To_v1beta3_RollingDeploymentStrategyParams,
+		autoconvert_api_RouteIngressCondition_To_v1beta3_RouteIngressCondition,
+		autoconvert_api_RouteIngress_To_v1beta3_RouteIngress,
 		autoconvert_api_RouteList_To_v1beta3_RouteList,
 		autoconvert_api_RoutePort_To_v1beta3_RoutePort,
 		autoconvert_api_RouteSpec_To_v1beta3_RouteSpec,
 		autoconvert_api_RouteStatus_To_v1beta3_RouteStatus,
+		autoconvert_api_RouteTargetReference_To_v1beta3_RouteTargetReference,
 		autoconvert_api_Route_To_v1beta3_Route,
 		autoconvert_api_SecretBuildSource_To_v1beta3_SecretBuildSource,
 		autoconvert_api_SecretSpec_To_v1beta3_SecretSpec,
@@ -7814,6 +8414,7 @@ func init() {
 		autoconvert_api_SourceBuildStrategy_To_v1beta3_SourceBuildStrategy,
 		autoconvert_api_SourceControlUser_To_v1beta3_SourceControlUser,
 		autoconvert_api_SourceRevision_To_v1beta3_SourceRevision,
+		autoconvert_api_StageInfo_To_v1beta3_StageInfo,
 		autoconvert_api_SubjectAccessReviewResponse_To_v1beta3_SubjectAccessReviewResponse,
 		autoconvert_api_SubjectAccessReview_To_v1beta3_SubjectAccessReview,
 		autoconvert_api_TCPSocketAction_To_v1beta3_TCPSocketAction,
@@ -7830,6 +8431,7 @@ func init() {
 		autoconvert_v1beta3_AWSElasticBlockStoreVolumeSource_To_api_AWSElasticBlockStoreVolumeSource,
 		autoconvert_v1beta3_BinaryBuildRequestOptions_To_api_BinaryBuildRequestOptions,
 		autoconvert_v1beta3_BinaryBuildSource_To_api_BinaryBuildSource,
+		autoconvert_v1beta3_BlueGreenDeploymentStrategyParams_To_api_BlueGreenDeploymentStrategyParams,
 		autoconvert_v1beta3_BuildConfigList_To_api_BuildConfigList,
 		autoconvert_v1beta3_BuildConfigSpec_To_api_BuildConfigSpec,
 		autoconvert_v1beta3_BuildConfigStatus_To_api_BuildConfigStatus,
@@ -7837,6 +8439,7 @@ func init() {
 		autoconvert_v1beta3_BuildList_To_api_BuildList,
 		autoconvert_v1beta3_BuildLogOptions_To_api_BuildLogOptions,
 		autoconvert_v1beta3_BuildLog_To_api_BuildLog,
+		autoconvert_v1beta3_BuildOutputTarget_To_api_BuildOutputTarget,
 		autoconvert_v1beta3_BuildOutput_To_api_BuildOutput,
 		autoconvert_v1beta3_BuildRequest_To_api_BuildRequest,
 		autoconvert_v1beta3_BuildSource_To_api_BuildSource,
@@ -7933,6 +8536,7 @@ func init() {
 		autoconvert_v1beta3_ObjectReference_To_api_ObjectReference,
 		autoconvert_v1beta3_Parameter_To_api_Parameter,
 		autoconvert_v1beta3_PersistentVolumeClaimVolumeSource_To_api_PersistentVolumeClaimVolumeSource,
+		autoconvert_v1beta3_PipelineBuildStrategy_To_api_PipelineBuildStrategy,
 		autoconvert_v1beta3_PodSpec_To_api_PodSpec,
 		autoconvert_v1beta3_PodTemplateSpec_To_api_PodTemplateSpec,
 		autoconvert_v1beta3_PolicyBindingList_To_api_PolicyBindingList,
@@ -7955,10 +8559,13 @@ func init() {
 		autoconvert_v1beta3_RoleList_To_api_RoleList,
 		autoconvert_v1beta3_Role_To_api_Role,
 		autoconvert_v1beta3_RollingDeploymentStrategyParams_To_api_RollingDeploymentStrategyParams,
+		autoconvert_v1beta3_RouteIngressCondition_To_api_RouteIngressCondition,
+		autoconvert_v1beta3_RouteIngress_To_api_RouteIngress,
 		autoconvert_v1beta3_RouteList_To_api_RouteList,
 		autoconvert_v1beta3_RoutePort_To_api_RoutePort,
 		autoconvert_v1beta3_RouteSpec_To_api_RouteSpec,
 		autoconvert_v1beta3_RouteStatus_To_api_RouteStatus,
+		autoconvert_v1beta3_RouteTargetReference_To_api_RouteTargetReference,
 		autoconvert_v1beta3_Route_To_api_Route,
 		autoconvert_v1beta3_SecretBuildSource_To_api_SecretBuildSource,
 		autoconvert_v1beta3_SecretSpec_To_api_SecretSpec,
@@ -7966,6 +8573,7 @@ func init() {
 		autoconvert_v1beta3_SourceBuildStrategy_To_api_SourceBuildStrategy,
 		autoconvert_v1beta3_SourceControlUser_To_api_SourceControlUser,
 		autoconvert_v1beta3_SourceRevision_To_api_SourceRevision,
+		autoconvert_v1beta3_StageInfo_To_api_StageInfo,
 		autoconvert_v1beta3_SubjectAccessReviewResponse_To_api_SubjectAccessReviewResponse,
 		autoconvert_v1beta3_SubjectAccessReview_To_api_SubjectAccessReview,
 		autoconvert_v1beta3_TCPSocketAction_To_api_TCPSocketAction,
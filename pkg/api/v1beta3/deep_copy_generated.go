@@ -302,6 +302,14 @@ func deepCopy_v1beta3_LocalSubjectAccessReview(in v1beta3.LocalSubjectAccessRevi
 	} else {
 		out.GroupsSlice = nil
 	}
+	if in.Scopes != nil {
+		out.Scopes = make([]string, len(in.Scopes))
+		for i := range in.Scopes {
+			out.Scopes[i] = in.Scopes[i]
+		}
+	} else {
+		out.Scopes = nil
+	}
 	return nil
 }
 
@@ -680,6 +688,14 @@ func deepCopy_v1beta3_SubjectAccessReview(in v1beta3.SubjectAccessReview, out *v
 	} else {
 		out.GroupsSlice = nil
 	}
+	if in.Scopes != nil {
+		out.Scopes = make([]string, len(in.Scopes))
+		for i := range in.Scopes {
+			out.Scopes[i] = in.Scopes[i]
+		}
+	} else {
+		out.Scopes = nil
+	}
 	return nil
 }
 
@@ -796,9 +812,22 @@ func deepCopy_v1beta3_BuildConfigSpec(in apiv1beta3.BuildConfigSpec, out *apiv1b
 	} else {
 		out.Triggers = nil
 	}
+	out.RunPolicy = in.RunPolicy
 	if err := deepCopy_v1beta3_BuildSpec(in.BuildSpec, &out.BuildSpec, c); err != nil {
 		return err
 	}
+	if in.SuccessfulBuildsHistoryLimit != nil {
+		out.SuccessfulBuildsHistoryLimit = new(int32)
+		*out.SuccessfulBuildsHistoryLimit = *in.SuccessfulBuildsHistoryLimit
+	} else {
+		out.SuccessfulBuildsHistoryLimit = nil
+	}
+	if in.FailedBuildsHistoryLimit != nil {
+		out.FailedBuildsHistoryLimit = new(int32)
+		*out.FailedBuildsHistoryLimit = *in.FailedBuildsHistoryLimit
+	} else {
+		out.FailedBuildsHistoryLimit = nil
+	}
 	return nil
 }
 
@@ -906,6 +935,34 @@ func deepCopy_v1beta3_BuildOutput(in apiv1beta3.BuildOutput, out *apiv1beta3.Bui
 	} else {
 		out.PushSecret = nil
 	}
+	if in.AdditionalTargets != nil {
+		out.AdditionalTargets = make([]apiv1beta3.BuildOutputTarget, len(in.AdditionalTargets))
+		for i := range in.AdditionalTargets {
+			if err := deepCopy_v1beta3_BuildOutputTarget(in.AdditionalTargets[i], &out.AdditionalTargets[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.AdditionalTargets = nil
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_BuildOutputTarget(in apiv1beta3.BuildOutputTarget, out *apiv1beta3.BuildOutputTarget, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.To); err != nil {
+		return err
+	} else {
+		out.To = newVal.(pkgapiv1beta3.ObjectReference)
+	}
+	if in.PushSecret != nil {
+		if newVal, err := c.DeepCopy(in.PushSecret); err != nil {
+			return err
+		} else {
+			out.PushSecret = newVal.(*pkgapiv1beta3.LocalObjectReference)
+		}
+	} else {
+		out.PushSecret = nil
+	}
 	return nil
 }
 
@@ -1099,6 +1156,24 @@ func deepCopy_v1beta3_BuildStatus(in apiv1beta3.BuildStatus, out *apiv1beta3.Bui
 	} else {
 		out.Config = nil
 	}
+	if in.Stages != nil {
+		out.Stages = make([]apiv1beta3.StageInfo, len(in.Stages))
+		for i := range in.Stages {
+			if err := deepCopy_v1beta3_StageInfo(in.Stages[i], &out.Stages[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Stages = nil
+	}
+	if in.OutputDockerImageReferences != nil {
+		out.OutputDockerImageReferences = make([]string, len(in.OutputDockerImageReferences))
+		for i := range in.OutputDockerImageReferences {
+			out.OutputDockerImageReferences[i] = in.OutputDockerImageReferences[i]
+		}
+	} else {
+		out.OutputDockerImageReferences = nil
+	}
 	return nil
 }
 
@@ -1128,6 +1203,14 @@ func deepCopy_v1beta3_BuildStrategy(in apiv1beta3.BuildStrategy, out *apiv1beta3
 	} else {
 		out.CustomStrategy = nil
 	}
+	if in.PipelineStrategy != nil {
+		out.PipelineStrategy = new(apiv1beta3.PipelineBuildStrategy)
+		if err := deepCopy_v1beta3_PipelineBuildStrategy(*in.PipelineStrategy, out.PipelineStrategy, c); err != nil {
+			return err
+		}
+	} else {
+		out.PipelineStrategy = nil
+	}
 	return nil
 }
 
@@ -1149,6 +1232,22 @@ func deepCopy_v1beta3_BuildTriggerPolicy(in apiv1beta3.BuildTriggerPolicy, out *
 	} else {
 		out.GenericWebHook = nil
 	}
+	if in.GitLabWebHook != nil {
+		out.GitLabWebHook = new(apiv1beta3.WebHookTrigger)
+		if err := deepCopy_v1beta3_WebHookTrigger(*in.GitLabWebHook, out.GitLabWebHook, c); err != nil {
+			return err
+		}
+	} else {
+		out.GitLabWebHook = nil
+	}
+	if in.BitbucketWebHook != nil {
+		out.BitbucketWebHook = new(apiv1beta3.WebHookTrigger)
+		if err := deepCopy_v1beta3_WebHookTrigger(*in.BitbucketWebHook, out.BitbucketWebHook, c); err != nil {
+			return err
+		}
+	} else {
+		out.BitbucketWebHook = nil
+	}
 	if in.ImageChange != nil {
 		out.ImageChange = new(apiv1beta3.ImageChangeTrigger)
 		if err := deepCopy_v1beta3_ImageChangeTrigger(*in.ImageChange, out.ImageChange, c); err != nil {
@@ -1318,6 +1417,24 @@ func deepCopy_v1beta3_ImageSourcePath(in apiv1beta3.ImageSourcePath, out *apiv1b
 	return nil
 }
 
+func deepCopy_v1beta3_PipelineBuildStrategy(in apiv1beta3.PipelineBuildStrategy, out *apiv1beta3.PipelineBuildStrategy, c *conversion.Cloner) error {
+	out.Jenkinsfile = in.Jenkinsfile
+	out.JenkinsfilePath = in.JenkinsfilePath
+	if in.Env != nil {
+		out.Env = make([]pkgapiv1beta3.EnvVar, len(in.Env))
+		for i := range in.Env {
+			if newVal, err := c.DeepCopy(in.Env[i]); err != nil {
+				return err
+			} else {
+				out.Env[i] = newVal.(pkgapiv1beta3.EnvVar)
+			}
+		}
+	} else {
+		out.Env = nil
+	}
+	return nil
+}
+
 func deepCopy_v1beta3_SecretBuildSource(in apiv1beta3.SecretBuildSource, out *apiv1beta3.SecretBuildSource, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.Secret); err != nil {
 		return err
@@ -1390,11 +1507,68 @@ func deepCopy_v1beta3_SourceRevision(in apiv1beta3.SourceRevision, out *apiv1bet
 	return nil
 }
 
+func deepCopy_v1beta3_StageInfo(in apiv1beta3.StageInfo, out *apiv1beta3.StageInfo, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.Phase = in.Phase
+	if in.StartTimestamp != nil {
+		if newVal, err := c.DeepCopy(in.StartTimestamp); err != nil {
+			return err
+		} else {
+			out.StartTimestamp = newVal.(*unversioned.Time)
+		}
+	} else {
+		out.StartTimestamp = nil
+	}
+	if in.CompletionTimestamp != nil {
+		if newVal, err := c.DeepCopy(in.CompletionTimestamp); err != nil {
+			return err
+		} else {
+			out.CompletionTimestamp = newVal.(*unversioned.Time)
+		}
+	} else {
+		out.CompletionTimestamp = nil
+	}
+	return nil
+}
+
 func deepCopy_v1beta3_WebHookTrigger(in apiv1beta3.WebHookTrigger, out *apiv1beta3.WebHookTrigger, c *conversion.Cloner) error {
 	out.Secret = in.Secret
 	return nil
 }
 
+func deepCopy_v1beta3_BlueGreenDeploymentStrategyParams(in deployapiv1beta3.BlueGreenDeploymentStrategyParams, out *deployapiv1beta3.BlueGreenDeploymentStrategyParams, c *conversion.Cloner) error {
+	out.ServiceName = in.ServiceName
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	if in.VerificationSeconds != nil {
+		out.VerificationSeconds = new(int64)
+		*out.VerificationSeconds = *in.VerificationSeconds
+	} else {
+		out.VerificationSeconds = nil
+	}
+	if in.Pre != nil {
+		out.Pre = new(deployapiv1beta3.LifecycleHook)
+		if err := deepCopy_v1beta3_LifecycleHook(*in.Pre, out.Pre, c); err != nil {
+			return err
+		}
+	} else {
+		out.Pre = nil
+	}
+	if in.Post != nil {
+		out.Post = new(deployapiv1beta3.LifecycleHook)
+		if err := deepCopy_v1beta3_LifecycleHook(*in.Post, out.Post, c); err != nil {
+			return err
+		}
+	} else {
+		out.Post = nil
+	}
+	return nil
+}
+
 func deepCopy_v1beta3_CustomDeploymentStrategyParams(in deployapiv1beta3.CustomDeploymentStrategyParams, out *deployapiv1beta3.CustomDeploymentStrategyParams, c *conversion.Cloner) error {
 	out.Image = in.Image
 	if in.Environment != nil {
@@ -1661,6 +1835,14 @@ func deepCopy_v1beta3_DeploymentStrategy(in deployapiv1beta3.DeploymentStrategy,
 	} else {
 		out.RollingParams = nil
 	}
+	if in.BlueGreenParams != nil {
+		out.BlueGreenParams = new(deployapiv1beta3.BlueGreenDeploymentStrategyParams)
+		if err := deepCopy_v1beta3_BlueGreenDeploymentStrategyParams(*in.BlueGreenParams, out.BlueGreenParams, c); err != nil {
+			return err
+		}
+	} else {
+		out.BlueGreenParams = nil
+	}
 	if newVal, err := c.DeepCopy(in.Resources); err != nil {
 		return err
 	} else {
@@ -1840,6 +2022,18 @@ func deepCopy_v1beta3_RollingDeploymentStrategyParams(in deployapiv1beta3.Rollin
 	} else {
 		out.UpdatePercent = nil
 	}
+	if in.PausePoints != nil {
+		out.PausePoints = make([]intstr.IntOrString, len(in.PausePoints))
+		for i := range in.PausePoints {
+			if newVal, err := c.DeepCopy(in.PausePoints[i]); err != nil {
+				return err
+			} else {
+				out.PausePoints[i] = newVal.(intstr.IntOrString)
+			}
+		}
+	} else {
+		out.PausePoints = nil
+	}
 	if in.Pre != nil {
 		out.Pre = new(deployapiv1beta3.LifecycleHook)
 		if err := deepCopy_v1beta3_LifecycleHook(*in.Pre, out.Pre, c); err != nil {
@@ -2139,6 +2333,14 @@ func deepCopy_v1beta3_TagReference(in imageapiv1beta3.TagReference, out *imageap
 	if err := deepCopy_v1beta3_TagImportPolicy(in.ImportPolicy, &out.ImportPolicy, c); err != nil {
 		return err
 	}
+	if err := deepCopy_v1beta3_TagReferencePolicy(in.ReferencePolicy, &out.ReferencePolicy, c); err != nil {
+		return err
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_TagReferencePolicy(in imageapiv1beta3.TagReferencePolicy, out *imageapiv1beta3.TagReferencePolicy, c *conversion.Cloner) error {
+	out.Type = in.Type
 	return nil
 }
 
@@ -2441,6 +2643,39 @@ func deepCopy_v1beta3_Route(in routeapiv1beta3.Route, out *routeapiv1beta3.Route
 	return nil
 }
 
+func deepCopy_v1beta3_RouteIngress(in routeapiv1beta3.RouteIngress, out *routeapiv1beta3.RouteIngress, c *conversion.Cloner) error {
+	out.Host = in.Host
+	out.RouterName = in.RouterName
+	if in.Conditions != nil {
+		out.Conditions = make([]routeapiv1beta3.RouteIngressCondition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := deepCopy_v1beta3_RouteIngressCondition(in.Conditions[i], &out.Conditions[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_RouteIngressCondition(in routeapiv1beta3.RouteIngressCondition, out *routeapiv1beta3.RouteIngressCondition, c *conversion.Cloner) error {
+	out.Type = in.Type
+	out.Status = in.Status
+	out.Reason = in.Reason
+	out.Message = in.Message
+	if in.LastTransitionTime != nil {
+		if newVal, err := c.DeepCopy(in.LastTransitionTime); err != nil {
+			return err
+		} else {
+			out.LastTransitionTime = newVal.(*unversioned.Time)
+		}
+	} else {
+		out.LastTransitionTime = nil
+	}
+	return nil
+}
+
 func deepCopy_v1beta3_RouteList(in routeapiv1beta3.RouteList, out *routeapiv1beta3.RouteList, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -2477,10 +2712,18 @@ func deepCopy_v1beta3_RoutePort(in routeapiv1beta3.RoutePort, out *routeapiv1bet
 func deepCopy_v1beta3_RouteSpec(in routeapiv1beta3.RouteSpec, out *routeapiv1beta3.RouteSpec, c *conversion.Cloner) error {
 	out.Host = in.Host
 	out.Path = in.Path
-	if newVal, err := c.DeepCopy(in.To); err != nil {
+	if err := deepCopy_v1beta3_RouteTargetReference(in.To, &out.To, c); err != nil {
 		return err
+	}
+	if in.AlternateBackends != nil {
+		out.AlternateBackends = make([]routeapiv1beta3.RouteTargetReference, len(in.AlternateBackends))
+		for i := range in.AlternateBackends {
+			if err := deepCopy_v1beta3_RouteTargetReference(in.AlternateBackends[i], &out.AlternateBackends[i], c); err != nil {
+				return err
+			}
+		}
 	} else {
-		out.To = newVal.(pkgapiv1beta3.ObjectReference)
+		out.AlternateBackends = nil
 	}
 	if in.Port != nil {
 		out.Port = new(routeapiv1beta3.RoutePort)
@@ -2498,10 +2741,33 @@ func deepCopy_v1beta3_RouteSpec(in routeapiv1beta3.RouteSpec, out *routeapiv1bet
 	} else {
 		out.TLS = nil
 	}
+	out.WildcardPolicy = in.WildcardPolicy
 	return nil
 }
 
 func deepCopy_v1beta3_RouteStatus(in routeapiv1beta3.RouteStatus, out *routeapiv1beta3.RouteStatus, c *conversion.Cloner) error {
+	if in.Ingress != nil {
+		out.Ingress = make([]routeapiv1beta3.RouteIngress, len(in.Ingress))
+		for i := range in.Ingress {
+			if err := deepCopy_v1beta3_RouteIngress(in.Ingress[i], &out.Ingress[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Ingress = nil
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_RouteTargetReference(in routeapiv1beta3.RouteTargetReference, out *routeapiv1beta3.RouteTargetReference, c *conversion.Cloner) error {
+	out.Kind = in.Kind
+	out.Name = in.Name
+	if in.Weight != nil {
+		out.Weight = new(int32)
+		*out.Weight = *in.Weight
+	} else {
+		out.Weight = nil
+	}
 	return nil
 }
 
@@ -2936,6 +3202,7 @@ func init() {
 		deepCopy_v1beta3_BuildLog,
 		deepCopy_v1beta3_BuildLogOptions,
 		deepCopy_v1beta3_BuildOutput,
+		deepCopy_v1beta3_BuildOutputTarget,
 		deepCopy_v1beta3_BuildRequest,
 		deepCopy_v1beta3_BuildSource,
 		deepCopy_v1beta3_BuildSpec,
@@ -2949,12 +3216,15 @@ func init() {
 		deepCopy_v1beta3_ImageChangeTrigger,
 		deepCopy_v1beta3_ImageSource,
 		deepCopy_v1beta3_ImageSourcePath,
+		deepCopy_v1beta3_PipelineBuildStrategy,
 		deepCopy_v1beta3_SecretBuildSource,
 		deepCopy_v1beta3_SecretSpec,
 		deepCopy_v1beta3_SourceBuildStrategy,
 		deepCopy_v1beta3_SourceControlUser,
 		deepCopy_v1beta3_SourceRevision,
+		deepCopy_v1beta3_StageInfo,
 		deepCopy_v1beta3_WebHookTrigger,
+		deepCopy_v1beta3_BlueGreenDeploymentStrategyParams,
 		deepCopy_v1beta3_CustomDeploymentStrategyParams,
 		deepCopy_v1beta3_DeploymentCause,
 		deepCopy_v1beta3_DeploymentCauseImageTrigger,
@@ -2990,6 +3260,7 @@ func init() {
 		deepCopy_v1beta3_TagEventCondition,
 		deepCopy_v1beta3_TagImportPolicy,
 		deepCopy_v1beta3_TagReference,
+		deepCopy_v1beta3_TagReferencePolicy,
 		deepCopy_v1beta3_OAuthAccessToken,
 		deepCopy_v1beta3_OAuthAccessTokenList,
 		deepCopy_v1beta3_OAuthAuthorizeToken,
@@ -3004,10 +3275,13 @@ func init() {
 		deepCopy_v1beta3_ProjectSpec,
 		deepCopy_v1beta3_ProjectStatus,
 		deepCopy_v1beta3_Route,
+		deepCopy_v1beta3_RouteIngress,
+		deepCopy_v1beta3_RouteIngressCondition,
 		deepCopy_v1beta3_RouteList,
 		deepCopy_v1beta3_RoutePort,
 		deepCopy_v1beta3_RouteSpec,
 		deepCopy_v1beta3_RouteStatus,
+		deepCopy_v1beta3_RouteTargetReference,
 		deepCopy_v1beta3_TLSConfig,
 		deepCopy_v1beta3_ClusterNetwork,
 		deepCopy_v1beta3_ClusterNetworkList,
@@ -13,6 +13,11 @@ const (
 	// This is useful when the immutable providerUserName is different than the login used to authenticate
 	// If present, this extra value is used as the preferred username
 	IdentityPreferredUsernameKey = "preferred_username"
+
+	// ScopesAnnotation is set on the user returned for users/~ to the scopes, separated by spaces, of the
+	// token the request was authenticated with. It allows servers that authenticate tokens against the
+	// master to limit the user to the same scopes.
+	ScopesAnnotation = "openshift.io/token-scopes"
 )
 
 // UserIdentityInfo contains information about an identity.  Identities are distinct from users.  An authentication server of
@@ -35,6 +40,14 @@ type UserIdentityMapper interface {
 	UserFor(identityInfo UserIdentityInfo) (user.Info, error)
 }
 
+// ScopedUserInfo is a user.Info whose authority is limited to the scopes granted to the token it
+// authenticated with. An empty list of scopes does not limit the user.
+type ScopedUserInfo interface {
+	user.Info
+	// GetScopes returns the scopes that limit what the user may do
+	GetScopes() []string
+}
+
 type Client interface {
 	GetId() string
 	GetSecret() string
@@ -79,3 +92,13 @@ func (i *DefaultUserIdentityInfo) GetProviderUserName() string {
 func (i *DefaultUserIdentityInfo) GetExtra() map[string]string {
 	return i.Extra
 }
+
+// DefaultScopedUserInfo is a user.DefaultInfo limited to a set of scopes
+type DefaultScopedUserInfo struct {
+	user.DefaultInfo
+	Scopes []string
+}
+
+func (i *DefaultScopedUserInfo) GetScopes() []string {
+	return i.Scopes
+}
@@ -4,8 +4,10 @@ import (
 	"k8s.io/kubernetes/pkg/auth/user"
 	kclient "k8s.io/kubernetes/pkg/client/unversioned"
 
+	authapi "github.com/openshift/origin/pkg/auth/api"
 	"github.com/openshift/origin/pkg/client"
 	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+	"github.com/openshift/origin/pkg/oauth/scope"
 )
 
 type Authenticator struct {
@@ -40,9 +42,14 @@ func (a *Authenticator) AuthenticateToken(value string) (user.Info, bool, error)
 		return nil, false, err
 	}
 
-	return &user.DefaultInfo{
+	info := user.DefaultInfo{
 		Name:   u.Name,
 		UID:    string(u.UID),
 		Groups: u.Groups,
-	}, true, nil
+	}
+	// the master reports the scopes of the token, which must limit the user here as well
+	if scopes := scope.Split(u.Annotations[authapi.ScopesAnnotation]); len(scopes) > 0 {
+		return &authapi.DefaultScopedUserInfo{DefaultInfo: info, Scopes: scopes}, true, nil
+	}
+	return &info, true, nil
 }
@@ -0,0 +1,63 @@
+package remotemaster
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+
+	authapi "github.com/openshift/origin/pkg/auth/api"
+)
+
+func TestAuthenticateTokenScopes(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations string
+		expected    []string
+	}{
+		{
+			name: "unscoped",
+		},
+		{
+			name:        "scoped",
+			annotations: `"annotations":{"` + authapi.ScopesAnnotation + `":"user:info role:view:myproject"},`,
+			expected:    []string{"user:info", "role:view:myproject"},
+		},
+	}
+
+	for _, tc := range tests {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			if req.URL.Path != "/oapi/v1/users/~" || req.Header.Get("Authorization") != "Bearer mytoken" {
+				http.NotFound(w, req)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"kind":"User","apiVersion":"v1","metadata":{%s"name":"bob","uid":"1"},"groups":["mygroup"]}`, tc.annotations)
+		}))
+
+		authenticator, err := NewAuthenticator(kclient.Config{Host: server.URL})
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tc.name, err)
+		}
+		user, ok, err := authenticator.AuthenticateToken("mytoken")
+		server.Close()
+		if err != nil || !ok {
+			t.Errorf("%s: expected the token to authenticate, got %v %v", tc.name, ok, err)
+			continue
+		}
+		if user.GetName() != "bob" || user.GetUID() != "1" || !reflect.DeepEqual(user.GetGroups(), []string{"mygroup"}) {
+			t.Errorf("%s: unexpected user %#v", tc.name, user)
+		}
+		scoped, isScoped := user.(authapi.ScopedUserInfo)
+		if isScoped != (len(tc.expected) > 0) {
+			t.Errorf("%s: expected scoped %v, got %#v", tc.name, len(tc.expected) > 0, user)
+			continue
+		}
+		if isScoped && !reflect.DeepEqual(scoped.GetScopes(), tc.expected) {
+			t.Errorf("%s: expected scopes %v, got %v", tc.name, tc.expected, scoped.GetScopes())
+		}
+	}
+}
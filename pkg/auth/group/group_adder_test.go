@@ -5,6 +5,7 @@ import (
 	"reflect"
 	"testing"
 
+	authapi "github.com/openshift/origin/pkg/auth/api"
 	"github.com/openshift/origin/pkg/auth/authenticator"
 	"k8s.io/kubernetes/pkg/auth/user"
 )
@@ -24,3 +25,24 @@ func TestGroupAdder(t *testing.T) {
 		t.Errorf("Expected original,added groups, got %#v", user.GetGroups())
 	}
 }
+
+func TestGroupAdderKeepsScopes(t *testing.T) {
+	adder := NewGroupAdder(
+		authenticator.RequestFunc(func(req *http.Request) (user.Info, bool, error) {
+			return &authapi.DefaultScopedUserInfo{DefaultInfo: user.DefaultInfo{Name: "user"}, Scopes: []string{"user:info"}}, true, nil
+		}),
+		[]string{"added"},
+	)
+
+	u, _, _ := adder.AuthenticateRequest(nil)
+	scoped, ok := u.(authapi.ScopedUserInfo)
+	if !ok {
+		t.Fatalf("Expected a scoped user, got %#v", u)
+	}
+	if !reflect.DeepEqual(scoped.GetScopes(), []string{"user:info"}) {
+		t.Errorf("Expected user:info scope, got %#v", scoped.GetScopes())
+	}
+	if !reflect.DeepEqual(scoped.GetGroups(), []string{"added"}) {
+		t.Errorf("Expected added group, got %#v", scoped.GetGroups())
+	}
+}
@@ -6,23 +6,46 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strings"
 
 	"github.com/RangelReale/osincli"
 	"github.com/golang/glog"
 
+	"k8s.io/kubernetes/pkg/util/sets"
+
 	authapi "github.com/openshift/origin/pkg/auth/api"
 	"github.com/openshift/origin/pkg/auth/oauth/external"
+	"github.com/openshift/origin/pkg/auth/server/errorpage"
 )
 
 const (
-	githubAuthorizeURL = "https://github.com/login/oauth/authorize"
-	githubTokenURL     = "https://github.com/login/oauth/access_token"
-	githubUserApiURL   = "https://api.github.com/user"
-	githubOAuthScope   = "user:email"
+	githubHostname   = "github.com"
+	githubAPIURL     = "https://api.github.com"
+	githubOAuthScope = "user:email"
+	// githubOrgScope allows reading the organization and team memberships of the user
+	githubOrgScope = "read:org"
+
+	// the paths are relative to the host for the OAuth endpoints and to the API URL for the API endpoints
+	githubAuthorizePath = "/login/oauth/authorize"
+	githubTokenPath     = "/login/oauth/access_token"
+	githubUserPath      = "/user"
+	githubUserOrgsPath  = "/user/orgs"
+	githubUserTeamsPath = "/user/teams"
+	// githubEnterpriseAPIPath is the path of the API on a GitHub Enterprise host
+	githubEnterpriseAPIPath = "/api/v3"
+
+	// githubPageSize is the number of organizations or teams requested per page
+	githubPageSize = 100
 )
 
 type provider struct {
 	providerName, clientID, clientSecret string
+
+	authorizeURL, tokenURL, apiURL string
+	transport                      http.RoundTripper
+
+	allowedOrganizations sets.String
+	allowedTeams         sets.String
 }
 
 type githubUser struct {
@@ -32,51 +55,74 @@ type githubUser struct {
 	Name  string
 }
 
-func NewProvider(providerName, clientID, clientSecret string) external.Provider {
-	return provider{providerName, clientID, clientSecret}
+type githubOrg struct {
+	Login string
 }
 
-func (p provider) GetTransport() (http.RoundTripper, error) {
-	return nil, nil
+type githubTeam struct {
+	Slug         string
+	Organization githubOrg
+}
+
+// NewProvider returns a GitHub provider. If hostname is empty, github.com is used, otherwise hostname is a GitHub
+// Enterprise host. If organizations or teams (in the <org>/<team> format) are given, only their members may log in.
+func NewProvider(providerName, clientID, clientSecret, hostname string, transport http.RoundTripper, organizations, teams []string) external.Provider {
+	p := &provider{
+		providerName:         providerName,
+		clientID:             clientID,
+		clientSecret:         clientSecret,
+		authorizeURL:         "https://" + githubHostname + githubAuthorizePath,
+		tokenURL:             "https://" + githubHostname + githubTokenPath,
+		apiURL:               githubAPIURL,
+		transport:            transport,
+		allowedOrganizations: sets.NewString(),
+		allowedTeams:         sets.NewString(),
+	}
+	if len(hostname) > 0 && hostname != githubHostname {
+		p.authorizeURL = "https://" + hostname + githubAuthorizePath
+		p.tokenURL = "https://" + hostname + githubTokenPath
+		p.apiURL = "https://" + hostname + githubEnterpriseAPIPath
+	}
+	// GitHub organization and team names are case-insensitive
+	for _, organization := range organizations {
+		p.allowedOrganizations.Insert(strings.ToLower(organization))
+	}
+	for _, team := range teams {
+		p.allowedTeams.Insert(strings.ToLower(team))
+	}
+	return p
+}
+
+func (p *provider) GetTransport() (http.RoundTripper, error) {
+	return p.transport, nil
 }
 
 // NewConfig implements external/interfaces/Provider.NewConfig
-func (p provider) NewConfig() (*osincli.ClientConfig, error) {
+func (p *provider) NewConfig() (*osincli.ClientConfig, error) {
+	scopes := []string{githubOAuthScope}
+	if p.restricted() {
+		scopes = append(scopes, githubOrgScope)
+	}
 	config := &osincli.ClientConfig{
 		ClientId:                 p.clientID,
 		ClientSecret:             p.clientSecret,
 		ErrorsInStatusCode:       true,
 		SendClientSecretInParams: true,
-		AuthorizeUrl:             githubAuthorizeURL,
-		TokenUrl:                 githubTokenURL,
-		Scope:                    githubOAuthScope,
+		AuthorizeUrl:             p.authorizeURL,
+		TokenUrl:                 p.tokenURL,
+		Scope:                    strings.Join(scopes, " "),
 	}
 	return config, nil
 }
 
 // AddCustomParameters implements external/interfaces/Provider.AddCustomParameters
-func (p provider) AddCustomParameters(req *osincli.AuthorizeRequest) {
+func (p *provider) AddCustomParameters(req *osincli.AuthorizeRequest) {
 }
 
 // GetUserIdentity implements external/interfaces/Provider.GetUserIdentity
-func (p provider) GetUserIdentity(data *osincli.AccessData) (authapi.UserIdentityInfo, bool, error) {
-	req, _ := http.NewRequest("GET", githubUserApiURL, nil)
-	req.Header.Set("Authorization", fmt.Sprintf("bearer %s", data.AccessToken))
-
-	res, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return nil, false, err
-	}
-	defer res.Body.Close()
-
-	body, err := ioutil.ReadAll(res.Body)
-	if err != nil {
-		return nil, false, err
-	}
-
+func (p *provider) GetUserIdentity(data *osincli.AccessData) (authapi.UserIdentityInfo, bool, error) {
 	userdata := githubUser{}
-	err = json.Unmarshal(body, &userdata)
-	if err != nil {
+	if _, err := p.getJSON(p.apiURL+githubUserPath, data.AccessToken, &userdata); err != nil {
 		return nil, false, err
 	}
 
@@ -84,6 +130,16 @@ func (p provider) GetUserIdentity(data *osincli.AccessData) (authapi.UserIdentit
 		return nil, false, errors.New("Could not retrieve GitHub id")
 	}
 
+	if p.restricted() {
+		allowed, err := p.isAllowed(data.AccessToken)
+		if err != nil {
+			return nil, false, err
+		}
+		if !allowed {
+			return nil, false, errorpage.NewAccessDeniedError("GitHub user %s is not a member of any of the organizations or teams allowed to log in", userdata.Login)
+		}
+	}
+
 	identity := authapi.NewDefaultUserIdentityInfo(p.providerName, fmt.Sprintf("%d", userdata.ID))
 	if len(userdata.Name) > 0 {
 		identity.Extra[authapi.IdentityDisplayNameKey] = userdata.Name
@@ -98,3 +154,94 @@ func (p provider) GetUserIdentity(data *osincli.AccessData) (authapi.UserIdentit
 
 	return identity, true, nil
 }
+
+// restricted returns true if only the members of some organizations or teams may log in
+func (p *provider) restricted() bool {
+	return len(p.allowedOrganizations) > 0 || len(p.allowedTeams) > 0
+}
+
+// isAllowed returns true if the user is a member of an allowed organization or team
+func (p *provider) isAllowed(accessToken string) (bool, error) {
+	if len(p.allowedOrganizations) > 0 {
+		for url := fmt.Sprintf("%s%s?per_page=%d", p.apiURL, githubUserOrgsPath, githubPageSize); len(url) > 0; {
+			orgs := []githubOrg{}
+			next, err := p.getJSON(url, accessToken, &orgs)
+			if err != nil {
+				return false, err
+			}
+			for _, org := range orgs {
+				if p.allowedOrganizations.Has(strings.ToLower(org.Login)) {
+					glog.V(4).Infof("User is a member of allowed organization %s", org.Login)
+					return true, nil
+				}
+			}
+			url = next
+		}
+	}
+
+	if len(p.allowedTeams) > 0 {
+		for url := fmt.Sprintf("%s%s?per_page=%d", p.apiURL, githubUserTeamsPath, githubPageSize); len(url) > 0; {
+			teams := []githubTeam{}
+			next, err := p.getJSON(url, accessToken, &teams)
+			if err != nil {
+				return false, err
+			}
+			for _, team := range teams {
+				if p.allowedTeams.Has(strings.ToLower(team.Organization.Login + "/" + team.Slug)) {
+					glog.V(4).Infof("User is a member of allowed team %s/%s", team.Organization.Login, team.Slug)
+					return true, nil
+				}
+			}
+			url = next
+		}
+	}
+
+	return false, nil
+}
+
+// getJSON decodes the response to an authenticated GET of url into data, and returns the URL of the next page, if any
+func (p *provider) getJSON(url, accessToken string, data interface{}) (string, error) {
+	req, _ := http.NewRequest("GET", url, nil)
+	req.Header.Set("Authorization", fmt.Sprintf("bearer %s", accessToken))
+
+	client := &http.Client{Transport: p.transport}
+	res, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("Non-200 response from GitHub API call %s: %d", url, res.StatusCode)
+	}
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return "", err
+	}
+	if err := json.Unmarshal(body, data); err != nil {
+		return "", err
+	}
+
+	return nextPage(res.Header.Get("Link")), nil
+}
+
+// nextPage returns the next page URL from a Link header, e.g. <https://api.github.com/user/orgs?page=2>; rel="next"
+func nextPage(link string) string {
+	for _, part := range strings.Split(link, ",") {
+		segments := strings.Split(part, ";")
+		if len(segments) < 2 {
+			continue
+		}
+		url := strings.TrimSpace(segments[0])
+		if !strings.HasPrefix(url, "<") || !strings.HasSuffix(url, ">") {
+			continue
+		}
+		for _, param := range segments[1:] {
+			if strings.TrimSpace(param) == `rel="next"` {
+				return url[1 : len(url)-1]
+			}
+		}
+	}
+	return ""
+}
@@ -1,11 +1,150 @@
 package github
 
 import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/RangelReale/osincli"
+
 	"github.com/openshift/origin/pkg/auth/oauth/external"
+	"github.com/openshift/origin/pkg/auth/server/errorpage"
 )
 
 func TestGitHub(t *testing.T) {
-	_ = external.Provider(NewProvider("github", "clientid", "clientsecret"))
+	_ = external.Provider(NewProvider("github", "clientid", "clientsecret", "", nil, nil, nil))
+}
+
+func TestGitHubConfig(t *testing.T) {
+	testCases := map[string]struct {
+		hostname      string
+		organizations []string
+		teams         []string
+
+		expectedAuthorizeURL string
+		expectedTokenURL     string
+		expectedAPIURL       string
+		expectedScope        string
+	}{
+		"github.com": {
+			expectedAuthorizeURL: "https://github.com/login/oauth/authorize",
+			expectedTokenURL:     "https://github.com/login/oauth/access_token",
+			expectedAPIURL:       "https://api.github.com",
+			expectedScope:        "user:email",
+		},
+		"enterprise restricted to organizations": {
+			hostname:             "github.example.com",
+			organizations:        []string{"myorg"},
+			expectedAuthorizeURL: "https://github.example.com/login/oauth/authorize",
+			expectedTokenURL:     "https://github.example.com/login/oauth/access_token",
+			expectedAPIURL:       "https://github.example.com/api/v3",
+			expectedScope:        "user:email read:org",
+		},
+		"restricted to teams": {
+			teams:                []string{"myorg/myteam"},
+			expectedAuthorizeURL: "https://github.com/login/oauth/authorize",
+			expectedTokenURL:     "https://github.com/login/oauth/access_token",
+			expectedAPIURL:       "https://api.github.com",
+			expectedScope:        "user:email read:org",
+		},
+	}
+
+	for k, tc := range testCases {
+		p := NewProvider("github", "clientid", "clientsecret", tc.hostname, nil, tc.organizations, tc.teams)
+		config, err := p.NewConfig()
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", k, err)
+		}
+		if config.AuthorizeUrl != tc.expectedAuthorizeURL {
+			t.Errorf("%s: expected authorize URL %s, got %s", k, tc.expectedAuthorizeURL, config.AuthorizeUrl)
+		}
+		if config.TokenUrl != tc.expectedTokenURL {
+			t.Errorf("%s: expected token URL %s, got %s", k, tc.expectedTokenURL, config.TokenUrl)
+		}
+		if apiURL := p.(*provider).apiURL; apiURL != tc.expectedAPIURL {
+			t.Errorf("%s: expected API URL %s, got %s", k, tc.expectedAPIURL, apiURL)
+		}
+		if config.Scope != tc.expectedScope {
+			t.Errorf("%s: expected scope %q, got %q", k, tc.expectedScope, config.Scope)
+		}
+	}
+}
+
+func TestGitHubMembership(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Header.Get("Authorization") != "bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch req.URL.Path {
+		case "/user":
+			fmt.Fprint(w, `{"id": 42, "login": "octocat", "email": "octocat@example.com", "name": "The Octocat"}`)
+		case "/user/orgs":
+			if req.URL.Query().Get("page") == "2" {
+				fmt.Fprint(w, `[{"login": "SecondPageOrg"}]`)
+				return
+			}
+			w.Header().Set("Link", fmt.Sprintf(`<%s/user/orgs?page=2>; rel="next", <%s/user/orgs?page=2>; rel="last"`, server.URL, server.URL))
+			fmt.Fprint(w, `[{"login": "firstpageorg"}]`)
+		case "/user/teams":
+			fmt.Fprint(w, `[{"slug": "myteam", "organization": {"login": "teamorg"}}]`)
+		default:
+			t.Errorf("Unexpected request path %s", req.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	testCases := map[string]struct {
+		organizations []string
+		teams         []string
+		expectAllowed bool
+	}{
+		"unrestricted": {
+			expectAllowed: true,
+		},
+		"member of an organization on the first page": {
+			organizations: []string{"other", "FirstPageOrg"},
+			expectAllowed: true,
+		},
+		"member of an organization on the second page": {
+			organizations: []string{"secondpageorg"},
+			expectAllowed: true,
+		},
+		"member of a team": {
+			organizations: []string{"other"},
+			teams:         []string{"teamorg/myteam"},
+			expectAllowed: true,
+		},
+		"not a member of the team": {
+			teams:         []string{"teamorg/otherteam"},
+			expectAllowed: false,
+		},
+		"not a member of the organization": {
+			organizations: []string{"teamorg-other"},
+			expectAllowed: false,
+		},
+	}
+
+	for k, tc := range testCases {
+		p := NewProvider("github", "clientid", "clientsecret", "", http.DefaultTransport, tc.organizations, tc.teams)
+		p.(*provider).apiURL = server.URL
+
+		identity, ok, err := p.GetUserIdentity(&osincli.AccessData{AccessToken: "token"})
+		if !tc.expectAllowed {
+			if !errorpage.IsAccessDenied(err) {
+				t.Errorf("%s: expected an access denied error, got %v", k, err)
+			}
+			continue
+		}
+		if err != nil || !ok {
+			t.Errorf("%s: unexpected error: %v", k, err)
+			continue
+		}
+		if identity.GetProviderUserName() != "42" {
+			t.Errorf("%s: unexpected identity %#v", k, identity)
+		}
+	}
 }
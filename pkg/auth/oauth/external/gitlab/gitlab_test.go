@@ -0,0 +1,77 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/RangelReale/osincli"
+
+	authapi "github.com/openshift/origin/pkg/auth/api"
+	"github.com/openshift/origin/pkg/auth/oauth/external"
+)
+
+func TestGitLab(t *testing.T) {
+	p, err := NewProvider("gitlab", nil, "https://gitlab.example.com/gitlab", "clientid", "clientsecret")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	_ = external.Provider(p)
+
+	config, err := p.NewConfig()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if config.AuthorizeUrl != "https://gitlab.example.com/gitlab/oauth/authorize" {
+		t.Errorf("Unexpected authorize URL %s", config.AuthorizeUrl)
+	}
+	if config.TokenUrl != "https://gitlab.example.com/gitlab/oauth/token" {
+		t.Errorf("Unexpected token URL %s", config.TokenUrl)
+	}
+
+	if _, err := NewProvider("gitlab", nil, "gitlab.example.com", "clientid", "clientsecret"); err == nil {
+		t.Errorf("Expected an error for a URL without a scheme")
+	}
+}
+
+func TestGitLabUserIdentity(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/api/v3/user" {
+			t.Errorf("Unexpected request path %s", req.URL.Path)
+		}
+		if req.Header.Get("Authorization") != "Bearer valid" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, `{"id": 42, "username": "jsmith", "email": "jsmith@example.com", "name": "John Smith"}`)
+	}))
+	defer server.Close()
+
+	p, err := NewProvider("gitlab", http.DefaultTransport, server.URL, "clientid", "clientsecret")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	identity, ok, err := p.GetUserIdentity(&osincli.AccessData{AccessToken: "valid"})
+	if err != nil || !ok {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if identity.GetProviderName() != "gitlab" || identity.GetProviderUserName() != "42" {
+		t.Errorf("Unexpected identity %#v", identity)
+	}
+	expected := map[string]string{
+		authapi.IdentityDisplayNameKey:       "John Smith",
+		authapi.IdentityPreferredUsernameKey: "jsmith",
+		authapi.IdentityEmailKey:             "jsmith@example.com",
+	}
+	for k, v := range expected {
+		if identity.GetExtra()[k] != v {
+			t.Errorf("Expected %s=%q, got %q", k, v, identity.GetExtra()[k])
+		}
+	}
+
+	if _, _, err := p.GetUserIdentity(&osincli.AccessData{AccessToken: "invalid"}); err == nil {
+		t.Errorf("Expected an error for a rejected token")
+	}
+}
@@ -0,0 +1,27 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/RangelReale/osin"
+
+	authorizerscope "github.com/openshift/origin/pkg/authorization/authorizer/scope"
+	"github.com/openshift/origin/pkg/oauth/scope"
+	"github.com/openshift/origin/pkg/oauth/server/osinserver"
+)
+
+type scopeValidator struct{}
+
+// NewScopeValidator returns an authorize handler that rejects requests for scopes that are not
+// understood with an invalid_scope error, so that no token is granted for them.
+func NewScopeValidator() osinserver.AuthorizeHandler {
+	return scopeValidator{}
+}
+
+// HandleAuthorize implements osinserver.AuthorizeHandler
+func (scopeValidator) HandleAuthorize(ar *osin.AuthorizeRequest, w http.ResponseWriter) (bool, error) {
+	if err := authorizerscope.Validate(scope.Split(ar.Scope)); err != nil {
+		return false, osinserver.NewInvalidScopeError(err.Error())
+	}
+	return false, nil
+}
@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/RangelReale/osin"
+
+	"github.com/openshift/origin/pkg/oauth/server/osinserver"
+)
+
+func TestScopeValidator(t *testing.T) {
+	tests := []struct {
+		scope       string
+		errExpected bool
+	}{
+		{scope: ""},
+		{scope: "user:info user:check-access"},
+		{scope: "user:full"},
+		{scope: "role:edit:myproject"},
+		{scope: "role:view:*"},
+		{scope: "user:unknown", errExpected: true},
+		{scope: "user:info role:edit", errExpected: true},
+		{scope: "admin", errExpected: true},
+	}
+
+	for _, tc := range tests {
+		handled, err := NewScopeValidator().HandleAuthorize(&osin.AuthorizeRequest{Scope: tc.scope}, httptest.NewRecorder())
+		if handled {
+			t.Errorf("%q: unexpected handled request", tc.scope)
+		}
+		if !tc.errExpected {
+			if err != nil {
+				t.Errorf("%q: unexpected error: %v", tc.scope, err)
+			}
+			continue
+		}
+		authorizeErr, ok := err.(*osinserver.AuthorizeError)
+		if !ok {
+			t.Errorf("%q: expected an AuthorizeError, got %#v", tc.scope, err)
+			continue
+		}
+		if authorizeErr.Code != osin.E_INVALID_SCOPE {
+			t.Errorf("%q: expected %s, got %s", tc.scope, osin.E_INVALID_SCOPE, authorizeErr.Code)
+		}
+	}
+}
@@ -332,3 +332,32 @@ func TestAuthenticateTokenValidated(t *testing.T) {
 		t.Error("Did not get a user!")
 	}
 }
+
+func TestAuthenticateTokenScoped(t *testing.T) {
+	tokenRegistry := &test.AccessTokenRegistry{
+		Err: nil,
+		AccessToken: &oapi.OAuthAccessToken{
+			ObjectMeta: kapi.ObjectMeta{CreationTimestamp: unversioned.Time{Time: time.Now()}},
+			ExpiresIn:  600, // 10 minutes
+			UserName:   "foo",
+			UserUID:    string("bar"),
+			Scopes:     []string{"user:info"},
+		},
+	}
+	userRegistry := usertest.NewUserRegistry()
+	userRegistry.Get["foo"] = &userapi.User{ObjectMeta: kapi.ObjectMeta{UID: "bar"}}
+
+	tokenAuthenticator := NewTokenAuthenticator(tokenRegistry, userRegistry, identitymapper.NoopGroupMapper{})
+
+	userInfo, found, err := tokenAuthenticator.AuthenticateToken("token")
+	if !found || err != nil {
+		t.Fatalf("Expected a user, got %v: %v", found, err)
+	}
+	scoped, ok := userInfo.(api.ScopedUserInfo)
+	if !ok {
+		t.Fatalf("Expected a scoped user, got %#v", userInfo)
+	}
+	if scopes := scoped.GetScopes(); len(scopes) != 1 || scopes[0] != "user:info" {
+		t.Errorf("Expected the token scopes, got %v", scopes)
+	}
+}
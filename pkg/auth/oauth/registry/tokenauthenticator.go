@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	authapi "github.com/openshift/origin/pkg/auth/api"
 	"github.com/openshift/origin/pkg/auth/userregistry/identitymapper"
 	"github.com/openshift/origin/pkg/oauth/registry/oauthaccesstoken"
 	"github.com/openshift/origin/pkg/user/registry/user"
@@ -57,9 +58,14 @@ func (a *TokenAuthenticator) AuthenticateToken(value string) (kuser.Info, bool,
 	}
 	groupNames = append(groupNames, u.Groups...)
 
-	return &kuser.DefaultInfo{
+	info := kuser.DefaultInfo{
 		Name:   u.Name,
 		UID:    string(u.UID),
 		Groups: groupNames,
-	}, true, nil
+	}
+	if len(token.Scopes) > 0 {
+		// the authorizer limits the user to what the token was granted
+		return &authapi.DefaultScopedUserInfo{DefaultInfo: info, Scopes: token.Scopes}, true, nil
+	}
+	return &info, true, nil
 }
@@ -0,0 +1,586 @@
+// Package saml implements a SAML 2.0 service provider that authenticates users with the Web Browser
+// SSO profile (http://docs.oasis-open.org/security/saml/v2.0/saml-profiles-2.0-os.pdf)
+package saml
+
+import (
+	"bytes"
+	"compress/flate"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"html/template"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pborman/uuid"
+
+	"k8s.io/kubernetes/pkg/util"
+
+	authapi "github.com/openshift/origin/pkg/auth/api"
+	"github.com/openshift/origin/pkg/auth/oauth/external"
+	"github.com/openshift/origin/pkg/auth/oauth/handlers"
+	"github.com/openshift/origin/pkg/auth/server/errorpage"
+)
+
+const (
+	protocolNS  = "urn:oasis:names:tc:SAML:2.0:protocol"
+	assertionNS = "urn:oasis:names:tc:SAML:2.0:assertion"
+
+	// BindingHTTPRedirect sends messages in the query of a redirect
+	BindingHTTPRedirect = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect"
+	// BindingHTTPPOST sends messages in a form posted by the browser
+	BindingHTTPPOST = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST"
+
+	statusSuccess             = "urn:oasis:names:tc:SAML:2.0:status:Success"
+	subjectConfirmationBearer = "urn:oasis:names:tc:SAML:2.0:cm:bearer"
+
+	// maxClockSkew is the difference allowed between the clocks of the identity provider and the master
+	maxClockSkew = 90 * time.Second
+	// requestTimeout is how long users have to authenticate with the identity provider
+	requestTimeout = 10 * time.Minute
+)
+
+// Config configures a SAML service provider
+type Config struct {
+	// ProviderName is the name of the identity provider identities are created for
+	ProviderName string
+
+	// EntityID is the entity ID of the service provider
+	EntityID string
+	// AssertionConsumerServiceURL is the URL the identity provider posts responses to
+	AssertionConsumerServiceURL string
+
+	// SSOURL is the single sign-on URL of the identity provider
+	SSOURL string
+	// SSOBinding is the binding used to send authentication requests to SSOURL
+	SSOBinding string
+	// IdentityProviderEntityID is the optional entity ID of the identity provider. If set, assertions must be issued by it.
+	IdentityProviderEntityID string
+	// Certificates are the certificates responses or assertions may be signed with
+	Certificates []*x509.Certificate
+
+	// The names of the attributes to use as the identity ID, preferred username, email and name, in order of preference.
+	// If no ID attribute is set, the NameID of the subject is used.
+	IDAttributes                []string
+	PreferredUsernameAttributes []string
+	EmailAttributes             []string
+	NameAttributes              []string
+}
+
+// Handler sends authentication requests to a SAML identity provider, and handles its responses
+type Handler struct {
+	config       Config
+	state        external.State
+	store        Store
+	success      handlers.AuthenticationSuccessHandler
+	errorHandler handlers.AuthenticationErrorHandler
+	mapper       authapi.UserIdentityMapper
+
+	// now returns the current time
+	now func() time.Time
+}
+
+// NewHandler returns a SAML service provider. store holds the pending authentication requests and the
+// IDs of the assertions which were used, to prevent their replay. It must be shared by every master.
+func NewHandler(config Config, state external.State, store Store, success handlers.AuthenticationSuccessHandler, errorHandler handlers.AuthenticationErrorHandler, mapper authapi.UserIdentityMapper) (*Handler, error) {
+	if len(config.SSOURL) == 0 {
+		return nil, errors.New("SSO URL is required")
+	}
+	if config.SSOBinding != BindingHTTPRedirect && config.SSOBinding != BindingHTTPPOST {
+		return nil, fmt.Errorf("unsupported SSO binding %q", config.SSOBinding)
+	}
+	if len(config.Certificates) == 0 {
+		return nil, errors.New("at least one identity provider certificate is required")
+	}
+	if store == nil {
+		return nil, errors.New("a store is required")
+	}
+
+	return &Handler{
+		config:       config,
+		state:        state,
+		store:        store,
+		success:      success,
+		errorHandler: errorHandler,
+		mapper:       mapper,
+		now:          time.Now,
+	}, nil
+}
+
+type authnRequest struct {
+	XMLName                     xml.Name     `xml:"urn:oasis:names:tc:SAML:2.0:protocol AuthnRequest"`
+	ID                          string       `xml:",attr"`
+	Version                     string       `xml:",attr"`
+	IssueInstant                string       `xml:",attr"`
+	Destination                 string       `xml:",attr"`
+	AssertionConsumerServiceURL string       `xml:",attr"`
+	ProtocolBinding             string       `xml:",attr"`
+	Issuer                      issuer       `xml:"urn:oasis:names:tc:SAML:2.0:assertion Issuer"`
+	NameIDPolicy                nameIDPolicy `xml:"urn:oasis:names:tc:SAML:2.0:protocol NameIDPolicy"`
+}
+
+type issuer struct {
+	Value string `xml:",chardata"`
+}
+
+type nameIDPolicy struct {
+	AllowCreate bool `xml:",attr"`
+}
+
+// AuthenticationRedirect implements oauth.handlers.RedirectAuthHandler
+func (h *Handler) AuthenticationRedirect(w http.ResponseWriter, req *http.Request) error {
+	glog.V(4).Infof("Authentication needed for %v", h.config.ProviderName)
+
+	state, err := h.state.Generate(w, req)
+	if err != nil {
+		glog.V(4).Infof("Error generating state: %v", err)
+		return err
+	}
+
+	// The relay state is limited to 80 bytes, so the state and the request ID are kept in the store
+	// under an opaque relay state
+	id := "_" + uuid.NewRandom().String()
+	relayState := uuid.NewRandom().String()
+	if err := h.store.PutRequest(relayState, Request{ID: id, State: state}, requestTimeout); err != nil {
+		return err
+	}
+
+	request, err := xml.Marshal(authnRequest{
+		ID:                          id,
+		Version:                     "2.0",
+		IssueInstant:                h.now().UTC().Format(time.RFC3339),
+		Destination:                 h.config.SSOURL,
+		AssertionConsumerServiceURL: h.config.AssertionConsumerServiceURL,
+		ProtocolBinding:             BindingHTTPPOST,
+		Issuer:                      issuer{h.config.EntityID},
+		NameIDPolicy:                nameIDPolicy{AllowCreate: true},
+	})
+	if err != nil {
+		return err
+	}
+
+	if h.config.SSOBinding == BindingHTTPPOST {
+		w.Header().Set("Content-Type", "text/html")
+		w.WriteHeader(http.StatusOK)
+		return postFormTemplate.Execute(w, postForm{
+			URL:         h.config.SSOURL,
+			SAMLRequest: base64.StdEncoding.EncodeToString(request),
+			RelayState:  relayState,
+		})
+	}
+
+	// The redirect binding deflates the request
+	deflated := &bytes.Buffer{}
+	writer, err := flate.NewWriter(deflated, flate.BestCompression)
+	if err != nil {
+		return err
+	}
+	if _, err := writer.Write(request); err != nil {
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+	ssoURL, err := url.Parse(h.config.SSOURL)
+	if err != nil {
+		return err
+	}
+	query := ssoURL.Query()
+	query.Set("SAMLRequest", base64.StdEncoding.EncodeToString(deflated.Bytes()))
+	query.Set("RelayState", relayState)
+	ssoURL.RawQuery = query.Encode()
+
+	glog.V(4).Infof("redirect to %v", ssoURL)
+	http.Redirect(w, req, ssoURL.String(), http.StatusFound)
+	return nil
+}
+
+type postForm struct {
+	URL         string
+	SAMLRequest string
+	RelayState  string
+}
+
+var postFormTemplate = template.Must(template.New("samlPostForm").Parse(`<!DOCTYPE html>
+<html>
+  <body onload="document.forms[0].submit()">
+    <form method="POST" action="{{ .URL }}">
+      <input type="hidden" name="SAMLRequest" value="{{ .SAMLRequest }}">
+      <input type="hidden" name="RelayState" value="{{ .RelayState }}">
+      <noscript><input type="submit" value="Continue"></noscript>
+    </form>
+  </body>
+</html>
+`))
+
+// ServeHTTP handles the responses the identity provider posts to the assertion consumer service URL
+func (h *Handler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != "POST" {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	request, err := h.store.TakeRequest(req.PostFormValue("RelayState"))
+	if err != nil {
+		glog.V(4).Infof("Error retrieving the authentication request: %v", err)
+		h.handleError(err, w, req)
+		return
+	}
+	ok, err := h.state.Check(request.State, req)
+	if !ok {
+		glog.V(4).Infof("State is invalid")
+		err := errors.New("State is invalid")
+		h.handleError(err, w, req)
+		return
+	}
+	if err != nil {
+		glog.V(4).Infof("Error verifying state: %v", err)
+		h.handleError(err, w, req)
+		return
+	}
+
+	identity, err := h.getUserIdentity(req.PostFormValue("SAMLResponse"), request.ID)
+	if err != nil {
+		glog.V(4).Infof("Error getting userIdentityInfo info: %v", err)
+		h.handleError(err, w, req)
+		return
+	}
+
+	user, err := h.mapper.UserFor(identity)
+	glog.V(4).Infof("Got userIdentityMapping: %#v", user)
+	if err != nil {
+		glog.V(4).Infof("Error creating or updating mapping for: %#v due to %v", identity, err)
+		h.handleError(err, w, req)
+		return
+	}
+
+	_, err = h.success.AuthenticationSucceeded(user, request.State, w, req)
+	if err != nil {
+		glog.V(4).Infof("Error calling success handler: %v", err)
+		h.handleError(err, w, req)
+		return
+	}
+}
+
+func (h *Handler) handleError(err error, w http.ResponseWriter, req *http.Request) {
+	handled, err := h.errorHandler.AuthenticationError(err, w, req)
+	if handled {
+		return
+	}
+	w.WriteHeader(http.StatusInternalServerError)
+	w.Write([]byte(`An error occurred`))
+}
+
+type response struct {
+	XMLName      xml.Name    `xml:"urn:oasis:names:tc:SAML:2.0:protocol Response"`
+	Destination  string      `xml:",attr"`
+	InResponseTo string      `xml:",attr"`
+	Assertions   []assertion `xml:"urn:oasis:names:tc:SAML:2.0:assertion Assertion"`
+}
+
+type assertion struct {
+	XMLName             xml.Name              `xml:"urn:oasis:names:tc:SAML:2.0:assertion Assertion"`
+	ID                  string                `xml:",attr"`
+	Issuer              string                `xml:"Issuer"`
+	NameID              string                `xml:"Subject>NameID"`
+	SubjectConfirmation []subjectConfirmation `xml:"Subject>SubjectConfirmation"`
+	Conditions          *conditions           `xml:"Conditions"`
+	Attributes          []attribute           `xml:"AttributeStatement>Attribute"`
+}
+
+type subjectConfirmation struct {
+	Method string                  `xml:",attr"`
+	Data   subjectConfirmationData `xml:"SubjectConfirmationData"`
+}
+
+type subjectConfirmationData struct {
+	NotOnOrAfter string `xml:",attr"`
+	Recipient    string `xml:",attr"`
+	InResponseTo string `xml:",attr"`
+}
+
+type conditions struct {
+	NotBefore            string                `xml:",attr"`
+	NotOnOrAfter         string                `xml:",attr"`
+	AudienceRestrictions []audienceRestriction `xml:"AudienceRestriction"`
+}
+
+type audienceRestriction struct {
+	Audiences []string `xml:"Audience"`
+}
+
+type attribute struct {
+	Name   string   `xml:",attr"`
+	Values []string `xml:"AttributeValue"`
+}
+
+// getUserIdentity validates a base64 encoded SAML response to the authentication request requestID and
+// returns the identity its assertion is about
+func (h *Handler) getUserIdentity(encodedResponse, requestID string) (authapi.UserIdentityInfo, error) {
+	data, err := base64.StdEncoding.DecodeString(encodedResponse)
+	if err != nil {
+		return nil, fmt.Errorf("the SAML response is not base64 encoded: %v", err)
+	}
+	root, err := parseXML(data)
+	if err != nil {
+		return nil, fmt.Errorf("the SAML response is not valid XML: %v", err)
+	}
+	if !root.is(protocolNS, "Response") {
+		return nil, fmt.Errorf("expected a SAML response, got %s", root.Local)
+	}
+	if err := checkStatus(root); err != nil {
+		return nil, err
+	}
+
+	assertion, err := h.verifiedAssertion(root, requestID)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.validateAssertion(assertion, requestID); err != nil {
+		return nil, err
+	}
+
+	values := map[string]string{}
+	for _, attribute := range assertion.Attributes {
+		for _, value := range attribute.Values {
+			if len(value) > 0 {
+				values[attribute.Name] = value
+				break
+			}
+		}
+	}
+
+	id := assertion.NameID
+	if len(h.config.IDAttributes) > 0 {
+		id = firstValue(values, h.config.IDAttributes)
+	}
+	if len(id) == 0 {
+		return nil, fmt.Errorf("could not retrieve the id of the subject of assertion %s", assertion.ID)
+	}
+
+	identity := authapi.NewDefaultUserIdentityInfo(h.config.ProviderName, id)
+	if preferredUsername := firstValue(values, h.config.PreferredUsernameAttributes); len(preferredUsername) > 0 {
+		identity.Extra[authapi.IdentityPreferredUsernameKey] = preferredUsername
+	}
+	if email := firstValue(values, h.config.EmailAttributes); len(email) > 0 {
+		identity.Extra[authapi.IdentityEmailKey] = email
+	}
+	if name := firstValue(values, h.config.NameAttributes); len(name) > 0 {
+		identity.Extra[authapi.IdentityDisplayNameKey] = name
+	}
+	glog.V(4).Infof("Got identity=%#v", identity)
+
+	return identity, nil
+}
+
+// checkStatus returns an error if the response is not successful. Responses that are not successful
+// are often not signed, the error is only informative.
+func checkStatus(root *element) error {
+	status, err := root.child(protocolNS, "Status")
+	if err != nil {
+		return err
+	}
+	statusCode, err := status.child(protocolNS, "StatusCode")
+	if err != nil {
+		return err
+	}
+	if statusCode.attr("Value") == statusSuccess {
+		return nil
+	}
+
+	code := statusCode.attr("Value")
+	if subcodes := statusCode.children(protocolNS, "StatusCode"); len(subcodes) > 0 {
+		code = subcodes[0].attr("Value")
+	}
+	message := ""
+	if messages := status.children(protocolNS, "StatusMessage"); len(messages) > 0 {
+		message = ": " + messages[0].text()
+	}
+	return errorpage.NewAccessDeniedError("The identity provider did not authenticate you (%s)%s", code, message)
+}
+
+// verifiedAssertion returns the assertion of the response to the authentication request requestID, from
+// the content of the response or assertion signature
+func (h *Handler) verifiedAssertion(root *element, requestID string) (*assertion, error) {
+	if len(root.children(assertionNS, "EncryptedAssertion")) > 0 {
+		return nil, errors.New("encrypted assertions are not supported")
+	}
+
+	if len(root.children(xmldsigNS, "Signature")) > 0 {
+		signed, err := verifySignature(root, h.config.Certificates)
+		if err != nil {
+			return nil, fmt.Errorf("could not verify the signature of the SAML response: %v", err)
+		}
+		signedResponse := &response{}
+		if err := xml.Unmarshal(signed, signedResponse); err != nil {
+			return nil, err
+		}
+		if len(signedResponse.Destination) > 0 && signedResponse.Destination != h.config.AssertionConsumerServiceURL {
+			return nil, fmt.Errorf("the SAML response is destined to %s", signedResponse.Destination)
+		}
+		if len(signedResponse.InResponseTo) > 0 && signedResponse.InResponseTo != requestID {
+			return nil, fmt.Errorf("the SAML response is in response to %s, not %s", signedResponse.InResponseTo, requestID)
+		}
+		if len(signedResponse.Assertions) != 1 {
+			return nil, fmt.Errorf("expected one assertion in the SAML response, found %d", len(signedResponse.Assertions))
+		}
+		return &signedResponse.Assertions[0], nil
+	}
+
+	assertionElement, err := root.child(assertionNS, "Assertion")
+	if err != nil {
+		return nil, err
+	}
+	if len(assertionElement.children(xmldsigNS, "Signature")) == 0 {
+		return nil, errors.New("neither the SAML response nor its assertion is signed")
+	}
+	signed, err := verifySignature(assertionElement, h.config.Certificates)
+	if err != nil {
+		return nil, fmt.Errorf("could not verify the signature of the SAML assertion: %v", err)
+	}
+	signedAssertion := &assertion{}
+	if err := xml.Unmarshal(signed, signedAssertion); err != nil {
+		return nil, err
+	}
+	return signedAssertion, nil
+}
+
+// validateAssertion checks that the assertion was issued for the service provider in response to the
+// authentication request requestID, and is valid
+func (h *Handler) validateAssertion(assertion *assertion, requestID string) error {
+	now := h.now()
+
+	if len(h.config.IdentityProviderEntityID) > 0 && assertion.Issuer != h.config.IdentityProviderEntityID {
+		return fmt.Errorf("assertion %s was issued by %q", assertion.ID, assertion.Issuer)
+	}
+
+	if assertion.Conditions == nil {
+		return fmt.Errorf("assertion %s has no conditions", assertion.ID)
+	}
+	if len(assertion.Conditions.NotBefore) > 0 {
+		notBefore, err := time.Parse(time.RFC3339, assertion.Conditions.NotBefore)
+		if err != nil {
+			return err
+		}
+		if now.Add(maxClockSkew).Before(notBefore) {
+			return fmt.Errorf("assertion %s is not valid before %s", assertion.ID, assertion.Conditions.NotBefore)
+		}
+	}
+	if len(assertion.Conditions.NotOnOrAfter) > 0 {
+		notOnOrAfter, err := time.Parse(time.RFC3339, assertion.Conditions.NotOnOrAfter)
+		if err != nil {
+			return err
+		}
+		if !now.Add(-maxClockSkew).Before(notOnOrAfter) {
+			return fmt.Errorf("assertion %s expired at %s", assertion.ID, assertion.Conditions.NotOnOrAfter)
+		}
+	}
+	// Every audience restriction must include the service provider
+	if len(assertion.Conditions.AudienceRestrictions) == 0 {
+		return fmt.Errorf("assertion %s has no audience restriction", assertion.ID)
+	}
+	for _, restriction := range assertion.Conditions.AudienceRestrictions {
+		if !contains(restriction.Audiences, h.config.EntityID) {
+			return fmt.Errorf("assertion %s is for audiences %v", assertion.ID, restriction.Audiences)
+		}
+	}
+
+	// A bearer subject confirmation must confirm the assertion was sent to the service provider in
+	// response to the request, which rejects unsolicited responses and responses to other requests
+	var expiration time.Time
+	for _, confirmation := range assertion.SubjectConfirmation {
+		if confirmation.Method != subjectConfirmationBearer || confirmation.Data.Recipient != h.config.AssertionConsumerServiceURL {
+			continue
+		}
+		if confirmation.Data.InResponseTo != requestID {
+			continue
+		}
+		notOnOrAfter, err := time.Parse(time.RFC3339, confirmation.Data.NotOnOrAfter)
+		if err != nil || !now.Add(-maxClockSkew).Before(notOnOrAfter) {
+			continue
+		}
+		expiration = notOnOrAfter.Add(maxClockSkew)
+		break
+	}
+	if expiration.IsZero() {
+		return fmt.Errorf("assertion %s has no valid bearer subject confirmation for %s in response to %s", assertion.ID, h.config.AssertionConsumerServiceURL, requestID)
+	}
+
+	unused, err := h.store.UseAssertion(assertion.ID, expiration.Sub(now))
+	if err != nil {
+		return err
+	}
+	if !unused {
+		return fmt.Errorf("assertion %s was already used", assertion.ID)
+	}
+	return nil
+}
+
+func firstValue(values map[string]string, names []string) string {
+	for _, name := range names {
+		if value := values[name]; len(value) > 0 {
+			return value
+		}
+	}
+	return ""
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+type entityDescriptor struct {
+	XMLName         xml.Name        `xml:"urn:oasis:names:tc:SAML:2.0:metadata EntityDescriptor"`
+	EntityID        string          `xml:"entityID,attr"`
+	SPSSODescriptor spSSODescriptor `xml:"SPSSODescriptor"`
+}
+
+type spSSODescriptor struct {
+	AuthnRequestsSigned        bool                     `xml:",attr"`
+	WantAssertionsSigned       bool                     `xml:",attr"`
+	ProtocolSupportEnumeration string                   `xml:"protocolSupportEnumeration,attr"`
+	AssertionConsumerService   assertionConsumerService `xml:"AssertionConsumerService"`
+}
+
+type assertionConsumerService struct {
+	Binding   string `xml:",attr"`
+	Location  string `xml:",attr"`
+	Index     int    `xml:"index,attr"`
+	IsDefault bool   `xml:"isDefault,attr"`
+}
+
+// ServeMetadata serves the metadata of the service provider, to register it with the identity provider
+func (h *Handler) ServeMetadata(w http.ResponseWriter, req *http.Request) {
+	metadata, err := xml.MarshalIndent(entityDescriptor{
+		EntityID: h.config.EntityID,
+		SPSSODescriptor: spSSODescriptor{
+			AuthnRequestsSigned:        false,
+			WantAssertionsSigned:       true,
+			ProtocolSupportEnumeration: protocolNS,
+			AssertionConsumerService: assertionConsumerService{
+				Binding:   BindingHTTPPOST,
+				Location:  h.config.AssertionConsumerServiceURL,
+				Index:     0,
+				IsDefault: true,
+			},
+		},
+	}, "", "  ")
+	if err != nil {
+		util.HandleError(fmt.Errorf("unable to render SAML metadata: %v", err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/samlmetadata+xml")
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(xml.Header))
+	w.Write(metadata)
+}
@@ -0,0 +1,413 @@
+package saml
+
+import (
+	"bytes"
+	"compress/flate"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"k8s.io/kubernetes/pkg/auth/user"
+
+	authapi "github.com/openshift/origin/pkg/auth/api"
+	"github.com/openshift/origin/pkg/auth/server/errorpage"
+)
+
+const (
+	testEntityID = "https://master.example.com/oauth2callback/saml/metadata"
+	testACSURL   = "https://master.example.com/oauth2callback/saml"
+	testSSOURL   = "https://idp.example.com/sso?tenant=1"
+	testIssuer   = "https://idp.example.com"
+
+	testRequestID = "_request"
+)
+
+type testState struct{}
+
+func (testState) Generate(w http.ResponseWriter, req *http.Request) (string, error) {
+	return "state", nil
+}
+
+func (testState) Check(state string, req *http.Request) (bool, error) {
+	return state == "state", nil
+}
+
+type testStore struct {
+	requests   map[string]Request
+	assertions map[string]bool
+}
+
+func newTestStore() *testStore {
+	return &testStore{requests: map[string]Request{}, assertions: map[string]bool{}}
+}
+
+func (s *testStore) PutRequest(relayState string, request Request, ttl time.Duration) error {
+	s.requests[relayState] = request
+	return nil
+}
+
+func (s *testStore) TakeRequest(relayState string) (*Request, error) {
+	request, ok := s.requests[relayState]
+	if !ok {
+		return nil, ErrRequestNotFound
+	}
+	delete(s.requests, relayState)
+	return &request, nil
+}
+
+func (s *testStore) UseAssertion(id string, ttl time.Duration) (bool, error) {
+	if s.assertions[id] {
+		return false, nil
+	}
+	s.assertions[id] = true
+	return true, nil
+}
+
+type testMapper struct {
+	identity authapi.UserIdentityInfo
+}
+
+func (m *testMapper) UserFor(identity authapi.UserIdentityInfo) (user.Info, error) {
+	m.identity = identity
+	return &user.DefaultInfo{Name: identity.GetProviderUserName()}, nil
+}
+
+type testSuccessHandler struct {
+	user user.Info
+}
+
+func (h *testSuccessHandler) AuthenticationSucceeded(user user.Info, state string, w http.ResponseWriter, req *http.Request) (bool, error) {
+	h.user = user
+	return true, nil
+}
+
+type testErrorHandler struct {
+	err error
+}
+
+func (h *testErrorHandler) AuthenticationError(err error, w http.ResponseWriter, req *http.Request) (bool, error) {
+	h.err = err
+	return true, nil
+}
+
+func newTestHandler(t *testing.T, binding string, certificate *x509.Certificate, now time.Time) (*Handler, *testStore, *testMapper, *testSuccessHandler, *testErrorHandler) {
+	store, mapper, success, errorHandler := newTestStore(), &testMapper{}, &testSuccessHandler{}, &testErrorHandler{}
+	handler, err := NewHandler(Config{
+		ProviderName:                "saml",
+		EntityID:                    testEntityID,
+		AssertionConsumerServiceURL: testACSURL,
+		SSOURL:                      testSSOURL,
+		SSOBinding:                  binding,
+		IdentityProviderEntityID:    testIssuer,
+		Certificates:                []*x509.Certificate{certificate},
+		PreferredUsernameAttributes: []string{"uid"},
+		EmailAttributes:             []string{"mail", "email"},
+		NameAttributes:              []string{"cn"},
+	}, testState{}, store, success, errorHandler, mapper)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	handler.now = func() time.Time { return now }
+	return handler, store, mapper, success, errorHandler
+}
+
+// testResponse returns a response with an assertion. The placeholders of the response and assertion
+// signatures are left in place.
+func testResponse(assertionID string, notOnOrAfter time.Time, audience, recipient string) string {
+	return `<samlp:Response xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol" xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion" ID="response" Version="2.0" Destination="` + testACSURL + `" InResponseTo="` + testRequestID + `">
+  <saml:Issuer>` + testIssuer + `</saml:Issuer>
+  <!--response-signature-->
+  <samlp:Status><samlp:StatusCode Value="urn:oasis:names:tc:SAML:2.0:status:Success"/></samlp:Status>
+  <saml:Assertion xmlns:xs="http://www.w3.org/2001/XMLSchema" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" ID="` + assertionID + `" Version="2.0">
+    <saml:Issuer>` + testIssuer + `</saml:Issuer>
+    ` + signaturePlaceholder + `
+    <saml:Subject>
+      <saml:NameID Format="urn:oasis:names:tc:SAML:2.0:nameid-format:persistent">jsmith-id</saml:NameID>
+      <saml:SubjectConfirmation Method="urn:oasis:names:tc:SAML:2.0:cm:bearer">
+        <saml:SubjectConfirmationData NotOnOrAfter="` + notOnOrAfter.UTC().Format(time.RFC3339) + `" Recipient="` + recipient + `" InResponseTo="` + testRequestID + `"/>
+      </saml:SubjectConfirmation>
+    </saml:Subject>
+    <saml:Conditions NotBefore="` + notOnOrAfter.Add(-10*time.Minute).UTC().Format(time.RFC3339) + `" NotOnOrAfter="` + notOnOrAfter.UTC().Format(time.RFC3339) + `">
+      <saml:AudienceRestriction><saml:Audience>` + audience + `</saml:Audience></saml:AudienceRestriction>
+    </saml:Conditions>
+    <saml:AttributeStatement>
+      <saml:Attribute Name="uid"><saml:AttributeValue xsi:type="xs:string">jsmith</saml:AttributeValue></saml:Attribute>
+      <saml:Attribute Name="email"><saml:AttributeValue xsi:type="xs:string">jsmith@example.com</saml:AttributeValue></saml:Attribute>
+      <saml:Attribute Name="cn"><saml:AttributeValue xsi:type="xs:string">John Smith</saml:AttributeValue></saml:Attribute>
+    </saml:AttributeStatement>
+  </saml:Assertion>
+</samlp:Response>`
+}
+
+func signResponse(t *testing.T, response string, key *rsa.PrivateKey) string {
+	return strings.Replace(sign(t, strings.Replace(response, "<!--response-signature-->", signaturePlaceholder, 1), "response", key), signaturePlaceholder, "", 1)
+}
+
+func signAssertion(t *testing.T, response, assertionID string, key *rsa.PrivateKey) string {
+	return sign(t, response, assertionID, key)
+}
+
+func encode(response string) string {
+	return base64.StdEncoding.EncodeToString([]byte(response))
+}
+
+func TestGetUserIdentity(t *testing.T) {
+	certificate, key := newCertificate(t)
+	_, otherKey := newCertificate(t)
+	now := time.Now()
+	valid := now.Add(5 * time.Minute)
+
+	testCases := map[string]struct {
+		response           string
+		expectErr          bool
+		expectAccessDenied bool
+	}{
+		"signed assertion": {
+			response: signAssertion(t, testResponse("assertion", valid, testEntityID, testACSURL), "assertion", key),
+		},
+		"signed response": {
+			response: signResponse(t, testResponse("assertion", valid, testEntityID, testACSURL), key),
+		},
+		"unsigned": {
+			response:  testResponse("assertion", valid, testEntityID, testACSURL),
+			expectErr: true,
+		},
+		"signed by another key": {
+			response:  signAssertion(t, testResponse("assertion", valid, testEntityID, testACSURL), "assertion", otherKey),
+			expectErr: true,
+		},
+		"modified after signing": {
+			response:  strings.Replace(signAssertion(t, testResponse("assertion", valid, testEntityID, testACSURL), "assertion", key), ">jsmith<", ">admin<", 1),
+			expectErr: true,
+		},
+		"signed assertion wrapped in an unsigned one": {
+			response: strings.Replace(
+				testResponse("evil", valid, testEntityID, testACSURL),
+				"<saml:Subject>",
+				signAssertion(t, testResponse("assertion", valid, testEntityID, testACSURL), "assertion", key)+"<saml:Subject>",
+				1),
+			expectErr: true,
+		},
+		"expired": {
+			response:  signAssertion(t, testResponse("assertion", now.Add(-5*time.Minute), testEntityID, testACSURL), "assertion", key),
+			expectErr: true,
+		},
+		"other audience": {
+			response:  signAssertion(t, testResponse("assertion", valid, "https://other.example.com", testACSURL), "assertion", key),
+			expectErr: true,
+		},
+		"other recipient": {
+			response:  signAssertion(t, testResponse("assertion", valid, testEntityID, "https://other.example.com"), "assertion", key),
+			expectErr: true,
+		},
+		"other issuer": {
+			response:  signAssertion(t, strings.Replace(testResponse("assertion", valid, testEntityID, testACSURL), "<saml:Issuer>"+testIssuer, "<saml:Issuer>https://other.example.com", -1), "assertion", key),
+			expectErr: true,
+		},
+		"assertion in response to another request": {
+			response:  signAssertion(t, strings.Replace(testResponse("assertion", valid, testEntityID, testACSURL), `Recipient="`+testACSURL+`" InResponseTo="`+testRequestID+`"`, `Recipient="`+testACSURL+`" InResponseTo="_other"`, 1), "assertion", key),
+			expectErr: true,
+		},
+		"unsolicited assertion": {
+			response:  signAssertion(t, strings.Replace(testResponse("assertion", valid, testEntityID, testACSURL), ` InResponseTo="`+testRequestID+`"/>`, `/>`, 1), "assertion", key),
+			expectErr: true,
+		},
+		"signed response to another request": {
+			response:  signResponse(t, strings.Replace(testResponse("assertion", valid, testEntityID, testACSURL), `Destination="`+testACSURL+`" InResponseTo="`+testRequestID+`"`, `Destination="`+testACSURL+`" InResponseTo="_other"`, 1), key),
+			expectErr: true,
+		},
+		"failed": {
+			response: `<samlp:Response xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol" ID="response" Version="2.0">
+  <samlp:Status>
+    <samlp:StatusCode Value="urn:oasis:names:tc:SAML:2.0:status:Responder"><samlp:StatusCode Value="urn:oasis:names:tc:SAML:2.0:status:RequestDenied"/></samlp:StatusCode>
+    <samlp:StatusMessage>Not allowed</samlp:StatusMessage>
+  </samlp:Status>
+</samlp:Response>`,
+			expectErr:          true,
+			expectAccessDenied: true,
+		},
+	}
+
+	for k, tc := range testCases {
+		handler, _, _, _, _ := newTestHandler(t, BindingHTTPRedirect, certificate, now)
+		identity, err := handler.getUserIdentity(encode(tc.response), testRequestID)
+		if tc.expectErr {
+			if err == nil {
+				t.Errorf("%s: expected an error, got %#v", k, identity)
+			} else if errorpage.IsAccessDenied(err) != tc.expectAccessDenied {
+				t.Errorf("%s: expected access denied %v, got %v", k, tc.expectAccessDenied, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", k, err)
+			continue
+		}
+		if identity.GetProviderName() != "saml" || identity.GetProviderUserName() != "jsmith-id" {
+			t.Errorf("%s: unexpected identity %#v", k, identity)
+		}
+		expected := map[string]string{
+			authapi.IdentityPreferredUsernameKey: "jsmith",
+			authapi.IdentityEmailKey:             "jsmith@example.com",
+			authapi.IdentityDisplayNameKey:       "John Smith",
+		}
+		for key, value := range expected {
+			if identity.GetExtra()[key] != value {
+				t.Errorf("%s: expected %s=%q, got %q", k, key, value, identity.GetExtra()[key])
+			}
+		}
+
+		// assertions may only be used once
+		if _, err := handler.getUserIdentity(encode(tc.response), testRequestID); err == nil {
+			t.Errorf("%s: expected an error replaying the assertion", k)
+		}
+	}
+}
+
+func TestIDAttributes(t *testing.T) {
+	certificate, key := newCertificate(t)
+	now := time.Now()
+	handler, _, _, _, _ := newTestHandler(t, BindingHTTPRedirect, certificate, now)
+	handler.config.IDAttributes = []string{"missing", "uid"}
+
+	identity, err := handler.getUserIdentity(encode(signAssertion(t, testResponse("assertion", now.Add(time.Minute), testEntityID, testACSURL), "assertion", key)), testRequestID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if identity.GetProviderUserName() != "jsmith" {
+		t.Errorf("Expected the id from the uid attribute, got %s", identity.GetProviderUserName())
+	}
+}
+
+func TestServeHTTP(t *testing.T) {
+	certificate, key := newCertificate(t)
+	now := time.Now()
+	handler, store, mapper, success, errorHandler := newTestHandler(t, BindingHTTPRedirect, certificate, now)
+	response := signAssertion(t, testResponse("assertion", now.Add(time.Minute), testEntityID, testACSURL), "assertion", key)
+	store.PutRequest("relay", Request{ID: testRequestID, State: "state"}, time.Minute)
+	store.PutRequest("forged-state", Request{ID: testRequestID, State: "forged"}, time.Minute)
+
+	post := func(relayState string) {
+		form := url.Values{"SAMLResponse": {encode(response)}, "RelayState": {relayState}}
+		req, _ := http.NewRequest("POST", testACSURL, strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	post("forged")
+	if errorHandler.err == nil || success.user != nil {
+		t.Errorf("Expected an unknown relay state to be rejected")
+	}
+
+	errorHandler.err = nil
+	post("forged-state")
+	if errorHandler.err == nil || success.user != nil {
+		t.Errorf("Expected an invalid state to be rejected")
+	}
+
+	errorHandler.err = nil
+	post("relay")
+	if errorHandler.err != nil {
+		t.Fatalf("Unexpected error: %v", errorHandler.err)
+	}
+	if success.user == nil || success.user.GetName() != "jsmith-id" {
+		t.Errorf("Expected the user to be authenticated, got %#v", success.user)
+	}
+	if mapper.identity == nil || mapper.identity.GetExtra()[authapi.IdentityEmailKey] != "jsmith@example.com" {
+		t.Errorf("Expected the identity to be mapped, got %#v", mapper.identity)
+	}
+
+	// each request is only answered once
+	errorHandler.err, success.user = nil, nil
+	post("relay")
+	if errorHandler.err == nil || success.user != nil {
+		t.Errorf("Expected a second response to the same request to be rejected")
+	}
+}
+
+func TestAuthenticationRedirect(t *testing.T) {
+	certificate, _ := newCertificate(t)
+
+	handler, store, _, _, _ := newTestHandler(t, BindingHTTPRedirect, certificate, time.Now())
+	w := httptest.NewRecorder()
+	if err := handler.AuthenticationRedirect(w, &http.Request{URL: &url.URL{}}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	location, err := url.Parse(w.Header().Get("Location"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if location.Host != "idp.example.com" || location.Query().Get("tenant") != "1" {
+		t.Errorf("Unexpected redirect %s", location)
+	}
+	relayState := location.Query().Get("RelayState")
+	if len(relayState) == 0 || len(relayState) > 80 {
+		t.Errorf("Expected a relay state of at most 80 bytes, got %q", relayState)
+	}
+	stored, ok := store.requests[relayState]
+	if !ok || stored.State != "state" {
+		t.Fatalf("Expected the state to be stored under the relay state, got %#v", store.requests)
+	}
+	deflated, err := base64.StdEncoding.DecodeString(location.Query().Get("SAMLRequest"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	request, err := ioutil.ReadAll(flate.NewReader(bytes.NewReader(deflated)))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for _, expected := range []string{`ID="` + stored.ID + `"`, `AssertionConsumerServiceURL="` + testACSURL + `"`, `Destination="` + testSSOURL + `"`, testEntityID + `</Issuer>`} {
+		if !strings.Contains(string(request), expected) {
+			t.Errorf("Expected the request to contain %s, got %s", expected, string(request))
+		}
+	}
+
+	handler, store, _, _, _ = newTestHandler(t, BindingHTTPPOST, certificate, time.Now())
+	w = httptest.NewRecorder()
+	if err := handler.AuthenticationRedirect(w, &http.Request{URL: &url.URL{}}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(store.requests) != 1 {
+		t.Fatalf("Expected one stored request, got %#v", store.requests)
+	}
+	for relayState = range store.requests {
+	}
+	for _, expected := range []string{`action="https://idp.example.com/sso?tenant=1"`, `name="SAMLRequest"`, `name="RelayState" value="` + relayState + `"`} {
+		if !strings.Contains(w.Body.String(), expected) {
+			t.Errorf("Expected the form to contain %s, got %s", expected, w.Body.String())
+		}
+	}
+}
+
+func TestServeMetadata(t *testing.T) {
+	certificate, _ := newCertificate(t)
+	handler, _, _, _, _ := newTestHandler(t, BindingHTTPRedirect, certificate, time.Now())
+
+	w := httptest.NewRecorder()
+	handler.ServeMetadata(w, &http.Request{})
+	for _, expected := range []string{`entityID="` + testEntityID + `"`, `Location="` + testACSURL + `"`, `Binding="` + BindingHTTPPOST + `"`} {
+		if !strings.Contains(w.Body.String(), expected) {
+			t.Errorf("Expected the metadata to contain %s, got %s", expected, w.Body.String())
+		}
+	}
+}
+
+func TestNewHandler(t *testing.T) {
+	certificate, _ := newCertificate(t)
+	if _, err := NewHandler(Config{SSOURL: testSSOURL, SSOBinding: "other", Certificates: []*x509.Certificate{certificate}}, testState{}, newTestStore(), nil, nil, nil); err == nil {
+		t.Errorf("Expected an error for an unknown binding")
+	}
+	if _, err := NewHandler(Config{SSOURL: testSSOURL, SSOBinding: BindingHTTPPOST}, testState{}, newTestStore(), nil, nil, nil); err == nil {
+		t.Errorf("Expected an error without certificates")
+	}
+	if _, err := NewHandler(Config{SSOURL: testSSOURL, SSOBinding: BindingHTTPPOST, Certificates: []*x509.Certificate{certificate}}, testState{}, nil, nil, nil, nil); err == nil {
+		t.Errorf("Expected an error without a store")
+	}
+}
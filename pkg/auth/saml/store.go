@@ -0,0 +1,113 @@
+package saml
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"time"
+
+	etcdclient "github.com/coreos/go-etcd/etcd"
+	etcdutil "k8s.io/kubernetes/pkg/storage/etcd/util"
+)
+
+// ErrRequestNotFound is returned by a Store when no authentication request is stored under a relay state,
+// because it was never sent, it expired, or its response was already received.
+var ErrRequestNotFound = errors.New("no pending SAML authentication request matches the relay state")
+
+// Request is what the service provider remembers about an authentication request sent to the identity
+// provider, until the response is received.
+type Request struct {
+	// ID is the ID of the AuthnRequest. Assertions must be issued in response to it.
+	ID string `json:"id"`
+	// State is the state of the login, which holds the URL to return to once the user is authenticated.
+	State string `json:"state"`
+}
+
+// Store keeps the state of SAML logins where every master can read it, so that the response of the
+// identity provider may be received by another master than the one that sent the request.
+type Store interface {
+	// PutRequest stores request under relayState until ttl elapses.
+	PutRequest(relayState string, request Request, ttl time.Duration) error
+	// TakeRequest returns the request stored under relayState and removes it, so that each request is
+	// only answered once. It returns ErrRequestNotFound if there is none.
+	TakeRequest(relayState string) (*Request, error)
+	// UseAssertion records that the assertion with the given ID was used until ttl elapses. It returns
+	// false if the assertion was already used.
+	UseAssertion(id string, ttl time.Duration) (bool, error)
+}
+
+// EtcdStore is a Store that keeps the state of SAML logins in etcd, with keys that expire with the
+// state they hold.
+type EtcdStore struct {
+	client *etcdclient.Client
+	prefix string
+}
+
+// NewEtcdStore returns a Store that keeps its keys under prefix with client.
+func NewEtcdStore(client *etcdclient.Client, prefix string) *EtcdStore {
+	return &EtcdStore{client: client, prefix: prefix}
+}
+
+var _ Store = &EtcdStore{}
+
+func (s *EtcdStore) requestKey(relayState string) string {
+	return path.Join(s.prefix, "requests", url.QueryEscape(relayState))
+}
+
+func (s *EtcdStore) assertionKey(id string) string {
+	return path.Join(s.prefix, "assertions", url.QueryEscape(id))
+}
+
+// PutRequest creates the key of the relay state, which must not exist.
+func (s *EtcdStore) PutRequest(relayState string, request Request, ttl time.Duration) error {
+	value, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+	if _, err := s.client.Create(s.requestKey(relayState), string(value), ttlSeconds(ttl)); err != nil {
+		return fmt.Errorf("unable to store SAML authentication request %s: %v", request.ID, err)
+	}
+	return nil
+}
+
+// TakeRequest deletes the key of the relay state, only the first caller gets the request back.
+func (s *EtcdStore) TakeRequest(relayState string) (*Request, error) {
+	response, err := s.client.Delete(s.requestKey(relayState), false)
+	if etcdutil.IsEtcdNotFound(err) {
+		return nil, ErrRequestNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve the SAML authentication request: %v", err)
+	}
+	if response.PrevNode == nil {
+		return nil, ErrRequestNotFound
+	}
+	request := &Request{}
+	if err := json.Unmarshal([]byte(response.PrevNode.Value), request); err != nil {
+		return nil, fmt.Errorf("unable to decode the SAML authentication request: %v", err)
+	}
+	return request, nil
+}
+
+// UseAssertion creates the key of the assertion, which fails if it was already created.
+func (s *EtcdStore) UseAssertion(id string, ttl time.Duration) (bool, error) {
+	_, err := s.client.Create(s.assertionKey(id), id, ttlSeconds(ttl))
+	if etcdutil.IsEtcdNodeExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("unable to record the use of assertion %s: %v", id, err)
+	}
+	return true, nil
+}
+
+// ttlSeconds rounds ttl up to whole seconds, the precision of etcd
+func ttlSeconds(ttl time.Duration) uint64 {
+	seconds := uint64((ttl + time.Second - 1) / time.Second)
+	if seconds == 0 {
+		return 1
+	}
+	return seconds
+}
@@ -0,0 +1,45 @@
+package saml
+
+import (
+	"testing"
+	"time"
+
+	etcdtesting "k8s.io/kubernetes/pkg/storage/etcd/testing"
+)
+
+func TestEtcdStore(t *testing.T) {
+	server := etcdtesting.NewEtcdTestClientServer(t)
+	defer server.Terminate(t)
+
+	store := NewEtcdStore(server.Client, "/saml/test")
+
+	if _, err := store.TakeRequest("relay"); err != ErrRequestNotFound {
+		t.Errorf("Expected ErrRequestNotFound, got %v", err)
+	}
+	if err := store.PutRequest("relay", Request{ID: "_request", State: "state"}, time.Minute); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := store.PutRequest("relay", Request{ID: "_other", State: "other"}, time.Minute); err == nil {
+		t.Errorf("Expected an existing relay state not to be overwritten")
+	}
+	request, err := store.TakeRequest("relay")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if request.ID != "_request" || request.State != "state" {
+		t.Errorf("Unexpected request %#v", request)
+	}
+	if _, err := store.TakeRequest("relay"); err != ErrRequestNotFound {
+		t.Errorf("Expected a request to be taken only once, got %v", err)
+	}
+
+	for i, expected := range []bool{true, false} {
+		ok, err := store.UseAssertion("assertion", time.Minute)
+		if err != nil {
+			t.Fatalf("%d: Unexpected error: %v", i, err)
+		}
+		if ok != expected {
+			t.Errorf("%d: Expected %v, got %v", i, expected, ok)
+		}
+	}
+}
@@ -0,0 +1,477 @@
+package saml
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	// register the hash functions of the supported algorithms
+	_ "crypto/sha1"
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+)
+
+const (
+	xmlNamespace   = "http://www.w3.org/XML/1998/namespace"
+	xmldsigNS      = "http://www.w3.org/2000/09/xmldsig#"
+	excC14N        = "http://www.w3.org/2001/10/xml-exc-c14n#"
+	envelopedSig   = "http://www.w3.org/2000/09/xmldsig#enveloped-signature"
+	rsaSHA1        = "http://www.w3.org/2000/09/xmldsig#rsa-sha1"
+	rsaSHA256      = "http://www.w3.org/2001/04/xmldsig-more#rsa-sha256"
+	rsaSHA512      = "http://www.w3.org/2001/04/xmldsig-more#rsa-sha512"
+	digestSHA1     = "http://www.w3.org/2000/09/xmldsig#sha1"
+	digestSHA256   = "http://www.w3.org/2001/04/xmlenc#sha256"
+	digestSHA512   = "http://www.w3.org/2001/04/xmlenc#sha512"
+	idAttribute    = "ID"
+	algorithmAttr  = "Algorithm"
+	prefixListAttr = "PrefixList"
+)
+
+var signatureHashes = map[string]crypto.Hash{
+	rsaSHA1:   crypto.SHA1,
+	rsaSHA256: crypto.SHA256,
+	rsaSHA512: crypto.SHA512,
+}
+
+var digestHashes = map[string]crypto.Hash{
+	digestSHA1:   crypto.SHA1,
+	digestSHA256: crypto.SHA256,
+	digestSHA512: crypto.SHA512,
+}
+
+// element is a parsed XML element that keeps the namespace prefixes of the document, which the
+// canonicalization of signed content depends on
+type element struct {
+	Prefix string
+	Local  string
+	// Attrs are the attributes of the element other than namespace declarations. Attr.Name.Space is the prefix.
+	Attrs []xml.Attr
+	// Namespaces are the namespace declarations of the element, by prefix ("" for the default namespace)
+	Namespaces map[string]string
+	// Children are *element or xml.CharData nodes. Comments are dropped.
+	Children []interface{}
+	Parent   *element
+}
+
+// parseXML parses a document into a tree of elements. Documents with a DTD are rejected.
+func parseXML(data []byte) (*element, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	var root, current *element
+	for {
+		token, err := decoder.RawToken()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			e := &element{Prefix: t.Name.Space, Local: t.Name.Local, Namespaces: map[string]string{}, Parent: current}
+			for _, attr := range t.Attr {
+				switch {
+				case attr.Name.Space == "" && attr.Name.Local == "xmlns":
+					e.Namespaces[""] = attr.Value
+				case attr.Name.Space == "xmlns":
+					e.Namespaces[attr.Name.Local] = attr.Value
+				default:
+					e.Attrs = append(e.Attrs, attr)
+				}
+			}
+			if current == nil {
+				if root != nil {
+					return nil, errors.New("document has more than one root element")
+				}
+				root = e
+			} else {
+				current.Children = append(current.Children, e)
+			}
+			current = e
+		case xml.EndElement:
+			if current == nil || current.Prefix != t.Name.Space || current.Local != t.Name.Local {
+				return nil, fmt.Errorf("unexpected end element %s", t.Name.Local)
+			}
+			current = current.Parent
+		case xml.CharData:
+			if current != nil {
+				current.Children = append(current.Children, t.Copy())
+			}
+		case xml.Directive:
+			return nil, errors.New("documents with a DTD are not allowed")
+		}
+	}
+	if root == nil {
+		return nil, errors.New("document has no root element")
+	}
+	if current != nil {
+		return nil, errors.New("document is not complete")
+	}
+	return root, nil
+}
+
+// namespaceURI returns the namespace bound to prefix in the scope of e
+func (e *element) namespaceURI(prefix string) (string, bool) {
+	if prefix == "xml" {
+		return xmlNamespace, true
+	}
+	for ; e != nil; e = e.Parent {
+		if uri, ok := e.Namespaces[prefix]; ok {
+			return uri, true
+		}
+	}
+	return "", false
+}
+
+// is returns true if e is the element local in namespace
+func (e *element) is(namespace, local string) bool {
+	uri, _ := e.namespaceURI(e.Prefix)
+	return e.Local == local && uri == namespace
+}
+
+// attr returns the value of the unqualified attribute name
+func (e *element) attr(name string) string {
+	for _, attr := range e.Attrs {
+		if attr.Name.Space == "" && attr.Name.Local == name {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+// children returns the child elements local in namespace
+func (e *element) children(namespace, local string) []*element {
+	children := []*element{}
+	for _, child := range e.Children {
+		if c, ok := child.(*element); ok && c.is(namespace, local) {
+			children = append(children, c)
+		}
+	}
+	return children
+}
+
+// child returns the only child element local in namespace
+func (e *element) child(namespace, local string) (*element, error) {
+	children := e.children(namespace, local)
+	if len(children) != 1 {
+		return nil, fmt.Errorf("expected one %s element in %s, found %d", local, e.Local, len(children))
+	}
+	return children[0], nil
+}
+
+// text returns the character data of e
+func (e *element) text() string {
+	text := ""
+	for _, child := range e.Children {
+		if data, ok := child.(xml.CharData); ok {
+			text += string(data)
+		}
+	}
+	return text
+}
+
+// canonicalize serializes e and its descendants, without the excluded element, with Exclusive XML
+// Canonicalization without comments (http://www.w3.org/TR/xml-exc-c14n/). The namespaces in
+// inclusivePrefixes are handled as in inclusive canonicalization.
+func canonicalize(e *element, excluded *element, inclusivePrefixes []string) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	if err := writeCanonical(buf, e, excluded, inclusivePrefixes, map[string]string{}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func writeCanonical(buf *bytes.Buffer, e *element, excluded *element, inclusivePrefixes []string, rendered map[string]string) error {
+	// Namespaces are rendered on the elements that visibly utilize them, unless an output ancestor
+	// already rendered them with the same value
+	utilized := map[string]bool{e.Prefix: true}
+	for _, attr := range e.Attrs {
+		if len(attr.Name.Space) > 0 {
+			utilized[attr.Name.Space] = true
+		}
+	}
+	for _, prefix := range inclusivePrefixes {
+		if prefix == "#default" {
+			prefix = ""
+		}
+		if _, ok := e.namespaceURI(prefix); ok {
+			utilized[prefix] = true
+		}
+	}
+
+	prefixes := []string{}
+	for prefix := range utilized {
+		if prefix == "xml" {
+			continue
+		}
+		uri, ok := e.namespaceURI(prefix)
+		if !ok && len(prefix) > 0 {
+			return fmt.Errorf("namespace prefix %q is not bound", prefix)
+		}
+		renderedURI, wasRendered := rendered[prefix]
+		if wasRendered && renderedURI == uri {
+			continue
+		}
+		// the default namespace is only undeclared if an output ancestor declared it
+		if !wasRendered && len(uri) == 0 {
+			continue
+		}
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Strings(prefixes)
+
+	childRendered := rendered
+	if len(prefixes) > 0 {
+		childRendered = map[string]string{}
+		for prefix, uri := range rendered {
+			childRendered[prefix] = uri
+		}
+	}
+
+	name := qualifiedName(e.Prefix, e.Local)
+	buf.WriteString("<" + name)
+	for _, prefix := range prefixes {
+		uri, _ := e.namespaceURI(prefix)
+		childRendered[prefix] = uri
+		if len(prefix) == 0 {
+			buf.WriteString(` xmlns="`)
+		} else {
+			buf.WriteString(` xmlns:` + prefix + `="`)
+		}
+		buf.WriteString(escapeAttr(uri) + `"`)
+	}
+
+	attrs, err := sortedAttrs(e)
+	if err != nil {
+		return err
+	}
+	for _, attr := range attrs {
+		buf.WriteString(" " + qualifiedName(attr.Name.Space, attr.Name.Local) + `="` + escapeAttr(attr.Value) + `"`)
+	}
+	buf.WriteString(">")
+
+	for _, child := range e.Children {
+		switch c := child.(type) {
+		case *element:
+			if c == excluded {
+				continue
+			}
+			if err := writeCanonical(buf, c, excluded, inclusivePrefixes, childRendered); err != nil {
+				return err
+			}
+		case xml.CharData:
+			buf.WriteString(escapeText(string(c)))
+		}
+	}
+
+	buf.WriteString("</" + name + ">")
+	return nil
+}
+
+type canonicalAttr struct {
+	xml.Attr
+	namespace string
+}
+
+// sortedAttrs returns the attributes of e ordered by namespace URI, then local name
+func sortedAttrs(e *element) ([]xml.Attr, error) {
+	attrs := []canonicalAttr{}
+	for _, attr := range e.Attrs {
+		namespace := ""
+		if len(attr.Name.Space) > 0 {
+			uri, ok := e.namespaceURI(attr.Name.Space)
+			if !ok {
+				return nil, fmt.Errorf("namespace prefix %q is not bound", attr.Name.Space)
+			}
+			namespace = uri
+		}
+		attrs = append(attrs, canonicalAttr{attr, namespace})
+	}
+	sort.Sort(byNamespaceAndName(attrs))
+
+	sorted := []xml.Attr{}
+	for _, attr := range attrs {
+		sorted = append(sorted, attr.Attr)
+	}
+	return sorted, nil
+}
+
+type byNamespaceAndName []canonicalAttr
+
+func (a byNamespaceAndName) Len() int      { return len(a) }
+func (a byNamespaceAndName) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a byNamespaceAndName) Less(i, j int) bool {
+	if a[i].namespace != a[j].namespace {
+		return a[i].namespace < a[j].namespace
+	}
+	return a[i].Name.Local < a[j].Name.Local
+}
+
+func qualifiedName(prefix, local string) string {
+	if len(prefix) == 0 {
+		return local
+	}
+	return prefix + ":" + local
+}
+
+var textEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", "\r", "&#xD;")
+
+var attrEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", `"`, "&quot;", "\t", "&#x9;", "\n", "&#xA;", "\r", "&#xD;")
+
+func escapeText(s string) string {
+	return textEscaper.Replace(s)
+}
+
+func escapeAttr(s string) string {
+	return attrEscaper.Replace(s)
+}
+
+// verifySignature verifies the enveloped signature of e with one of certificates, and returns the
+// canonical form of e that was signed. Only the returned content should be trusted: the rest of the
+// document, including the other parts of e's parse tree, may have been added after signing.
+func verifySignature(e *element, certificates []*x509.Certificate) ([]byte, error) {
+	signature, err := e.child(xmldsigNS, "Signature")
+	if err != nil {
+		return nil, err
+	}
+	signedInfo, err := signature.child(xmldsigNS, "SignedInfo")
+	if err != nil {
+		return nil, err
+	}
+
+	// Canonicalize and digest the referenced element, which must be e
+	reference, err := signedInfo.child(xmldsigNS, "Reference")
+	if err != nil {
+		return nil, err
+	}
+	id := e.attr(idAttribute)
+	if len(id) == 0 || reference.attr("URI") != "#"+id {
+		return nil, fmt.Errorf("signature reference %q does not match the signed element ID %q", reference.attr("URI"), id)
+	}
+	referencePrefixes, err := referenceTransforms(reference)
+	if err != nil {
+		return nil, err
+	}
+	signedContent, err := canonicalize(e, signature, referencePrefixes)
+	if err != nil {
+		return nil, err
+	}
+	digestMethod, err := reference.child(xmldsigNS, "DigestMethod")
+	if err != nil {
+		return nil, err
+	}
+	digestHash, ok := digestHashes[digestMethod.attr(algorithmAttr)]
+	if !ok {
+		return nil, fmt.Errorf("unsupported digest algorithm %q", digestMethod.attr(algorithmAttr))
+	}
+	digestValue, err := reference.child(xmldsigNS, "DigestValue")
+	if err != nil {
+		return nil, err
+	}
+	expectedDigest, err := decodeBase64(digestValue.text())
+	if err != nil {
+		return nil, err
+	}
+	digest := digestHash.New()
+	digest.Write(signedContent)
+	if !bytes.Equal(digest.Sum(nil), expectedDigest) {
+		return nil, errors.New("the digest of the signed element does not match its signature")
+	}
+
+	// Canonicalize SignedInfo and verify its signature
+	canonicalizationMethod, err := signedInfo.child(xmldsigNS, "CanonicalizationMethod")
+	if err != nil {
+		return nil, err
+	}
+	signedInfoPrefixes, err := canonicalizationPrefixes(canonicalizationMethod)
+	if err != nil {
+		return nil, err
+	}
+	canonicalSignedInfo, err := canonicalize(signedInfo, nil, signedInfoPrefixes)
+	if err != nil {
+		return nil, err
+	}
+	signatureMethod, err := signedInfo.child(xmldsigNS, "SignatureMethod")
+	if err != nil {
+		return nil, err
+	}
+	signatureHash, ok := signatureHashes[signatureMethod.attr(algorithmAttr)]
+	if !ok {
+		return nil, fmt.Errorf("unsupported signature algorithm %q", signatureMethod.attr(algorithmAttr))
+	}
+	signatureValue, err := signature.child(xmldsigNS, "SignatureValue")
+	if err != nil {
+		return nil, err
+	}
+	signatureBytes, err := decodeBase64(signatureValue.text())
+	if err != nil {
+		return nil, err
+	}
+	hashed := signatureHash.New()
+	hashed.Write(canonicalSignedInfo)
+	for _, certificate := range certificates {
+		key, ok := certificate.PublicKey.(*rsa.PublicKey)
+		if !ok {
+			continue
+		}
+		if rsa.VerifyPKCS1v15(key, signatureHash, hashed.Sum(nil), signatureBytes) == nil {
+			return signedContent, nil
+		}
+	}
+	return nil, errors.New("the signature was not made by a trusted certificate")
+}
+
+// referenceTransforms checks that the transforms of a reference are the enveloped signature
+// transform and exclusive canonicalization, and returns the inclusive namespace prefixes
+func referenceTransforms(reference *element) ([]string, error) {
+	transforms, err := reference.child(xmldsigNS, "Transforms")
+	if err != nil {
+		return nil, err
+	}
+	enveloped, canonicalized := false, false
+	prefixes := []string{}
+	for _, transform := range transforms.children(xmldsigNS, "Transform") {
+		switch transform.attr(algorithmAttr) {
+		case envelopedSig:
+			enveloped = true
+		case excC14N:
+			canonicalized = true
+			if prefixes, err = canonicalizationPrefixes(transform); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, fmt.Errorf("unsupported transform %q", transform.attr(algorithmAttr))
+		}
+	}
+	if !enveloped {
+		return nil, errors.New("the signature must be an enveloped signature")
+	}
+	if !canonicalized {
+		// exclusive canonicalization is the only one supported, require it to be explicit
+		return nil, errors.New("the signed element must be canonicalized with exclusive canonicalization")
+	}
+	return prefixes, nil
+}
+
+// canonicalizationPrefixes checks that method is exclusive canonicalization, and returns its inclusive namespace prefixes
+func canonicalizationPrefixes(method *element) ([]string, error) {
+	if method.attr(algorithmAttr) != excC14N {
+		return nil, fmt.Errorf("unsupported canonicalization algorithm %q", method.attr(algorithmAttr))
+	}
+	inclusiveNamespaces := method.children(excC14N, "InclusiveNamespaces")
+	if len(inclusiveNamespaces) == 0 {
+		return nil, nil
+	}
+	return strings.Fields(inclusiveNamespaces[0].attr(prefixListAttr)), nil
+}
+
+func decodeBase64(s string) ([]byte, error) {
+	return base64.StdEncoding.DecodeString(strings.Join(strings.Fields(s), ""))
+}
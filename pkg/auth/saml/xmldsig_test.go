@@ -0,0 +1,240 @@
+package saml
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+)
+
+// findByID returns the element of the tree with the ID attribute id
+func findByID(e *element, id string) *element {
+	if e.attr(idAttribute) == id {
+		return e
+	}
+	for _, child := range e.Children {
+		if c, ok := child.(*element); ok {
+			if found := findByID(c, id); found != nil {
+				return found
+			}
+		}
+	}
+	return nil
+}
+
+func TestCanonicalize(t *testing.T) {
+	testCases := map[string]struct {
+		document          string
+		id                string
+		inclusivePrefixes []string
+		expected          string
+	}{
+		// http://www.w3.org/TR/xml-exc-c14n/#sec-Enveloping
+		"specification example": {
+			document: `<n0:local xmlns:n0="foo:bar" xmlns:n3="ftp://example.org"><n1:elem2 ID="e" xmlns:n1="http://example.net" xml:lang="en"><n3:stuff xmlns:n3="ftp://example.org"/></n1:elem2></n0:local>`,
+			id:       "e",
+			expected: `<n1:elem2 xmlns:n1="http://example.net" ID="e" xml:lang="en"><n3:stuff xmlns:n3="ftp://example.org"></n3:stuff></n1:elem2>`,
+		},
+		"namespaces are only rendered where they are visibly utilized": {
+			document: `<root xmlns="urn:default" xmlns:a="urn:a" xmlns:b="urn:b"><a:child ID="e" b:attr="1" plain="2"><a:grandchild/><b:grandchild/><grandchild/></a:child></root>`,
+			id:       "e",
+			expected: `<a:child xmlns:a="urn:a" xmlns:b="urn:b" ID="e" plain="2" b:attr="1"><a:grandchild></a:grandchild><b:grandchild></b:grandchild><grandchild xmlns="urn:default"></grandchild></a:child>`,
+		},
+		"the default namespace is undeclared below an element that declared it": {
+			document: `<child xmlns="urn:default" ID="e"><grandchild xmlns=""/></child>`,
+			id:       "e",
+			expected: `<child xmlns="urn:default" ID="e"><grandchild xmlns=""></grandchild></child>`,
+		},
+		"an empty default namespace is not rendered": {
+			document: `<root><child ID="e" xmlns=""><grandchild/></child></root>`,
+			id:       "e",
+			expected: `<child ID="e"><grandchild></grandchild></child>`,
+		},
+		"attributes are sorted by namespace, text and attributes are escaped, comments are dropped": {
+			document: "<a:child xmlns:a=\"urn:a\" xmlns:z=\"urn:0\" ID=\"e\" z:b=\"1\" a:a=\"&quot;&#9;&lt;&gt;\" c=\"&amp;\"><!-- comment -->x &amp; &lt;y&gt; <![CDATA[<z>]]>&#13;</a:child>",
+			id:       "e",
+			expected: "<a:child xmlns:a=\"urn:a\" xmlns:z=\"urn:0\" ID=\"e\" c=\"&amp;\" z:b=\"1\" a:a=\"&quot;&#x9;&lt;>\">x &amp; &lt;y&gt; &lt;z&gt;&#xD;</a:child>",
+		},
+		"inclusive prefixes are rendered when in scope": {
+			document:          `<root xmlns:xs="urn:xs" xmlns:unused="urn:unused"><child ID="e"><value>xs:string</value></child></root>`,
+			id:                "e",
+			inclusivePrefixes: []string{"xs", "missing"},
+			expected:          `<child xmlns:xs="urn:xs" ID="e"><value>xs:string</value></child>`,
+		},
+	}
+
+	for k, tc := range testCases {
+		root, err := parseXML([]byte(tc.document))
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", k, err)
+			continue
+		}
+		e := findByID(root, tc.id)
+		if e == nil {
+			t.Errorf("%s: element %s not found", k, tc.id)
+			continue
+		}
+		canonical, err := canonicalize(e, nil, tc.inclusivePrefixes)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", k, err)
+			continue
+		}
+		if string(canonical) != tc.expected {
+			t.Errorf("%s: expected\n%s\ngot\n%s", k, tc.expected, string(canonical))
+		}
+	}
+}
+
+func TestParseXMLRejectsDTD(t *testing.T) {
+	if _, err := parseXML([]byte(`<!DOCTYPE r [<!ENTITY e "x">]><r>&e;</r>`)); err == nil {
+		t.Errorf("Expected documents with a DTD to be rejected")
+	}
+}
+
+// newCertificate returns a self-signed certificate and its key
+func newCertificate(t *testing.T) (*x509.Certificate, *rsa.PrivateKey) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "idp.example.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	certificate, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	return certificate, key
+}
+
+const signaturePlaceholder = "<!--signature-->"
+
+// sign replaces the signature placeholder of document with an enveloped signature of the element id,
+// the way identity providers sign responses and assertions
+func sign(t *testing.T, document, id string, key *rsa.PrivateKey) string {
+	root, err := parseXML([]byte(document))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	e := findByID(root, id)
+	if e == nil {
+		t.Fatalf("Element %s not found", id)
+	}
+	content, err := canonicalize(e, nil, []string{"xs"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	digest := sha256.Sum256(content)
+
+	signedInfo := `<ds:SignedInfo>` +
+		`<ds:CanonicalizationMethod Algorithm="http://www.w3.org/2001/10/xml-exc-c14n#"/>` +
+		`<ds:SignatureMethod Algorithm="http://www.w3.org/2001/04/xmldsig-more#rsa-sha256"/>` +
+		`<ds:Reference URI="#` + id + `">` +
+		`<ds:Transforms>` +
+		`<ds:Transform Algorithm="http://www.w3.org/2000/09/xmldsig#enveloped-signature"/>` +
+		`<ds:Transform Algorithm="http://www.w3.org/2001/10/xml-exc-c14n#"><ec:InclusiveNamespaces xmlns:ec="http://www.w3.org/2001/10/xml-exc-c14n#" PrefixList="xs"/></ds:Transform>` +
+		`</ds:Transforms>` +
+		`<ds:DigestMethod Algorithm="http://www.w3.org/2001/04/xmlenc#sha256"/>` +
+		`<ds:DigestValue>` + base64.StdEncoding.EncodeToString(digest[:]) + `</ds:DigestValue>` +
+		`</ds:Reference>` +
+		`</ds:SignedInfo>`
+	signedInfoElement, err := parseXML([]byte(`<ds:Signature xmlns:ds="http://www.w3.org/2000/09/xmldsig#">` + signedInfo + `</ds:Signature>`))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	canonicalSignedInfo, err := canonicalize(signedInfoElement.Children[0].(*element), nil, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	hashed := sha256.Sum256(canonicalSignedInfo)
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, hashed[:])
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	return strings.Replace(document, signaturePlaceholder, `<ds:Signature xmlns:ds="http://www.w3.org/2000/09/xmldsig#">`+
+		signedInfo+
+		`<ds:SignatureValue>`+base64.StdEncoding.EncodeToString(signature)+`</ds:SignatureValue>`+
+		`</ds:Signature>`, 1)
+}
+
+func TestVerifySignature(t *testing.T) {
+	certificate, key := newCertificate(t)
+	otherCertificate, _ := newCertificate(t)
+
+	document := `<root xmlns:xs="urn:xs">
+  <item ID="signed" b="2" a="1">
+    ` + signaturePlaceholder + `
+    <value>xs:string</value>
+  </item>
+</root>`
+	signed := sign(t, document, "signed", key)
+
+	testCases := map[string]struct {
+		document     string
+		certificates []*x509.Certificate
+		expectErr    bool
+	}{
+		"valid": {
+			document:     signed,
+			certificates: []*x509.Certificate{otherCertificate, certificate},
+		},
+		"untrusted certificate": {
+			document:     signed,
+			certificates: []*x509.Certificate{otherCertificate},
+			expectErr:    true,
+		},
+		"modified content": {
+			document:     strings.Replace(signed, "<value>xs:string</value>", "<value>xs:other</value>", 1),
+			certificates: []*x509.Certificate{certificate},
+			expectErr:    true,
+		},
+		"reference to another element": {
+			document:     strings.Replace(signed, `ID="signed"`, `ID="other"`, 1),
+			certificates: []*x509.Certificate{certificate},
+			expectErr:    true,
+		},
+		"unsupported algorithm": {
+			document:     strings.Replace(signed, "xmldsig-more#rsa-sha256", "xmldsig-more#hmac-sha256", 1),
+			certificates: []*x509.Certificate{certificate},
+			expectErr:    true,
+		},
+	}
+
+	for k, tc := range testCases {
+		root, err := parseXML([]byte(tc.document))
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", k, err)
+			continue
+		}
+		signedContent, err := verifySignature(root.Children[1].(*element), tc.certificates)
+		if tc.expectErr {
+			if err == nil {
+				t.Errorf("%s: expected an error", k)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", k, err)
+			continue
+		}
+		expected := "<item xmlns:xs=\"urn:xs\" ID=\"signed\" a=\"1\" b=\"2\">\n    \n    <value>xs:string</value>\n  </item>"
+		if string(signedContent) != expected {
+			t.Errorf("%s: expected the signed content\n%s\ngot\n%s", k, expected, string(signedContent))
+		}
+	}
+}
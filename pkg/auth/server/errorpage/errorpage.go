@@ -0,0 +1,97 @@
+package errorpage
+
+import (
+	"fmt"
+	"html/template"
+	"net/http"
+
+	"k8s.io/kubernetes/pkg/util"
+
+	"github.com/openshift/origin/pkg/auth/oauth/handlers"
+)
+
+// AccessDeniedError is returned by identity providers when a user authenticated with the provider
+// but is not allowed to log in. Its message is shown to the user.
+type AccessDeniedError struct {
+	Message string
+}
+
+func (e *AccessDeniedError) Error() string {
+	return e.Message
+}
+
+// NewAccessDeniedError returns an AccessDeniedError with a formatted message
+func NewAccessDeniedError(format string, args ...interface{}) error {
+	return &AccessDeniedError{Message: fmt.Sprintf(format, args...)}
+}
+
+// IsAccessDenied returns true if err is an AccessDeniedError
+func IsAccessDenied(err error) bool {
+	_, ok := err.(*AccessDeniedError)
+	return ok
+}
+
+type ErrorPageRenderer interface {
+	Render(data ErrorData, w http.ResponseWriter, req *http.Request)
+}
+
+type ErrorData struct {
+	Error string
+}
+
+// ErrorPage renders access denied errors to the user. Other errors are left to the next handler.
+type ErrorPage struct {
+	render ErrorPageRenderer
+}
+
+var _ = handlers.AuthenticationErrorHandler(&ErrorPage{})
+
+func NewErrorPage(render ErrorPageRenderer) *ErrorPage {
+	return &ErrorPage{render: render}
+}
+
+// NewErrorPageRenderer returns a renderer that uses the default error page template
+func NewErrorPageRenderer() ErrorPageRenderer {
+	return errorPageTemplateRenderer{defaultErrorPageTemplate}
+}
+
+// AuthenticationError implements handlers.AuthenticationErrorHandler
+func (p *ErrorPage) AuthenticationError(err error, w http.ResponseWriter, req *http.Request) (bool, error) {
+	accessDenied, ok := err.(*AccessDeniedError)
+	if !ok {
+		return false, err
+	}
+	p.render.Render(ErrorData{Error: accessDenied.Message}, w, req)
+	return true, nil
+}
+
+type errorPageTemplateRenderer struct {
+	errorPageTemplate *template.Template
+}
+
+func (r errorPageTemplateRenderer) Render(data ErrorData, w http.ResponseWriter, req *http.Request) {
+	w.Header().Add("Content-Type", "text/html")
+	w.WriteHeader(http.StatusForbidden)
+	if err := r.errorPageTemplate.Execute(w, data); err != nil {
+		util.HandleError(fmt.Errorf("unable to render error page template: %v", err))
+	}
+}
+
+var defaultErrorPageTemplate = template.Must(template.New("defaultErrorPage").Parse(`<!DOCTYPE html>
+<html>
+  <head>
+    <title>Login Error</title>
+    <style type="text/css">
+      body {
+        font-family: "Open Sans", Helvetica, Arial, sans-serif;
+        font-size: 14px;
+        margin: 15px;
+      }
+    </style>
+  </head>
+  <body>
+    <h1>Access denied</h1>
+    <p>{{ .Error }}</p>
+  </body>
+</html>
+`))
@@ -0,0 +1,31 @@
+package errorpage
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestAuthenticationError(t *testing.T) {
+	page := NewErrorPage(NewErrorPageRenderer())
+
+	w := httptest.NewRecorder()
+	handled, err := page.AuthenticationError(errors.New("internal details"), w, &http.Request{})
+	if handled || err == nil {
+		t.Errorf("Expected other errors not to be handled, got %v %v", handled, err)
+	}
+
+	w = httptest.NewRecorder()
+	handled, err = page.AuthenticationError(NewAccessDeniedError("user <%s> is not a member", "bob"), w, &http.Request{})
+	if !handled || err != nil {
+		t.Fatalf("Expected access denied errors to be handled, got %v %v", handled, err)
+	}
+	if w.Code != http.StatusForbidden {
+		t.Errorf("Expected %d, got %d", http.StatusForbidden, w.Code)
+	}
+	if body := w.Body.String(); !strings.Contains(body, "user &lt;bob&gt; is not a member") {
+		t.Errorf("Expected the escaped message in the page, got %s", body)
+	}
+}
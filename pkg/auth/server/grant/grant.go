@@ -14,6 +14,7 @@ import (
 	"github.com/golang/glog"
 	"github.com/openshift/origin/pkg/auth/authenticator"
 	"github.com/openshift/origin/pkg/auth/server/csrf"
+	authorizerscope "github.com/openshift/origin/pkg/authorization/authorizer/scope"
 	oapi "github.com/openshift/origin/pkg/oauth/api"
 	"github.com/openshift/origin/pkg/oauth/registry/oauthclient"
 	"github.com/openshift/origin/pkg/oauth/registry/oauthclientauthorization"
@@ -41,9 +42,17 @@ type FormRenderer interface {
 type Form struct {
 	Action string
 	Error  string
+	// Scopes describes each requested scope to the user
+	Scopes []ScopeDescription
 	Values FormValues
 }
 
+// ScopeDescription is a requested scope and a human readable description of what it grants
+type ScopeDescription struct {
+	Name        string
+	Description string
+}
+
 type FormValues struct {
 	Then             string
 	ThenParam        string
@@ -134,6 +143,7 @@ func (l *Grant) handleForm(user user.Info, w http.ResponseWriter, req *http.Requ
 
 	form := Form{
 		Action: uri.String(),
+		Scopes: describeScopes(scope.Split(scopes)),
 		Values: FormValues{
 			Then:             then,
 			ThenParam:        thenParam,
@@ -223,6 +233,20 @@ func (l *Grant) handleGrant(user user.Info, w http.ResponseWriter, req *http.Req
 	http.Redirect(w, req, then, http.StatusFound)
 }
 
+// describeScopes describes the requested scopes. Scopes that are not understood are described as
+// granting nothing, which is what the authorizer does with them.
+func describeScopes(scopes []string) []ScopeDescription {
+	descriptions := []ScopeDescription{}
+	for _, s := range scopes {
+		description, err := authorizerscope.Describe(s)
+		if err != nil {
+			description = "Unrecognized scope, it grants no access"
+		}
+		descriptions = append(descriptions, ScopeDescription{Name: s, Description: description})
+	}
+	return descriptions
+}
+
 func (l *Grant) failed(reason string, w http.ResponseWriter, req *http.Request) {
 	form := Form{
 		Error: reason,
@@ -286,9 +310,16 @@ var grantTemplate = template.Must(template.New("grantForm").Parse(`
 <p>Do you approve granting an access token to the following OAuth client?</p>
 <pre>
 Client: {{ .Values.ClientID }}
-Scope:  {{ .Values.Scopes }}
 URI:    {{ .Values.RedirectURI }}
 </pre>
+{{ if .Scopes }}
+<p>The client requests the following access:</p>
+<ul>
+{{ range .Scopes }}  <li><code>{{ .Name }}</code>: {{ .Description }}</li>
+{{ end }}</ul>
+{{ else }}
+<p>The client requests full access with all of your permissions.</p>
+{{ end }}
   
   <input type="submit" name="{{ .Values.ApproveParam }}" value="Approve">
   <input type="submit" name="{{ .Values.DenyParam }}" value="Reject">
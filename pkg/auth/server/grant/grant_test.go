@@ -90,6 +90,35 @@ func TestGrant(t *testing.T) {
 				`name="scopes" value="myscope1 myscope2"`,
 				`name="redirect_uri" value="/myredirect"`,
 				`name="then" value="/authorize"`,
+				`<code>myscope1</code>: Unrecognized scope, it grants no access`,
+			},
+		},
+
+		"display scope descriptions": {
+			CSRF:           &csrf.FakeCSRF{Token: "test"},
+			Auth:           goodAuth("username"),
+			ClientRegistry: goodClientRegistry("myclient", []string{"myredirect"}),
+			AuthRegistry:   emptyAuthRegistry(),
+			Path:           "/grant?client_id=myclient&scopes=user%3Ainfo%20role%3Aview%3Amyproject&redirect_uri=/myredirect&then=/authorize",
+
+			ExpectStatusCode: 200,
+			ExpectContains: []string{
+				`name="scopes" value="user:info role:view:myproject"`,
+				`<code>user:info</code>: Read-only access to your user object`,
+				`<code>role:view:myproject</code>: Anything the view role allows, in project myproject`,
+			},
+		},
+
+		"display full access without scopes": {
+			CSRF:           &csrf.FakeCSRF{Token: "test"},
+			Auth:           goodAuth("username"),
+			ClientRegistry: goodClientRegistry("myclient", []string{"myredirect"}),
+			AuthRegistry:   emptyAuthRegistry(),
+			Path:           "/grant?client_id=myclient&redirect_uri=/myredirect&then=/authorize",
+
+			ExpectStatusCode: 200,
+			ExpectContains: []string{
+				`full access with all of your permissions`,
 			},
 		},
 
@@ -88,7 +88,7 @@ var (
 		PermissionGrantingGroupName: {"roles", "rolebindings", "resourceaccessreviews" /* cluster scoped*/, "subjectaccessreviews" /* cluster scoped*/, "localresourceaccessreviews", "localsubjectaccessreviews"},
 		OpenshiftExposedGroupName:   {BuildGroupName, ImageGroupName, DeploymentGroupName, TemplateGroupName, "routes"},
 		OpenshiftAllGroupName: {OpenshiftExposedGroupName, UserGroupName, OAuthGroupName, PolicyOwnerGroupName, SDNGroupName, PermissionGrantingGroupName, OpenshiftStatusGroupName, "projects",
-			"clusterroles", "clusterrolebindings", "clusterpolicies", "clusterpolicybindings", "images" /* cluster scoped*/, "projectrequests", "builds/details", "imagestreams/secrets"},
+			"clusterroles", "clusterrolebindings", "clusterpolicies", "clusterpolicybindings", "images" /* cluster scoped*/, "projectrequests", "builds/details", "imagestreams/secrets", "images/signatures"},
 		OpenshiftStatusGroupName: {"imagestreams/status", "routes/status"},
 
 		QuotaGroupName:         {"limitranges", "resourcequotas", "resourcequotausages"},
@@ -236,6 +236,8 @@ type SubjectAccessReview struct {
 	User string
 	// Groups is optional.  Groups is the list of groups to which the User belongs.
 	Groups sets.String
+	// Scopes is optional.  Scopes limit the User to what they allow, as if the User was authenticated with a token with these scopes.
+	Scopes []string
 }
 
 // LocalResourceAccessReview is a means to request a list of which users and groups are authorized to perform the action specified by spec in a particular namespace
@@ -256,6 +258,8 @@ type LocalSubjectAccessReview struct {
 	User string
 	// Groups is optional.  Groups is the list of groups to which the User belongs.
 	Groups sets.String
+	// Scopes is optional.  Scopes limit the User to what they allow, as if the User was authenticated with a token with these scopes.
+	Scopes []string
 }
 
 type AuthorizationAttributes struct {
@@ -149,6 +149,8 @@ type SubjectAccessReview struct {
 	User string `json:"user" description:"optional, if both user and groups are empty, the current authenticated user is used"`
 	// GroupsSlice is optional. Groups is the list of groups to which the User belongs.
 	GroupsSlice []string `json:"groups" description:"optional, list of groups to which the user belongs"`
+	// Scopes is optional. Scopes limit the User to what they allow, as if the User was authenticated with a token with these scopes.
+	Scopes []string `json:"scopes,omitempty" description:"optional, scopes that limit the user, as if the user was authenticated with a token with these scopes"`
 }
 
 // LocalResourceAccessReview is a means to request a list of which users and groups are authorized to perform the action specified by spec in a particular namespace
@@ -169,6 +171,8 @@ type LocalSubjectAccessReview struct {
 	User string `json:"user" description:"optional, if both user and groups are empty, the current authenticated user is used"`
 	// Groups is optional.  Groups is the list of groups to which the User belongs.
 	GroupsSlice []string `json:"groups" description:"optional, list of groups to which the user belongs"`
+	// Scopes is optional. Scopes limit the User to what they allow, as if the User was authenticated with a token with these scopes.
+	Scopes []string `json:"scopes,omitempty" description:"optional, scopes that limit the user, as if the user was authenticated with a token with these scopes"`
 }
 
 type AuthorizationAttributes struct {
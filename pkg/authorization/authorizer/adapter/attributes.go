@@ -5,6 +5,7 @@ import (
 	kauthorizer "k8s.io/kubernetes/pkg/auth/authorizer"
 	"k8s.io/kubernetes/pkg/auth/user"
 
+	authapi "github.com/openshift/origin/pkg/auth/api"
 	oauthorizer "github.com/openshift/origin/pkg/authorization/authorizer"
 )
 
@@ -17,6 +18,7 @@ type AdapterAttributes struct {
 	namespace string
 	userName  string
 	groups    []string
+	scopes    []string
 	oauthorizer.AuthorizationAttributes
 }
 
@@ -26,10 +28,16 @@ func OriginAuthorizerAttributes(kattrs kauthorizer.Attributes) (kapi.Context, oa
 	// Build a context to hold the namespace and user info
 	ctx := kapi.NewContext()
 	ctx = kapi.WithNamespace(ctx, kattrs.GetNamespace())
-	ctx = kapi.WithUser(ctx, &user.DefaultInfo{
+	info := user.DefaultInfo{
 		Name:   kattrs.GetUserName(),
 		Groups: kattrs.GetGroups(),
-	})
+	}
+	// Keep the scopes that limit the user
+	if adapterAttrs, ok := kattrs.(AdapterAttributes); ok && len(adapterAttrs.scopes) > 0 {
+		ctx = kapi.WithUser(ctx, &authapi.DefaultScopedUserInfo{DefaultInfo: info, Scopes: adapterAttrs.scopes})
+	} else {
+		ctx = kapi.WithUser(ctx, &info)
+	}
 
 	// If the passed attributes already satisfy our interface, use it directly
 	if oattrs, ok := kattrs.(oauthorizer.AuthorizationAttributes); ok {
@@ -54,12 +62,14 @@ func OriginAuthorizerAttributes(kattrs kauthorizer.Attributes) (kapi.Context, oa
 }
 
 // KubernetesAuthorizerAttributes adapts Origin authorization attributes to Kubernetes authorization attributes
-// The returned attributes can be passed to OriginAuthorizerAttributes to access extra information from the Origin attributes interface
-func KubernetesAuthorizerAttributes(namespace string, userName string, groups []string, oattrs oauthorizer.AuthorizationAttributes) kauthorizer.Attributes {
+// The returned attributes can be passed to OriginAuthorizerAttributes to access extra information from the Origin attributes interface,
+// including the scopes that limit the user
+func KubernetesAuthorizerAttributes(namespace string, userName string, groups []string, scopes []string, oattrs oauthorizer.AuthorizationAttributes) kauthorizer.Attributes {
 	return AdapterAttributes{
 		namespace:               namespace,
 		userName:                userName,
 		groups:                  groups,
+		scopes:                  scopes,
 		AuthorizationAttributes: oattrs,
 	}
 }
@@ -8,6 +8,7 @@ import (
 	kauthorizer "k8s.io/kubernetes/pkg/auth/authorizer"
 	"k8s.io/kubernetes/pkg/util/sets"
 
+	authapi "github.com/openshift/origin/pkg/auth/api"
 	oauthorizer "github.com/openshift/origin/pkg/authorization/authorizer"
 )
 
@@ -29,7 +30,7 @@ func TestRoundTrip(t *testing.T) {
 	}
 
 	// Convert to kube attributes
-	kattrs := KubernetesAuthorizerAttributes("ns", "myuser", []string{"mygroup"}, oattrs)
+	kattrs := KubernetesAuthorizerAttributes("ns", "myuser", []string{"mygroup"}, []string{"user:info"}, oattrs)
 	if kattrs.GetUserName() != "myuser" {
 		t.Errorf("Expected %v, got %v", "myuser", kattrs.GetUserName())
 	}
@@ -65,6 +66,8 @@ func TestRoundTrip(t *testing.T) {
 		t.Errorf("Expected %v, got %v", "myuser", user.GetName())
 	} else if !reflect.DeepEqual(user.GetGroups(), []string{"mygroup"}) {
 		t.Errorf("Expected %v, got %v", []string{"mygroup"}, user.GetGroups())
+	} else if scoped, ok := user.(authapi.ScopedUserInfo); !ok || !reflect.DeepEqual(scoped.GetScopes(), []string{"user:info"}) {
+		t.Errorf("Expected scopes %v, got %#v", []string{"user:info"}, user)
 	}
 
 	// Ensure common attribute info is preserved
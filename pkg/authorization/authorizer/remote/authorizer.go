@@ -7,6 +7,7 @@ import (
 	kerrs "k8s.io/kubernetes/pkg/api/errors"
 	"k8s.io/kubernetes/pkg/util/sets"
 
+	authapi "github.com/openshift/origin/pkg/auth/api"
 	authzapi "github.com/openshift/origin/pkg/authorization/api"
 	"github.com/openshift/origin/pkg/authorization/authorizer"
 	oclient "github.com/openshift/origin/pkg/client"
@@ -42,9 +43,14 @@ func (r *RemoteAuthorizer) Authorize(ctx kapi.Context, a authorizer.Authorizatio
 	// Extract user from context
 	user := ""
 	groups := sets.NewString()
+	var scopes []string
 	if userInfo, ok := kapi.UserFrom(ctx); ok {
 		user = userInfo.GetName()
 		groups.Insert(userInfo.GetGroups()...)
+		// the master limits the user to the scopes of the token it was authenticated with
+		if scoped, ok := userInfo.(authapi.ScopedUserInfo); ok {
+			scopes = scoped.GetScopes()
+		}
 	}
 
 	// Make sure we don't run a subject access review on our own permissions
@@ -57,12 +63,14 @@ func (r *RemoteAuthorizer) Authorize(ctx kapi.Context, a authorizer.Authorizatio
 		result, err = r.client.LocalSubjectAccessReviews(namespace).Create(&authzapi.LocalSubjectAccessReview{
 			User:   user,
 			Groups: groups,
+			Scopes: scopes,
 			Action: getAction(namespace, a),
 		})
 	} else {
 		result, err = r.client.SubjectAccessReviews().Create(&authzapi.SubjectAccessReview{
 			User:   user,
 			Groups: groups,
+			Scopes: scopes,
 			Action: getAction(namespace, a),
 		})
 	}
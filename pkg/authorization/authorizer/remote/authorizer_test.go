@@ -1,7 +1,69 @@
 package remote
 
-import "testing"
+import (
+	"reflect"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/auth/user"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	authapi "github.com/openshift/origin/pkg/auth/api"
+	authzapi "github.com/openshift/origin/pkg/authorization/api"
+	"github.com/openshift/origin/pkg/authorization/authorizer"
+	"github.com/openshift/origin/pkg/client/testclient"
+)
 
 func TestAuthorizer(t *testing.T) {
 	_, _ = NewAuthorizer(nil)
 }
+
+func TestAuthorizeScopes(t *testing.T) {
+	tests := []struct {
+		name      string
+		namespace string
+		user      user.Info
+		expected  []string
+	}{
+		{
+			name: "unscoped",
+			user: &user.DefaultInfo{Name: "bob"},
+		},
+		{
+			name:     "scoped",
+			user:     &authapi.DefaultScopedUserInfo{DefaultInfo: user.DefaultInfo{Name: "bob"}, Scopes: []string{"user:info"}},
+			expected: []string{"user:info"},
+		},
+		{
+			name:      "scoped in a namespace",
+			namespace: "myproject",
+			user:      &authapi.DefaultScopedUserInfo{DefaultInfo: user.DefaultInfo{Name: "bob"}, Scopes: []string{"role:view:myproject"}},
+			expected:  []string{"role:view:myproject"},
+		},
+	}
+
+	for _, tc := range tests {
+		var scopes []string
+		client := &testclient.Fake{}
+		client.AddReactor("create", "*", func(action ktestclient.Action) (bool, runtime.Object, error) {
+			switch review := action.(ktestclient.CreateAction).GetObject().(type) {
+			case *authzapi.SubjectAccessReview:
+				scopes = review.Scopes
+			case *authzapi.LocalSubjectAccessReview:
+				scopes = review.Scopes
+			}
+			return true, &authzapi.SubjectAccessReviewResponse{Allowed: true}, nil
+		})
+
+		authz, _ := NewAuthorizer(client)
+		ctx := kapi.WithUser(kapi.WithNamespace(kapi.NewContext(), tc.namespace), tc.user)
+		if _, _, err := authz.Authorize(ctx, &authorizer.DefaultAuthorizationAttributes{Verb: "get", Resource: "nodes/log"}); err != nil {
+			t.Errorf("%s: unexpected error: %v", tc.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(scopes, tc.expected) {
+			t.Errorf("%s: expected the review to be limited to scopes %v, got %v", tc.name, tc.expected, scopes)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package scope
+
+import (
+	"fmt"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	authapi "github.com/openshift/origin/pkg/auth/api"
+	"github.com/openshift/origin/pkg/authorization/authorizer"
+	"github.com/openshift/origin/pkg/authorization/rulevalidation"
+)
+
+type scopeAuthorizer struct {
+	delegate            authorizer.Authorizer
+	clusterPolicyGetter rulevalidation.ClusterPolicyGetter
+}
+
+// NewAuthorizer returns an authorizer that limits users authenticated with a scoped token to the
+// intersection of what their scopes allow and what delegate allows.
+func NewAuthorizer(delegate authorizer.Authorizer, clusterPolicyGetter rulevalidation.ClusterPolicyGetter) authorizer.Authorizer {
+	return &scopeAuthorizer{delegate: delegate, clusterPolicyGetter: clusterPolicyGetter}
+}
+
+func (a *scopeAuthorizer) Authorize(ctx kapi.Context, passedAttributes authorizer.AuthorizationAttributes) (bool, string, error) {
+	user, exists := kapi.UserFrom(ctx)
+	if !exists {
+		return a.delegate.Authorize(ctx, passedAttributes)
+	}
+	scoped, ok := user.(authapi.ScopedUserInfo)
+	if !ok || len(scoped.GetScopes()) == 0 {
+		return a.delegate.Authorize(ctx, passedAttributes)
+	}
+
+	attributes := defaultAuthorizationAttributes(passedAttributes)
+	rules, ruleRetrievalError := ScopesToRules(scoped.GetScopes(), kapi.NamespaceValue(ctx), a.clusterPolicyGetter)
+	for _, rule := range rules {
+		matches, err := attributes.RuleMatches(rule)
+		if err != nil {
+			return false, "", err
+		}
+		if matches {
+			// the scopes allow the action, the user must be allowed to perform it as well
+			return a.delegate.Authorize(ctx, passedAttributes)
+		}
+	}
+	if ruleRetrievalError != nil {
+		return false, "", ruleRetrievalError
+	}
+
+	return false, fmt.Sprintf("scopes %v prevent this action", scoped.GetScopes()), nil
+}
+
+// GetAllowedSubjects returns the subjects the delegate allows, scopes are only known for a request
+func (a *scopeAuthorizer) GetAllowedSubjects(ctx kapi.Context, attributes authorizer.AuthorizationAttributes) (sets.String, sets.String, error) {
+	return a.delegate.GetAllowedSubjects(ctx, attributes)
+}
+
+func defaultAuthorizationAttributes(passedAttributes authorizer.AuthorizationAttributes) *authorizer.DefaultAuthorizationAttributes {
+	if attributes, ok := passedAttributes.(*authorizer.DefaultAuthorizationAttributes); ok {
+		return attributes
+	}
+	return &authorizer.DefaultAuthorizationAttributes{
+		APIGroup:          passedAttributes.GetAPIGroup(),
+		Verb:              passedAttributes.GetVerb(),
+		RequestAttributes: passedAttributes.GetRequestAttributes(),
+		Resource:          passedAttributes.GetResource(),
+		ResourceName:      passedAttributes.GetResourceName(),
+		NonResourceURL:    passedAttributes.IsNonResourceURL(),
+		URL:               passedAttributes.GetURL(),
+	}
+}
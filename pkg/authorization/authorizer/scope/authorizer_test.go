@@ -0,0 +1,153 @@
+package scope
+
+import (
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/auth/user"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	authapi "github.com/openshift/origin/pkg/auth/api"
+	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
+	"github.com/openshift/origin/pkg/authorization/authorizer"
+	testpolicyregistry "github.com/openshift/origin/pkg/authorization/registry/test"
+)
+
+type allowAllAuthorizer struct {
+	called bool
+}
+
+func (a *allowAllAuthorizer) Authorize(ctx kapi.Context, attributes authorizer.AuthorizationAttributes) (bool, string, error) {
+	a.called = true
+	return true, "allowed", nil
+}
+
+func (a *allowAllAuthorizer) GetAllowedSubjects(ctx kapi.Context, attributes authorizer.AuthorizationAttributes) (sets.String, sets.String, error) {
+	return sets.NewString(), sets.NewString(), nil
+}
+
+func newClusterPolicyRegistry() *testpolicyregistry.ClusterPolicyRegistry {
+	return testpolicyregistry.NewClusterPolicyRegistry([]authorizationapi.ClusterPolicy{
+		{
+			ObjectMeta: kapi.ObjectMeta{Name: authorizationapi.PolicyName},
+			Roles: map[string]*authorizationapi.ClusterRole{
+				"view": {
+					ObjectMeta: kapi.ObjectMeta{Name: "view"},
+					Rules: []authorizationapi.PolicyRule{
+						{Verbs: sets.NewString("get", "list"), Resources: sets.NewString("pods")},
+					},
+				},
+			},
+		},
+	}, nil)
+}
+
+func TestScopeAuthorizer(t *testing.T) {
+	getUser := &authorizer.DefaultAuthorizationAttributes{Verb: "get", Resource: "users", ResourceName: "~"}
+	listPods := &authorizer.DefaultAuthorizationAttributes{Verb: "list", Resource: "pods"}
+	deletePods := &authorizer.DefaultAuthorizationAttributes{Verb: "delete", Resource: "pods"}
+
+	tests := []struct {
+		name       string
+		user       user.Info
+		namespace  string
+		attributes authorizer.AuthorizationAttributes
+		allowed    bool
+		expectErr  bool
+	}{
+		{
+			name:       "unscoped user",
+			user:       &user.DefaultInfo{Name: "bob"},
+			attributes: deletePods,
+			namespace:  "myproject",
+			allowed:    true,
+		},
+		{
+			name:       "scoped user without scopes",
+			user:       &authapi.DefaultScopedUserInfo{DefaultInfo: user.DefaultInfo{Name: "bob"}},
+			attributes: deletePods,
+			namespace:  "myproject",
+			allowed:    true,
+		},
+		{
+			name:       "user:info allows getting the user",
+			user:       &authapi.DefaultScopedUserInfo{DefaultInfo: user.DefaultInfo{Name: "bob"}, Scopes: []string{UserInfo}},
+			attributes: getUser,
+			allowed:    true,
+		},
+		{
+			name:       "user:info denies listing pods",
+			user:       &authapi.DefaultScopedUserInfo{DefaultInfo: user.DefaultInfo{Name: "bob"}, Scopes: []string{UserInfo}},
+			attributes: listPods,
+			namespace:  "myproject",
+			allowed:    false,
+		},
+		{
+			name:       "user:full allows everything",
+			user:       &authapi.DefaultScopedUserInfo{DefaultInfo: user.DefaultInfo{Name: "bob"}, Scopes: []string{UserFull}},
+			attributes: deletePods,
+			namespace:  "myproject",
+			allowed:    true,
+		},
+		{
+			name:       "role scope allows the role rules in its namespace",
+			user:       &authapi.DefaultScopedUserInfo{DefaultInfo: user.DefaultInfo{Name: "bob"}, Scopes: []string{"role:view:myproject"}},
+			attributes: listPods,
+			namespace:  "myproject",
+			allowed:    true,
+		},
+		{
+			name:       "role scope denies other rules",
+			user:       &authapi.DefaultScopedUserInfo{DefaultInfo: user.DefaultInfo{Name: "bob"}, Scopes: []string{"role:view:myproject"}},
+			attributes: deletePods,
+			namespace:  "myproject",
+			allowed:    false,
+		},
+		{
+			name:       "role scope denies other namespaces",
+			user:       &authapi.DefaultScopedUserInfo{DefaultInfo: user.DefaultInfo{Name: "bob"}, Scopes: []string{"role:view:myproject"}},
+			attributes: listPods,
+			namespace:  "other",
+			allowed:    false,
+		},
+		{
+			name:       "role scope for all namespaces",
+			user:       &authapi.DefaultScopedUserInfo{DefaultInfo: user.DefaultInfo{Name: "bob"}, Scopes: []string{"role:view:*"}},
+			attributes: listPods,
+			namespace:  "other",
+			allowed:    true,
+		},
+		{
+			name:       "missing role",
+			user:       &authapi.DefaultScopedUserInfo{DefaultInfo: user.DefaultInfo{Name: "bob"}, Scopes: []string{"role:admin:myproject"}},
+			attributes: listPods,
+			namespace:  "myproject",
+			allowed:    false,
+			expectErr:  true,
+		},
+		{
+			name:       "unknown scope",
+			user:       &authapi.DefaultScopedUserInfo{DefaultInfo: user.DefaultInfo{Name: "bob"}, Scopes: []string{"unknown"}},
+			attributes: getUser,
+			allowed:    false,
+			expectErr:  true,
+		},
+	}
+
+	for _, tc := range tests {
+		delegate := &allowAllAuthorizer{}
+		a := NewAuthorizer(delegate, newClusterPolicyRegistry())
+		ctx := kapi.WithUser(kapi.WithNamespace(kapi.NewContext(), tc.namespace), tc.user)
+
+		allowed, _, err := a.Authorize(ctx, tc.attributes)
+		if allowed != tc.allowed {
+			t.Errorf("%s: expected allowed %v, got %v", tc.name, tc.allowed, allowed)
+		}
+		if (err != nil) != tc.expectErr {
+			t.Errorf("%s: expected error %v, got %v", tc.name, tc.expectErr, err)
+		}
+		if delegate.called != tc.allowed {
+			t.Errorf("%s: expected the delegate to be called only when the scopes allow the action", tc.name)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package scope
+
+import (
+	"fmt"
+	"strings"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kapierrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/runtime"
+	kerrors "k8s.io/kubernetes/pkg/util/errors"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
+	"github.com/openshift/origin/pkg/authorization/rulevalidation"
+)
+
+const (
+	// UserIndicator is the prefix of the scopes that grant a fixed set of actions about the user
+	UserIndicator = "user:"
+	// ClusterRoleIndicator is the prefix of the scopes that grant the rules of a cluster role,
+	// as in role:<cluster role name>:<namespace>
+	ClusterRoleIndicator = "role:"
+
+	// UserInfo allows reading the user object of the user
+	UserInfo = UserIndicator + "info"
+	// UserAccessCheck allows checking what the user may do
+	UserAccessCheck = UserIndicator + "check-access"
+	// UserFull allows everything the user may do
+	UserFull = UserIndicator + "full"
+
+	// AllNamespaces grants the rules of a role scope in every namespace and at the cluster scope
+	AllNamespaces = "*"
+)
+
+// userScopes maps each user scope to its description and rules
+var userScopes = map[string]struct {
+	description string
+	rules       []authorizationapi.PolicyRule
+}{
+	UserInfo: {
+		description: "Read-only access to your user object (your username and the names of your identities)",
+		rules: []authorizationapi.PolicyRule{
+			{Verbs: sets.NewString("get"), Resources: sets.NewString("users"), ResourceNames: sets.NewString("~")},
+		},
+	},
+	UserAccessCheck: {
+		description: "Read-only access to view your privileges (for example, \"can I create builds?\")",
+		rules: []authorizationapi.PolicyRule{
+			{Verbs: sets.NewString("create"), Resources: sets.NewString("subjectaccessreviews", "localsubjectaccessreviews"), AttributeRestrictions: runtime.EmbeddedObject{Object: &authorizationapi.IsPersonalSubjectAccessReview{}}},
+		},
+	},
+	UserFull: {
+		description: "Full read/write access with all of your permissions",
+		rules: []authorizationapi.PolicyRule{
+			{
+				Verbs:           sets.NewString(authorizationapi.VerbAll),
+				APIGroups:       []string{authorizationapi.APIGroupAll},
+				Resources:       sets.NewString(authorizationapi.ResourceAll),
+				NonResourceURLs: sets.NewString(authorizationapi.NonResourceAll),
+			},
+		},
+	},
+}
+
+// ParseClusterRoleScope returns the cluster role name and namespace of a role:<name>:<namespace> scope
+func ParseClusterRoleScope(scope string) (string, string, error) {
+	if !strings.HasPrefix(scope, ClusterRoleIndicator) {
+		return "", "", fmt.Errorf("%q is not a role scope", scope)
+	}
+	parts := strings.Split(strings.TrimPrefix(scope, ClusterRoleIndicator), ":")
+	if len(parts) != 2 || len(parts[0]) == 0 || len(parts[1]) == 0 {
+		return "", "", fmt.Errorf("%q must be of the form %s<cluster role name>:<namespace or %s>", scope, ClusterRoleIndicator, AllNamespaces)
+	}
+	return parts[0], parts[1], nil
+}
+
+// Describe returns a description of what scope grants, suitable to show to a user approving it
+func Describe(scope string) (string, error) {
+	if s, ok := userScopes[scope]; ok {
+		return s.description, nil
+	}
+	if strings.HasPrefix(scope, ClusterRoleIndicator) {
+		role, namespace, err := ParseClusterRoleScope(scope)
+		if err != nil {
+			return "", err
+		}
+		if namespace == AllNamespaces {
+			return fmt.Sprintf("Anything the %s role allows, in all projects and at the cluster scope", role), nil
+		}
+		return fmt.Sprintf("Anything the %s role allows, in project %s", role, namespace), nil
+	}
+	return "", fmt.Errorf("unknown scope %q", scope)
+}
+
+// Validate returns an error for each scope that is not part of the grammar
+func Validate(scopes []string) error {
+	errs := []error{}
+	for _, scope := range scopes {
+		if _, err := Describe(scope); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return kerrors.NewAggregate(errs)
+}
+
+// ScopesToRules returns the rules that the scopes allow in namespace. The rules of role scopes are
+// read from the cluster policy. If an error is returned, the rules may not be complete, but they
+// contain every rule that could be determined.
+func ScopesToRules(scopes []string, namespace string, clusterPolicyGetter rulevalidation.ClusterPolicyGetter) ([]authorizationapi.PolicyRule, error) {
+	rules := []authorizationapi.PolicyRule{}
+	errs := []error{}
+
+	for _, scope := range scopes {
+		if s, ok := userScopes[scope]; ok {
+			rules = append(rules, s.rules...)
+			continue
+		}
+
+		roleName, roleNamespace, err := ParseClusterRoleScope(scope)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("unknown scope %q", scope))
+			continue
+		}
+		if roleNamespace != AllNamespaces && roleNamespace != namespace {
+			continue
+		}
+		roleRules, err := clusterRoleRules(roleName, clusterPolicyGetter)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		rules = append(rules, roleRules...)
+	}
+
+	return rules, kerrors.NewAggregate(errs)
+}
+
+func clusterRoleRules(name string, clusterPolicyGetter rulevalidation.ClusterPolicyGetter) ([]authorizationapi.PolicyRule, error) {
+	policy, err := clusterPolicyGetter.GetClusterPolicy(kapi.NewContext(), authorizationapi.PolicyName)
+	if kapierrors.IsNotFound(err) {
+		return nil, kapierrors.NewNotFound("clusterrole", name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	role, exists := policy.Roles[name]
+	if !exists {
+		return nil, kapierrors.NewNotFound("clusterrole", name)
+	}
+	return role.Rules, nil
+}
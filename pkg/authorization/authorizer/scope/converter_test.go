@@ -0,0 +1,58 @@
+package scope
+
+import (
+	"testing"
+)
+
+func TestDescribe(t *testing.T) {
+	tests := []struct {
+		scope     string
+		expectErr bool
+	}{
+		{scope: UserInfo},
+		{scope: UserAccessCheck},
+		{scope: UserFull},
+		{scope: "role:view:myproject"},
+		{scope: "role:view:*"},
+		{scope: "role:view", expectErr: true},
+		{scope: "role::myproject", expectErr: true},
+		{scope: "role:view:myproject:extra", expectErr: true},
+		{scope: "user:unknown", expectErr: true},
+		{scope: "unknown", expectErr: true},
+	}
+
+	for _, tc := range tests {
+		description, err := Describe(tc.scope)
+		if tc.expectErr {
+			if err == nil {
+				t.Errorf("%s: expected an error, got description %q", tc.scope, description)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tc.scope, err)
+		}
+		if len(description) == 0 {
+			t.Errorf("%s: expected a description", tc.scope)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	if err := Validate([]string{UserInfo, "role:edit:myproject"}); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if err := Validate([]string{UserInfo, "unknown"}); err == nil {
+		t.Errorf("Expected an error for an unknown scope")
+	}
+}
+
+func TestScopesToRules(t *testing.T) {
+	rules, err := ScopesToRules([]string{UserInfo, "role:view:myproject", "role:view:other"}, "myproject", newClusterPolicyRegistry())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(rules) != 2 {
+		t.Errorf("Expected the user:info rule and the view rule, got %#v", rules)
+	}
+}
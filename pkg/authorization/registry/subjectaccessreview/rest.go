@@ -9,6 +9,7 @@ import (
 	"k8s.io/kubernetes/pkg/auth/user"
 	"k8s.io/kubernetes/pkg/runtime"
 
+	authapi "github.com/openshift/origin/pkg/auth/api"
 	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
 	authorizationvalidation "github.com/openshift/origin/pkg/authorization/api/validation"
 	"github.com/openshift/origin/pkg/authorization/authorizer"
@@ -66,6 +67,17 @@ func (r *REST) Create(ctx kapi.Context, obj runtime.Object) (runtime.Object, err
 		}
 
 	}
+	if len(subjectAccessReview.Scopes) > 0 {
+		// the scopes limit the user just like the scopes of a token limit the user authenticated with it
+		userToCheck = &authapi.DefaultScopedUserInfo{
+			DefaultInfo: user.DefaultInfo{
+				Name:   userToCheck.GetName(),
+				UID:    userToCheck.GetUID(),
+				Groups: userToCheck.GetGroups(),
+			},
+			Scopes: subjectAccessReview.Scopes,
+		}
+	}
 
 	requestContext := kapi.WithNamespace(kapi.WithUser(ctx, userToCheck), subjectAccessReview.Action.Namespace)
 	attributes := authorizer.ToDefaultAuthorizationAttributes(subjectAccessReview.Action)
@@ -6,9 +6,11 @@ import (
 	"testing"
 
 	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/auth/user"
 	"k8s.io/kubernetes/pkg/util"
 	"k8s.io/kubernetes/pkg/util/sets"
 
+	authapi "github.com/openshift/origin/pkg/auth/api"
 	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
 	"github.com/openshift/origin/pkg/authorization/authorizer"
 )
@@ -25,6 +27,7 @@ type testAuthorizer struct {
 	deniedNamespaces sets.String
 
 	actualAttributes authorizer.DefaultAuthorizationAttributes
+	actualUser       user.Info
 }
 
 func (a *testAuthorizer) Authorize(ctx kapi.Context, passedAttributes authorizer.AuthorizationAttributes) (allowed bool, reason string, err error) {
@@ -43,6 +46,7 @@ func (a *testAuthorizer) Authorize(ctx kapi.Context, passedAttributes authorizer
 	}
 
 	a.actualAttributes = attributes
+	a.actualUser, _ = kapi.UserFrom(ctx)
 
 	if len(a.err) == 0 {
 		return a.allowed, a.reason, nil
@@ -129,6 +133,32 @@ func TestErrors(t *testing.T) {
 	test.runTest(t)
 }
 
+func TestScopes(t *testing.T) {
+	testAuthorizer := &testAuthorizer{allowed: true}
+	storage := REST{testAuthorizer}
+	reviewRequest := &authorizationapi.SubjectAccessReview{
+		Action: authorizationapi.AuthorizationAttributes{
+			Verb:     "get",
+			Resource: "nodes/log",
+		},
+		User:   "foo",
+		Groups: sets.NewString("first"),
+		Scopes: []string{"user:info"},
+	}
+
+	ctx := kapi.WithUser(kapi.WithNamespace(kapi.NewContext(), ""), &user.DefaultInfo{Name: "system:node:mynode"})
+	if _, err := storage.Create(ctx, reviewRequest); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	scoped, ok := testAuthorizer.actualUser.(authapi.ScopedUserInfo)
+	if !ok {
+		t.Fatalf("expected the user to be limited to the scopes, got %#v", testAuthorizer.actualUser)
+	}
+	if scoped.GetName() != "foo" || !reflect.DeepEqual(scoped.GetGroups(), []string{"first"}) || !reflect.DeepEqual(scoped.GetScopes(), []string{"user:info"}) {
+		t.Errorf("unexpected user %#v", scoped)
+	}
+}
+
 func (r *subjectAccessTest) runTest(t *testing.T) {
 	storage := REST{r.authorizer}
 
@@ -110,6 +110,8 @@ func getBuildEnv(build *buildapi.Build) *[]kapi.EnvVar {
 		return &build.Spec.Strategy.SourceStrategy.Env
 	case build.Spec.Strategy.CustomStrategy != nil:
 		return &build.Spec.Strategy.CustomStrategy.Env
+	case build.Spec.Strategy.PipelineStrategy != nil:
+		return &build.Spec.Strategy.PipelineStrategy.Env
 	}
 	return nil
 }
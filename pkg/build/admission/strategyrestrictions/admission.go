@@ -83,6 +83,8 @@ func resourceForStrategyType(strategy buildapi.BuildStrategy) string {
 		return authorizationapi.CustomBuildResource
 	case strategy.SourceStrategy != nil:
 		return authorizationapi.SourceBuildResource
+	case strategy.PipelineStrategy != nil:
+		return authorizationapi.PipelineBuildResource
 	}
 	return ""
 }
@@ -17,8 +17,18 @@ const (
 	BuildCloneAnnotation = "openshift.io/build.clone-of"
 	// BuildPodNameAnnotation is an annotation whose value is the name of the pod running this build
 	BuildPodNameAnnotation = "openshift.io/build.pod-name"
+	// BuildAcceptedAnnotation is an annotation used to update a queued Build so the build
+	// controller handles it again once the Build that blocked it has completed.
+	BuildAcceptedAnnotation = "openshift.io/build.accepted"
+	// BuildLogArchivedAnnotation is an annotation set on a Build once the log of its pod has been archived,
+	// with the value "true", or once it is known that it cannot be archived because the pod was deleted,
+	// with the value "unavailable"
+	BuildLogArchivedAnnotation = "openshift.io/build.log-archived"
 	// BuildLabel is the key of a Pod label whose value is the Name of a Build which is run.
 	BuildLabel = "openshift.io/build.name"
+	// BuildRunPolicyLabel is the key of a Build label whose value is the RunPolicy of the
+	// BuildConfig the Build was created from.
+	BuildRunPolicyLabel = "openshift.io/build.start-policy"
 	// DefaultDockerLabelNamespace is the key of a Build label, whose values are build metadata.
 	DefaultDockerLabelNamespace = "io.openshift."
 	// OriginVersion is an environment variable key that indicates the version of origin that
@@ -104,6 +114,29 @@ type BuildStatus struct {
 
 	// Config is an ObjectReference to the BuildConfig this Build is based on.
 	Config *kapi.ObjectReference
+
+	// Stages contains the status of each stage of a Pipeline build, as reported by the pipeline runner.
+	Stages []StageInfo
+
+	// OutputDockerImageReferences lists the Docker images pushed by this build, starting with
+	// the image pushed to Spec.Output.To followed by the images pushed to the additional targets.
+	// It is reported by the builder once the pushes are complete.
+	OutputDockerImageReferences []string
+}
+
+// StageInfo describes the status of a stage of a Pipeline build.
+type StageInfo struct {
+	// Name is the name of the stage in the pipeline definition.
+	Name string
+
+	// Phase is the point in the lifecycle of the stage.
+	Phase BuildPhase
+
+	// StartTimestamp is the time the stage started running.
+	StartTimestamp *unversioned.Time
+
+	// CompletionTimestamp is the time the stage finished running.
+	CompletionTimestamp *unversioned.Time
 }
 
 // BuildPhase represents the status of a build at a point in time.
@@ -167,6 +200,11 @@ const (
 	// StatusReasonExceededRetryTimeout is an error condition when the build has
 	// not completed and retrying the build times out.
 	StatusReasonExceededRetryTimeout = "ExceededRetryTimeout"
+
+	// StatusReasonWaitingForPreviousBuild is a condition when a new build is
+	// queued until a previous build from the same build config completes, as
+	// required by the build config's run policy.
+	StatusReasonWaitingForPreviousBuild = "WaitingForPreviousBuild"
 )
 
 // BuildSource is the input used for the build.
@@ -323,6 +361,9 @@ type BuildStrategy struct {
 
 	// CustomStrategy holds the parameters to the Custom build strategy
 	CustomStrategy *CustomBuildStrategy
+
+	// PipelineStrategy holds the parameters to the Pipeline build strategy
+	PipelineStrategy *PipelineBuildStrategy
 }
 
 // BuildStrategyType describes a particular way of performing a build.
@@ -364,6 +405,40 @@ type CustomBuildStrategy struct {
 	BuildAPIVersion string
 }
 
+const (
+	// PipelineDefinitionKey is the environment variable that holds the inline pipeline definition
+	// passed to the pipeline runner.
+	PipelineDefinitionKey = "PIPELINE_DEFINITION"
+	// PipelineDefinitionPathKey is the environment variable that holds the path of the pipeline
+	// definition in the source repository passed to the pipeline runner.
+	PipelineDefinitionPathKey = "PIPELINE_DEFINITION_PATH"
+	// DefaultPipelineDefinitionPath is the path of the pipeline definition in the source repository
+	// when none is specified.
+	DefaultPipelineDefinitionPath = "Jenkinsfile"
+)
+
+// PipelineBuildStrategy defines input parameters specific to a Pipeline build. The pipeline is
+// executed by a pipeline runner pod, which reports the status of each stage on the build.
+//
+// The runner reports stages by updating the build through the builds/details subresource with
+// only Status.Stages changed. Each update replaces the whole list, which holds one entry per stage
+// in pipeline order with a unique name. A stage is reported as Pending or Running with its
+// StartTimestamp set once it starts, and as Complete, Failed or Cancelled with its
+// CompletionTimestamp set once it finishes. The stages are informational: the phase of the build
+// follows the exit code of the runner pod.
+type PipelineBuildStrategy struct {
+	// Jenkinsfile is the inline pipeline definition. When set, JenkinsfilePath may not be set
+	// and no source is required.
+	Jenkinsfile string
+
+	// JenkinsfilePath is the path of the pipeline definition relative to the root of the
+	// context (contextDir). Defaults to Jenkinsfile when Jenkinsfile is not set.
+	JenkinsfilePath string
+
+	// Env contains additional environment variables you want to pass into the pipeline runner
+	Env []kapi.EnvVar
+}
+
 // DockerBuildStrategy defines input parameters specific to Docker build.
 type DockerBuildStrategy struct {
 	// From is reference to an DockerImage, ImageStream, ImageStreamTag, or ImageStreamImage from which
@@ -429,6 +504,21 @@ type BuildOutput struct {
 	// up the authentication for executing the Docker push to authentication
 	// enabled Docker Registry (or Docker Hub).
 	PushSecret *kapi.LocalObjectReference
+
+	// AdditionalTargets are further locations the output of this build is pushed to after To.
+	AdditionalTargets []BuildOutputTarget
+}
+
+// BuildOutputTarget is an additional location the output of a build is pushed to.
+type BuildOutputTarget struct {
+	// To defines the location to push the output of the build to. Kind must be one of
+	// 'ImageStreamTag' or 'DockerImage'. The tag may reference the source revision of the
+	// build with ${SOURCE_COMMIT}, ${SOURCE_COMMIT_SHORT} and ${SOURCE_REF}, which are
+	// replaced by the builder once the source has been fetched.
+	To kapi.ObjectReference
+
+	// PushSecret is the name of a Secret used to authenticate the push to this target.
+	PushSecret *kapi.LocalObjectReference
 }
 
 const (
@@ -461,10 +551,50 @@ type BuildConfigSpec struct {
 	// are defined, a new build can only occur as a result of an explicit client build creation.
 	Triggers []BuildTriggerPolicy
 
+	// RunPolicy describes how the new build created from this build
+	// configuration will be scheduled for execution.
+	// This is optional, if not specified we default to "Serial".
+	RunPolicy BuildRunPolicy
+
 	// BuildSpec is the desired build specification
 	BuildSpec
+
+	// SuccessfulBuildsHistoryLimit is the number of old successful builds to retain.
+	// If not specified, all successful builds are retained.
+	SuccessfulBuildsHistoryLimit *int32
+
+	// FailedBuildsHistoryLimit is the number of old failed builds to retain.
+	// Failed, errored and cancelled builds are counted together.
+	// If not specified, all failed builds are retained.
+	FailedBuildsHistoryLimit *int32
 }
 
+// BuildRunPolicy defines the behaviour of how the new builds are executed
+// from the existing build configuration.
+type BuildRunPolicy string
+
+const (
+	// BuildRunPolicyParallel schedules new builds immediately after they are
+	// created. Builds will be executed in parallel.
+	BuildRunPolicyParallel BuildRunPolicy = "Parallel"
+
+	// BuildRunPolicySerial schedules new builds to execute in a sequence as
+	// they are created. Every build gets queued up and will execute when the
+	// previous build completes. This is the default policy.
+	BuildRunPolicySerial BuildRunPolicy = "Serial"
+
+	// BuildRunPolicySerialLatestOnly schedules only the latest build to execute,
+	// cancelling all the previously queued builds.
+	BuildRunPolicySerialLatestOnly BuildRunPolicy = "SerialLatestOnly"
+)
+
+// KnownBuildRunPolicies is the set of valid values for BuildConfigSpec.RunPolicy.
+var KnownBuildRunPolicies = sets.NewString(
+	string(BuildRunPolicyParallel),
+	string(BuildRunPolicySerial),
+	string(BuildRunPolicySerialLatestOnly),
+)
+
 // BuildConfigStatus contains current state of the build config object.
 type BuildConfigStatus struct {
 	// LastVersion is used to inform about number of last triggered build.
@@ -501,6 +631,12 @@ type BuildTriggerPolicy struct {
 	// GenericWebHook contains the parameters for a Generic webhook type of trigger
 	GenericWebHook *WebHookTrigger
 
+	// GitLabWebHook contains the parameters for a GitLab webhook type of trigger
+	GitLabWebHook *WebHookTrigger
+
+	// BitbucketWebHook contains the parameters for a Bitbucket webhook type of trigger
+	BitbucketWebHook *WebHookTrigger
+
 	// ImageChange contains parameters for an ImageChange type of trigger
 	ImageChange *ImageChangeTrigger
 }
@@ -512,6 +648,8 @@ type BuildTriggerType string
 var KnownTriggerTypes = sets.NewString(
 	string(GitHubWebHookBuildTriggerType),
 	string(GenericWebHookBuildTriggerType),
+	string(GitLabWebHookBuildTriggerType),
+	string(BitbucketWebHookBuildTriggerType),
 	string(ImageChangeBuildTriggerType),
 	string(ConfigChangeBuildTriggerType),
 )
@@ -527,6 +665,14 @@ const (
 	GenericWebHookBuildTriggerType           BuildTriggerType = "Generic"
 	GenericWebHookBuildTriggerTypeDeprecated BuildTriggerType = "generic"
 
+	// GitLabWebHookBuildTriggerType represents a trigger that launches builds on
+	// GitLab webhook invocations
+	GitLabWebHookBuildTriggerType BuildTriggerType = "GitLab"
+
+	// BitbucketWebHookBuildTriggerType represents a trigger that launches builds on
+	// Bitbucket webhook invocations
+	BitbucketWebHookBuildTriggerType BuildTriggerType = "Bitbucket"
+
 	// ImageChangeBuildTriggerType represents a trigger that launches builds on
 	// availability of a new version of an image
 	ImageChangeBuildTriggerType           BuildTriggerType = "ImageChange"
@@ -1,12 +1,30 @@
 package api
 
-import "github.com/openshift/origin/pkg/util/namer"
+import (
+	"regexp"
+	"strings"
+
+	"github.com/openshift/origin/pkg/util/namer"
+)
 
 const (
 	// BuildPodSuffix is the suffix used to append to a build pod name given a build name
 	BuildPodSuffix = "build"
+
+	// SourceCommitVariable is replaced in the tag of an output target with the commit the build ran from.
+	SourceCommitVariable = "${SOURCE_COMMIT}"
+	// SourceCommitShortVariable is replaced in the tag of an output target with the abbreviated commit.
+	SourceCommitShortVariable = "${SOURCE_COMMIT_SHORT}"
+	// SourceRefVariable is replaced in the tag of an output target with the Git ref the build ran from.
+	SourceRefVariable = "${SOURCE_REF}"
+
+	// shortCommitLength is the length of the commit abbreviated by SourceCommitShortVariable
+	shortCommitLength = 7
 )
 
+// invalidTagCharacters matches the characters of a Git ref that may not be part of a Docker tag
+var invalidTagCharacters = regexp.MustCompile(`[^\w.-]`)
+
 // GetBuildPodName returns name of the build pod.
 func GetBuildPodName(build *Build) string {
 	return namer.GetPodName(build.Name, BuildPodSuffix)
@@ -20,6 +38,8 @@ func StrategyType(strategy BuildStrategy) string {
 		return "Custom"
 	case strategy.SourceStrategy != nil:
 		return "Source"
+	case strategy.PipelineStrategy != nil:
+		return "Pipeline"
 	}
 	return ""
 }
@@ -43,3 +63,28 @@ func SourceType(source BuildSource) string {
 	}
 	return sourceType
 }
+
+// ExpandOutputTargetReference replaces the source revision variables in the reference of an
+// output target with commit and ref. Characters of ref that are not valid in a tag are replaced
+// with '-'. It returns false if the reference uses a variable whose value is empty.
+func ExpandOutputTargetReference(reference, commit, ref string) (string, bool) {
+	short := commit
+	if len(short) > shortCommitLength {
+		short = short[:shortCommitLength]
+	}
+	values := map[string]string{
+		SourceCommitVariable:      commit,
+		SourceCommitShortVariable: short,
+		SourceRefVariable:         invalidTagCharacters.ReplaceAllString(ref, "-"),
+	}
+	for variable, value := range values {
+		if !strings.Contains(reference, variable) {
+			continue
+		}
+		if len(value) == 0 {
+			return "", false
+		}
+		reference = strings.Replace(reference, variable, value, -1)
+	}
+	return reference, true
+}
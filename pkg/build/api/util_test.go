@@ -0,0 +1,26 @@
+package api
+
+import "testing"
+
+func TestExpandOutputTargetReference(t *testing.T) {
+	commit := "1575a90c569a7cc0eea84fbd3304d9df37c9f5ee"
+	tests := []struct {
+		reference   string
+		commit, ref string
+		expected    string
+		ok          bool
+	}{
+		{reference: "registry/ns/app:latest", expected: "registry/ns/app:latest", ok: true},
+		{reference: "registry/ns/app:${SOURCE_COMMIT}", commit: commit, expected: "registry/ns/app:" + commit, ok: true},
+		{reference: "app:git-${SOURCE_COMMIT_SHORT}", commit: commit, expected: "app:git-1575a90", ok: true},
+		{reference: "app:${SOURCE_REF}-${SOURCE_COMMIT_SHORT}", commit: "abc", ref: "feature/new", expected: "app:feature-new-abc", ok: true},
+		{reference: "app:${SOURCE_COMMIT}", ref: "master"},
+		{reference: "app:${SOURCE_REF}", commit: commit},
+	}
+	for i, test := range tests {
+		expanded, ok := ExpandOutputTargetReference(test.reference, test.commit, test.ref)
+		if ok != test.ok || expanded != test.expected {
+			t.Errorf("%d: expected %q (%t), got %q (%t)", i, test.expected, test.ok, expanded, ok)
+		}
+	}
+}
@@ -165,6 +165,8 @@ func convert_api_BuildStrategy_To_v1_BuildStrategy(in *newer.BuildStrategy, out
 		out.Type = DockerBuildStrategyType
 	case in.CustomStrategy != nil:
 		out.Type = CustomBuildStrategyType
+	case in.PipelineStrategy != nil:
+		out.Type = PipelineBuildStrategyType
 	}
 	return nil
 }
@@ -185,6 +187,12 @@ func init() {
 					strategy.DockerStrategy = &DockerBuildStrategy{}
 				}
 			}
+			if (strategy != nil) && (strategy.Type == PipelineBuildStrategyType) {
+				//  initialize PipelineStrategy to a default state if it's not set.
+				if strategy.PipelineStrategy == nil {
+					strategy.PipelineStrategy = &PipelineBuildStrategy{}
+				}
+			}
 		},
 		func(obj *SourceBuildStrategy) {
 			if len(obj.From.Kind) == 0 {
@@ -206,6 +214,11 @@ func init() {
 				obj.ImageChange = &ImageChangeTrigger{}
 			}
 		},
+		func(obj *BuildConfigSpec) {
+			if len(obj.RunPolicy) == 0 {
+				obj.RunPolicy = BuildRunPolicySerial
+			}
+		},
 	)
 	if err != nil {
 		panic(err)
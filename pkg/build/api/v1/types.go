@@ -85,6 +85,29 @@ type BuildStatus struct {
 
 	// Config is an ObjectReference to the BuildConfig this Build is based on.
 	Config *kapi.ObjectReference `json:"config,omitempty" description:"reference to build config from which this build was derived"`
+
+	// Stages contains the status of each stage of a Pipeline build, as reported by the pipeline runner.
+	Stages []StageInfo `json:"stages,omitempty" description:"status of each stage of a pipeline build"`
+
+	// OutputDockerImageReferences lists the Docker images pushed by this build, starting with
+	// the image pushed to Spec.Output.To followed by the images pushed to the additional targets.
+	// It is reported by the builder once the pushes are complete.
+	OutputDockerImageReferences []string `json:"outputDockerImageReferences,omitempty" description:"references to the Docker images pushed by this build, including the additional output targets"`
+}
+
+// StageInfo describes the status of a stage of a Pipeline build.
+type StageInfo struct {
+	// Name is the name of the stage in the pipeline definition.
+	Name string `json:"name" description:"name of the stage in the pipeline definition"`
+
+	// Phase is the point in the lifecycle of the stage.
+	Phase BuildPhase `json:"phase" description:"point in the lifecycle of the stage"`
+
+	// StartTimestamp is the time the stage started running.
+	StartTimestamp *unversioned.Time `json:"startTimestamp,omitempty" description:"server time when the stage started running"`
+
+	// CompletionTimestamp is the time the stage finished running.
+	CompletionTimestamp *unversioned.Time `json:"completionTimestamp,omitempty" description:"server time when the stage finished running"`
 }
 
 // BuildPhase represents the status of a build at a point in time.
@@ -296,6 +319,9 @@ type BuildStrategy struct {
 
 	// CustomStrategy holds the parameters to the Custom build strategy
 	CustomStrategy *CustomBuildStrategy `json:"customStrategy,omitempty" description:"holds parameters to the Custom build strategy"`
+
+	// PipelineStrategy holds the parameters to the Pipeline build strategy
+	PipelineStrategy *PipelineBuildStrategy `json:"pipelineStrategy,omitempty" description:"holds parameters to the Pipeline build strategy"`
 }
 
 // BuildStrategyType describes a particular way of performing a build.
@@ -312,6 +338,9 @@ const (
 
 	// CustomBuildStrategyType performs builds using custom builder Docker image.
 	CustomBuildStrategyType BuildStrategyType = "Custom"
+
+	// PipelineBuildStrategyType performs builds by handing a pipeline definition to a pipeline runner.
+	PipelineBuildStrategyType BuildStrategyType = "Pipeline"
 )
 
 // CustomBuildStrategy defines input parameters specific to Custom build.
@@ -344,6 +373,28 @@ type CustomBuildStrategy struct {
 	BuildAPIVersion string `json:"buildAPIVersion,omitempty" description:"requested API version for the Build object serialized and passed to the custom builder"`
 }
 
+// PipelineBuildStrategy defines input parameters specific to a Pipeline build. The pipeline is
+// executed by a pipeline runner pod, which reports the status of each stage on the build.
+//
+// The runner reports stages by updating the build through the builds/details subresource with
+// only Status.Stages changed. Each update replaces the whole list, which holds one entry per stage
+// in pipeline order with a unique name. A stage is reported as Pending or Running with its
+// StartTimestamp set once it starts, and as Complete, Failed or Cancelled with its
+// CompletionTimestamp set once it finishes. The stages are informational: the phase of the build
+// follows the exit code of the runner pod.
+type PipelineBuildStrategy struct {
+	// Jenkinsfile is the inline pipeline definition. When set, JenkinsfilePath may not be set
+	// and no source is required.
+	Jenkinsfile string `json:"jenkinsfile,omitempty" description:"inline pipeline definition"`
+
+	// JenkinsfilePath is the path of the pipeline definition relative to the root of the
+	// context (contextDir). Defaults to Jenkinsfile when Jenkinsfile is not set.
+	JenkinsfilePath string `json:"jenkinsfilePath,omitempty" description:"path of the pipeline definition relative to the context dir; defaults to Jenkinsfile"`
+
+	// Env contains additional environment variables you want to pass into the pipeline runner
+	Env []kapi.EnvVar `json:"env,omitempty" description:"additional environment variables you want to pass into the pipeline runner"`
+}
+
 // DockerBuildStrategy defines input parameters specific to Docker build.
 type DockerBuildStrategy struct {
 	// From is reference to an DockerImage, ImageStreamTag, or ImageStreamImage from which
@@ -409,6 +460,21 @@ type BuildOutput struct {
 	// up the authentication for executing the Docker push to authentication
 	// enabled Docker Registry (or Docker Hub).
 	PushSecret *kapi.LocalObjectReference `json:"pushSecret,omitempty" description:"supported type: dockercfg"`
+
+	// AdditionalTargets are further locations the output of this build is pushed to after To.
+	AdditionalTargets []BuildOutputTarget `json:"additionalTargets,omitempty" description:"additional locations the output of this build is pushed to"`
+}
+
+// BuildOutputTarget is an additional location the output of a build is pushed to.
+type BuildOutputTarget struct {
+	// To defines the location to push the output of the build to. Kind must be one of
+	// 'ImageStreamTag' or 'DockerImage'. The tag may reference the source revision of the
+	// build with ${SOURCE_COMMIT}, ${SOURCE_COMMIT_SHORT} and ${SOURCE_REF}, which are
+	// replaced by the builder once the source has been fetched.
+	To kapi.ObjectReference `json:"to" description:"image stream tag or Docker image to push the output of this build to; the tag may reference ${SOURCE_COMMIT}, ${SOURCE_COMMIT_SHORT} and ${SOURCE_REF}"`
+
+	// PushSecret is the name of a Secret used to authenticate the push to this target.
+	PushSecret *kapi.LocalObjectReference `json:"pushSecret,omitempty" description:"supported type: dockercfg"`
 }
 
 // BuildConfig is a template which can be used to create new builds.
@@ -429,10 +495,43 @@ type BuildConfigSpec struct {
 	// are defined, a new build can only occur as a result of an explicit client build creation.
 	Triggers []BuildTriggerPolicy `json:"triggers" description:"determines how new builds can be launched from a build config.  if no triggers are defined, a new build can only occur as a result of an explicit client build creation."`
 
+	// RunPolicy describes how the new build created from this build
+	// configuration will be scheduled for execution.
+	// This is optional, if not specified we default to "Serial".
+	RunPolicy BuildRunPolicy `json:"runPolicy,omitempty" description:"describes how new builds created from this build configuration are scheduled for execution; one of Serial, SerialLatestOnly or Parallel, defaults to Serial"`
+
 	// BuildSpec is the desired build specification
 	BuildSpec `json:",inline" description:"the desired build specification"`
+
+	// SuccessfulBuildsHistoryLimit is the number of old successful builds to retain.
+	// If not specified, all successful builds are retained.
+	SuccessfulBuildsHistoryLimit *int32 `json:"successfulBuildsHistoryLimit,omitempty" description:"number of old successful builds to retain; all successful builds are retained if not specified"`
+
+	// FailedBuildsHistoryLimit is the number of old failed builds to retain.
+	// Failed, errored and cancelled builds are counted together.
+	// If not specified, all failed builds are retained.
+	FailedBuildsHistoryLimit *int32 `json:"failedBuildsHistoryLimit,omitempty" description:"number of old failed, errored and cancelled builds to retain; all failed builds are retained if not specified"`
 }
 
+// BuildRunPolicy defines the behaviour of how the new builds are executed
+// from the existing build configuration.
+type BuildRunPolicy string
+
+const (
+	// BuildRunPolicyParallel schedules new builds immediately after they are
+	// created. Builds will be executed in parallel.
+	BuildRunPolicyParallel BuildRunPolicy = "Parallel"
+
+	// BuildRunPolicySerial schedules new builds to execute in a sequence as
+	// they are created. Every build gets queued up and will execute when the
+	// previous build completes. This is the default policy.
+	BuildRunPolicySerial BuildRunPolicy = "Serial"
+
+	// BuildRunPolicySerialLatestOnly schedules only the latest build to execute,
+	// cancelling all the previously queued builds.
+	BuildRunPolicySerialLatestOnly BuildRunPolicy = "SerialLatestOnly"
+)
+
 // BuildConfigStatus contains current state of the build config object.
 type BuildConfigStatus struct {
 	// LastVersion is used to inform about number of last triggered build.
@@ -469,6 +568,12 @@ type BuildTriggerPolicy struct {
 	// GenericWebHook contains the parameters for a Generic webhook type of trigger
 	GenericWebHook *WebHookTrigger `json:"generic,omitempty" description:"parameters for a Generic webhook type of trigger"`
 
+	// GitLabWebHook contains the parameters for a GitLab webhook type of trigger
+	GitLabWebHook *WebHookTrigger `json:"gitlab,omitempty" description:"parameters for a GitLab webhook type of trigger"`
+
+	// BitbucketWebHook contains the parameters for a Bitbucket webhook type of trigger
+	BitbucketWebHook *WebHookTrigger `json:"bitbucket,omitempty" description:"parameters for a Bitbucket webhook type of trigger"`
+
 	// ImageChange contains parameters for an ImageChange type of trigger
 	ImageChange *ImageChangeTrigger `json:"imageChange,omitempty" description:"parameters for an ImageChange type of trigger"`
 }
@@ -487,6 +592,14 @@ const (
 	GenericWebHookBuildTriggerType           BuildTriggerType = "Generic"
 	GenericWebHookBuildTriggerTypeDeprecated BuildTriggerType = "generic"
 
+	// GitLabWebHookBuildTriggerType represents a trigger that launches builds on
+	// GitLab webhook invocations
+	GitLabWebHookBuildTriggerType BuildTriggerType = "GitLab"
+
+	// BitbucketWebHookBuildTriggerType represents a trigger that launches builds on
+	// Bitbucket webhook invocations
+	BitbucketWebHookBuildTriggerType BuildTriggerType = "Bitbucket"
+
 	// ImageChangeBuildTriggerType represents a trigger that launches builds on
 	// availability of a new version of an image
 	ImageChangeBuildTriggerType           BuildTriggerType = "ImageChange"
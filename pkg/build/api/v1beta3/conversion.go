@@ -102,6 +102,10 @@ func convert_v1beta3_BuildTriggerPolicy_To_api_BuildTriggerPolicy(in *BuildTrigg
 		out.Type = newer.GenericWebHookBuildTriggerType
 	case GitHubWebHookBuildTriggerType:
 		out.Type = newer.GitHubWebHookBuildTriggerType
+	case GitLabWebHookBuildTriggerType:
+		out.Type = newer.GitLabWebHookBuildTriggerType
+	case BitbucketWebHookBuildTriggerType:
+		out.Type = newer.BitbucketWebHookBuildTriggerType
 	}
 	return nil
 }
@@ -117,6 +121,10 @@ func convert_api_BuildTriggerPolicy_To_v1beta3_BuildTriggerPolicy(in *newer.Buil
 		out.Type = GenericWebHookBuildTriggerType
 	case newer.GitHubWebHookBuildTriggerType:
 		out.Type = GitHubWebHookBuildTriggerType
+	case newer.GitLabWebHookBuildTriggerType:
+		out.Type = GitLabWebHookBuildTriggerType
+	case newer.BitbucketWebHookBuildTriggerType:
+		out.Type = BitbucketWebHookBuildTriggerType
 	}
 	return nil
 }
@@ -180,6 +188,8 @@ func convert_api_BuildStrategy_To_v1beta3_BuildStrategy(in *newer.BuildStrategy,
 		out.Type = DockerBuildStrategyType
 	case in.CustomStrategy != nil:
 		out.Type = CustomBuildStrategyType
+	case in.PipelineStrategy != nil:
+		out.Type = PipelineBuildStrategyType
 	}
 	return nil
 }
@@ -192,6 +202,12 @@ func init() {
 					strategy.DockerStrategy = &DockerBuildStrategy{}
 				}
 			}
+			if (strategy != nil) && (strategy.Type == PipelineBuildStrategyType) {
+				//  initialize PipelineStrategy to a default state if it's not set.
+				if strategy.PipelineStrategy == nil {
+					strategy.PipelineStrategy = &PipelineBuildStrategy{}
+				}
+			}
 		},
 		func(obj *SourceBuildStrategy) {
 			if len(obj.From.Kind) == 0 {
@@ -213,6 +229,11 @@ func init() {
 				obj.ImageChange = &ImageChangeTrigger{}
 			}
 		},
+		func(obj *BuildConfigSpec) {
+			if len(obj.RunPolicy) == 0 {
+				obj.RunPolicy = BuildRunPolicySerial
+			}
+		},
 	)
 	if err != nil {
 		panic(err)
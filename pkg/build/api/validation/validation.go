@@ -9,6 +9,7 @@ import (
 
 	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/validation"
+	"k8s.io/kubernetes/pkg/util/sets"
 	kvalidation "k8s.io/kubernetes/pkg/util/validation"
 	"k8s.io/kubernetes/pkg/util/validation/field"
 
@@ -31,6 +32,7 @@ func ValidateBuildUpdate(build *buildapi.Build, older *buildapi.Build) field.Err
 	allErrs = append(allErrs, validation.ValidateObjectMetaUpdate(&build.ObjectMeta, &older.ObjectMeta, field.NewPath("metadata"))...)
 
 	allErrs = append(allErrs, ValidateBuild(build)...)
+	allErrs = append(allErrs, ValidateBuildStages(build.Status.Stages, field.NewPath("status", "stages"))...)
 
 	if buildutil.IsBuildComplete(older) && older.Status.Phase != build.Status.Phase {
 		allErrs = append(allErrs, field.Invalid(field.NewPath("status", "phase"), build.Status.Phase, "phase cannot be updated from a terminal state"))
@@ -81,6 +83,16 @@ func ValidateBuildConfig(config *buildapi.BuildConfig) field.ErrorList {
 		fromRefs[fromKey] = struct{}{}
 	}
 
+	if len(config.Spec.RunPolicy) > 0 && !buildapi.KnownBuildRunPolicies.Has(string(config.Spec.RunPolicy)) {
+		allErrs = append(allErrs, field.NotSupported(specPath.Child("runPolicy"), config.Spec.RunPolicy, buildapi.KnownBuildRunPolicies.List()))
+	}
+	if limit := config.Spec.SuccessfulBuildsHistoryLimit; limit != nil && *limit < 0 {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("successfulBuildsHistoryLimit"), *limit, "must be greater than or equal to 0"))
+	}
+	if limit := config.Spec.FailedBuildsHistoryLimit; limit != nil && *limit < 0 {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("failedBuildsHistoryLimit"), *limit, "must be greater than or equal to 0"))
+	}
+
 	allErrs = append(allErrs, validateBuildSpec(&config.Spec.BuildSpec, specPath)...)
 
 	// validate ImageChangeTriggers of DockerStrategy builds
@@ -112,7 +124,9 @@ func validateBuildSpec(spec *buildapi.BuildSpec, fldPath *field.Path) field.Erro
 	allErrs := field.ErrorList{}
 	s := spec.Strategy
 
-	if s.CustomStrategy == nil && spec.Source.Git == nil && spec.Source.Binary == nil && spec.Source.Dockerfile == nil {
+	// custom builds and pipelines defined inline do not require a source
+	inlinePipeline := s.PipelineStrategy != nil && len(s.PipelineStrategy.Jenkinsfile) > 0
+	if s.CustomStrategy == nil && !inlinePipeline && spec.Source.Git == nil && spec.Source.Binary == nil && spec.Source.Dockerfile == nil {
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("source"), spec.Source, "must provide a value for at least one of source, binary, or dockerfile"))
 	}
 
@@ -375,6 +389,38 @@ func validateOutput(output *buildapi.BuildOutput, fldPath *field.Path) field.Err
 
 	allErrs = append(allErrs, validateSecretRef(output.PushSecret, fldPath.Child("pushSecret"))...)
 
+	for i := range output.AdditionalTargets {
+		allErrs = append(allErrs, validateOutputTarget(&output.AdditionalTargets[i], fldPath.Child("additionalTargets").Index(i))...)
+	}
+
+	return allErrs
+}
+
+// validateOutputTarget validates an additional output target, whose tag may reference the source revision
+func validateOutputTarget(target *buildapi.BuildOutputTarget, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	toPath := fldPath.Child("to")
+	name := target.To.Name
+
+	// the variables may only be used in the tag, the repository has to be known when the build starts
+	repository := name
+	if i := strings.LastIndex(name, ":"); i != -1 {
+		repository = name[:i]
+	}
+	if strings.Contains(repository, "${") {
+		allErrs = append(allErrs, field.Invalid(toPath.Child("name"), name, "source revision variables may only be used in the tag"))
+		return allErrs
+	}
+	expanded, _ := buildapi.ExpandOutputTargetReference(name, strings.Repeat("0", 40), "master")
+	if strings.Contains(expanded, "${") {
+		allErrs = append(allErrs, field.Invalid(toPath.Child("name"), name, fmt.Sprintf("the tag may only reference %s, %s and %s", buildapi.SourceCommitVariable, buildapi.SourceCommitShortVariable, buildapi.SourceRefVariable)))
+		return allErrs
+	}
+
+	reference := target.To
+	reference.Name = expanded
+	allErrs = append(allErrs, validateToImageReference(&reference, toPath)...)
+	allErrs = append(allErrs, validateSecretRef(target.PushSecret, fldPath.Child("pushSecret"))...)
 	return allErrs
 }
 
@@ -391,8 +437,11 @@ func validateStrategy(strategy *buildapi.BuildStrategy, fldPath *field.Path) fie
 	if strategy.CustomStrategy != nil {
 		strategyCount++
 	}
+	if strategy.PipelineStrategy != nil {
+		strategyCount++
+	}
 	if strategyCount != 1 {
-		return append(allErrs, field.Invalid(fldPath, strategy, "must provide a value for exactly one of sourceStrategy, customStrategy, or dockerStrategy"))
+		return append(allErrs, field.Invalid(fldPath, strategy, "must provide a value for exactly one of sourceStrategy, customStrategy, dockerStrategy, or pipelineStrategy"))
 	}
 
 	if strategy.SourceStrategy != nil {
@@ -404,6 +453,9 @@ func validateStrategy(strategy *buildapi.BuildStrategy, fldPath *field.Path) fie
 	if strategy.CustomStrategy != nil {
 		allErrs = append(allErrs, validateCustomStrategy(strategy.CustomStrategy, fldPath.Child("customStrategy"))...)
 	}
+	if strategy.PipelineStrategy != nil {
+		allErrs = append(allErrs, validatePipelineStrategy(strategy.PipelineStrategy, fldPath.Child("pipelineStrategy"))...)
+	}
 
 	return allErrs
 }
@@ -453,6 +505,58 @@ func validateCustomStrategy(strategy *buildapi.CustomBuildStrategy, fldPath *fie
 	return allErrs
 }
 
+func validatePipelineStrategy(strategy *buildapi.PipelineBuildStrategy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(strategy.Jenkinsfile) != 0 && len(strategy.JenkinsfilePath) != 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("jenkinsfilePath"), strategy.JenkinsfilePath, "may not be set when jenkinsfile is also set"))
+	}
+	if len(strategy.Jenkinsfile) > maxDockerfileLengthBytes {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("jenkinsfile"), "", fmt.Sprintf("must be smaller than %d bytes", maxDockerfileLengthBytes)))
+	}
+
+	if len(strategy.JenkinsfilePath) != 0 {
+		cleaned := path.Clean(strategy.JenkinsfilePath)
+		switch {
+		case strings.HasPrefix(cleaned, "/"):
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("jenkinsfilePath"), strategy.JenkinsfilePath, "jenkinsfilePath must not be an absolute path"))
+		case strings.HasPrefix(cleaned, ".."):
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("jenkinsfilePath"), strategy.JenkinsfilePath, "jenkinsfilePath must not start with .."))
+		default:
+			if cleaned == "." {
+				cleaned = ""
+			}
+			strategy.JenkinsfilePath = cleaned
+		}
+	}
+
+	allErrs = append(allErrs, ValidateStrategyEnv(strategy.Env, fldPath.Child("env"))...)
+
+	return allErrs
+}
+
+// ValidateBuildStages tests the stages of a Pipeline build reported by the pipeline runner.
+func ValidateBuildStages(stages []buildapi.StageInfo, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	names := sets.NewString()
+	for i, stage := range stages {
+		idxPath := fldPath.Index(i)
+		if len(stage.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name")))
+		} else if names.Has(stage.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), stage.Name))
+		}
+		names.Insert(stage.Name)
+
+		switch stage.Phase {
+		case buildapi.BuildPhasePending, buildapi.BuildPhaseRunning, buildapi.BuildPhaseComplete, buildapi.BuildPhaseFailed, buildapi.BuildPhaseCancelled:
+		default:
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("phase"), stage.Phase, []string{string(buildapi.BuildPhasePending), string(buildapi.BuildPhaseRunning), string(buildapi.BuildPhaseComplete), string(buildapi.BuildPhaseFailed), string(buildapi.BuildPhaseCancelled)}))
+		}
+	}
+	return allErrs
+}
+
 func validateTrigger(trigger *buildapi.BuildTriggerPolicy, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	if len(trigger.Type) == 0 {
@@ -474,6 +578,18 @@ func validateTrigger(trigger *buildapi.BuildTriggerPolicy, fldPath *field.Path)
 		} else {
 			allErrs = append(allErrs, validateWebHook(trigger.GenericWebHook, fldPath.Child("generic"))...)
 		}
+	case buildapi.GitLabWebHookBuildTriggerType:
+		if trigger.GitLabWebHook == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("gitlab")))
+		} else {
+			allErrs = append(allErrs, validateWebHook(trigger.GitLabWebHook, fldPath.Child("gitlab"))...)
+		}
+	case buildapi.BitbucketWebHookBuildTriggerType:
+		if trigger.BitbucketWebHook == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("bitbucket")))
+		} else {
+			allErrs = append(allErrs, validateWebHook(trigger.BitbucketWebHook, fldPath.Child("bitbucket"))...)
+		}
 	case buildapi.ImageChangeBuildTriggerType:
 		if trigger.ImageChange == nil {
 			allErrs = append(allErrs, field.Required(fldPath.Child("imageChange")))
@@ -206,6 +206,48 @@ func TestValidateBuildUpdate(t *testing.T) {
 	}
 }
 
+func TestValidateBuildStages(t *testing.T) {
+	valid := []buildapi.StageInfo{
+		{Name: "build", Phase: buildapi.BuildPhaseComplete},
+		{Name: "test", Phase: buildapi.BuildPhaseRunning},
+	}
+	if errs := ValidateBuildStages(valid, field.NewPath("status", "stages")); len(errs) != 0 {
+		t.Errorf("expected success: %v", errs)
+	}
+
+	errorCases := map[string]struct {
+		Stages []buildapi.StageInfo
+		T      field.ErrorType
+		F      string
+	}{
+		"missing name": {
+			Stages: []buildapi.StageInfo{{Phase: buildapi.BuildPhaseRunning}},
+			T:      field.ErrorTypeRequired,
+			F:      "status.stages[0].name",
+		},
+		"duplicate name": {
+			Stages: []buildapi.StageInfo{{Name: "build", Phase: buildapi.BuildPhaseComplete}, {Name: "build", Phase: buildapi.BuildPhaseRunning}},
+			T:      field.ErrorTypeDuplicate,
+			F:      "status.stages[1].name",
+		},
+		"invalid phase": {
+			Stages: []buildapi.StageInfo{{Name: "build", Phase: buildapi.BuildPhaseNew}},
+			T:      field.ErrorTypeNotSupported,
+			F:      "status.stages[0].phase",
+		},
+	}
+	for k, v := range errorCases {
+		errs := ValidateBuildStages(v.Stages, field.NewPath("status", "stages"))
+		if len(errs) != 1 {
+			t.Errorf("%s: expected one error, got %v", k, errs)
+			continue
+		}
+		if errs[0].Type != v.T || errs[0].Field != v.F {
+			t.Errorf("%s: expected %s error on %s, got %v", k, v.T, v.F, errs[0])
+		}
+	}
+}
+
 func TestBuildConfigGitSourceWithProxyFailure(t *testing.T) {
 	proxyAddress := "127.0.0.1:3128"
 	buildConfig := &buildapi.BuildConfig{
@@ -326,6 +368,99 @@ func TestBuildConfigValidationFailureRequiredName(t *testing.T) {
 	}
 }
 
+func TestBuildConfigValidationRunPolicy(t *testing.T) {
+	tests := []struct {
+		policy   buildapi.BuildRunPolicy
+		expected bool
+	}{
+		{policy: "", expected: true},
+		{policy: buildapi.BuildRunPolicyParallel, expected: true},
+		{policy: buildapi.BuildRunPolicySerial, expected: true},
+		{policy: buildapi.BuildRunPolicySerialLatestOnly, expected: true},
+		{policy: "Invalid", expected: false},
+	}
+	for _, test := range tests {
+		buildConfig := &buildapi.BuildConfig{
+			ObjectMeta: kapi.ObjectMeta{Name: "config-id", Namespace: "namespace"},
+			Spec: buildapi.BuildConfigSpec{
+				RunPolicy: test.policy,
+				BuildSpec: buildapi.BuildSpec{
+					Source: buildapi.BuildSource{
+						Git: &buildapi.GitBuildSource{
+							URI: "http://github.com/my/repository",
+						},
+					},
+					Strategy: buildapi.BuildStrategy{
+						DockerStrategy: &buildapi.DockerBuildStrategy{},
+					},
+				},
+			},
+		}
+		errors := ValidateBuildConfig(buildConfig)
+		if test.expected && len(errors) != 0 {
+			t.Errorf("%q: unexpected validation errors %v", test.policy, errors)
+			continue
+		}
+		if !test.expected {
+			if len(errors) != 1 {
+				t.Errorf("%q: expected a single validation error, got %v", test.policy, errors)
+				continue
+			}
+			if errors[0].Type != field.ErrorTypeNotSupported || errors[0].Field != "spec.runPolicy" {
+				t.Errorf("%q: unexpected error %v", test.policy, errors[0])
+			}
+		}
+	}
+}
+
+func TestBuildConfigValidationHistoryLimits(t *testing.T) {
+	negative, zero := int32(-1), int32(0)
+	tests := []struct {
+		name            string
+		successfulLimit *int32
+		failedLimit     *int32
+		expectedField   string
+	}{
+		{name: "unset limits"},
+		{name: "zero limits", successfulLimit: &zero, failedLimit: &zero},
+		{name: "negative successful limit", successfulLimit: &negative, expectedField: "spec.successfulBuildsHistoryLimit"},
+		{name: "negative failed limit", failedLimit: &negative, expectedField: "spec.failedBuildsHistoryLimit"},
+	}
+	for _, test := range tests {
+		buildConfig := &buildapi.BuildConfig{
+			ObjectMeta: kapi.ObjectMeta{Name: "config-id", Namespace: "namespace"},
+			Spec: buildapi.BuildConfigSpec{
+				BuildSpec: buildapi.BuildSpec{
+					Source: buildapi.BuildSource{
+						Git: &buildapi.GitBuildSource{
+							URI: "http://github.com/my/repository",
+						},
+					},
+					Strategy: buildapi.BuildStrategy{
+						DockerStrategy: &buildapi.DockerBuildStrategy{},
+					},
+				},
+				SuccessfulBuildsHistoryLimit: test.successfulLimit,
+				FailedBuildsHistoryLimit:     test.failedLimit,
+			},
+		}
+		errors := ValidateBuildConfig(buildConfig)
+		if len(test.expectedField) == 0 {
+			if len(errors) != 0 {
+				t.Errorf("%s: unexpected validation errors %v", test.name, errors)
+			}
+			continue
+		}
+		if len(errors) != 1 {
+			t.Errorf("%s: expected a single validation error, got %v", test.name, errors)
+			continue
+		}
+		if errors[0].Type != field.ErrorTypeInvalid || errors[0].Field != test.expectedField {
+			t.Errorf("%s: unexpected error %v", test.name, errors[0])
+		}
+	}
+}
+
 func TestBuildConfigImageChangeTriggers(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -985,6 +1120,36 @@ func TestValidateStrategy(t *testing.T) {
 				CustomStrategy: &buildapi.CustomBuildStrategy{},
 			},
 		},
+		// 1
+		{
+			t:    field.ErrorTypeInvalid,
+			path: "pipelineStrategy.jenkinsfilePath",
+			strategy: &buildapi.BuildStrategy{
+				PipelineStrategy: &buildapi.PipelineBuildStrategy{
+					Jenkinsfile:     "node {}",
+					JenkinsfilePath: "Jenkinsfile",
+				},
+			},
+		},
+		// 2
+		{
+			t:    field.ErrorTypeInvalid,
+			path: "pipelineStrategy.jenkinsfilePath",
+			strategy: &buildapi.BuildStrategy{
+				PipelineStrategy: &buildapi.PipelineBuildStrategy{
+					JenkinsfilePath: "../Jenkinsfile",
+				},
+			},
+		},
+		// 3
+		{
+			strategy: &buildapi.BuildStrategy{
+				PipelineStrategy: &buildapi.PipelineBuildStrategy{
+					JenkinsfilePath: "ci/Jenkinsfile",
+				},
+			},
+			ok: true,
+		},
 	}
 	for i, tc := range errorCases {
 		errors := validateStrategy(tc.strategy, nil)
@@ -1730,6 +1895,54 @@ func TestValidateToImageReference(t *testing.T) {
 	}
 }
 
+func TestValidateOutputTarget(t *testing.T) {
+	tests := map[string]struct {
+		target buildapi.BuildOutputTarget
+		field  string
+	}{
+		"image stream tag with revision": {
+			target: buildapi.BuildOutputTarget{To: kapi.ObjectReference{Kind: "ImageStreamTag", Name: "app:git-${SOURCE_COMMIT_SHORT}"}},
+		},
+		"external image with ref": {
+			target: buildapi.BuildOutputTarget{
+				To:         kapi.ObjectReference{Kind: "DockerImage", Name: "registry.example.com:5000/team/app:${SOURCE_REF}"},
+				PushSecret: &kapi.LocalObjectReference{Name: "external"},
+			},
+		},
+		"variable in repository": {
+			target: buildapi.BuildOutputTarget{To: kapi.ObjectReference{Kind: "DockerImage", Name: "registry/${SOURCE_REF}/app:latest"}},
+			field:  "to.name",
+		},
+		"unknown variable": {
+			target: buildapi.BuildOutputTarget{To: kapi.ObjectReference{Kind: "ImageStreamTag", Name: "app:${BUILD_NUMBER}"}},
+			field:  "to.name",
+		},
+		"missing kind": {
+			target: buildapi.BuildOutputTarget{To: kapi.ObjectReference{Name: "app:latest"}},
+			field:  "to.kind",
+		},
+		"invalid push secret": {
+			target: buildapi.BuildOutputTarget{
+				To:         kapi.ObjectReference{Kind: "ImageStreamTag", Name: "app:latest"},
+				PushSecret: &kapi.LocalObjectReference{},
+			},
+			field: "pushSecret.name",
+		},
+	}
+	for name, test := range tests {
+		errs := validateOutputTarget(&test.target, nil)
+		if len(test.field) == 0 {
+			if len(errs) != 0 {
+				t.Errorf("%s: unexpected errors: %v", name, errs)
+			}
+			continue
+		}
+		if len(errs) != 1 || errs[0].Field != test.field {
+			t.Errorf("%s: expected one error on %s, got %v", name, test.field, errs)
+		}
+	}
+}
+
 func TestValidateStrategyEnvVars(t *testing.T) {
 	tests := []struct {
 		env         []kapi.EnvVar
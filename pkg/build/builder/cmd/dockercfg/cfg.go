@@ -23,6 +23,7 @@ const (
 	PushAuthType       = "PUSH_DOCKERCFG_PATH"
 	PullAuthType       = "PULL_DOCKERCFG_PATH"
 	PullSourceAuthType = "PULL_SOURCE_DOCKERCFG_PATH_"
+	PushTargetAuthType = "PUSH_TARGET_DOCKERCFG_PATH_"
 )
 
 // Helper contains all the valid config options for reading the local dockercfg file
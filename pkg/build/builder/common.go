@@ -1,9 +1,13 @@
 package builder
 
 import (
+	"fmt"
+	"os"
+
 	"github.com/golang/glog"
 
 	"github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/build/builder/cmd/dockercfg"
 	"github.com/openshift/origin/pkg/client"
 	"github.com/openshift/origin/pkg/generate/git"
 )
@@ -82,3 +86,61 @@ func updateBuildRevision(c client.BuildInterface, build *api.Build, sourceInfo *
 		glog.Warningf("An error occurred saving build revision: %v", err)
 	}
 }
+
+// pushOutputTargets tags the image built as tag with the references of the additional output
+// targets of build and pushes them. The source revision variables in the references are
+// replaced first; targets referencing a revision that is not known are skipped. Each push
+// authenticates with the push secret of its target, or with the push secret of the build if the
+// target has none. It returns the references that were pushed.
+func pushOutputTargets(dockerClient DockerClient, build *api.Build, tag string) ([]string, error) {
+	var commit, ref string
+	if build.Spec.Revision != nil && build.Spec.Revision.Git != nil {
+		commit = build.Spec.Revision.Git.Commit
+	}
+	if build.Spec.Source.Git != nil {
+		ref = build.Spec.Source.Git.Ref
+	}
+
+	pushed := []string{}
+	for i, target := range build.Spec.Output.AdditionalTargets {
+		name, ok := api.ExpandOutputTargetReference(target.To.Name, commit, ref)
+		if !ok {
+			glog.Warningf("Skipping output target %s, the source revision it references is not known", target.To.Name)
+			continue
+		}
+		if err := tagImage(dockerClient, tag, name); err != nil {
+			return pushed, fmt.Errorf("Failed to tag image %s as %s: %v", tag, name, err)
+		}
+
+		authType := fmt.Sprintf("%s%d", dockercfg.PushTargetAuthType, i)
+		if len(os.Getenv(authType)) == 0 {
+			authType = dockercfg.PushAuthType
+		}
+		pushAuthConfig, authPresent := dockercfg.NewHelper().GetDockerAuth(name, authType)
+		if authPresent {
+			glog.V(4).Infof("Authenticating Docker push to %s with user %q", name, pushAuthConfig.Username)
+		}
+		glog.Infof("Pushing image %s ...", name)
+		if err := pushImage(dockerClient, name, pushAuthConfig); err != nil {
+			return pushed, fmt.Errorf("Failed to push image %s: %v", name, err)
+		}
+		pushed = append(pushed, name)
+	}
+	return pushed, nil
+}
+
+// updateBuildOutputs records the references of the images pushed by the build in its status.
+func updateBuildOutputs(c client.BuildInterface, build *api.Build, references []string) {
+	if len(references) == 0 {
+		return
+	}
+	build.Status.OutputDockerImageReferences = references
+
+	// Reset ResourceVersion to avoid a conflict with other updates to the build
+	build.ResourceVersion = ""
+
+	glog.V(4).Infof("Setting build output references to %v", references)
+	if _, err := c.UpdateDetails(build); err != nil {
+		glog.Warningf("An error occurred saving build output references: %v", err)
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/fsouza/go-dockerclient"
+
 	kapi "k8s.io/kubernetes/pkg/api"
 
 	"github.com/openshift/origin/pkg/build/api"
@@ -49,3 +51,52 @@ func TestBuildInfo(t *testing.T) {
 		t.Errorf("buildInfo(%+v) = %+v; want %+v", b, got, want)
 	}
 }
+
+func TestPushOutputTargets(t *testing.T) {
+	b := &api.Build{
+		Spec: api.BuildSpec{
+			Source: api.BuildSource{
+				Git: &api.GitBuildSource{URI: "github.com/openshift/sample-app"},
+			},
+			Revision: &api.SourceRevision{
+				Git: &api.GitSourceRevision{
+					Commit: "1575a90c569a7cc0eea84fbd3304d9df37c9f5ee",
+				},
+			},
+			Output: api.BuildOutput{
+				AdditionalTargets: []api.BuildOutputTarget{
+					{To: kapi.ObjectReference{Kind: "DockerImage", Name: "registry/ns/app:latest"}},
+					{To: kapi.ObjectReference{Kind: "DockerImage", Name: "registry/ns/app:git-${SOURCE_COMMIT_SHORT}"}},
+					{To: kapi.ObjectReference{Kind: "DockerImage", Name: "external.com/team/app:${SOURCE_REF}"}},
+				},
+			},
+		},
+	}
+	var tagged, pushedImages []string
+	dockerClient := &FakeDocker{
+		tagImageFunc: func(name string, opts docker.TagImageOptions) error {
+			if name != "registry/ns/app:build" {
+				t.Errorf("unexpected image tagged: %s", name)
+			}
+			tagged = append(tagged, opts.Repo+":"+opts.Tag)
+			return nil
+		},
+		pushImageFunc: func(opts docker.PushImageOptions, auth docker.AuthConfiguration) error {
+			pushedImages = append(pushedImages, opts.Name+":"+opts.Tag)
+			return nil
+		},
+	}
+
+	pushed, err := pushOutputTargets(dockerClient, b, "registry/ns/app:build")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// the last target is skipped since the source has no ref
+	want := []string{"registry/ns/app:latest", "registry/ns/app:git-1575a90"}
+	if !reflect.DeepEqual(pushed, want) {
+		t.Errorf("expected %v to be pushed, got %v", want, pushed)
+	}
+	if !reflect.DeepEqual(tagged, want) || !reflect.DeepEqual(pushedImages, want) {
+		t.Errorf("expected %v to be tagged and pushed, got %v and %v", want, tagged, pushedImages)
+	}
+}
@@ -87,6 +87,7 @@ func (d *DockerBuilder) Build() error {
 		return err
 	}
 
+	var pushed []string
 	if push {
 		// Get the Docker push authentication
 		pushAuthConfig, authPresent := dockercfg.NewHelper().GetDockerAuth(
@@ -101,8 +102,12 @@ func (d *DockerBuilder) Build() error {
 			return fmt.Errorf("Failed to push image: %v", err)
 		}
 		glog.Infof("Push successful")
+		pushed = append(pushed, d.build.Status.OutputDockerImageReference)
 	}
-	return nil
+
+	targets, err := pushOutputTargets(d.dockerClient, d.build, d.build.Status.OutputDockerImageReference)
+	updateBuildOutputs(d.client, d.build, append(pushed, targets...))
+	return err
 }
 
 // copySecrets copies all files from the directory where the secret is
@@ -35,6 +35,7 @@ var (
 type DockerClient interface {
 	BuildImage(opts docker.BuildImageOptions) error
 	PushImage(opts docker.PushImageOptions, auth docker.AuthConfiguration) error
+	TagImage(name string, opts docker.TagImageOptions) error
 	RemoveImage(name string) error
 	CreateContainer(opts docker.CreateContainerOptions) (*docker.Container, error)
 	DownloadFromContainer(id string, opts docker.DownloadFromContainerOptions) error
@@ -84,6 +85,12 @@ func pushImage(client DockerClient, name string, authConfig docker.AuthConfigura
 	return err
 }
 
+// tagImage adds the repository and tag of newName to the image name.
+func tagImage(client DockerClient, name, newName string) error {
+	repository, tag := docker.ParseRepositoryTag(newName)
+	return client.TagImage(name, docker.TagImageOptions{Repo: repository, Tag: tag, Force: true})
+}
+
 func removeImage(client DockerClient, name string) error {
 	return client.RemoveImage(name)
 }
@@ -10,6 +10,7 @@ type FakeDocker struct {
 	pushImageFunc   func(opts docker.PushImageOptions, auth docker.AuthConfiguration) error
 	buildImageFunc  func(opts docker.BuildImageOptions) error
 	removeImageFunc func(name string) error
+	tagImageFunc    func(name string, opts docker.TagImageOptions) error
 
 	buildImageCalled  bool
 	pushImageCalled   bool
@@ -30,6 +31,12 @@ func (d *FakeDocker) PushImage(opts docker.PushImageOptions, auth docker.AuthCon
 	}
 	return d.errPushImage
 }
+func (d *FakeDocker) TagImage(name string, opts docker.TagImageOptions) error {
+	if d.tagImageFunc != nil {
+		return d.tagImageFunc(name, opts)
+	}
+	return nil
+}
 func (d *FakeDocker) RemoveImage(name string) error {
 	if d.removeImageFunc != nil {
 		return d.removeImageFunc(name)
@@ -219,6 +219,7 @@ func (s *S2IBuilder) Build() error {
 		return err
 	}
 
+	var pushed []string
 	if push {
 		// Get the Docker push authentication
 		pushAuthConfig, authPresent := dockercfg.NewHelper().GetDockerAuth(
@@ -248,8 +249,12 @@ func (s *S2IBuilder) Build() error {
 		}
 		glog.Infof("Successfully pushed %s", tag)
 		glog.Flush()
+		pushed = append(pushed, tag)
 	}
-	return nil
+
+	targets, err := pushOutputTargets(s.dockerClient, s.build, tag)
+	updateBuildOutputs(s.client, s.build, append(pushed, targets...))
+	return err
 }
 
 type downloader struct {
@@ -1,6 +1,8 @@
 package client
 
 import (
+	kapi "k8s.io/kubernetes/pkg/api"
+
 	buildapi "github.com/openshift/origin/pkg/build/api"
 	osclient "github.com/openshift/origin/pkg/client"
 )
@@ -57,6 +59,26 @@ func (c OSClientBuildClient) Update(namespace string, build *buildapi.Build) err
 	return e
 }
 
+// BuildLister provides methods for listing the Builds.
+type BuildLister interface {
+	List(namespace string, opts kapi.ListOptions) (*buildapi.BuildList, error)
+}
+
+// List lists the builds using the OpenShift client.
+func (c OSClientBuildClient) List(namespace string, opts kapi.ListOptions) (*buildapi.BuildList, error) {
+	return c.Client.Builds(namespace).List(opts)
+}
+
+// BuildDeleter provides methods for deleting existing Builds.
+type BuildDeleter interface {
+	Delete(namespace, name string) error
+}
+
+// Delete deletes a build using the OpenShift client.
+func (c OSClientBuildClient) Delete(namespace, name string) error {
+	return c.Client.Builds(namespace).Delete(name)
+}
+
 // BuildCloner provides methods for cloning builds
 type BuildCloner interface {
 	Clone(namespace string, request *buildapi.BuildRequest) (*buildapi.Build, error)
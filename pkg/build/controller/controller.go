@@ -10,6 +10,7 @@ import (
 	"k8s.io/kubernetes/pkg/api/unversioned"
 	"k8s.io/kubernetes/pkg/client/cache"
 	"k8s.io/kubernetes/pkg/client/record"
+	"k8s.io/kubernetes/pkg/util"
 
 	buildapi "github.com/openshift/origin/pkg/build/api"
 	buildclient "github.com/openshift/origin/pkg/build/client"
@@ -21,10 +22,14 @@ import (
 // BuildController watches build resources and manages their state
 type BuildController struct {
 	BuildUpdater      buildclient.BuildUpdater
+	BuildLister       buildclient.BuildLister
+	BuildPruner       buildPruner
 	PodManager        podManager
 	BuildStrategy     BuildStrategy
 	ImageStreamClient imageStreamClient
 	Recorder          record.EventRecorder
+	// LogArchiver, if set, archives the log of a build before its pod is deleted on cancellation
+	LogArchiver logArchiver
 }
 
 // BuildStrategy knows how to create a pod spec for a pod which can execute a build.
@@ -38,6 +43,12 @@ type podManager interface {
 	GetPod(namespace, name string) (*kapi.Pod, error)
 }
 
+// logArchiver keeps the logs of finished builds after their pods are deleted
+type logArchiver interface {
+	Archive(build *buildapi.Build) error
+	Remove(build *buildapi.Build) error
+}
+
 type imageStreamClient interface {
 	GetImageStream(namespace, name string) (*imageapi.ImageStream, error)
 }
@@ -57,6 +68,16 @@ func (bc *BuildController) CancelBuild(build *buildapi.Build) error {
 			return fmt.Errorf("Failed to get pod for build %s/%s: %v", build.Namespace, build.Name, err)
 		}
 	} else {
+		// The log is lost with the pod, so it is archived first. Failing to archive it does not
+		// hold up the cancellation.
+		if bc.LogArchiver != nil && !isLogArchiveDone(build) {
+			if err := bc.LogArchiver.Archive(build); err != nil {
+				bc.Recorder.Eventf(build, kapi.EventTypeWarning, "FailedLogArchive", "Unable to archive the log of the cancelled build: %v", err)
+				util.HandleError(err)
+			} else {
+				setLogArchiveState(build, logArchived)
+			}
+		}
 		err := bc.PodManager.DeletePod(build.Namespace, pod)
 		if err != nil && !errors.IsNotFound(err) {
 			return fmt.Errorf("Couldn't delete build pod %s/%s: %v", build.Namespace, pod.Name, err)
@@ -73,6 +94,13 @@ func (bc *BuildController) CancelBuild(build *buildapi.Build) error {
 	}
 
 	glog.V(4).Infof("Build %s/%s was successfully cancelled.", build.Namespace, build.Name)
+
+	if err := handleBuildCompletion(build, bc.BuildLister, bc.BuildUpdater); err != nil {
+		glog.V(2).Infof("Failed to start the next queued build after cancelling build %s/%s: %v", build.Namespace, build.Name, err)
+	}
+	if err := bc.BuildPruner.PruneBuilds(build); err != nil {
+		glog.V(2).Infof("Failed to prune old builds after cancelling build %s/%s: %v", build.Namespace, build.Name, err)
+	}
 	return nil
 }
 
@@ -94,6 +122,22 @@ func (bc *BuildController) HandleBuild(build *buildapi.Build) error {
 		return nil
 	}
 
+	// Builds that cannot start yet because of the run policy of their config
+	// stay queued until a previous build completes.
+	phase, reason := build.Status.Phase, build.Status.Reason
+	runnable, err := bc.isRunnable(build)
+	if err != nil {
+		return err
+	}
+	if !runnable {
+		if build.Status.Phase != phase || build.Status.Reason != reason {
+			if err := bc.BuildUpdater.Update(build.Namespace, build); err != nil {
+				return fmt.Errorf("failed to update build %s/%s: %v", build.Namespace, build.Name, err)
+			}
+		}
+		return nil
+	}
+
 	if err := bc.nextBuildPhase(build); err != nil {
 		return err
 	}
@@ -122,7 +166,7 @@ func (bc *BuildController) nextBuildPhase(build *buildapi.Build) error {
 	}
 
 	// Set the output Docker image reference.
-	ref, err := bc.resolveOutputDockerImageReference(build)
+	ref, err := bc.resolveOutputDockerImageReference(build, build.Spec.Output.To)
 	if err != nil {
 		build.Status.Reason = buildapi.StatusReasonInvalidOutputReference
 		return err
@@ -149,6 +193,20 @@ func (bc *BuildController) nextBuildPhase(build *buildapi.Build) error {
 			Name: ref,
 		}
 	}
+	// The tags of the additional targets may still reference the source revision,
+	// which is substituted by the builder once the source has been fetched.
+	for i := range buildCopy.Spec.Output.AdditionalTargets {
+		target := &buildCopy.Spec.Output.AdditionalTargets[i]
+		targetRef, err := bc.resolveOutputDockerImageReference(build, &target.To)
+		if err != nil {
+			build.Status.Reason = buildapi.StatusReasonInvalidOutputReference
+			return err
+		}
+		target.To = kapi.ObjectReference{
+			Kind: "DockerImage",
+			Name: targetRef,
+		}
+	}
 
 	// Invoke the strategy to get a build pod.
 	podSpec, err := bc.BuildStrategy.CreateBuildPod(buildCopy)
@@ -186,9 +244,9 @@ func (bc *BuildController) nextBuildPhase(build *buildapi.Build) error {
 }
 
 // resolveOutputDockerImageReference returns a reference to a Docker image
-// computed from the buid.Spec.Output.To reference.
-func (bc *BuildController) resolveOutputDockerImageReference(build *buildapi.Build) (string, error) {
-	outputTo := build.Spec.Output.To
+// computed from outputTo, which is build.Spec.Output.To or the target of one of
+// build.Spec.Output.AdditionalTargets.
+func (bc *BuildController) resolveOutputDockerImageReference(build *buildapi.Build, outputTo *kapi.ObjectReference) (string, error) {
 	if outputTo == nil || outputTo.Name == "" {
 		return "", nil
 	}
@@ -234,6 +292,8 @@ func (bc *BuildController) resolveOutputDockerImageReference(build *buildapi.Bui
 type BuildPodController struct {
 	BuildStore   cache.Store
 	BuildUpdater buildclient.BuildUpdater
+	BuildLister  buildclient.BuildLister
+	BuildPruner  buildPruner
 	PodManager   podManager
 }
 
@@ -293,6 +353,15 @@ func (bc *BuildPodController) HandlePod(pod *kapi.Pod) error {
 			return fmt.Errorf("failed to update build %s/%s: %v", build.Namespace, build.Name, err)
 		}
 		glog.V(4).Infof("Build %s/%s status was updated %s -> %s", build.Namespace, build.Name, build.Status.Phase, nextStatus)
+
+		if buildutil.IsBuildComplete(build) {
+			if err := handleBuildCompletion(build, bc.BuildLister, bc.BuildUpdater); err != nil {
+				glog.V(2).Infof("Failed to start the next queued build after build %s/%s completed: %v", build.Namespace, build.Name, err)
+			}
+			if err := bc.BuildPruner.PruneBuilds(build); err != nil {
+				glog.V(2).Infof("Failed to prune old builds after build %s/%s completed: %v", build.Namespace, build.Name, err)
+			}
+		}
 	}
 	return nil
 }
@@ -306,6 +375,8 @@ func isBuildCancellable(build *buildapi.Build) bool {
 type BuildPodDeleteController struct {
 	BuildStore   cache.Store
 	BuildUpdater buildclient.BuildUpdater
+	BuildLister  buildclient.BuildLister
+	BuildPruner  buildPruner
 }
 
 // HandleBuildPodDeletion sets the status of a build to error if the build pod has been deleted
@@ -344,18 +415,93 @@ func (bc *BuildPodDeleteController) HandleBuildPodDeletion(pod *kapi.Pod) error
 		if err := bc.BuildUpdater.Update(build.Namespace, build); err != nil {
 			return fmt.Errorf("Failed to update build %s/%s: %v", build.Namespace, build.Name, err)
 		}
+		if err := handleBuildCompletion(build, bc.BuildLister, bc.BuildUpdater); err != nil {
+			glog.V(2).Infof("Failed to start the next queued build after build %s/%s failed: %v", build.Namespace, build.Name, err)
+		}
+		if err := bc.BuildPruner.PruneBuilds(build); err != nil {
+			glog.V(2).Infof("Failed to prune old builds after build %s/%s failed: %v", build.Namespace, build.Name, err)
+		}
 	}
 	return nil
 }
 
+// BuildLogArchiveController watches builds and archives the logs of finished builds while
+// their pods still exist
+type BuildLogArchiveController struct {
+	BuildUpdater buildclient.BuildUpdater
+	PodManager   podManager
+	LogArchiver  logArchiver
+}
+
+// HandleBuild archives the log of a finished build if it was not archived yet. Archiving
+// errors are returned so that they are retried. A build whose pod was deleted is marked so
+// that it is not looked at again.
+func (bc *BuildLogArchiveController) HandleBuild(build *buildapi.Build) error {
+	if !buildutil.IsBuildComplete(build) || isLogArchiveDone(build) {
+		return nil
+	}
+	state := logArchived
+	if _, err := bc.PodManager.GetPod(build.Namespace, buildutil.GetBuildPodName(build)); err != nil {
+		if !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to get pod for build %s/%s: %v", build.Namespace, build.Name, err)
+		}
+		glog.V(4).Infof("Not archiving the log of build %s/%s because its pod was deleted", build.Namespace, build.Name)
+		state = logUnavailable
+	} else if err := bc.LogArchiver.Archive(build); err != nil {
+		return err
+	}
+	setLogArchiveState(build, state)
+	if err := bc.BuildUpdater.Update(build.Namespace, build); err != nil {
+		return fmt.Errorf("failed to update build %s/%s: %v", build.Namespace, build.Name, err)
+	}
+	return nil
+}
+
+const (
+	// logArchived is the value of the log archived annotation of a build whose log was archived
+	logArchived = "true"
+	// logUnavailable is the value of the log archived annotation of a build whose pod was deleted
+	// before its log could be archived
+	logUnavailable = "unavailable"
+)
+
+// isLogArchived returns true if the log of the build pod has been archived
+func isLogArchived(build *buildapi.Build) bool {
+	return build.Annotations[buildapi.BuildLogArchivedAnnotation] == logArchived
+}
+
+// isLogArchiveDone returns true if the log of the build pod has been archived or cannot be
+// archived anymore
+func isLogArchiveDone(build *buildapi.Build) bool {
+	_, ok := build.Annotations[buildapi.BuildLogArchivedAnnotation]
+	return ok
+}
+
+// setLogArchiveState records on the build whether the log of its pod has been archived
+func setLogArchiveState(build *buildapi.Build, state string) {
+	if build.Annotations == nil {
+		build.Annotations = map[string]string{}
+	}
+	build.Annotations[buildapi.BuildLogArchivedAnnotation] = state
+}
+
 // BuildDeleteController watches for builds being deleted and cleans up associated pods
+// and archived logs
 type BuildDeleteController struct {
 	PodManager podManager
+	// LogArchiver, if set, removes the archived log of a deleted build
+	LogArchiver logArchiver
 }
 
-// HandleBuildDeletion deletes a build pod if the corresponding build has been deleted
+// HandleBuildDeletion deletes a build pod and the archived log of the build if the
+// corresponding build has been deleted
 func (bc *BuildDeleteController) HandleBuildDeletion(build *buildapi.Build) error {
 	glog.V(4).Infof("Handling deletion of build %s", build.Name)
+	if bc.LogArchiver != nil {
+		if err := bc.LogArchiver.Remove(build); err != nil {
+			glog.V(2).Infof("Failed to remove the archived log of build %s/%s: %v", build.Namespace, build.Name, err)
+		}
+	}
 	podName := buildutil.GetBuildPodName(build)
 	pod, err := bc.PodManager.GetPod(build.Namespace, podName)
 	if err != nil && !errors.IsNotFound(err) {
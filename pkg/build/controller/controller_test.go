@@ -22,6 +22,12 @@ func (okc *okBuildUpdater) Update(namespace string, build *buildapi.Build) error
 	return nil
 }
 
+type okBuildPruner struct{}
+
+func (okp *okBuildPruner) PruneBuilds(build *buildapi.Build) error {
+	return nil
+}
+
 type errBuildUpdater struct{}
 
 func (ec *errBuildUpdater) Update(namespace string, build *buildapi.Build) error {
@@ -138,6 +144,7 @@ func mockBuild(phase buildapi.BuildPhase, output buildapi.BuildOutput) *buildapi
 func mockBuildController() *BuildController {
 	return &BuildController{
 		BuildUpdater:      &okBuildUpdater{},
+		BuildPruner:       &okBuildPruner{},
 		PodManager:        &okPodManager{},
 		BuildStrategy:     &okStrategy{},
 		ImageStreamClient: &okImageStreamClient{},
@@ -149,6 +156,7 @@ func mockBuildPodController(build *buildapi.Build) *BuildPodController {
 	return &BuildPodController{
 		BuildStore:   buildtest.NewFakeBuildStore(build),
 		BuildUpdater: &okBuildUpdater{},
+		BuildPruner:  &okBuildPruner{},
 		PodManager:   &okPodManager{},
 	}
 }
@@ -699,7 +707,7 @@ func (c *customPodManager) GetPod(namespace, name string) (*kapi.Pod, error) {
 func TestHandleHandleBuildDeletionOK(t *testing.T) {
 	deleteWasCalled := false
 	build := mockBuild(buildapi.BuildPhaseComplete, buildapi.BuildOutput{})
-	ctrl := BuildDeleteController{&customPodManager{
+	ctrl := BuildDeleteController{PodManager: &customPodManager{
 		GetPodFunc: func(namespace, names string) (*kapi.Pod, error) {
 			return &kapi.Pod{ObjectMeta: kapi.ObjectMeta{Labels: map[string]string{buildapi.BuildLabel: build.Name}}}, nil
 		},
@@ -721,7 +729,7 @@ func TestHandleHandleBuildDeletionOK(t *testing.T) {
 func TestHandleHandleBuildDeletionOKDeprecatedLabel(t *testing.T) {
 	deleteWasCalled := false
 	build := mockBuild(buildapi.BuildPhaseComplete, buildapi.BuildOutput{})
-	ctrl := BuildDeleteController{&customPodManager{
+	ctrl := BuildDeleteController{PodManager: &customPodManager{
 		GetPodFunc: func(namespace, names string) (*kapi.Pod, error) {
 			return &kapi.Pod{ObjectMeta: kapi.ObjectMeta{Labels: map[string]string{buildapi.BuildLabel: build.Name}}}, nil
 		},
@@ -742,7 +750,7 @@ func TestHandleHandleBuildDeletionOKDeprecatedLabel(t *testing.T) {
 
 func TestHandleHandleBuildDeletionFailGetPod(t *testing.T) {
 	build := mockBuild(buildapi.BuildPhaseComplete, buildapi.BuildOutput{})
-	ctrl := BuildDeleteController{&customPodManager{
+	ctrl := BuildDeleteController{PodManager: &customPodManager{
 		GetPodFunc: func(namespace, name string) (*kapi.Pod, error) {
 			return nil, errors.New("random")
 		},
@@ -757,7 +765,7 @@ func TestHandleHandleBuildDeletionFailGetPod(t *testing.T) {
 func TestHandleHandleBuildDeletionGetPodNotFound(t *testing.T) {
 	deleteWasCalled := false
 	build := mockBuild(buildapi.BuildPhaseComplete, buildapi.BuildOutput{})
-	ctrl := BuildDeleteController{&customPodManager{
+	ctrl := BuildDeleteController{PodManager: &customPodManager{
 		GetPodFunc: func(namespace, name string) (*kapi.Pod, error) {
 			return nil, kerrors.NewNotFound("Pod", name)
 		},
@@ -779,7 +787,7 @@ func TestHandleHandleBuildDeletionGetPodNotFound(t *testing.T) {
 func TestHandleHandleBuildDeletionMismatchedLabels(t *testing.T) {
 	deleteWasCalled := false
 	build := mockBuild(buildapi.BuildPhaseComplete, buildapi.BuildOutput{})
-	ctrl := BuildDeleteController{&customPodManager{
+	ctrl := BuildDeleteController{PodManager: &customPodManager{
 		GetPodFunc: func(namespace, names string) (*kapi.Pod, error) {
 			return &kapi.Pod{}, nil
 		},
@@ -800,7 +808,7 @@ func TestHandleHandleBuildDeletionMismatchedLabels(t *testing.T) {
 
 func TestHandleHandleBuildDeletionDeletePodError(t *testing.T) {
 	build := mockBuild(buildapi.BuildPhaseComplete, buildapi.BuildOutput{})
-	ctrl := BuildDeleteController{&customPodManager{
+	ctrl := BuildDeleteController{PodManager: &customPodManager{
 		GetPodFunc: func(namespace, names string) (*kapi.Pod, error) {
 			return &kapi.Pod{ObjectMeta: kapi.ObjectMeta{Labels: map[string]string{buildapi.BuildLabel: build.Name}}}, nil
 		},
@@ -827,6 +835,7 @@ func mockBuildPodDeleteController(build *buildapi.Build, buildUpdater *customBui
 	return &BuildPodDeleteController{
 		BuildStore:   buildtest.FakeBuildStore{Build: build, Err: err},
 		BuildUpdater: buildUpdater,
+		BuildPruner:  &okBuildPruner{},
 	}
 }
 
@@ -936,3 +945,181 @@ func TestHandleBuildPodDeletionBuildUpdateError(t *testing.T) {
 		t.Error("Expected random error, but got none!")
 	}
 }
+
+type fakeLogArchiver struct {
+	archived []string
+	removed  []string
+	err      error
+}
+
+func (a *fakeLogArchiver) Archive(build *buildapi.Build) error {
+	if a.err != nil {
+		return a.err
+	}
+	a.archived = append(a.archived, build.Name)
+	return nil
+}
+
+func (a *fakeLogArchiver) Remove(build *buildapi.Build) error {
+	a.removed = append(a.removed, build.Name)
+	return nil
+}
+
+func TestHandlePodDoesNotArchiveLog(t *testing.T) {
+	build := mockBuild(buildapi.BuildPhaseRunning, buildapi.BuildOutput{})
+	ctrl := mockBuildPodController(build)
+	if err := ctrl.HandlePod(mockPod(kapi.PodSucceeded, 0)); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if isLogArchived(build) {
+		t.Errorf("Expected the pod controller to leave the log to the log archive controller")
+	}
+}
+
+func TestHandleBuildArchivesLog(t *testing.T) {
+	tests := []struct {
+		name        string
+		phase       buildapi.BuildPhase
+		state       string
+		podManager  podManager
+		archiveErr  error
+		errExpected bool
+		archive     bool
+		unavailable bool
+	}{
+		{
+			name:       "running",
+			phase:      buildapi.BuildPhaseRunning,
+			podManager: &okPodManager{},
+		},
+		{
+			name:       "complete",
+			phase:      buildapi.BuildPhaseComplete,
+			podManager: &okPodManager{},
+			archive:    true,
+		},
+		{
+			name:       "failed pod",
+			phase:      buildapi.BuildPhaseFailed,
+			podManager: &okPodManager{},
+			archive:    true,
+		},
+		{
+			name:       "error",
+			phase:      buildapi.BuildPhaseError,
+			podManager: &okPodManager{},
+			archive:    true,
+		},
+		{
+			name:       "already archived",
+			phase:      buildapi.BuildPhaseComplete,
+			state:      logArchived,
+			podManager: &okPodManager{},
+		},
+		{
+			name:        "pod deleted",
+			phase:       buildapi.BuildPhaseError,
+			podManager:  &errExistsPodManager{},
+			unavailable: true,
+		},
+		{
+			name:       "already known to be unavailable",
+			phase:      buildapi.BuildPhaseComplete,
+			state:      logUnavailable,
+			podManager: &errPodManager{},
+		},
+		{
+			name:        "pod lookup failure is retried",
+			phase:       buildapi.BuildPhaseComplete,
+			podManager:  &errPodManager{},
+			errExpected: true,
+		},
+		{
+			name:        "archive failure is retried",
+			phase:       buildapi.BuildPhaseFailed,
+			podManager:  &okPodManager{},
+			archiveErr:  errors.New("archive error"),
+			errExpected: true,
+		},
+	}
+
+	for _, tc := range tests {
+		build := mockBuild(tc.phase, buildapi.BuildOutput{})
+		if len(tc.state) > 0 {
+			setLogArchiveState(build, tc.state)
+		}
+		archiver := &fakeLogArchiver{err: tc.archiveErr}
+		ctrl := BuildLogArchiveController{
+			BuildUpdater: &okBuildUpdater{},
+			PodManager:   tc.podManager,
+			LogArchiver:  archiver,
+		}
+		err := ctrl.HandleBuild(build)
+		if tc.errExpected != (err != nil) {
+			t.Errorf("%s: expected error %v, got %v", tc.name, tc.errExpected, err)
+		}
+		if archived := len(archiver.archived) == 1; archived != tc.archive {
+			t.Errorf("%s: expected archived %v, got %v", tc.name, tc.archive, archiver.archived)
+		}
+		if tc.archive && !isLogArchived(build) {
+			t.Errorf("%s: expected the build to be marked as archived", tc.name)
+		}
+		if tc.unavailable && build.Annotations[buildapi.BuildLogArchivedAnnotation] != logUnavailable {
+			t.Errorf("%s: expected the build to be marked as unavailable, got %v", tc.name, build.Annotations)
+		}
+	}
+}
+
+func TestCancelBuildArchivesLog(t *testing.T) {
+	tests := []struct {
+		name       string
+		archiveErr error
+		archived   bool
+	}{
+		{
+			name:     "archived",
+			archived: true,
+		},
+		{
+			name:       "archive failure does not block cancellation",
+			archiveErr: errors.New("archive error"),
+		},
+	}
+
+	for _, tc := range tests {
+		build := mockBuild(buildapi.BuildPhaseRunning, buildapi.BuildOutput{})
+		archiver := &fakeLogArchiver{err: tc.archiveErr}
+		ctrl := mockBuildController()
+		ctrl.LogArchiver = archiver
+		if err := ctrl.CancelBuild(build); err != nil {
+			t.Errorf("%s: unexpected error: %v", tc.name, err)
+			continue
+		}
+		if build.Status.Phase != buildapi.BuildPhaseCancelled {
+			t.Errorf("%s: expected phase %s, got %s", tc.name, buildapi.BuildPhaseCancelled, build.Status.Phase)
+		}
+		if isLogArchived(build) != tc.archived {
+			t.Errorf("%s: expected archived %v, got %v", tc.name, tc.archived, archiver.archived)
+		}
+	}
+}
+
+func TestHandleBuildDeletionRemovesArchivedLog(t *testing.T) {
+	archiver := &fakeLogArchiver{}
+	build := mockBuild(buildapi.BuildPhaseComplete, buildapi.BuildOutput{})
+	ctrl := BuildDeleteController{
+		PodManager: &customPodManager{
+			GetPodFunc: func(namespace, name string) (*kapi.Pod, error) {
+				return nil, kerrors.NewNotFound("pod", name)
+			},
+		},
+		LogArchiver: archiver,
+	}
+
+	if err := ctrl.HandleBuildDeletion(build); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+	if !reflect.DeepEqual(archiver.removed, []string{build.Name}) {
+		t.Errorf("Expected the archived log of %s to be removed, got %v", build.Name, archiver.removed)
+	}
+}
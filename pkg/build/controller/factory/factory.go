@@ -20,6 +20,7 @@ import (
 	buildclient "github.com/openshift/origin/pkg/build/client"
 	buildcontroller "github.com/openshift/origin/pkg/build/controller"
 	strategy "github.com/openshift/origin/pkg/build/controller/strategy"
+	"github.com/openshift/origin/pkg/build/logarchive"
 	buildutil "github.com/openshift/origin/pkg/build/util"
 	osclient "github.com/openshift/origin/pkg/client"
 	controller "github.com/openshift/origin/pkg/controller"
@@ -57,12 +58,16 @@ func limitedLogAndRetry(buildupdater buildclient.BuildUpdater, maxTimeout time.D
 
 // BuildControllerFactory constructs BuildController objects
 type BuildControllerFactory struct {
-	OSClient            osclient.Interface
-	KubeClient          kclient.Interface
-	BuildUpdater        buildclient.BuildUpdater
-	DockerBuildStrategy *strategy.DockerBuildStrategy
-	SourceBuildStrategy *strategy.SourceBuildStrategy
-	CustomBuildStrategy *strategy.CustomBuildStrategy
+	OSClient              osclient.Interface
+	KubeClient            kclient.Interface
+	BuildUpdater          buildclient.BuildUpdater
+	DockerBuildStrategy   *strategy.DockerBuildStrategy
+	SourceBuildStrategy   *strategy.SourceBuildStrategy
+	CustomBuildStrategy   *strategy.CustomBuildStrategy
+	PipelineBuildStrategy *strategy.PipelineBuildStrategy
+	// LogArchiver, if set, archives the logs of finished and cancelled builds and removes the
+	// archived logs of deleted builds.
+	LogArchiver *logarchive.Archiver
 	// Stop may be set to allow controllers created by this factory to be terminated.
 	Stop <-chan struct{}
 }
@@ -78,15 +83,21 @@ func (factory *BuildControllerFactory) Create() controller.RunnableController {
 	client := ControllerClient{factory.KubeClient, factory.OSClient}
 	buildController := &buildcontroller.BuildController{
 		BuildUpdater:      factory.BuildUpdater,
+		BuildLister:       buildclient.NewOSClientBuildClient(factory.OSClient),
+		BuildPruner:       newBuildHistoryPruner(client),
 		ImageStreamClient: client,
 		PodManager:        client,
 		BuildStrategy: &typeBasedFactoryStrategy{
-			DockerBuildStrategy: factory.DockerBuildStrategy,
-			SourceBuildStrategy: factory.SourceBuildStrategy,
-			CustomBuildStrategy: factory.CustomBuildStrategy,
+			DockerBuildStrategy:   factory.DockerBuildStrategy,
+			SourceBuildStrategy:   factory.SourceBuildStrategy,
+			CustomBuildStrategy:   factory.CustomBuildStrategy,
+			PipelineBuildStrategy: factory.PipelineBuildStrategy,
 		},
 		Recorder: eventBroadcaster.NewRecorder(kapi.EventSource{Component: "build-controller"}),
 	}
+	if factory.LogArchiver != nil {
+		buildController.LogArchiver = factory.LogArchiver
+	}
 
 	return &controller.RetryController{
 		Queue: queue,
@@ -126,6 +137,9 @@ func (factory *BuildControllerFactory) CreateDeleteController() controller.Runna
 	buildDeleteController := &buildcontroller.BuildDeleteController{
 		PodManager: client,
 	}
+	if factory.LogArchiver != nil {
+		buildDeleteController.LogArchiver = factory.LogArchiver
+	}
 
 	return &controller.RetryController{
 		Queue: queue,
@@ -146,6 +160,32 @@ func (factory *BuildControllerFactory) CreateDeleteController() controller.Runna
 	}
 }
 
+// CreateLogArchiveController constructs a BuildLogArchiveController. LogArchiver must be set.
+func (factory *BuildControllerFactory) CreateLogArchiveController() controller.RunnableController {
+	queue := cache.NewFIFO(cache.MetaNamespaceKeyFunc)
+	cache.NewReflector(&buildLW{client: factory.OSClient}, &buildapi.Build{}, queue, 2*time.Minute).RunUntil(factory.Stop)
+
+	client := ControllerClient{factory.KubeClient, factory.OSClient}
+	logArchiveController := &buildcontroller.BuildLogArchiveController{
+		BuildUpdater: factory.BuildUpdater,
+		PodManager:   client,
+		LogArchiver:  factory.LogArchiver,
+	}
+
+	return &controller.RetryController{
+		Queue: queue,
+		RetryManager: controller.NewQueueRetryManager(
+			queue,
+			cache.MetaNamespaceKeyFunc,
+			retryFunc("BuildLogArchive", nil),
+			kutil.NewTokenBucketRateLimiter(1, 10)),
+		Handle: func(obj interface{}) error {
+			build := obj.(*buildapi.Build)
+			return logArchiveController.HandleBuild(build)
+		},
+	}
+}
+
 // BuildPodControllerFactory construct BuildPodController objects
 type BuildPodControllerFactory struct {
 	OSClient     osclient.Interface
@@ -191,6 +231,8 @@ func (factory *BuildPodControllerFactory) Create() controller.RunnableController
 	buildPodController := &buildcontroller.BuildPodController{
 		BuildStore:   factory.buildStore,
 		BuildUpdater: factory.BuildUpdater,
+		BuildLister:  buildclient.NewOSClientBuildClient(factory.OSClient),
+		BuildPruner:  newBuildHistoryPruner(client),
 		PodManager:   client,
 	}
 
@@ -241,6 +283,8 @@ func (factory *BuildPodControllerFactory) CreateDeleteController() controller.Ru
 	buildPodDeleteController := &buildcontroller.BuildPodDeleteController{
 		BuildStore:   factory.buildStore,
 		BuildUpdater: factory.BuildUpdater,
+		BuildLister:  buildclient.NewOSClientBuildClient(factory.OSClient),
+		BuildPruner:  newBuildHistoryPruner(client),
 	}
 
 	return &controller.RetryController{
@@ -352,9 +396,10 @@ func (pe *podEnumerator) Get(index int) interface{} {
 }
 
 type typeBasedFactoryStrategy struct {
-	DockerBuildStrategy *strategy.DockerBuildStrategy
-	SourceBuildStrategy *strategy.SourceBuildStrategy
-	CustomBuildStrategy *strategy.CustomBuildStrategy
+	DockerBuildStrategy   *strategy.DockerBuildStrategy
+	SourceBuildStrategy   *strategy.SourceBuildStrategy
+	CustomBuildStrategy   *strategy.CustomBuildStrategy
+	PipelineBuildStrategy *strategy.PipelineBuildStrategy
 }
 
 func (f *typeBasedFactoryStrategy) CreateBuildPod(build *buildapi.Build) (*kapi.Pod, error) {
@@ -367,6 +412,11 @@ func (f *typeBasedFactoryStrategy) CreateBuildPod(build *buildapi.Build) (*kapi.
 		pod, err = f.SourceBuildStrategy.CreateBuildPod(build)
 	case build.Spec.Strategy.CustomStrategy != nil:
 		pod, err = f.CustomBuildStrategy.CreateBuildPod(build)
+	case build.Spec.Strategy.PipelineStrategy != nil:
+		if f.PipelineBuildStrategy == nil {
+			return nil, strategy.FatalError("the Pipeline build strategy is not enabled, pipelineBuildConfig must be set in the master configuration")
+		}
+		pod, err = f.PipelineBuildStrategy.CreateBuildPod(build)
 	default:
 		return nil, fmt.Errorf("no supported build strategy defined for Build %s/%s", build.Namespace, build.Name)
 	}
@@ -606,6 +656,18 @@ func (lw *buildPodDeleteLW) Watch(options kapi.ListOptions) (watch.Interface, er
 	return lw.KubeClient.Pods(kapi.NamespaceAll).Watch(opts)
 }
 
+// newBuildHistoryPruner returns a pruner that removes builds exceeding the
+// history limits of their BuildConfig.
+func newBuildHistoryPruner(client ControllerClient) *buildcontroller.BuildHistoryPruner {
+	buildClient := buildclient.NewOSClientBuildClient(client.Client)
+	return &buildcontroller.BuildHistoryPruner{
+		BuildConfigGetter: buildclient.NewOSClientBuildConfigClient(client.Client),
+		BuildLister:       buildClient,
+		BuildDeleter:      buildClient,
+		PodManager:        client,
+	}
+}
+
 // ControllerClient implements the common interfaces needed for build controllers
 type ControllerClient struct {
 	KubeClient kclient.Interface
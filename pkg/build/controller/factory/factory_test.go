@@ -11,6 +11,7 @@ import (
 	"k8s.io/kubernetes/pkg/api/unversioned"
 
 	buildapi "github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/build/controller/strategy"
 	controller "github.com/openshift/origin/pkg/controller"
 )
 
@@ -125,3 +126,22 @@ func TestControllerRetryFunc(t *testing.T) {
 		}
 	}
 }
+
+func TestTypeBasedFactoryStrategyPipelineNotEnabled(t *testing.T) {
+	f := &typeBasedFactoryStrategy{}
+	build := &buildapi.Build{
+		ObjectMeta: kapi.ObjectMeta{Name: "build", Namespace: "default"},
+		Spec: buildapi.BuildSpec{
+			Strategy: buildapi.BuildStrategy{
+				PipelineStrategy: &buildapi.PipelineBuildStrategy{},
+			},
+		},
+	}
+	pod, err := f.CreateBuildPod(build)
+	if pod != nil {
+		t.Errorf("Expected no pod, got %#v", pod)
+	}
+	if !strategy.IsFatal(err) {
+		t.Errorf("Expected a fatal error, got %v", err)
+	}
+}
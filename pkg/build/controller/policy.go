@@ -0,0 +1,180 @@
+package controller
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/golang/glog"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	buildclient "github.com/openshift/origin/pkg/build/client"
+	buildutil "github.com/openshift/origin/pkg/build/util"
+)
+
+// runPolicyForBuild returns the run policy the build was created with. Builds
+// that were not created from a BuildConfig, or that were created before run
+// policies existed, run in parallel.
+func runPolicyForBuild(build *buildapi.Build) buildapi.BuildRunPolicy {
+	if len(buildutil.ConfigNameForBuild(build)) == 0 {
+		return buildapi.BuildRunPolicyParallel
+	}
+	policy := buildapi.BuildRunPolicy(build.Labels[buildapi.BuildRunPolicyLabel])
+	if !buildapi.KnownBuildRunPolicies.Has(string(policy)) {
+		return buildapi.BuildRunPolicyParallel
+	}
+	return policy
+}
+
+// buildsByNumber sorts builds by their build number, using the creation
+// timestamp for builds that share the same number.
+type buildsByNumber []buildapi.Build
+
+func (b buildsByNumber) Len() int {
+	return len(b)
+}
+
+func (b buildsByNumber) Less(i, j int) bool {
+	vi, vj := buildutil.VersionForBuild(&b[i]), buildutil.VersionForBuild(&b[j])
+	if vi != vj {
+		return vi < vj
+	}
+	return b[i].CreationTimestamp.Before(b[j].CreationTimestamp)
+}
+
+func (b buildsByNumber) Swap(i, j int) {
+	b[i], b[j] = b[j], b[i]
+}
+
+// isNewerBuild returns true if a was queued after b.
+func isNewerBuild(a, b *buildapi.Build) bool {
+	return buildsByNumber{*b, *a}.Less(0, 1)
+}
+
+// configBuilds returns all builds created from the same BuildConfig as the
+// provided build, ordered by their build number.
+func configBuilds(lister buildclient.BuildLister, build *buildapi.Build) ([]buildapi.Build, error) {
+	configName := buildutil.ConfigNameForBuild(build)
+	list, err := lister.List(build.Namespace, kapi.ListOptions{LabelSelector: buildutil.BuildConfigSelector(configName)})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list builds for build config %s/%s: %v", build.Namespace, configName, err)
+	}
+	builds := list.Items
+	if len(builds) == 0 {
+		// Builds created before the current label was introduced still carry
+		// the deprecated one.
+		list, err = lister.List(build.Namespace, kapi.ListOptions{LabelSelector: buildutil.BuildConfigSelectorDeprecated(configName)})
+		if err != nil {
+			return nil, fmt.Errorf("unable to list builds for build config %s/%s: %v", build.Namespace, configName, err)
+		}
+		builds = list.Items
+	}
+	sort.Sort(buildsByNumber(builds))
+	return builds, nil
+}
+
+// isRunnable checks whether the run policy of the build allows it to start
+// now. When the build has to wait for another build, the build status is
+// updated with the reason. For the SerialLatestOnly policy, queued builds that
+// are superseded by a newer build are cancelled, including the provided build.
+func (bc *BuildController) isRunnable(build *buildapi.Build) (bool, error) {
+	policy := runPolicyForBuild(build)
+	if policy == buildapi.BuildRunPolicyParallel {
+		return true, nil
+	}
+
+	builds, err := configBuilds(bc.BuildLister, build)
+	if err != nil {
+		return false, err
+	}
+
+	runnable := true
+	for i := range builds {
+		other := &builds[i]
+		if other.Name == build.Name {
+			continue
+		}
+		switch other.Status.Phase {
+		case buildapi.BuildPhasePending, buildapi.BuildPhaseRunning:
+			runnable = false
+		case buildapi.BuildPhaseNew:
+			if other.Status.Cancelled {
+				continue
+			}
+			if policy == buildapi.BuildRunPolicySerialLatestOnly {
+				if isNewerBuild(other, build) {
+					glog.V(4).Infof("Build %s/%s is superseded by build %s", build.Namespace, build.Name, other.Name)
+					markBuildCancelled(build, other.Name)
+					return false, nil
+				}
+				if err := bc.cancelQueuedBuild(other, build.Name); err != nil {
+					return false, err
+				}
+				continue
+			}
+			if isNewerBuild(build, other) {
+				runnable = false
+			}
+		}
+	}
+
+	if !runnable {
+		glog.V(4).Infof("Build %s/%s is waiting for a previous build of the same config to complete", build.Namespace, build.Name)
+		build.Status.Reason = buildapi.StatusReasonWaitingForPreviousBuild
+		build.Status.Message = fmt.Sprintf("The build is queued until previous builds from build config %s complete (run policy %s).", buildutil.ConfigNameForBuild(build), policy)
+	}
+	return runnable, nil
+}
+
+// cancelQueuedBuild cancels a build that has not started yet because a newer
+// build from the same config supersedes it.
+func (bc *BuildController) cancelQueuedBuild(build *buildapi.Build, newer string) error {
+	glog.V(4).Infof("Cancelling queued build %s/%s superseded by build %s", build.Namespace, build.Name, newer)
+	markBuildCancelled(build, newer)
+	if err := bc.BuildUpdater.Update(build.Namespace, build); err != nil {
+		return fmt.Errorf("failed to cancel queued build %s/%s: %v", build.Namespace, build.Name, err)
+	}
+	return nil
+}
+
+// markBuildCancelled sets the status of a queued build to cancelled.
+func markBuildCancelled(build *buildapi.Build, newer string) {
+	build.Status.Cancelled = true
+	build.Status.Phase = buildapi.BuildPhaseCancelled
+	build.Status.Reason = ""
+	build.Status.Message = fmt.Sprintf("The build was superseded by build %s.", newer)
+	now := unversioned.Now()
+	build.Status.CompletionTimestamp = &now
+}
+
+// handleBuildCompletion notifies the next queued build from the same config
+// that the provided build has completed, so it can be started.
+func handleBuildCompletion(build *buildapi.Build, lister buildclient.BuildLister, updater buildclient.BuildUpdater) error {
+	if runPolicyForBuild(build) == buildapi.BuildRunPolicyParallel {
+		return nil
+	}
+	builds, err := configBuilds(lister, build)
+	if err != nil {
+		return err
+	}
+	for i := range builds {
+		next := &builds[i]
+		if next.Name == build.Name || next.Status.Phase != buildapi.BuildPhaseNew || next.Status.Cancelled {
+			continue
+		}
+		if next.Annotations == nil {
+			next.Annotations = make(map[string]string)
+		}
+		// Updating the queued build causes the build controller to handle it again.
+		next.Annotations[buildapi.BuildAcceptedAnnotation] = time.Now().UTC().Format(time.RFC3339Nano)
+		glog.V(4).Infof("Build %s/%s completed, starting queued build %s", build.Namespace, build.Name, next.Name)
+		if err := updater.Update(next.Namespace, next); err != nil {
+			return fmt.Errorf("failed to start queued build %s/%s: %v", next.Namespace, next.Name, err)
+		}
+		return nil
+	}
+	return nil
+}
@@ -0,0 +1,199 @@
+package controller
+
+import (
+	"strconv"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/labels"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+)
+
+type fakeBuildLister struct {
+	builds []buildapi.Build
+}
+
+func (f *fakeBuildLister) List(namespace string, opts kapi.ListOptions) (*buildapi.BuildList, error) {
+	list := &buildapi.BuildList{}
+	for _, b := range f.builds {
+		if b.Namespace != namespace {
+			continue
+		}
+		if opts.LabelSelector != nil && !opts.LabelSelector.Matches(labels.Set(b.Labels)) {
+			continue
+		}
+		list.Items = append(list.Items, b)
+	}
+	return list, nil
+}
+
+type recordingBuildUpdater struct {
+	updated []*buildapi.Build
+}
+
+func (r *recordingBuildUpdater) Update(namespace string, build *buildapi.Build) error {
+	r.updated = append(r.updated, build)
+	return nil
+}
+
+func mockConfigBuild(number int, phase buildapi.BuildPhase, policy buildapi.BuildRunPolicy) buildapi.Build {
+	build := mockBuild(phase, buildapi.BuildOutput{})
+	build.Name = "config-" + strconv.Itoa(number)
+	build.Labels = map[string]string{
+		buildapi.BuildConfigLabel:    "config",
+		buildapi.BuildRunPolicyLabel: string(policy),
+	}
+	build.Annotations = map[string]string{
+		buildapi.BuildNumberAnnotation: strconv.Itoa(number),
+	}
+	return *build
+}
+
+func TestHandleBuildRunPolicy(t *testing.T) {
+	tests := []struct {
+		name           string
+		policy         buildapi.BuildRunPolicy
+		others         []buildapi.BuildPhase
+		number         int
+		expectedPhase  buildapi.BuildPhase
+		expectedReason buildapi.StatusReason
+		expectedCancel []string
+	}{
+		{
+			name:          "parallel build runs next to a running build",
+			policy:        buildapi.BuildRunPolicyParallel,
+			others:        []buildapi.BuildPhase{buildapi.BuildPhaseRunning},
+			number:        2,
+			expectedPhase: buildapi.BuildPhasePending,
+		},
+		{
+			name:           "serial build waits for a running build",
+			policy:         buildapi.BuildRunPolicySerial,
+			others:         []buildapi.BuildPhase{buildapi.BuildPhaseRunning},
+			number:         2,
+			expectedPhase:  buildapi.BuildPhaseNew,
+			expectedReason: buildapi.StatusReasonWaitingForPreviousBuild,
+		},
+		{
+			name:           "serial build waits for an older queued build",
+			policy:         buildapi.BuildRunPolicySerial,
+			others:         []buildapi.BuildPhase{buildapi.BuildPhaseComplete, buildapi.BuildPhaseNew},
+			number:         3,
+			expectedPhase:  buildapi.BuildPhaseNew,
+			expectedReason: buildapi.StatusReasonWaitingForPreviousBuild,
+		},
+		{
+			name:          "oldest serial build runs",
+			policy:        buildapi.BuildRunPolicySerial,
+			others:        []buildapi.BuildPhase{buildapi.BuildPhaseComplete, buildapi.BuildPhaseNew},
+			number:        2,
+			expectedPhase: buildapi.BuildPhasePending,
+		},
+		{
+			name:           "latest only build cancels older queued builds",
+			policy:         buildapi.BuildRunPolicySerialLatestOnly,
+			others:         []buildapi.BuildPhase{buildapi.BuildPhaseNew, buildapi.BuildPhaseNew},
+			number:         3,
+			expectedPhase:  buildapi.BuildPhasePending,
+			expectedCancel: []string{"config-1", "config-2"},
+		},
+		{
+			name:           "latest only build cancels older queued builds and waits for running build",
+			policy:         buildapi.BuildRunPolicySerialLatestOnly,
+			others:         []buildapi.BuildPhase{buildapi.BuildPhaseRunning, buildapi.BuildPhaseNew},
+			number:         3,
+			expectedPhase:  buildapi.BuildPhaseNew,
+			expectedReason: buildapi.StatusReasonWaitingForPreviousBuild,
+			expectedCancel: []string{"config-2"},
+		},
+		{
+			name:          "superseded latest only build is cancelled",
+			policy:        buildapi.BuildRunPolicySerialLatestOnly,
+			others:        []buildapi.BuildPhase{buildapi.BuildPhaseNew, buildapi.BuildPhaseNew},
+			number:        1,
+			expectedPhase: buildapi.BuildPhaseCancelled,
+		},
+	}
+
+	for _, test := range tests {
+		lister := &fakeBuildLister{}
+		number := 1
+		for _, phase := range test.others {
+			if number == test.number {
+				number++
+			}
+			lister.builds = append(lister.builds, mockConfigBuild(number, phase, test.policy))
+			number++
+		}
+		build := mockConfigBuild(test.number, buildapi.BuildPhaseNew, test.policy)
+		lister.builds = append(lister.builds, build)
+
+		updater := &recordingBuildUpdater{}
+		ctrl := mockBuildController()
+		ctrl.BuildLister = lister
+		ctrl.BuildUpdater = updater
+
+		if err := ctrl.HandleBuild(&build); err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if build.Status.Phase != test.expectedPhase {
+			t.Errorf("%s: expected phase %s, got %s", test.name, test.expectedPhase, build.Status.Phase)
+		}
+		if build.Status.Reason != test.expectedReason {
+			t.Errorf("%s: expected reason %q, got %q", test.name, test.expectedReason, build.Status.Reason)
+		}
+		cancelled := []string{}
+		for _, b := range updater.updated {
+			if b.Name != build.Name && b.Status.Phase == buildapi.BuildPhaseCancelled {
+				cancelled = append(cancelled, b.Name)
+			}
+		}
+		if len(cancelled) != len(test.expectedCancel) {
+			t.Errorf("%s: expected cancelled builds %v, got %v", test.name, test.expectedCancel, cancelled)
+			continue
+		}
+		for i := range cancelled {
+			if cancelled[i] != test.expectedCancel[i] {
+				t.Errorf("%s: expected cancelled builds %v, got %v", test.name, test.expectedCancel, cancelled)
+			}
+		}
+	}
+}
+
+func TestHandleBuildCompletionStartsNextQueuedBuild(t *testing.T) {
+	completed := mockConfigBuild(1, buildapi.BuildPhaseComplete, buildapi.BuildRunPolicySerial)
+	cancelled := mockConfigBuild(2, buildapi.BuildPhaseNew, buildapi.BuildRunPolicySerial)
+	cancelled.Status.Cancelled = true
+	lister := &fakeBuildLister{builds: []buildapi.Build{
+		mockConfigBuild(4, buildapi.BuildPhaseNew, buildapi.BuildRunPolicySerial),
+		completed,
+		cancelled,
+		mockConfigBuild(3, buildapi.BuildPhaseNew, buildapi.BuildRunPolicySerial),
+	}}
+	updater := &recordingBuildUpdater{}
+
+	if err := handleBuildCompletion(&completed, lister, updater); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(updater.updated) != 1 {
+		t.Fatalf("expected a single build to be updated, got %d", len(updater.updated))
+	}
+	next := updater.updated[0]
+	if next.Name != "config-3" {
+		t.Errorf("expected config-3 to be started, got %s", next.Name)
+	}
+	if len(next.Annotations[buildapi.BuildAcceptedAnnotation]) == 0 {
+		t.Errorf("expected the %s annotation to be set", buildapi.BuildAcceptedAnnotation)
+	}
+
+	parallel := mockConfigBuild(1, buildapi.BuildPhaseComplete, buildapi.BuildRunPolicyParallel)
+	updater = &recordingBuildUpdater{}
+	if err := handleBuildCompletion(&parallel, lister, updater); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(updater.updated) != 0 {
+		t.Errorf("expected no builds to be updated for a parallel build, got %d", len(updater.updated))
+	}
+}
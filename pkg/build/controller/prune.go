@@ -0,0 +1,88 @@
+package controller
+
+import (
+	"fmt"
+
+	"github.com/golang/glog"
+
+	"k8s.io/kubernetes/pkg/api/errors"
+	kutilerrors "k8s.io/kubernetes/pkg/util/errors"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	buildclient "github.com/openshift/origin/pkg/build/client"
+	"github.com/openshift/origin/pkg/build/prune"
+	buildutil "github.com/openshift/origin/pkg/build/util"
+)
+
+// buildPruner removes old builds once a build has completed.
+type buildPruner interface {
+	PruneBuilds(build *buildapi.Build) error
+}
+
+// BuildHistoryPruner deletes the oldest builds of a BuildConfig, together with
+// their pods, when the number of completed builds exceeds the history limits
+// set on the BuildConfig.
+type BuildHistoryPruner struct {
+	BuildConfigGetter buildclient.BuildConfigGetter
+	BuildLister       buildclient.BuildLister
+	BuildDeleter      buildclient.BuildDeleter
+	PodManager        podManager
+}
+
+// PruneBuilds deletes the builds of the BuildConfig the provided build was
+// created from that exceed the successful and failed history limits of the
+// config. Builds that are not created from a BuildConfig are ignored.
+func (p *BuildHistoryPruner) PruneBuilds(build *buildapi.Build) error {
+	configName := buildutil.ConfigNameForBuild(build)
+	if len(configName) == 0 {
+		return nil
+	}
+	config, err := p.BuildConfigGetter.Get(build.Namespace, configName)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("unable to get build config %s/%s: %v", build.Namespace, configName, err)
+	}
+	if config.Spec.SuccessfulBuildsHistoryLimit == nil && config.Spec.FailedBuildsHistoryLimit == nil {
+		return nil
+	}
+
+	builds, err := configBuilds(p.BuildLister, build)
+	if err != nil {
+		return err
+	}
+	candidates := make([]*buildapi.Build, 0, len(builds))
+	for i := range builds {
+		candidates = append(candidates, &builds[i])
+	}
+	dataSet := prune.NewDataSet([]*buildapi.BuildConfig{config}, candidates)
+	resolver := prune.NewPerBuildConfigResolver(dataSet, historyLimit(config.Spec.SuccessfulBuildsHistoryLimit), historyLimit(config.Spec.FailedBuildsHistoryLimit))
+	prunable, err := resolver.Resolve()
+	if err != nil {
+		return err
+	}
+
+	podDeleter := &BuildDeleteController{PodManager: p.PodManager}
+	errs := []error{}
+	for _, old := range prunable {
+		glog.V(4).Infof("Pruning build %s/%s exceeding the history limits of build config %s", old.Namespace, old.Name, configName)
+		if err := p.BuildDeleter.Delete(old.Namespace, old.Name); err != nil && !errors.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("failed to delete build %s/%s: %v", old.Namespace, old.Name, err))
+			continue
+		}
+		if err := podDeleter.HandleBuildDeletion(old); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return kutilerrors.NewAggregate(errs)
+}
+
+// historyLimit converts a BuildConfig history limit into the number of builds
+// to keep, where a negative value keeps all builds.
+func historyLimit(limit *int32) int {
+	if limit == nil {
+		return -1
+	}
+	return int(*limit)
+}
@@ -0,0 +1,155 @@
+package controller
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+)
+
+type fakeBuildConfigGetter struct {
+	config *buildapi.BuildConfig
+}
+
+func (f *fakeBuildConfigGetter) Get(namespace, name string) (*buildapi.BuildConfig, error) {
+	if f.config == nil || f.config.Namespace != namespace || f.config.Name != name {
+		return nil, kerrors.NewNotFound("BuildConfig", name)
+	}
+	return f.config, nil
+}
+
+type recordingBuildDeleter struct {
+	deleted []string
+}
+
+func (r *recordingBuildDeleter) Delete(namespace, name string) error {
+	r.deleted = append(r.deleted, name)
+	return nil
+}
+
+type recordingPodManager struct {
+	okPodManager
+	deleted []string
+}
+
+func (r *recordingPodManager) GetPod(namespace, name string) (*kapi.Pod, error) {
+	return &kapi.Pod{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    map[string]string{buildapi.BuildLabel: name[:len(name)-len("-build")]},
+		},
+	}, nil
+}
+
+func (r *recordingPodManager) DeletePod(namespace string, pod *kapi.Pod) error {
+	r.deleted = append(r.deleted, pod.Name)
+	return nil
+}
+
+func int32Ptr(i int32) *int32 {
+	return &i
+}
+
+func TestPruneBuildsHistoryLimits(t *testing.T) {
+	phases := []buildapi.BuildPhase{
+		buildapi.BuildPhaseComplete,
+		buildapi.BuildPhaseFailed,
+		buildapi.BuildPhaseComplete,
+		buildapi.BuildPhaseCancelled,
+		buildapi.BuildPhaseComplete,
+		buildapi.BuildPhaseError,
+		buildapi.BuildPhaseRunning,
+	}
+	tests := []struct {
+		name            string
+		successfulLimit *int32
+		failedLimit     *int32
+		expectedDeleted []string
+	}{
+		{
+			name: "no limits keeps all builds",
+		},
+		{
+			name:            "successful limit prunes oldest complete builds",
+			successfulLimit: int32Ptr(1),
+			expectedDeleted: []string{"config-1", "config-3"},
+		},
+		{
+			name:            "failed limit prunes oldest failed, errored and cancelled builds",
+			failedLimit:     int32Ptr(1),
+			expectedDeleted: []string{"config-2", "config-4"},
+		},
+		{
+			name:            "zero limits prune all completed builds",
+			successfulLimit: int32Ptr(0),
+			failedLimit:     int32Ptr(0),
+			expectedDeleted: []string{"config-1", "config-2", "config-3", "config-4", "config-5", "config-6"},
+		},
+	}
+
+	for _, test := range tests {
+		config := &buildapi.BuildConfig{
+			ObjectMeta: kapi.ObjectMeta{Name: "config", Namespace: "namespace"},
+			Spec: buildapi.BuildConfigSpec{
+				SuccessfulBuildsHistoryLimit: test.successfulLimit,
+				FailedBuildsHistoryLimit:     test.failedLimit,
+			},
+		}
+		lister := &fakeBuildLister{}
+		start := time.Now()
+		for i, phase := range phases {
+			build := mockConfigBuild(i+1, phase, buildapi.BuildRunPolicySerial)
+			build.CreationTimestamp = unversioned.NewTime(start.Add(time.Duration(i) * time.Minute))
+			build.Status.Config = &kapi.ObjectReference{Name: config.Name, Namespace: config.Namespace}
+			lister.builds = append(lister.builds, build)
+		}
+		deleter := &recordingBuildDeleter{}
+		podManager := &recordingPodManager{}
+		pruner := &BuildHistoryPruner{
+			BuildConfigGetter: &fakeBuildConfigGetter{config: config},
+			BuildLister:       lister,
+			BuildDeleter:      deleter,
+			PodManager:        podManager,
+		}
+
+		last := lister.builds[len(lister.builds)-2]
+		if err := pruner.PruneBuilds(&last); err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		sort.Strings(deleter.deleted)
+		if !reflect.DeepEqual(deleter.deleted, test.expectedDeleted) {
+			t.Errorf("%s: expected deleted builds %v, got %v", test.name, test.expectedDeleted, deleter.deleted)
+		}
+		if len(podManager.deleted) != len(test.expectedDeleted) {
+			t.Errorf("%s: expected the pods of %d builds to be deleted, got %v", test.name, len(test.expectedDeleted), podManager.deleted)
+		}
+	}
+}
+
+func TestPruneBuildsIgnoresBuildsWithoutConfig(t *testing.T) {
+	deleter := &recordingBuildDeleter{}
+	pruner := &BuildHistoryPruner{
+		BuildConfigGetter: &fakeBuildConfigGetter{},
+		BuildLister:       &fakeBuildLister{},
+		BuildDeleter:      deleter,
+		PodManager:        &okPodManager{},
+	}
+	if err := pruner.PruneBuilds(mockBuild(buildapi.BuildPhaseComplete, buildapi.BuildOutput{})); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	build := mockConfigBuild(1, buildapi.BuildPhaseComplete, buildapi.BuildRunPolicySerial)
+	if err := pruner.PruneBuilds(&build); err != nil {
+		t.Fatalf("unexpected error for a deleted build config: %v", err)
+	}
+	if len(deleter.deleted) != 0 {
+		t.Errorf("expected no builds to be deleted, got %v", deleter.deleted)
+	}
+}
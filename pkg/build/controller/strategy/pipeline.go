@@ -0,0 +1,101 @@
+package strategy
+
+import (
+	"errors"
+	"fmt"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	buildutil "github.com/openshift/origin/pkg/build/util"
+	cmdutil "github.com/openshift/origin/pkg/cmd/util"
+)
+
+// PipelineBuildStrategy creates Pipeline builds, which hand the pipeline definition to a pipeline
+// runner. The runner executes the stages of the pipeline and reports their status on the build
+// through the builds/details subresource.
+type PipelineBuildStrategy struct {
+	// Image is the image of the pipeline runner.
+	Image string
+	// Codec is the codec to use for encoding the output pod.
+	// IMPORTANT: This may break backwards compatibility when
+	// it changes.
+	Codec runtime.Codec
+}
+
+// CreateBuildPod creates the pod that runs the pipeline runner for the build
+func (bs *PipelineBuildStrategy) CreateBuildPod(build *buildapi.Build) (*kapi.Pod, error) {
+	strategy := build.Spec.Strategy.PipelineStrategy
+	if strategy == nil {
+		return nil, errors.New("PipelineBuildStrategy cannot be executed without a pipeline strategy")
+	}
+	if len(bs.Image) == 0 {
+		return nil, FatalError("no pipeline runner image is configured")
+	}
+
+	data, err := bs.Codec.Encode(build)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode the Build %s/%s: %v", build.Namespace, build.Name, err)
+	}
+
+	containerEnv := []kapi.EnvVar{
+		{Name: "BUILD", Value: string(data)},
+		{Name: "BUILD_LOGLEVEL", Value: fmt.Sprintf("%d", cmdutil.GetLogLevel())},
+	}
+	if len(strategy.Jenkinsfile) > 0 {
+		containerEnv = append(containerEnv, kapi.EnvVar{Name: buildapi.PipelineDefinitionKey, Value: strategy.Jenkinsfile})
+	} else {
+		path := strategy.JenkinsfilePath
+		if len(path) == 0 {
+			path = buildapi.DefaultPipelineDefinitionPath
+		}
+		containerEnv = append(containerEnv, kapi.EnvVar{Name: buildapi.PipelineDefinitionPathKey, Value: path})
+	}
+
+	addSourceEnvVars(build.Spec.Source, &containerEnv)
+	addOriginVersionVar(&containerEnv)
+
+	// the runner is not privileged, so the environment is passed as is
+	if len(strategy.Env) > 0 {
+		containerEnv = append(containerEnv, strategy.Env...)
+	}
+
+	pod := &kapi.Pod{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:      buildutil.GetBuildPodName(build),
+			Namespace: build.Namespace,
+			Labels:    getPodLabels(build),
+		},
+		Spec: kapi.PodSpec{
+			ServiceAccountName: build.Spec.ServiceAccount,
+			Containers: []kapi.Container{
+				{
+					Name:  "pipeline-build",
+					Image: bs.Image,
+					Env:   containerEnv,
+					Args:  []string{"--loglevel=" + getContainerVerbosity(containerEnv)},
+				},
+			},
+			RestartPolicy: kapi.RestartPolicyNever,
+		},
+	}
+	pod.Spec.Containers[0].ImagePullPolicy = kapi.PullIfNotPresent
+	pod.Spec.Containers[0].Resources = build.Spec.Resources
+
+	if build.Spec.CompletionDeadlineSeconds != nil {
+		pod.Spec.ActiveDeadlineSeconds = build.Spec.CompletionDeadlineSeconds
+	}
+	if build.Spec.Source.Binary != nil {
+		pod.Spec.Containers[0].Stdin = true
+		pod.Spec.Containers[0].StdinOnce = true
+	}
+
+	if err := setupBuildEnv(build, pod); err != nil {
+		return nil, err
+	}
+	setupDockerSecrets(pod, build.Spec.Output, nil, build.Spec.Source.Images)
+	setupSourceSecrets(pod, build.Spec.Source.SourceSecret)
+	setupSecrets(pod, build.Spec.Source.Secrets)
+	return pod, nil
+}
@@ -0,0 +1,164 @@
+package strategy
+
+import (
+	"reflect"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/resource"
+
+	"github.com/openshift/origin/pkg/api/latest"
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	buildutil "github.com/openshift/origin/pkg/build/util"
+)
+
+func TestPipelineCreateBuildPod(t *testing.T) {
+	strategy := PipelineBuildStrategy{
+		Image: "pipeline-runner-image",
+		Codec: latest.Codec,
+	}
+
+	expected := mockPipelineBuild("")
+	actual, err := strategy.CreateBuildPod(expected)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if expected, actual := buildutil.GetBuildPodName(expected), actual.ObjectMeta.Name; expected != actual {
+		t.Errorf("Expected %s, but got %s!", expected, actual)
+	}
+	if !reflect.DeepEqual(map[string]string{buildapi.BuildLabel: expected.Name}, actual.Labels) {
+		t.Errorf("Pod Labels does not match Build Labels!")
+	}
+	container := actual.Spec.Containers[0]
+	if container.Name != "pipeline-build" {
+		t.Errorf("Expected pipeline-build, but got %s!", container.Name)
+	}
+	if container.Image != strategy.Image {
+		t.Errorf("Expected %s image, got %s!", strategy.Image, container.Image)
+	}
+	if container.SecurityContext != nil && container.SecurityContext.Privileged != nil && *container.SecurityContext.Privileged {
+		t.Errorf("Expected the pipeline runner not to be privileged")
+	}
+	if actual.Spec.RestartPolicy != kapi.RestartPolicyNever {
+		t.Errorf("Expected never, got %#v", actual.Spec.RestartPolicy)
+	}
+	if *actual.Spec.ActiveDeadlineSeconds != 60 {
+		t.Errorf("Expected ActiveDeadlineSeconds 60, got %d", *actual.Spec.ActiveDeadlineSeconds)
+	}
+	if !kapi.Semantic.DeepEqual(container.Resources, expected.Spec.Resources) {
+		t.Fatalf("Expected actual=expected, %v != %v", container.Resources, expected.Spec.Resources)
+	}
+	if len(container.VolumeMounts) != 2 {
+		t.Fatalf("Expected 2 volumes in container, got %d", len(container.VolumeMounts))
+	}
+	for i, expected := range []string{DockerPushSecretMountPath, sourceSecretMountPath} {
+		if container.VolumeMounts[i].MountPath != expected {
+			t.Fatalf("Expected %s in VolumeMount[%d], got %s", expected, i, container.VolumeMounts[i].MountPath)
+		}
+	}
+
+	buildJSON, _ := latest.Codec.Encode(expected)
+	expectedEnv := map[string]string{
+		"BUILD":                            string(buildJSON),
+		buildapi.PipelineDefinitionPathKey: buildapi.DefaultPipelineDefinitionPath,
+		"SOURCE_REPOSITORY":                "http://my.build.com/the/repository",
+		"FOO":                              "BAR",
+	}
+	for name, value := range expectedEnv {
+		found := false
+		for _, item := range container.Env {
+			if item.Name == name && item.Value == value {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("Expected %s variable to be set to %q", name, value)
+		}
+	}
+	for _, item := range container.Env {
+		if item.Name == buildapi.PipelineDefinitionKey {
+			t.Errorf("Expected no inline pipeline definition, got %q", item.Value)
+		}
+	}
+}
+
+func TestPipelineCreateBuildPodInlineDefinition(t *testing.T) {
+	strategy := PipelineBuildStrategy{
+		Image: "pipeline-runner-image",
+		Codec: latest.Codec,
+	}
+
+	definition := "node { stage 'build' }"
+	actual, err := strategy.CreateBuildPod(mockPipelineBuild(definition))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	found := false
+	for _, item := range actual.Spec.Containers[0].Env {
+		switch item.Name {
+		case buildapi.PipelineDefinitionKey:
+			found = item.Value == definition
+		case buildapi.PipelineDefinitionPathKey:
+			t.Errorf("Expected no pipeline definition path, got %q", item.Value)
+		}
+	}
+	if !found {
+		t.Errorf("Expected %s variable to be set to the inline definition", buildapi.PipelineDefinitionKey)
+	}
+}
+
+func TestPipelineCreateBuildPodWithoutImage(t *testing.T) {
+	strategy := PipelineBuildStrategy{
+		Codec: latest.Codec,
+	}
+	if _, err := strategy.CreateBuildPod(mockPipelineBuild("")); err == nil {
+		t.Errorf("Expected error when no pipeline runner image is configured, got nothing")
+	}
+}
+
+func mockPipelineBuild(jenkinsfile string) *buildapi.Build {
+	timeout := int64(60)
+	return &buildapi.Build{
+		ObjectMeta: kapi.ObjectMeta{
+			Name: "pipelineBuild",
+			Labels: map[string]string{
+				"name": "pipelineBuild",
+			},
+		},
+		Spec: buildapi.BuildSpec{
+			Source: buildapi.BuildSource{
+				Git: &buildapi.GitBuildSource{
+					URI: "http://my.build.com/the/repository",
+					Ref: "master",
+				},
+				SourceSecret: &kapi.LocalObjectReference{Name: "secretFoo"},
+			},
+			Strategy: buildapi.BuildStrategy{
+				PipelineStrategy: &buildapi.PipelineBuildStrategy{
+					Jenkinsfile: jenkinsfile,
+					Env: []kapi.EnvVar{
+						{Name: "FOO", Value: "BAR"},
+					},
+				},
+			},
+			Output: buildapi.BuildOutput{
+				To: &kapi.ObjectReference{
+					Kind: "DockerImage",
+					Name: "docker-registry/repository/pipelineBuild",
+				},
+				PushSecret: &kapi.LocalObjectReference{Name: "foo"},
+			},
+			Resources: kapi.ResourceRequirements{
+				Limits: kapi.ResourceList{
+					kapi.ResourceName(kapi.ResourceCPU):    resource.MustParse("10"),
+					kapi.ResourceName(kapi.ResourceMemory): resource.MustParse("10G"),
+				},
+			},
+			CompletionDeadlineSeconds: &timeout,
+		},
+		Status: buildapi.BuildStatus{
+			Phase: buildapi.BuildPhaseNew,
+		},
+	}
+}
@@ -22,6 +22,7 @@ const (
 	DockerPullSecretMountPath      = "/var/run/secrets/openshift.io/pull"
 	SecretBuildSourceBaseMountPath = "/var/run/secrets/openshift.io/build"
 	SourceImagePullSecretMountPath = "/var/run/secrets/openshift.io/source-image"
+	PushTargetSecretMountPath      = "/var/run/secrets/openshift.io/push-target"
 	sourceSecretMountPath          = "/var/run/secrets/openshift.io/source"
 )
 
@@ -117,8 +118,8 @@ func mountSecretVolume(pod *kapi.Pod, secretName, mountPath, volumeSuffix string
 
 // setupDockerSecrets mounts Docker Registry secrets into Pod running the build,
 // allowing Docker to authenticate against private registries or Docker Hub.
-func setupDockerSecrets(pod *kapi.Pod, pushSecret, pullSecret *kapi.LocalObjectReference, imageSources []buildapi.ImageSource) {
-	if pushSecret != nil {
+func setupDockerSecrets(pod *kapi.Pod, output buildapi.BuildOutput, pullSecret *kapi.LocalObjectReference, imageSources []buildapi.ImageSource) {
+	if pushSecret := output.PushSecret; pushSecret != nil {
 		mountSecretVolume(pod, pushSecret.Name, DockerPushSecretMountPath, "push")
 		pod.Spec.Containers[0].Env = append(pod.Spec.Containers[0].Env, []kapi.EnvVar{
 			{Name: "PUSH_DOCKERCFG_PATH", Value: DockerPushSecretMountPath},
@@ -146,6 +147,18 @@ func setupDockerSecrets(pod *kapi.Pod, pushSecret, pullSecret *kapi.LocalObjectR
 		glog.V(3).Infof("%s will be used for docker pull in %s", mountPath, pod.Name)
 
 	}
+
+	for i, target := range output.AdditionalTargets {
+		if target.PushSecret == nil {
+			continue
+		}
+		mountPath := filepath.Join(PushTargetSecretMountPath, strconv.Itoa(i))
+		mountSecretVolume(pod, target.PushSecret.Name, mountPath, fmt.Sprintf("push-target-%d", i))
+		pod.Spec.Containers[0].Env = append(pod.Spec.Containers[0].Env, []kapi.EnvVar{
+			{Name: fmt.Sprintf("%s%d", dockercfg.PushTargetAuthType, i), Value: mountPath},
+		}...)
+		glog.V(3).Infof("%s will be used for docker push to %s in %s", mountPath, target.To.Name, pod.Name)
+	}
 }
 
 // setupSourceSecrets mounts SSH key used for accessing private SCM to clone
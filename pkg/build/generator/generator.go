@@ -190,6 +190,8 @@ func updateBuildEnv(strategy *buildapi.BuildStrategy, env []kapi.EnvVar) {
 		buildEnv = &strategy.DockerStrategy.Env
 	case strategy.CustomStrategy != nil:
 		buildEnv = &strategy.CustomStrategy.Env
+	case strategy.PipelineStrategy != nil:
+		buildEnv = &strategy.PipelineStrategy.Env
 	}
 
 	newEnv := []kapi.EnvVar{}
@@ -413,6 +415,7 @@ func (g *BuildGenerator) generateBuildFromConfig(ctx kapi.Context, bc *buildapi.
 	}
 	build.Labels[buildapi.BuildConfigLabelDeprecated] = bcCopy.Name
 	build.Labels[buildapi.BuildConfigLabel] = bcCopy.Name
+	setBuildRunPolicyLabel(build, bcCopy)
 
 	builderSecrets, err := g.FetchServiceAccountSecrets(bc.Namespace, serviceAccount)
 	if err != nil {
@@ -421,6 +424,12 @@ func (g *BuildGenerator) generateBuildFromConfig(ctx kapi.Context, bc *buildapi.
 	if build.Spec.Output.PushSecret == nil {
 		build.Spec.Output.PushSecret = g.resolveImageSecret(ctx, builderSecrets, build.Spec.Output.To, bc.Namespace)
 	}
+	for i := range build.Spec.Output.AdditionalTargets {
+		target := &build.Spec.Output.AdditionalTargets[i]
+		if target.PushSecret == nil {
+			target.PushSecret = g.resolveImageSecret(ctx, builderSecrets, &target.To, bc.Namespace)
+		}
+	}
 	strategyImageChangeTrigger := getStrategyImageChangeTrigger(bc)
 
 	// Resolve image source if present
@@ -663,6 +672,7 @@ func generateBuildFromBuild(build *buildapi.Build, buildConfig *buildapi.BuildCo
 	newBuild.Annotations[buildapi.BuildCloneAnnotation] = build.Name
 	if buildConfig != nil {
 		newBuild.Annotations[buildapi.BuildNumberAnnotation] = strconv.Itoa(buildConfig.Status.LastVersion)
+		setBuildRunPolicyLabel(newBuild, buildConfig)
 	} else {
 		// builds without a buildconfig don't have build numbers.
 		delete(newBuild.Annotations, buildapi.BuildNumberAnnotation)
@@ -670,6 +680,19 @@ func generateBuildFromBuild(build *buildapi.Build, buildConfig *buildapi.BuildCo
 	return newBuild
 }
 
+// setBuildRunPolicyLabel records the run policy of the BuildConfig on the build, so
+// the build controller knows how to schedule the build without looking up its config.
+func setBuildRunPolicyLabel(build *buildapi.Build, buildConfig *buildapi.BuildConfig) {
+	policy := buildConfig.Spec.RunPolicy
+	if len(policy) == 0 {
+		policy = buildapi.BuildRunPolicySerial
+	}
+	if build.Labels == nil {
+		build.Labels = make(map[string]string)
+	}
+	build.Labels[buildapi.BuildRunPolicyLabel] = string(policy)
+}
+
 // getNextBuildNameFromBuild returns name of the next build with random uuid added at the end
 func getNextBuildNameFromBuild(build *buildapi.Build, buildConfig *buildapi.BuildConfig) string {
 	var buildName string
@@ -633,6 +633,9 @@ func TestGenerateBuildFromConfig(t *testing.T) {
 	if build.Labels[buildapi.BuildConfigLabelDeprecated] != bc.Name {
 		t.Errorf("Build does not contain labels from BuildConfig")
 	}
+	if build.Labels[buildapi.BuildRunPolicyLabel] != string(buildapi.BuildRunPolicySerial) {
+		t.Errorf("Build does not contain the run policy label of the BuildConfig")
+	}
 	if build.Status.Config.Name != bc.Name || build.Status.Config.Namespace != bc.Namespace || build.Status.Config.Kind != "BuildConfig" {
 		t.Errorf("Build does not contain correct BuildConfig reference: %v", build.Status.Config)
 	}
@@ -908,7 +911,8 @@ func TestGenerateBuildFromBuildWithBuildConfig(t *testing.T) {
 	output := mocks.MockOutput()
 	annotatedBuild := &buildapi.Build{
 		ObjectMeta: kapi.ObjectMeta{
-			Name: "annotatedBuild",
+			Name:   "annotatedBuild",
+			Labels: map[string]string{"testlabel": "testvalue"},
 			Annotations: map[string]string{
 				buildapi.BuildCloneAnnotation: "sourceOfBuild",
 			},
@@ -926,7 +930,8 @@ func TestGenerateBuildFromBuildWithBuildConfig(t *testing.T) {
 	}
 	nonAnnotatedBuild := &buildapi.Build{
 		ObjectMeta: kapi.ObjectMeta{
-			Name: "nonAnnotatedBuild",
+			Name:   "nonAnnotatedBuild",
+			Labels: map[string]string{"testlabel": "testvalue"},
 		},
 		Spec: buildapi.BuildSpec{
 			Source: source,
@@ -953,6 +958,10 @@ func TestGenerateBuildFromBuildWithBuildConfig(t *testing.T) {
 	if !reflect.DeepEqual(annotatedBuild.Spec, newBuild.Spec) {
 		t.Errorf("Build parameters does not match the original Build parameters")
 	}
+	if newBuild.Labels[buildapi.BuildRunPolicyLabel] != string(buildapi.BuildRunPolicySerial) {
+		t.Errorf("Build run policy label is %s expected %s", newBuild.Labels[buildapi.BuildRunPolicyLabel], buildapi.BuildRunPolicySerial)
+	}
+	delete(newBuild.Labels, buildapi.BuildRunPolicyLabel)
 	if !reflect.DeepEqual(annotatedBuild.ObjectMeta.Labels, newBuild.ObjectMeta.Labels) {
 		t.Errorf("Build labels does not match the original Build labels")
 	}
@@ -967,6 +976,10 @@ func TestGenerateBuildFromBuildWithBuildConfig(t *testing.T) {
 	if !reflect.DeepEqual(nonAnnotatedBuild.Spec, newBuild.Spec) {
 		t.Errorf("Build parameters does not match the original Build parameters")
 	}
+	if newBuild.Labels[buildapi.BuildRunPolicyLabel] != string(buildapi.BuildRunPolicySerial) {
+		t.Errorf("Build run policy label is %s expected %s", newBuild.Labels[buildapi.BuildRunPolicyLabel], buildapi.BuildRunPolicySerial)
+	}
+	delete(newBuild.Labels, buildapi.BuildRunPolicyLabel)
 	if !reflect.DeepEqual(nonAnnotatedBuild.ObjectMeta.Labels, newBuild.ObjectMeta.Labels) {
 		t.Errorf("Build labels does not match the original Build labels")
 	}
@@ -0,0 +1,148 @@
+package logarchive
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/golang/glog"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	buildutil "github.com/openshift/origin/pkg/build/util"
+)
+
+// ErrNotArchived is returned by a Store when no log is archived for a build.
+var ErrNotArchived = errors.New("the build log was not archived")
+
+// Store holds the logs of finished builds.
+type Store interface {
+	// Put archives the log of the named build, replacing any log archived before.
+	Put(namespace, name string, log io.Reader) error
+	// Get returns the archived log of the named build, or ErrNotArchived.
+	Get(namespace, name string) (io.ReadCloser, error)
+	// Delete removes the archived log of the named build. It is not an error if there is none.
+	Delete(namespace, name string) error
+}
+
+// DirectoryStore is a Store that keeps one file per build in a directory, for instance on a
+// persistent volume.
+type DirectoryStore struct {
+	// Dir is the directory holding the logs, in a subdirectory per namespace.
+	Dir string
+	// MaxBytes is the maximum size of an archived log. Only the end of longer logs is kept.
+	// 0 means no limit.
+	MaxBytes int64
+}
+
+// NewDirectoryStore returns a Store that keeps the logs in dir, keeping at most maxBytes of each log.
+func NewDirectoryStore(dir string, maxBytes int64) *DirectoryStore {
+	return &DirectoryStore{Dir: dir, MaxBytes: maxBytes}
+}
+
+var _ Store = &DirectoryStore{}
+
+func (s *DirectoryStore) path(namespace, name string) string {
+	return filepath.Join(s.Dir, namespace, name+".log")
+}
+
+// Put writes log to a temporary file which replaces the archived log once it is complete.
+func (s *DirectoryStore) Put(namespace, name string, log io.Reader) error {
+	dir := filepath.Join(s.Dir, namespace)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile(dir, "."+name)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	size, err := io.Copy(f, log)
+	if err != nil {
+		return err
+	}
+	if s.MaxBytes > 0 && size > s.MaxBytes {
+		if f, err = s.truncate(f, size); err != nil {
+			return err
+		}
+		defer os.Remove(f.Name())
+		defer f.Close()
+	}
+	if err := f.Sync(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), s.path(namespace, name))
+}
+
+// truncate returns a new temporary file holding the last MaxBytes of the size bytes in f.
+func (s *DirectoryStore) truncate(f *os.File, size int64) (*os.File, error) {
+	if _, err := f.Seek(size-s.MaxBytes, os.SEEK_SET); err != nil {
+		return nil, err
+	}
+	truncated, err := ioutil.TempFile(filepath.Dir(f.Name()), filepath.Base(f.Name()))
+	if err != nil {
+		return nil, err
+	}
+	fmt.Fprintf(truncated, "... the log was truncated to its last %d bytes\n", s.MaxBytes)
+	if _, err := io.Copy(truncated, f); err != nil {
+		truncated.Close()
+		os.Remove(truncated.Name())
+		return nil, err
+	}
+	return truncated, nil
+}
+
+// Get opens the archived log of the named build.
+func (s *DirectoryStore) Get(namespace, name string) (io.ReadCloser, error) {
+	f, err := os.Open(s.path(namespace, name))
+	if os.IsNotExist(err) {
+		return nil, ErrNotArchived
+	}
+	return f, err
+}
+
+// Delete removes the archived log of the named build.
+func (s *DirectoryStore) Delete(namespace, name string) error {
+	if err := os.Remove(s.path(namespace, name)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// Archiver copies the logs of finished builds from their pods into a Store.
+type Archiver struct {
+	Store Store
+	Pods  kclient.PodsNamespacer
+}
+
+// NewArchiver returns an Archiver that reads the logs of build pods with pods and keeps them in store.
+func NewArchiver(store Store, pods kclient.PodsNamespacer) *Archiver {
+	return &Archiver{Store: store, Pods: pods}
+}
+
+// Archive copies the log of the pod of build into the store.
+func (a *Archiver) Archive(build *buildapi.Build) error {
+	podName := buildutil.GetBuildPodName(build)
+	log, err := a.Pods.Pods(build.Namespace).GetLogs(podName, &kapi.PodLogOptions{}).Stream()
+	if err != nil {
+		return fmt.Errorf("unable to read the log of pod %s/%s: %v", build.Namespace, podName, err)
+	}
+	defer log.Close()
+	if err := a.Store.Put(build.Namespace, build.Name, log); err != nil {
+		return fmt.Errorf("unable to archive the log of build %s/%s: %v", build.Namespace, build.Name, err)
+	}
+	glog.V(4).Infof("Archived the log of build %s/%s", build.Namespace, build.Name)
+	return nil
+}
+
+// Remove deletes the archived log of build.
+func (a *Archiver) Remove(build *buildapi.Build) error {
+	return a.Store.Delete(build.Namespace, build.Name)
+}
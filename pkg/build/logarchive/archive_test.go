@@ -0,0 +1,96 @@
+package logarchive
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+)
+
+func readArchived(t *testing.T, store Store, namespace, name string) string {
+	log, err := store.Get(namespace, name)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	defer log.Close()
+	data, err := ioutil.ReadAll(log)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	return string(data)
+}
+
+func TestDirectoryStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "logarchive")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	store := NewDirectoryStore(dir, 0)
+
+	if _, err := store.Get("ns", "build-1"); err != ErrNotArchived {
+		t.Errorf("Expected ErrNotArchived, got %v", err)
+	}
+	if err := store.Put("ns", "build-1", strings.NewReader("first")); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := store.Put("ns", "build-1", strings.NewReader("second")); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if log := readArchived(t, store, "ns", "build-1"); log != "second" {
+		t.Errorf("Expected the last archived log, got %q", log)
+	}
+	if _, err := store.Get("other", "build-1"); err != ErrNotArchived {
+		t.Errorf("Expected ErrNotArchived for another namespace, got %v", err)
+	}
+
+	files, err := ioutil.ReadDir(dir + "/ns")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(files) != 1 {
+		t.Errorf("Expected the temporary files to be removed, got %d files", len(files))
+	}
+
+	if err := store.Delete("ns", "build-1"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := store.Get("ns", "build-1"); err != ErrNotArchived {
+		t.Errorf("Expected ErrNotArchived after delete, got %v", err)
+	}
+	if err := store.Delete("ns", "build-1"); err != nil {
+		t.Errorf("Expected no error deleting a missing log, got %v", err)
+	}
+}
+
+func TestDirectoryStoreTruncate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "logarchive")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	store := NewDirectoryStore(dir, 4)
+
+	if err := store.Put("ns", "short", strings.NewReader("abcd")); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if log := readArchived(t, store, "ns", "short"); log != "abcd" {
+		t.Errorf("Expected the whole log, got %q", log)
+	}
+
+	if err := store.Put("ns", "long", strings.NewReader("abcdefgh")); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := "... the log was truncated to its last 4 bytes\nefgh"
+	if log := readArchived(t, store, "ns", "long"); log != expected {
+		t.Errorf("Expected %q, got %q", expected, log)
+	}
+
+	files, err := ioutil.ReadDir(dir + "/ns")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(files) != 2 {
+		t.Errorf("Expected the temporary files to be removed, got %d files", len(files))
+	}
+}
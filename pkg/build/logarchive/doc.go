@@ -0,0 +1,3 @@
+// Package logarchive keeps the logs of finished builds so they can be retrieved
+// after the build pods are deleted.
+package logarchive
@@ -109,26 +109,46 @@ type detailsStrategy struct {
 }
 
 // Prepares a build for update by only allowing an update to build details.
-// For now, this is the Spec.Revision field
+// For now, this is the Spec.Revision field, the Status.Stages reported for Pipeline builds
+// and the Status.OutputDockerImageReferences reported once the output has been pushed
 func (detailsStrategy) PrepareForUpdate(obj, old runtime.Object) {
 	newBuild := obj.(*api.Build)
 	oldBuild := old.(*api.Build)
 	revision := newBuild.Spec.Revision
+	stages := newBuild.Status.Stages
+	outputs := newBuild.Status.OutputDockerImageReferences
 	*newBuild = *oldBuild
 	newBuild.Spec.Revision = revision
+	newBuild.Status.Stages = stages
+	newBuild.Status.OutputDockerImageReferences = outputs
 }
 
-// Validates that an update is valid by ensuring that no Revision exists and that it's not getting updated to blank
+// Validates that an update is valid by ensuring that no Revision exists and that it's not getting updated to blank,
+// unless the update only reports the stages of a Pipeline build or the pushed output images
 func (detailsStrategy) ValidateUpdate(ctx kapi.Context, obj, old runtime.Object) field.ErrorList {
 	newBuild := obj.(*api.Build)
 	oldBuild := old.(*api.Build)
 	errors := field.ErrorList{}
-	if oldBuild.Spec.Revision != nil {
-		// If there was already a revision, then return an error
-		errors = append(errors, field.Duplicate(field.NewPath("status", "revision"), oldBuild.Spec.Revision))
+	statusOnly := kapi.Semantic.DeepEqual(newBuild.Spec.Revision, oldBuild.Spec.Revision) &&
+		(!kapi.Semantic.DeepEqual(newBuild.Status.Stages, oldBuild.Status.Stages) ||
+			!kapi.Semantic.DeepEqual(newBuild.Status.OutputDockerImageReferences, oldBuild.Status.OutputDockerImageReferences))
+	if !statusOnly {
+		if oldBuild.Spec.Revision != nil {
+			// If there was already a revision, then return an error
+			errors = append(errors, field.Duplicate(field.NewPath("status", "revision"), oldBuild.Spec.Revision))
+		}
+		if newBuild.Spec.Revision == nil {
+			errors = append(errors, field.Invalid(field.NewPath("status", "revision"), nil, "cannot set an empty revision in build status"))
+		}
 	}
-	if newBuild.Spec.Revision == nil {
-		errors = append(errors, field.Invalid(field.NewPath("status", "revision"), nil, "cannot set an empty revision in build status"))
+	if oldBuild.Spec.Strategy.PipelineStrategy == nil && len(newBuild.Status.Stages) > 0 {
+		errors = append(errors, field.Invalid(field.NewPath("status", "stages"), "", "stages may only be reported for pipeline builds"))
+	}
+	errors = append(errors, validation.ValidateBuildStages(newBuild.Status.Stages, field.NewPath("status", "stages"))...)
+	for i, ref := range newBuild.Status.OutputDockerImageReferences {
+		if len(ref) == 0 {
+			errors = append(errors, field.Required(field.NewPath("status", "outputDockerImageReferences").Index(i)))
+		}
 	}
 	return errors
 }
@@ -2,6 +2,7 @@ package buildlog
 
 import (
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/golang/glog"
@@ -17,6 +18,7 @@ import (
 
 	"github.com/openshift/origin/pkg/build/api"
 	"github.com/openshift/origin/pkg/build/api/validation"
+	"github.com/openshift/origin/pkg/build/logarchive"
 	"github.com/openshift/origin/pkg/build/registry"
 	buildutil "github.com/openshift/origin/pkg/build/util"
 )
@@ -28,6 +30,8 @@ type REST struct {
 	PodGetter      pod.ResourceGetter
 	ConnectionInfo kubeletclient.ConnectionInfoGetter
 	Timeout        time.Duration
+	// Archive holds the logs of finished builds whose pods are gone. It may be nil.
+	Archive logarchive.Store
 }
 
 type podGetter struct {
@@ -47,13 +51,16 @@ const defaultTimeout time.Duration = 10 * time.Second
 // NewREST creates a new REST for BuildLog
 // Takes build registry and pod client to get necessary attributes to assemble
 // URL to which the request shall be redirected in order to get build logs.
-func NewREST(getter rest.Getter, watcher rest.Watcher, pn unversioned.PodsNamespacer, connectionInfo kubeletclient.ConnectionInfoGetter) *REST {
+// The logs of finished builds whose pods were deleted are read from archive,
+// if it is not nil.
+func NewREST(getter rest.Getter, watcher rest.Watcher, pn unversioned.PodsNamespacer, connectionInfo kubeletclient.ConnectionInfoGetter, archive logarchive.Store) *REST {
 	return &REST{
 		Getter:         getter,
 		Watcher:        watcher,
 		PodGetter:      &podGetter{pn},
 		ConnectionInfo: connectionInfo,
 		Timeout:        defaultTimeout,
+		Archive:        archive,
 	}
 }
 
@@ -121,6 +128,9 @@ func (r *REST) Get(ctx kapi.Context, name string, opts runtime.Object) (runtime.
 	location, transport, err := pod.LogLocation(r.PodGetter, r.ConnectionInfo, ctx, buildPodName, logOpts)
 	if err != nil {
 		if errors.IsNotFound(err) {
+			if streamer := r.archivedLog(build, buildLogOpts); streamer != nil {
+				return streamer, nil
+			}
 			return nil, errors.NewNotFound("pod", buildPodName)
 		}
 		return nil, errors.NewBadRequest(err.Error())
@@ -134,6 +144,46 @@ func (r *REST) Get(ctx kapi.Context, name string, opts runtime.Object) (runtime.
 	}, nil
 }
 
+// archivedLog returns a streamer with the archived log of a finished build, or nil
+// if its log was not archived.
+func (r *REST) archivedLog(build *api.Build, opts *api.BuildLogOptions) *archivedLogStreamer {
+	if r.Archive == nil || !buildutil.IsBuildComplete(build) {
+		return nil
+	}
+	log, err := r.Archive.Get(build.Namespace, build.Name)
+	if err != nil {
+		if err != logarchive.ErrNotArchived {
+			glog.V(2).Infof("Unable to read the archived log of build %s/%s: %v", build.Namespace, build.Name, err)
+		}
+		return nil
+	}
+	glog.V(4).Infof("Serving the archived log of build %s/%s", build.Namespace, build.Name)
+	return &archivedLogStreamer{log: log, limitBytes: opts.LimitBytes}
+}
+
+// archivedLogStreamer streams an archived build log, up to limitBytes if set.
+type archivedLogStreamer struct {
+	log        io.ReadCloser
+	limitBytes *int64
+}
+
+var _ rest.ResourceStreamer = &archivedLogStreamer{}
+
+func (s *archivedLogStreamer) IsAnAPIObject() {}
+
+// InputStream returns the archived log as plain text.
+func (s *archivedLogStreamer) InputStream(apiVersion, acceptHeader string) (io.ReadCloser, bool, string, error) {
+	if s.limitBytes == nil {
+		return s.log, false, "text/plain", nil
+	}
+	return &limitedReadCloser{Reader: io.LimitReader(s.log, *s.limitBytes), Closer: s.log}, false, "text/plain", nil
+}
+
+type limitedReadCloser struct {
+	io.Reader
+	io.Closer
+}
+
 // NewGetOptions returns a new options object for build logs
 func (r *REST) NewGetOptions() (runtime.Object, bool, string) {
 	return &api.BuildLogOptions{}, false, ""
@@ -2,14 +2,17 @@ package buildlog
 
 import (
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/url"
+	"os"
 	"strconv"
 	"strings"
 	"testing"
 	"time"
 
 	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
 	"k8s.io/kubernetes/pkg/api/rest"
 	"k8s.io/kubernetes/pkg/api/unversioned"
 	kubeletclient "k8s.io/kubernetes/pkg/kubelet/client"
@@ -18,6 +21,7 @@ import (
 	"k8s.io/kubernetes/pkg/watch"
 
 	"github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/build/logarchive"
 	"github.com/openshift/origin/pkg/build/registry/test"
 )
 
@@ -177,6 +181,96 @@ func TestWaitForBuildTimeout(t *testing.T) {
 	}
 }
 
+type deletedPodGetter struct{}
+
+func (p *deletedPodGetter) Get(ctx kapi.Context, name string) (runtime.Object, error) {
+	return nil, errors.NewNotFound("pod", name)
+}
+
+func TestArchivedBuildLog(t *testing.T) {
+	dir, err := ioutil.TempDir("", "buildlog")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	archive := logarchive.NewDirectoryStore(dir, 0)
+	if err := archive.Put(kapi.NamespaceDefault, "archived", strings.NewReader("the build log\n")); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	limit := int64(9)
+	tests := []struct {
+		name     string
+		build    *api.Build
+		opts     *api.BuildLogOptions
+		expected string
+		notFound bool
+	}{
+		{
+			name:     "complete build with archived log",
+			build:    mockBuild(api.BuildPhaseComplete, "archived", 1),
+			opts:     &api.BuildLogOptions{},
+			expected: "the build log\n",
+		},
+		{
+			name:     "limited bytes",
+			build:    mockBuild(api.BuildPhaseFailed, "archived", 1),
+			opts:     &api.BuildLogOptions{LimitBytes: &limit},
+			expected: "the build",
+		},
+		{
+			name:     "complete build without archived log",
+			build:    mockBuild(api.BuildPhaseComplete, "missing", 1),
+			opts:     &api.BuildLogOptions{},
+			notFound: true,
+		},
+		{
+			name:     "running build",
+			build:    mockBuild(api.BuildPhaseRunning, "archived", 1),
+			opts:     &api.BuildLogOptions{},
+			notFound: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt.build.Namespace = kapi.NamespaceDefault
+		storage := &REST{
+			Getter:         &test.BuildStorage{Build: tt.build},
+			PodGetter:      &deletedPodGetter{},
+			ConnectionInfo: &kubeletclient.HTTPKubeletClient{Config: &kubeletclient.KubeletClientConfig{EnableHttps: true, Port: 12345}, Client: &http.Client{}},
+			Timeout:        defaultTimeout,
+			Archive:        archive,
+		}
+		obj, err := storage.Get(kapi.NewDefaultContext(), tt.build.Name, tt.opts)
+		if tt.notFound {
+			if !errors.IsNotFound(err) {
+				t.Errorf("%s: expected a not found error, got %v", tt.name, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tt.name, err)
+			continue
+		}
+		stream, _, contentType, err := obj.(rest.ResourceStreamer).InputStream("", "")
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tt.name, err)
+			continue
+		}
+		log, err := ioutil.ReadAll(stream)
+		stream.Close()
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tt.name, err)
+		}
+		if contentType != "text/plain" {
+			t.Errorf("%s: expected text/plain, got %s", tt.name, contentType)
+		}
+		if string(log) != tt.expected {
+			t.Errorf("%s: expected %q, got %q", tt.name, tt.expected, string(log))
+		}
+	}
+}
+
 type buildWatcher struct {
 	Build   *api.Build
 	Watcher watch.Interface
@@ -0,0 +1,208 @@
+package bitbucket
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/mail"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/build/webhook"
+)
+
+const (
+	eventHeader     = "X-Event-Key"
+	signatureHeader = "X-Hub-Signature"
+
+	// cloudPushEvent is sent by bitbucket.org for pushes to a repository.
+	cloudPushEvent = "repo:push"
+	// serverPushEvent is sent by Bitbucket Server for pushes to a repository.
+	serverPushEvent = "repo:refs_changed"
+	// pingEvent is sent by Bitbucket Server when testing the connection.
+	pingEvent = "diagnostics:ping"
+)
+
+// WebHook used for processing bitbucket webhook requests.
+type WebHook struct{}
+
+// New returns bitbucket webhook plugin.
+func New() *WebHook {
+	return &WebHook{}
+}
+
+type cloudCommit struct {
+	Hash    string `json:"hash,omitempty"`
+	Message string `json:"message,omitempty"`
+	Author  struct {
+		Raw string `json:"raw,omitempty"`
+	} `json:"author,omitempty"`
+}
+
+type cloudPushEventPayload struct {
+	Push struct {
+		Changes []struct {
+			New *struct {
+				Type   string      `json:"type,omitempty"`
+				Name   string      `json:"name,omitempty"`
+				Target cloudCommit `json:"target,omitempty"`
+			} `json:"new,omitempty"`
+		} `json:"changes,omitempty"`
+	} `json:"push,omitempty"`
+}
+
+type serverPushEventPayload struct {
+	Actor struct {
+		DisplayName  string `json:"displayName,omitempty"`
+		EmailAddress string `json:"emailAddress,omitempty"`
+	} `json:"actor,omitempty"`
+	Changes []struct {
+		Ref struct {
+			ID   string `json:"id,omitempty"`
+			Type string `json:"type,omitempty"`
+		} `json:"ref,omitempty"`
+		ToHash string `json:"toHash,omitempty"`
+		Type   string `json:"type,omitempty"`
+	} `json:"changes,omitempty"`
+}
+
+// Extract services webhooks from bitbucket.org and Bitbucket Server
+func (p *WebHook) Extract(buildCfg *api.BuildConfig, secret, path string, req *http.Request) (revision *api.SourceRevision, proceed bool, err error) {
+	trigger, ok := webhook.FindTriggerPolicy(api.BitbucketWebHookBuildTriggerType, buildCfg)
+	if !ok {
+		err = webhook.ErrHookNotEnabled
+		return
+	}
+	glog.V(4).Infof("Checking if the provided secret for BuildConfig %s/%s matches", buildCfg.Namespace, buildCfg.Name)
+	if !hmac.Equal([]byte(trigger.BitbucketWebHook.Secret), []byte(secret)) {
+		err = webhook.ErrSecretMismatch
+		return
+	}
+	glog.V(4).Infof("Verifying build request for BuildConfig %s/%s", buildCfg.Namespace, buildCfg.Name)
+	if err = verifyRequest(req); err != nil {
+		return
+	}
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return
+	}
+	// Bitbucket signs the payload with the secret configured for the hook. The
+	// signature is required, otherwise the secret in the URL alone would be enough.
+	if len(trigger.BitbucketWebHook.Secret) > 0 && !validSignature(req.Header.Get(signatureHeader), trigger.BitbucketWebHook.Secret, body) {
+		err = webhook.ErrSecretMismatch
+		return
+	}
+
+	var ref string
+	switch event := req.Header.Get(eventHeader); event {
+	case pingEvent:
+		proceed = false
+		return
+	case cloudPushEvent:
+		ref, revision, err = extractCloudPush(body)
+	case serverPushEvent:
+		ref, revision, err = extractServerPush(body)
+	default:
+		err = fmt.Errorf("Unknown %s %s", eventHeader, event)
+	}
+	if err != nil {
+		return
+	}
+	proceed = webhook.GitRefMatches(ref, buildCfg.Spec.Source.Git.Ref)
+	if !proceed {
+		glog.V(2).Infof("Skipping build for BuildConfig %s/%s.  Branch reference from '%s' does not match configuration", buildCfg.Namespace, buildCfg.Name, ref)
+	}
+	return
+}
+
+// extractCloudPush returns the ref and revision of the last branch updated in
+// a bitbucket.org push event.
+func extractCloudPush(body []byte) (string, *api.SourceRevision, error) {
+	var event cloudPushEventPayload
+	if err := json.Unmarshal(body, &event); err != nil {
+		return "", nil, err
+	}
+	for i := len(event.Push.Changes) - 1; i >= 0; i-- {
+		change := event.Push.Changes[i].New
+		// Deleted branches have no new state and tags do not trigger builds.
+		if change == nil || change.Type != "branch" {
+			continue
+		}
+		return "refs/heads/" + change.Name, &api.SourceRevision{
+			Git: &api.GitSourceRevision{
+				Commit:  change.Target.Hash,
+				Author:  parseUser(change.Target.Author.Raw),
+				Message: change.Target.Message,
+			},
+		}, nil
+	}
+	return "", nil, errors.New("no updated branch found in the push event")
+}
+
+// extractServerPush returns the ref and revision of the last branch updated in
+// a Bitbucket Server push event. The event does not describe the commits, so
+// the pushing user is used as the author.
+func extractServerPush(body []byte) (string, *api.SourceRevision, error) {
+	var event serverPushEventPayload
+	if err := json.Unmarshal(body, &event); err != nil {
+		return "", nil, err
+	}
+	for i := len(event.Changes) - 1; i >= 0; i-- {
+		change := event.Changes[i]
+		if change.Ref.Type != "BRANCH" || change.Type == "DELETE" {
+			continue
+		}
+		return change.Ref.ID, &api.SourceRevision{
+			Git: &api.GitSourceRevision{
+				Commit: change.ToHash,
+				Author: api.SourceControlUser{
+					Name:  event.Actor.DisplayName,
+					Email: event.Actor.EmailAddress,
+				},
+			},
+		}, nil
+	}
+	return "", nil, errors.New("no updated branch found in the push event")
+}
+
+// parseUser parses a user in the "Name <email>" form used by bitbucket.org.
+func parseUser(raw string) api.SourceControlUser {
+	if address, err := mail.ParseAddress(raw); err == nil {
+		return api.SourceControlUser{Name: address.Name, Email: address.Address}
+	}
+	return api.SourceControlUser{Name: strings.TrimSpace(raw)}
+}
+
+// validSignature checks the "sha256=<hex>" HMAC signature of the payload.
+func validSignature(signature, secret string, body []byte) bool {
+	const prefix = "sha256="
+	if !strings.HasPrefix(signature, prefix) {
+		return false
+	}
+	expected, err := hex.DecodeString(strings.TrimPrefix(signature, prefix))
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(mac.Sum(nil), expected)
+}
+
+func verifyRequest(req *http.Request) error {
+	if method := req.Method; method != "POST" {
+		return fmt.Errorf("Unsupported HTTP method %s", method)
+	}
+	if contentType := req.Header.Get("Content-Type"); !strings.HasPrefix(contentType, "application/json") {
+		return fmt.Errorf("Unsupported Content-Type %s", contentType)
+	}
+	if len(req.Header.Get(eventHeader)) == 0 {
+		return errors.New("Missing " + eventHeader)
+	}
+	return nil
+}
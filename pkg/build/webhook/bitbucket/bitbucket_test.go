@@ -0,0 +1,198 @@
+package bitbucket
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	"github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/build/webhook"
+)
+
+type okBuildConfigGetter struct{}
+
+func (c *okBuildConfigGetter) Get(namespace, name string) (*api.BuildConfig, error) {
+	return mockBuildConfig(), nil
+}
+
+type okBuildConfigInstantiator struct{}
+
+func (*okBuildConfigInstantiator) Instantiate(namespace string, request *api.BuildRequest) (*api.Build, error) {
+	return &api.Build{}, nil
+}
+
+func mockBuildConfig() *api.BuildConfig {
+	return &api.BuildConfig{
+		Spec: api.BuildConfigSpec{
+			Triggers: []api.BuildTriggerPolicy{
+				{
+					Type: api.BitbucketWebHookBuildTriggerType,
+					BitbucketWebHook: &api.WebHookTrigger{
+						Secret: "secret101",
+					},
+				},
+			},
+			BuildSpec: api.BuildSpec{
+				Source: api.BuildSource{
+					Git: &api.GitBuildSource{
+						URI: "git://bitbucket.org/my/repo.git",
+					},
+				},
+				Strategy: api.BuildStrategy{
+					SourceStrategy: &api.SourceBuildStrategy{
+						From: kapi.ObjectReference{
+							Kind: "DockerImage",
+							Name: "repository/image",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestWrongSecret(t *testing.T) {
+	server := httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
+		map[string]webhook.Plugin{"bitbucket": New()}))
+	defer server.Close()
+
+	client := &http.Client{}
+	req, _ := http.NewRequest("POST", server.URL+"/build100/wrongsecret/bitbucket", nil)
+	resp, _ := client.Do(req)
+	body, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusBadRequest ||
+		!strings.Contains(string(body), webhook.ErrSecretMismatch.Error()) {
+		t.Errorf("Expected BadRequest, got %s: %s!", resp.Status, string(body))
+	}
+}
+
+func setup(t *testing.T, filename, eventType string) (*http.Request, []byte) {
+	event, err := ioutil.ReadFile("fixtures/" + filename)
+	if err != nil {
+		t.Errorf("Failed to open %s: %v", filename, err)
+	}
+	req, _ := http.NewRequest("POST", "http://origin.com", bytes.NewReader(event))
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("X-Event-Key", eventType)
+	req.Header.Add("X-Hub-Signature", sign("secret101", event))
+	return req, event
+}
+
+func sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestExtractProvidesValidBuildForACloudPushEvent(t *testing.T) {
+	req, _ := setup(t, "pushevent.json", "repo:push")
+
+	revision, proceed, err := New().Extract(mockBuildConfig(), "secret101", "", req)
+	if err != nil {
+		t.Fatalf("Error while extracting build info: %s", err)
+	}
+	if !proceed {
+		t.Errorf("The 'proceed' return value should equal 'true' %t", proceed)
+	}
+	if revision == nil || revision.Git == nil {
+		t.Fatal("Expecting the revision to not be nil")
+	}
+	if revision.Git.Commit != "7b7a3c3c3b9b1f1e0bc63e6ea1e0d6a6c35f3e8d" {
+		t.Errorf("Expecting the revision to contain the commit id from the push event, got %s", revision.Git.Commit)
+	}
+	if revision.Git.Message != "Update the README\n" {
+		t.Errorf("Expecting the revision to contain the commit message, got %q", revision.Git.Message)
+	}
+	if revision.Git.Author.Name != "John Doe" || revision.Git.Author.Email != "jdoe@example.com" {
+		t.Errorf("Expecting the revision to contain the commit author, got %#v", revision.Git.Author)
+	}
+}
+
+func TestExtractProvidesValidBuildForAServerPushEvent(t *testing.T) {
+	req, _ := setup(t, "pushevent-server.json", "repo:refs_changed")
+
+	revision, proceed, err := New().Extract(mockBuildConfig(), "secret101", "", req)
+	if err != nil {
+		t.Fatalf("Error while extracting build info: %s", err)
+	}
+	if !proceed {
+		t.Errorf("The 'proceed' return value should equal 'true' %t", proceed)
+	}
+	if revision == nil || revision.Git.Commit != "178864a7d521b6f5e720b386b2c2b0ef8563e0dc" {
+		t.Fatalf("Expecting the revision to contain the commit id from the push event, got %#v", revision)
+	}
+	if revision.Git.Author.Name != "Administrator" || revision.Git.Author.Email != "admin@example.com" {
+		t.Errorf("Expecting the revision to contain the pushing user, got %#v", revision.Git.Author)
+	}
+}
+
+func TestExtractRejectsInvalidSignature(t *testing.T) {
+	req, body := setup(t, "pushevent-server.json", "repo:refs_changed")
+	req.Header.Set("X-Hub-Signature", sign("wrongsecret", body))
+
+	_, proceed, err := New().Extract(mockBuildConfig(), "secret101", "", req)
+	if err != webhook.ErrSecretMismatch {
+		t.Errorf("Expected %v, got %v", webhook.ErrSecretMismatch, err)
+	}
+	if proceed {
+		t.Errorf("The 'proceed' return value should equal 'false' %t", proceed)
+	}
+}
+
+func TestExtractRejectsMissingSignature(t *testing.T) {
+	req, _ := setup(t, "pushevent.json", "repo:push")
+	req.Header.Del("X-Hub-Signature")
+
+	_, proceed, err := New().Extract(mockBuildConfig(), "secret101", "", req)
+	if err != webhook.ErrSecretMismatch {
+		t.Errorf("Expected %v, got %v", webhook.ErrSecretMismatch, err)
+	}
+	if proceed {
+		t.Errorf("The 'proceed' return value should equal 'false' %t", proceed)
+	}
+}
+
+func TestExtractProvidesValidBuildForAPushEventOtherThanMaster(t *testing.T) {
+	req, _ := setup(t, "pushevent-not-master-branch.json", "repo:push")
+	buildCfg := mockBuildConfig()
+	buildCfg.Spec.Source.Git.Ref = "my_other_branch"
+
+	_, proceed, err := New().Extract(buildCfg, "secret101", "", req)
+	if err != nil {
+		t.Fatalf("Error while extracting build info: %s", err)
+	}
+	if !proceed {
+		t.Errorf("The 'proceed' return value should equal 'true' %t", proceed)
+	}
+}
+
+func TestExtractSkipsBuildForUnmatchedBranches(t *testing.T) {
+	req, _ := setup(t, "pushevent.json", "repo:push")
+	buildCfg := mockBuildConfig()
+	buildCfg.Spec.Source.Git.Ref = "adfj32qrafdavckeaewra"
+
+	_, proceed, _ := New().Extract(buildCfg, "secret101", "", req)
+	if proceed {
+		t.Errorf("Expecting to not continue from this event because the branch is not for this buildConfig '%s'", buildCfg.Spec.Source.Git.Ref)
+	}
+}
+
+func TestExtractForAPingEvent(t *testing.T) {
+	req, _ := setup(t, "pushevent.json", "diagnostics:ping")
+
+	_, proceed, err := New().Extract(mockBuildConfig(), "secret101", "", req)
+	if err != nil {
+		t.Errorf("Error while extracting build info: %s", err)
+	}
+	if proceed {
+		t.Errorf("The 'proceed' return value should equal 'false' %t", proceed)
+	}
+}
@@ -0,0 +1,120 @@
+package gitlab
+
+import (
+	"crypto/hmac"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/golang/glog"
+	"github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/build/webhook"
+)
+
+const (
+	eventHeader = "X-Gitlab-Event"
+	tokenHeader = "X-Gitlab-Token"
+	pushEvent   = "Push Hook"
+)
+
+// WebHook used for processing gitlab webhook requests.
+type WebHook struct{}
+
+// New returns gitlab webhook plugin.
+func New() *WebHook {
+	return &WebHook{}
+}
+
+type commit struct {
+	ID      string                `json:"id,omitempty"`
+	Message string                `json:"message,omitempty"`
+	Author  api.SourceControlUser `json:"author,omitempty"`
+}
+
+type pushEventPayload struct {
+	ObjectKind string   `json:"object_kind,omitempty"`
+	Ref        string   `json:"ref,omitempty"`
+	After      string   `json:"after,omitempty"`
+	Commits    []commit `json:"commits,omitempty"`
+}
+
+// Extract services webhooks from GitLab
+func (p *WebHook) Extract(buildCfg *api.BuildConfig, secret, path string, req *http.Request) (revision *api.SourceRevision, proceed bool, err error) {
+	trigger, ok := webhook.FindTriggerPolicy(api.GitLabWebHookBuildTriggerType, buildCfg)
+	if !ok {
+		err = webhook.ErrHookNotEnabled
+		return
+	}
+	glog.V(4).Infof("Checking if the provided secret for BuildConfig %s/%s matches", buildCfg.Namespace, buildCfg.Name)
+	if !hmac.Equal([]byte(trigger.GitLabWebHook.Secret), []byte(secret)) {
+		err = webhook.ErrSecretMismatch
+		return
+	}
+	// GitLab sends the secret token configured for the hook in a header. The
+	// header is required, otherwise the secret in the URL alone would be enough.
+	if len(trigger.GitLabWebHook.Secret) > 0 && !hmac.Equal([]byte(trigger.GitLabWebHook.Secret), []byte(req.Header.Get(tokenHeader))) {
+		err = webhook.ErrSecretMismatch
+		return
+	}
+	glog.V(4).Infof("Verifying build request for BuildConfig %s/%s", buildCfg.Namespace, buildCfg.Name)
+	if err = verifyRequest(req); err != nil {
+		return
+	}
+	if event := req.Header.Get(eventHeader); event != pushEvent {
+		err = fmt.Errorf("Unknown %s %s", eventHeader, event)
+		return
+	}
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return
+	}
+	var event pushEventPayload
+	if err = json.Unmarshal(body, &event); err != nil {
+		return
+	}
+	proceed = webhook.GitRefMatches(event.Ref, buildCfg.Spec.Source.Git.Ref)
+	if !proceed {
+		glog.V(2).Infof("Skipping build for BuildConfig %s/%s.  Branch reference from '%s' does not match configuration", buildCfg.Namespace, buildCfg.Name, event.Ref)
+	}
+
+	revision = &api.SourceRevision{
+		Git: &api.GitSourceRevision{
+			Commit: event.After,
+		},
+	}
+	if head := headCommit(event); head != nil {
+		revision.Git.Author = head.Author
+		revision.Git.Message = head.Message
+	}
+
+	return
+}
+
+// headCommit returns the commit the push moved the ref to. GitLab lists the
+// pushed commits from the oldest to the newest.
+func headCommit(event pushEventPayload) *commit {
+	for i := range event.Commits {
+		if event.Commits[i].ID == event.After {
+			return &event.Commits[i]
+		}
+	}
+	if len(event.Commits) > 0 {
+		return &event.Commits[len(event.Commits)-1]
+	}
+	return nil
+}
+
+func verifyRequest(req *http.Request) error {
+	if method := req.Method; method != "POST" {
+		return fmt.Errorf("Unsupported HTTP method %s", method)
+	}
+	if contentType := req.Header.Get("Content-Type"); contentType != "application/json" {
+		return fmt.Errorf("Unsupported Content-Type %s", contentType)
+	}
+	if len(req.Header.Get(eventHeader)) == 0 {
+		return errors.New("Missing " + eventHeader)
+	}
+	return nil
+}
@@ -0,0 +1,194 @@
+package gitlab
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	"github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/build/webhook"
+)
+
+type okBuildConfigGetter struct{}
+
+func (c *okBuildConfigGetter) Get(namespace, name string) (*api.BuildConfig, error) {
+	return mockBuildConfig(), nil
+}
+
+type okBuildConfigInstantiator struct{}
+
+func (*okBuildConfigInstantiator) Instantiate(namespace string, request *api.BuildRequest) (*api.Build, error) {
+	return &api.Build{}, nil
+}
+
+func mockBuildConfig() *api.BuildConfig {
+	return &api.BuildConfig{
+		Spec: api.BuildConfigSpec{
+			Triggers: []api.BuildTriggerPolicy{
+				{
+					Type: api.GitLabWebHookBuildTriggerType,
+					GitLabWebHook: &api.WebHookTrigger{
+						Secret: "secret101",
+					},
+				},
+			},
+			BuildSpec: api.BuildSpec{
+				Source: api.BuildSource{
+					Git: &api.GitBuildSource{
+						URI: "git://gitlab.com/my/repo.git",
+					},
+				},
+				Strategy: api.BuildStrategy{
+					SourceStrategy: &api.SourceBuildStrategy{
+						From: kapi.ObjectReference{
+							Kind: "DockerImage",
+							Name: "repository/image",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestWrongSecret(t *testing.T) {
+	server := httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
+		map[string]webhook.Plugin{"gitlab": New()}))
+	defer server.Close()
+
+	client := &http.Client{}
+	req, _ := http.NewRequest("POST", server.URL+"/build100/wrongsecret/gitlab", nil)
+	resp, _ := client.Do(req)
+	body, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusBadRequest ||
+		!strings.Contains(string(body), webhook.ErrSecretMismatch.Error()) {
+		t.Errorf("Expected BadRequest, got %s: %s!", resp.Status, string(body))
+	}
+}
+
+func TestJsonGitLabPushEvent(t *testing.T) {
+	server := httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
+		map[string]webhook.Plugin{"gitlab": New()}))
+	defer server.Close()
+
+	data, err := ioutil.ReadFile("fixtures/pushevent.json")
+	if err != nil {
+		t.Fatalf("Failed to open pushevent.json: %v", err)
+	}
+	req, _ := http.NewRequest("POST", server.URL+"/build100/secret101/gitlab", bytes.NewReader(data))
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("X-Gitlab-Event", "Push Hook")
+	req.Header.Add("X-Gitlab-Token", "secret101")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Failed posting webhook: %v", err)
+	}
+	body, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Expected OK, got %s: %s!", resp.Status, string(body))
+	}
+}
+
+func setup(t *testing.T, filename, eventType string) *http.Request {
+	event, err := ioutil.ReadFile("fixtures/" + filename)
+	if err != nil {
+		t.Errorf("Failed to open %s: %v", filename, err)
+	}
+	req, _ := http.NewRequest("POST", "http://origin.com", bytes.NewReader(event))
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("X-Gitlab-Event", eventType)
+	req.Header.Add("X-Gitlab-Token", "secret101")
+	return req
+}
+
+func TestExtractProvidesValidBuildForAPushEvent(t *testing.T) {
+	req := setup(t, "pushevent.json", "Push Hook")
+
+	revision, proceed, err := New().Extract(mockBuildConfig(), "secret101", "", req)
+	if err != nil {
+		t.Fatalf("Error while extracting build info: %s", err)
+	}
+	if !proceed {
+		t.Errorf("The 'proceed' return value should equal 'true' %t", proceed)
+	}
+	if revision == nil || revision.Git == nil {
+		t.Fatal("Expecting the revision to not be nil")
+	}
+	if revision.Git.Commit != "da1560886d4f094c3e6c9ef40349f7d38b5d27d7" {
+		t.Errorf("Expecting the revision to contain the commit id from the push event, got %s", revision.Git.Commit)
+	}
+	if revision.Git.Message != "fixed readme" {
+		t.Errorf("Expecting the revision to contain the head commit message, got %q", revision.Git.Message)
+	}
+	if revision.Git.Author.Name != "GitLab dev user" || revision.Git.Author.Email != "gitlabdev@dv6700.(none)" {
+		t.Errorf("Expecting the revision to contain the head commit author, got %#v", revision.Git.Author)
+	}
+}
+
+func TestExtractProvidesValidBuildForAPushEventOtherThanMaster(t *testing.T) {
+	req := setup(t, "pushevent-not-master-branch.json", "Push Hook")
+	buildCfg := mockBuildConfig()
+	buildCfg.Spec.Source.Git.Ref = "my_other_branch"
+
+	revision, proceed, err := New().Extract(buildCfg, "secret101", "", req)
+	if err != nil {
+		t.Fatalf("Error while extracting build info: %s", err)
+	}
+	if !proceed {
+		t.Errorf("The 'proceed' return value should equal 'true' %t", proceed)
+	}
+	if revision == nil || revision.Git.Commit != "da1560886d4f094c3e6c9ef40349f7d38b5d27d7" {
+		t.Errorf("Expecting the revision to contain the commit id from the push event, got %#v", revision)
+	}
+}
+
+func TestExtractSkipsBuildForUnmatchedBranches(t *testing.T) {
+	req := setup(t, "pushevent.json", "Push Hook")
+	buildCfg := mockBuildConfig()
+	buildCfg.Spec.Source.Git.Ref = "adfj32qrafdavckeaewra"
+
+	_, proceed, _ := New().Extract(buildCfg, "secret101", "", req)
+	if proceed {
+		t.Errorf("Expecting to not continue from this event because the branch is not for this buildConfig '%s'", buildCfg.Spec.Source.Git.Ref)
+	}
+}
+
+func TestExtractRejectsWrongSecretToken(t *testing.T) {
+	req := setup(t, "pushevent.json", "Push Hook")
+	req.Header.Set("X-Gitlab-Token", "wrongtoken")
+
+	_, proceed, err := New().Extract(mockBuildConfig(), "secret101", "", req)
+	if err != webhook.ErrSecretMismatch {
+		t.Errorf("Expected %v, got %v", webhook.ErrSecretMismatch, err)
+	}
+	if proceed {
+		t.Errorf("The 'proceed' return value should equal 'false' %t", proceed)
+	}
+}
+
+func TestExtractRejectsMissingSecretToken(t *testing.T) {
+	req := setup(t, "pushevent.json", "Push Hook")
+	req.Header.Del("X-Gitlab-Token")
+
+	_, proceed, err := New().Extract(mockBuildConfig(), "secret101", "", req)
+	if err != webhook.ErrSecretMismatch {
+		t.Errorf("Expected %v, got %v", webhook.ErrSecretMismatch, err)
+	}
+	if proceed {
+		t.Errorf("The 'proceed' return value should equal 'false' %t", proceed)
+	}
+}
+
+func TestExtractRejectsUnknownEvent(t *testing.T) {
+	req := setup(t, "pushevent.json", "Issue Hook")
+
+	_, _, err := New().Extract(mockBuildConfig(), "secret101", "", req)
+	if err == nil || !strings.Contains(err.Error(), "Unknown X-Gitlab-Event") {
+		t.Errorf("Expected an unknown event error, got %v", err)
+	}
+}
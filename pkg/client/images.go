@@ -16,6 +16,7 @@ type ImageInterface interface {
 	List(opts kapi.ListOptions) (*imageapi.ImageList, error)
 	Get(name string) (*imageapi.Image, error)
 	Create(image *imageapi.Image) (*imageapi.Image, error)
+	UpdateSignatures(image *imageapi.Image) (*imageapi.Image, error)
 	Delete(name string) error
 }
 
@@ -56,6 +57,13 @@ func (c *images) Create(image *imageapi.Image) (result *imageapi.Image, err erro
 	return
 }
 
+// UpdateSignatures takes the image with altered signatures. Returns the server's representation of the image and error if one occurs.
+func (c *images) UpdateSignatures(image *imageapi.Image) (result *imageapi.Image, err error) {
+	result = &imageapi.Image{}
+	err = c.r.Put().Resource("images").Name(image.Name).SubResource("signatures").Body(image).Do().Into(result)
+	return
+}
+
 // Delete deletes an image, returns error if one occurs.
 func (c *images) Delete(name string) (err error) {
 	err = c.r.Delete().Resource("images").Name(name).Do().Error()
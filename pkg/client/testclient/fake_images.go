@@ -44,6 +44,18 @@ func (c *FakeImages) Create(inObj *imageapi.Image) (*imageapi.Image, error) {
 	return obj.(*imageapi.Image), err
 }
 
+func (c *FakeImages) UpdateSignatures(inObj *imageapi.Image) (*imageapi.Image, error) {
+	action := ktestclient.NewRootUpdateAction("images", inObj)
+	action.Subresource = "signatures"
+
+	obj, err := c.Fake.Invokes(action, inObj)
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*imageapi.Image), err
+}
+
 func (c *FakeImages) Delete(name string) error {
 	_, err := c.Fake.Invokes(ktestclient.NewRootDeleteAction("images", name), &imageapi.Image{})
 	return err
@@ -9,6 +9,7 @@ import (
 	"github.com/openshift/openshift-sdn/pkg/cmd/admin/network"
 	"github.com/openshift/origin/pkg/cmd/admin/cert"
 	"github.com/openshift/origin/pkg/cmd/admin/groups"
+	"github.com/openshift/origin/pkg/cmd/admin/image"
 	"github.com/openshift/origin/pkg/cmd/admin/node"
 	"github.com/openshift/origin/pkg/cmd/admin/policy"
 	"github.com/openshift/origin/pkg/cmd/admin/project"
@@ -65,6 +66,7 @@ func NewCommandAdmin(name, fullName string, out io.Writer) *cobra.Command {
 				buildchain.NewCmdBuildChain(name, fullName+" "+buildchain.BuildChainRecommendedCommandName, f, out),
 				node.NewCommandManageNode(f, node.ManageNodeCommandName, fullName+" "+node.ManageNodeCommandName, out),
 				prune.NewCommandPrune(prune.PruneRecommendedName, fullName+" "+prune.PruneRecommendedName, f, out),
+				image.NewCmdVerifyImageSignature(image.VerifyImageSignatureRecommendedName, fullName+" "+image.VerifyImageSignatureRecommendedName, f, out),
 			},
 		},
 		{
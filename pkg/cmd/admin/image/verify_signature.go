@@ -0,0 +1,136 @@
+package image
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+
+	"github.com/openshift/origin/pkg/client"
+	"github.com/openshift/origin/pkg/cmd/server/bootstrappolicy"
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+	"github.com/openshift/origin/pkg/image/signature"
+)
+
+// VerifyImageSignatureRecommendedName is the recommended command name
+const VerifyImageSignatureRecommendedName = "verify-image-signature"
+
+const (
+	verifyImageSignatureLongDesc = `Verify the signatures of an image
+
+Checks each signature stored on the image against the public keys of a local GPG keyring
+and reports whether it was created for the image by a trusted key. The gpg binary must be
+available in the PATH.
+
+With --save, the result of the verification is recorded in the Trusted condition of each
+signature, which is used by the ImageSignaturePolicy admission plugin to decide whether
+pods may run the image. Only a user with the cluster role %s or higher may record the
+result.`
+
+	verifyImageSignatureExample = `  # Verify the signatures of an image against the keys in a keyring
+  $ %[1]s sha256:c841e9b64e4579bd56c794bdd7c36e1c257110fd2404bebbb8b613e4935228c4 --keyring=pubring.gpg
+
+  # Verify the signatures and record which of them are trusted
+  $ %[1]s sha256:c841e9b64e4579bd56c794bdd7c36e1c257110fd2404bebbb8b613e4935228c4 --keyring=pubring.gpg --save`
+)
+
+// VerifyImageSignatureOptions holds the options for verifying the signatures of an image
+type VerifyImageSignatureOptions struct {
+	ImageName string
+	Keyring   string
+	Save      bool
+
+	Verifier signature.Verifier
+	Client   client.ImageInterface
+	Out      io.Writer
+}
+
+// NewCmdVerifyImageSignature implements the OpenShift cli verify-image-signature command
+func NewCmdVerifyImageSignature(name, fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
+	opts := &VerifyImageSignatureOptions{}
+
+	cmd := &cobra.Command{
+		Use:     fmt.Sprintf("%s IMAGE --keyring=PATH [--save]", name),
+		Short:   "Verify the signatures of an image",
+		Long:    fmt.Sprintf(verifyImageSignatureLongDesc, bootstrappolicy.ImageSignerRoleName),
+		Example: fmt.Sprintf(verifyImageSignatureExample, fullName),
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := opts.Complete(f, args, out); err != nil {
+				kcmdutil.CheckErr(kcmdutil.UsageError(cmd, "%v", err))
+			}
+			kcmdutil.CheckErr(opts.Run())
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Keyring, "keyring", opts.Keyring, "The path of the GPG public keyring holding the trusted keys.")
+	cmd.Flags().BoolVar(&opts.Save, "save", opts.Save, "Record the result of the verification on the signatures of the image.")
+
+	return cmd
+}
+
+// Complete the options for verify-image-signature
+func (o *VerifyImageSignatureOptions) Complete(f *clientcmd.Factory, args []string, out io.Writer) error {
+	if len(args) != 1 {
+		return errors.New("exactly one image name must be specified")
+	}
+	if len(o.Keyring) == 0 {
+		return errors.New("--keyring must be specified")
+	}
+	o.ImageName = args[0]
+	o.Out = out
+
+	verifier, err := signature.NewGPGVerifier(o.Keyring)
+	if err != nil {
+		return err
+	}
+	o.Verifier = verifier
+
+	osClient, _, err := f.Clients()
+	if err != nil {
+		return err
+	}
+	o.Client = osClient.Images()
+	return nil
+}
+
+// Run verifies the signatures of the image, and records the results if requested.
+func (o *VerifyImageSignatureOptions) Run() error {
+	image, err := o.Client.Get(o.ImageName)
+	if err != nil {
+		return err
+	}
+	if len(image.Signatures) == 0 {
+		return fmt.Errorf("image %s has no signatures", image.Name)
+	}
+
+	now := unversioned.Now()
+	w := tabwriter.NewWriter(o.Out, 10, 4, 3, ' ', 0)
+	fmt.Fprintln(w, "SIGNATURE\tTYPE\tTRUSTED\tDETAILS")
+	untrusted := 0
+	for i := range image.Signatures {
+		sig := &image.Signatures[i]
+		signer, err := o.Verifier.Verify(image, sig)
+		if err != nil {
+			untrusted++
+			fmt.Fprintf(w, "%s\t%s\tfalse\t%v\n", sig.Name, sig.Type, err)
+		} else {
+			fmt.Fprintf(w, "%s\t%s\ttrue\tsigned by %s\n", sig.Name, sig.Type, signer)
+		}
+		signature.SetTrustedCondition(sig, signer, err, now)
+	}
+	w.Flush()
+
+	if o.Save {
+		if _, err := o.Client.UpdateSignatures(image); err != nil {
+			return fmt.Errorf("unable to record the verification of image %s: %v", image.Name, err)
+		}
+	}
+	if untrusted > 0 {
+		return fmt.Errorf("%d of %d signatures of image %s could not be verified", untrusted, len(image.Signatures), image.Name)
+	}
+	return nil
+}
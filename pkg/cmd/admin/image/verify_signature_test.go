@@ -0,0 +1,105 @@
+package image
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	"github.com/openshift/origin/pkg/client/testclient"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+	"github.com/openshift/origin/pkg/image/signature"
+)
+
+type fakeVerifier struct {
+	signers map[string]string
+}
+
+func (v *fakeVerifier) Verify(image *imageapi.Image, sig *imageapi.ImageSignature) (string, error) {
+	signer, ok := v.signers[sig.Name]
+	if !ok {
+		return "", fmt.Errorf("untrusted key")
+	}
+	return signer, nil
+}
+
+func TestVerifyImageSignature(t *testing.T) {
+	tests := map[string]struct {
+		signatures []string
+		save       bool
+		trusted    map[string]bool
+		err        bool
+	}{
+		"all trusted": {
+			signatures: []string{"good"},
+			trusted:    map[string]bool{"good": true},
+		},
+		"all trusted and saved": {
+			signatures: []string{"good"},
+			save:       true,
+			trusted:    map[string]bool{"good": true},
+		},
+		"untrusted and saved": {
+			signatures: []string{"good", "bad"},
+			save:       true,
+			trusted:    map[string]bool{"good": true, "bad": false},
+			err:        true,
+		},
+		"no signatures": {
+			err: true,
+		},
+	}
+
+	for name, test := range tests {
+		image := &imageapi.Image{ObjectMeta: kapi.ObjectMeta{Name: "sha256:0000"}}
+		for _, sigName := range test.signatures {
+			image.Signatures = append(image.Signatures, imageapi.ImageSignature{Name: sigName, Type: imageapi.ImageSignatureTypeGPG, Content: []byte(sigName)})
+		}
+
+		fake := &testclient.Fake{}
+		fake.AddReactor("get", "images", func(action ktestclient.Action) (bool, runtime.Object, error) {
+			return true, image, nil
+		})
+		var saved *imageapi.Image
+		fake.AddReactor("update", "images", func(action ktestclient.Action) (bool, runtime.Object, error) {
+			if action.GetSubresource() != "signatures" {
+				t.Errorf("%s: unexpected subresource %q", name, action.GetSubresource())
+			}
+			saved = action.(ktestclient.UpdateAction).GetObject().(*imageapi.Image)
+			return true, saved, nil
+		})
+
+		out := &bytes.Buffer{}
+		opts := &VerifyImageSignatureOptions{
+			ImageName: image.Name,
+			Save:      test.save,
+			Verifier:  &fakeVerifier{signers: map[string]string{"good": "Image Signer"}},
+			Client:    fake.Images(),
+			Out:       out,
+		}
+		err := opts.Run()
+		if test.err != (err != nil) {
+			t.Errorf("%s: unexpected error: %v", name, err)
+		}
+
+		if !test.save {
+			if saved != nil {
+				t.Errorf("%s: expected the signatures not to be saved", name)
+			}
+			continue
+		}
+		if saved == nil {
+			t.Errorf("%s: expected the signatures to be saved", name)
+			continue
+		}
+		for i := range saved.Signatures {
+			sig := &saved.Signatures[i]
+			if e, a := test.trusted[sig.Name], signature.IsTrusted(sig); e != a {
+				t.Errorf("%s: expected signature %s to be trusted=%t, got %t", name, sig.Name, e, a)
+			}
+		}
+	}
+}
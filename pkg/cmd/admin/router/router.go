@@ -122,6 +122,10 @@ type RouterConfig struct {
 	// network namespace or the container's.
 	HostNetwork bool
 
+	// AllowWildcardRoutes specifies whether the router admits routes with a
+	// wildcard policy of Subdomain.
+	AllowWildcardRoutes bool
+
 	// ServiceAccount specifies the service account under which the router will
 	// run.
 	ServiceAccount string
@@ -225,6 +229,7 @@ func NewCmdRouter(f *clientcmd.Factory, parentName, name string, out io.Writer)
 	cmd.Flags().BoolVar(&cfg.ExposeMetrics, "expose-metrics", cfg.ExposeMetrics, "This is a hint to run an extra container in the pod to expose metrics - the image will either be set depending on the router implementation or provided with --metrics-image.")
 	cmd.Flags().StringVar(&cfg.MetricsImage, "metrics-image", cfg.MetricsImage, "If --expose-metrics is specified this is the image to use to run a sidecar container in the pod exposing metrics. If not set and --expose-metrics is true the image will depend on router implementation.")
 	cmd.Flags().BoolVar(&cfg.HostNetwork, "host-network", cfg.HostNetwork, "If true (the default), then use host networking rather than using a separate container network stack.")
+	cmd.Flags().BoolVar(&cfg.AllowWildcardRoutes, "allow-wildcard-routes", cfg.AllowWildcardRoutes, "If true, the router will admit routes with a wildcard policy of Subdomain, which expose every host in the subdomain of their host.")
 	cmd.Flags().StringVar(&cfg.ExternalHost, "external-host", cfg.ExternalHost, "If the underlying router implementation connects with an external host, this is the external host's hostname.")
 	cmd.Flags().StringVar(&cfg.ExternalHostUsername, "external-host-username", cfg.ExternalHostUsername, "If the underlying router implementation connects with an external host, this is the username for authenticating with the external host.")
 	cmd.Flags().StringVar(&cfg.ExternalHostPassword, "external-host-password", cfg.ExternalHostPassword, "If the underlying router implementation connects with an external host, this is the password for authenticating with the external host.")
@@ -520,6 +525,7 @@ func RunCmdRouter(f *clientcmd.Factory, cmd *cobra.Command, out io.Writer, cfg *
 			"DEFAULT_CERTIFICATE":                 string(defaultCert),
 			"ROUTER_SERVICE_NAME":                 name,
 			"ROUTER_SERVICE_NAMESPACE":            namespace,
+			"ROUTER_ALLOW_WILDCARD_ROUTES":        strconv.FormatBool(cfg.AllowWildcardRoutes),
 			"ROUTER_EXTERNAL_HOST_HOSTNAME":       cfg.ExternalHost,
 			"ROUTER_EXTERNAL_HOST_USERNAME":       cfg.ExternalHostUsername,
 			"ROUTER_EXTERNAL_HOST_PASSWORD":       cfg.ExternalHostPassword,
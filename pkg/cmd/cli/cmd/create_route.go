@@ -366,7 +366,7 @@ func unsecuredRoute(kc *kclient.Client, namespace, routeName, serviceName, portS
 				Name: routeName,
 			},
 			Spec: api.RouteSpec{
-				To: kapi.ObjectReference{
+				To: api.RouteTargetReference{
 					Name: serviceName,
 				},
 				Port: resolveRoutePort(portString),
@@ -385,7 +385,7 @@ func unsecuredRoute(kc *kclient.Client, namespace, routeName, serviceName, portS
 			Labels: svc.Labels,
 		},
 		Spec: api.RouteSpec{
-			To: kapi.ObjectReference{
+			To: api.RouteTargetReference{
 				Name: serviceName,
 			},
 		},
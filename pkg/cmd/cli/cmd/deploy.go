@@ -39,12 +39,14 @@ type DeployOptions struct {
 	deployLatest         bool
 	retryDeploy          bool
 	cancelDeploy         bool
+	resumeDeploy         bool
+	abortDeploy          bool
 	enableTriggers       bool
 }
 
 const (
 	deployLong = `
-View, start, cancel, or retry a deployment
+View, start, cancel, resume, or retry a deployment
 
 This command allows you to control a deployment config. Each individual deployment is exposed
 as a new replication controller, and the deployment process manages scaling down old deployments
@@ -61,6 +63,10 @@ There are several deployment strategies defined:
   Use when your application cannot tolerate two versions of code running at the same time
 * Custom - run your own deployment process inside a Docker container using your own scripts.
 
+A rolling deployment may define pause points, at which the deployment waits with some of the new
+pods running alongside the old ones. Use '--resume' to continue the deployment to its next pause
+point or to completion, or '--abort' to scale the previous deployment back up and stop.
+
 If a deployment fails, you may opt to retry it (if the error was transient). Some deployments may
 never successfully complete - in which case you can use the '--latest' flag to force a redeployment.
 When rolling back to a previous deployment, a new deployment will be created with an identical copy
@@ -79,7 +85,13 @@ If no options are given, shows information about the latest deployment.`
   $ %[1]s deploy frontend --retry
 
   # Cancel the in-progress deployment based on 'frontend'
-  $ %[1]s deploy frontend --cancel`
+  $ %[1]s deploy frontend --cancel
+
+  # Resume the deployment of 'frontend' paused at a pause point
+  $ %[1]s deploy frontend --resume
+
+  # Abort the deployment of 'frontend' paused at a pause point and roll back
+  $ %[1]s deploy frontend --abort`
 )
 
 // NewCmdDeploy creates a new `deploy` command.
@@ -89,8 +101,8 @@ func NewCmdDeploy(fullName string, f *clientcmd.Factory, out io.Writer) *cobra.C
 	}
 
 	cmd := &cobra.Command{
-		Use:        "deploy DEPLOYMENTCONFIG [--latest|--retry|--cancel|--enable-triggers]",
-		Short:      "View, start, cancel, or retry a deployment",
+		Use:        "deploy DEPLOYMENTCONFIG [--latest|--retry|--cancel|--resume|--abort|--enable-triggers]",
+		Short:      "View, start, cancel, resume, or retry a deployment",
 		Long:       deployLong,
 		Example:    fmt.Sprintf(deployExample, fullName),
 		SuggestFor: []string{"deployment"},
@@ -112,6 +124,8 @@ func NewCmdDeploy(fullName string, f *clientcmd.Factory, out io.Writer) *cobra.C
 	cmd.Flags().BoolVar(&options.deployLatest, "latest", false, "Start a new deployment now.")
 	cmd.Flags().BoolVar(&options.retryDeploy, "retry", false, "Retry the latest failed deployment.")
 	cmd.Flags().BoolVar(&options.cancelDeploy, "cancel", false, "Cancel the in-progress deployment.")
+	cmd.Flags().BoolVar(&options.resumeDeploy, "resume", false, "Resume the deployment paused at a pause point.")
+	cmd.Flags().BoolVar(&options.abortDeploy, "abort", false, "Abort the deployment paused at a pause point and roll back to the previous deployment.")
 	cmd.Flags().BoolVar(&options.enableTriggers, "enable-triggers", false, "Enables all image triggers for the deployment config.")
 
 	return cmd
@@ -158,11 +172,17 @@ func (o DeployOptions) Validate() error {
 	if o.cancelDeploy {
 		numOptions++
 	}
+	if o.resumeDeploy {
+		numOptions++
+	}
+	if o.abortDeploy {
+		numOptions++
+	}
 	if o.enableTriggers {
 		numOptions++
 	}
 	if numOptions > 1 {
-		return errors.New("only one of --latest, --retry, --cancel, or --enable-triggers is allowed.")
+		return errors.New("only one of --latest, --retry, --cancel, --resume, --abort, or --enable-triggers is allowed.")
 	}
 	return nil
 }
@@ -189,6 +209,10 @@ func (o DeployOptions) RunDeploy() error {
 		err = o.retry(config, o.out)
 	case o.cancelDeploy:
 		err = o.cancel(config, o.out)
+	case o.resumeDeploy:
+		err = o.resume(config, o.out)
+	case o.abortDeploy:
+		err = o.abort(config, o.out)
 	case o.enableTriggers:
 		err = o.reenableTriggers(config, o.out)
 	default:
@@ -268,9 +292,10 @@ func (o DeployOptions) retry(config *deployapi.DeploymentConfig, out io.Writer)
 	}
 
 	deployment.Annotations[deployapi.DeploymentStatusAnnotation] = string(deployapi.DeploymentStatusNew)
-	// clear out the cancellation flag as well as any previous status-reason annotation
+	// clear out the cancellation flag as well as any previous status-reason and pause annotation
 	delete(deployment.Annotations, deployapi.DeploymentStatusReasonAnnotation)
 	delete(deployment.Annotations, deployapi.DeploymentCancelledAnnotation)
+	delete(deployment.Annotations, deployapi.DeploymentPausedAnnotation)
 	_, err = o.kubeClient.ReplicationControllers(deployment.Namespace).Update(deployment)
 	if err == nil {
 		fmt.Fprintf(out, "Retried #%d\n", config.Status.LatestVersion)
@@ -302,9 +327,7 @@ func (o DeployOptions) cancel(config *deployapi.DeploymentConfig, out io.Writer)
 				continue
 			}
 
-			deployment.Annotations[deployapi.DeploymentCancelledAnnotation] = deployapi.DeploymentCancelledAnnotationValue
-			deployment.Annotations[deployapi.DeploymentStatusReasonAnnotation] = deployapi.DeploymentCancelledByUser
-			_, err := o.kubeClient.ReplicationControllers(deployment.Namespace).Update(&deployment)
+			err := o.markCancelled(&deployment, deployapi.DeploymentCancelledByUser)
 			if err == nil {
 				fmt.Fprintf(out, "Cancelled deployment #%d\n", config.Status.LatestVersion)
 				anyCancelled = true
@@ -328,6 +351,67 @@ func (o DeployOptions) cancel(config *deployapi.DeploymentConfig, out io.Writer)
 	return nil
 }
 
+// markCancelled flags the deployment as cancelled for the given reason. The
+// deployer pods of the deployment are then terminated, and the previous
+// deployment is scaled back up.
+func (o DeployOptions) markCancelled(deployment *kapi.ReplicationController, reason string) error {
+	deployment.Annotations[deployapi.DeploymentCancelledAnnotation] = deployapi.DeploymentCancelledAnnotationValue
+	deployment.Annotations[deployapi.DeploymentStatusReasonAnnotation] = reason
+	_, err := o.kubeClient.ReplicationControllers(deployment.Namespace).Update(deployment)
+	return err
+}
+
+// pausedDeployment returns the latest deployment for config, and the number
+// of replicas it is paused at. An error is returned if the deployment is not
+// paused.
+func (o DeployOptions) pausedDeployment(config *deployapi.DeploymentConfig) (*kapi.ReplicationController, int, error) {
+	if config.Status.LatestVersion == 0 {
+		return nil, 0, fmt.Errorf("no deployments found for %s/%s", config.Namespace, config.Name)
+	}
+	deploymentName := deployutil.LatestDeploymentNameForConfig(config)
+	deployment, err := o.kubeClient.ReplicationControllers(config.Namespace).Get(deploymentName)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil, 0, fmt.Errorf("unable to find the latest deployment (#%d).", config.Status.LatestVersion)
+		}
+		return nil, 0, err
+	}
+	replicas, paused := deployutil.DeploymentPausedAt(deployment)
+	if !paused || deployutil.IsTerminatedDeployment(deployment) || deployutil.IsDeploymentCancelled(deployment) {
+		return nil, 0, fmt.Errorf("#%d is %s; only deployments paused at a pause point can be resumed or aborted.", config.Status.LatestVersion, strings.ToLower(string(deployutil.DeploymentStatusFor(deployment))))
+	}
+	return deployment, replicas, nil
+}
+
+// resume continues the latest deployment for config from the pause point it
+// is paused at.
+func (o DeployOptions) resume(config *deployapi.DeploymentConfig, out io.Writer) error {
+	deployment, replicas, err := o.pausedDeployment(config)
+	if err != nil {
+		return err
+	}
+	delete(deployment.Annotations, deployapi.DeploymentPausedAnnotation)
+	if _, err := o.kubeClient.ReplicationControllers(deployment.Namespace).Update(deployment); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Resumed deployment #%d paused at %d replicas\n", config.Status.LatestVersion, replicas)
+	return nil
+}
+
+// abort cancels the latest deployment for config at the pause point it is
+// paused at, which scales the previous deployment back up.
+func (o DeployOptions) abort(config *deployapi.DeploymentConfig, out io.Writer) error {
+	deployment, replicas, err := o.pausedDeployment(config)
+	if err != nil {
+		return err
+	}
+	if err := o.markCancelled(deployment, deployapi.DeploymentAbortedByUser); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Aborted deployment #%d paused at %d replicas; the previous deployment will be scaled back up\n", config.Status.LatestVersion, replicas)
+	return nil
+}
+
 // reenableTriggers enables all image triggers and then persists config.
 func (o DeployOptions) reenableTriggers(config *deployapi.DeploymentConfig, out io.Writer) error {
 	enabled := []string{}
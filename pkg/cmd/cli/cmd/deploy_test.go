@@ -276,6 +276,78 @@ func TestCmdDeploy_cancelOk(t *testing.T) {
 	}
 }
 
+func TestCmdDeploy_resumeAndAbortPaused(t *testing.T) {
+	for _, abort := range []bool{false, true} {
+		config := deploytest.OkDeploymentConfig(1)
+		existingDeployment := deploymentFor(config, deployapi.DeploymentStatusRunning)
+		existingDeployment.Annotations[deployapi.DeploymentPausedAnnotation] = "1"
+
+		var updatedDeployment *kapi.ReplicationController
+		kubeClient := &ktc.Fake{}
+		kubeClient.AddReactor("get", "replicationcontrollers", func(action ktc.Action) (handled bool, ret runtime.Object, err error) {
+			return true, existingDeployment, nil
+		})
+		kubeClient.AddReactor("update", "replicationcontrollers", func(action ktc.Action) (handled bool, ret runtime.Object, err error) {
+			updatedDeployment = action.(ktc.UpdateAction).GetObject().(*kapi.ReplicationController)
+			return true, updatedDeployment, nil
+		})
+
+		o := &DeployOptions{kubeClient: kubeClient}
+		var err error
+		if abort {
+			err = o.abort(config, ioutil.Discard)
+		} else {
+			err = o.resume(config, ioutil.Discard)
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if updatedDeployment == nil {
+			t.Fatalf("expected the deployment to be updated")
+		}
+		if abort {
+			if !deployutil.IsDeploymentCancelled(updatedDeployment) {
+				t.Errorf("expected the aborted deployment to be cancelled")
+			}
+			if e, a := deployapi.DeploymentAbortedByUser, deployutil.DeploymentStatusReasonFor(updatedDeployment); e != a {
+				t.Errorf("expected reason %q, got %q", e, a)
+			}
+			continue
+		}
+		if _, paused := deployutil.DeploymentPausedAt(updatedDeployment); paused {
+			t.Errorf("expected the resumed deployment not to be paused")
+		}
+		if deployutil.IsDeploymentCancelled(updatedDeployment) {
+			t.Errorf("unexpected cancellation of the resumed deployment")
+		}
+	}
+}
+
+func TestCmdDeploy_resumeAndAbortRejectNotPaused(t *testing.T) {
+	statusList := []deployapi.DeploymentStatus{
+		deployapi.DeploymentStatusRunning,
+		deployapi.DeploymentStatusComplete,
+		deployapi.DeploymentStatusFailed,
+	}
+
+	for _, status := range statusList {
+		config := deploytest.OkDeploymentConfig(1)
+		existingDeployment := deploymentFor(config, status)
+		if status == deployapi.DeploymentStatusFailed {
+			// a deployment that failed while paused cannot be resumed
+			existingDeployment.Annotations[deployapi.DeploymentPausedAnnotation] = "1"
+		}
+		kubeClient := ktc.NewSimpleFake(existingDeployment)
+		o := &DeployOptions{kubeClient: kubeClient}
+		if err := o.resume(config, ioutil.Discard); err == nil {
+			t.Errorf("expected an error resuming deployment with status %s", status)
+		}
+		if err := o.abort(config, ioutil.Discard); err == nil {
+			t.Errorf("expected an error aborting deployment with status %s", status)
+		}
+	}
+}
+
 func TestDeploy_reenableTriggers(t *testing.T) {
 	mktrigger := func() deployapi.DeploymentTriggerPolicy {
 		t := deploytest.OkImageChangeTrigger()
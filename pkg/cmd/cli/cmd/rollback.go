@@ -39,6 +39,11 @@ with a warning. This is to help prevent your rolled back deployment from being
 replaced by a triggered deployment soon after your rollback. To re-enable the
 triggers, use the 'deploy' command.
 
+For deployment configurations using the BlueGreen strategy, the rolled back
+deployment is brought up alongside the current one and the service is switched
+back to it once its pods are ready. A switch that is still within its
+verification window can be reverted immediately with 'deploy --cancel'.
+
 If you would like to review the outcome of the rollback, pass '--dry-run' to print
 a human-readable representation of the updated deployment configuration instead of
 executing the rollback. This is useful if you're not quite sure what the outcome
@@ -194,6 +194,13 @@ func printStrategy(strategy deployapi.DeploymentStrategy, w *tabwriter.Writer) {
 		if strategy.RollingParams != nil {
 			pre := strategy.RollingParams.Pre
 			post := strategy.RollingParams.Post
+			if len(strategy.RollingParams.PausePoints) > 0 {
+				points := []string{}
+				for _, point := range strategy.RollingParams.PausePoints {
+					points = append(points, point.String())
+				}
+				fmt.Fprintf(w, "\t  Pause Points:\t%s\n", strings.Join(points, ", "))
+			}
 			if pre != nil {
 				printHook("Pre-deployment", pre, w)
 			}
@@ -201,6 +208,19 @@ func printStrategy(strategy deployapi.DeploymentStrategy, w *tabwriter.Writer) {
 				printHook("Post-deployment", post, w)
 			}
 		}
+	case deployapi.DeploymentStrategyTypeBlueGreen:
+		if strategy.BlueGreenParams != nil {
+			fmt.Fprintf(w, "\t  Service:\t%s\n", strategy.BlueGreenParams.ServiceName)
+			if strategy.BlueGreenParams.VerificationSeconds != nil {
+				fmt.Fprintf(w, "\t  Verification:\t%ds\n", *strategy.BlueGreenParams.VerificationSeconds)
+			}
+			if strategy.BlueGreenParams.Pre != nil {
+				printHook("Pre-deployment", strategy.BlueGreenParams.Pre, w)
+			}
+			if strategy.BlueGreenParams.Post != nil {
+				printHook("Post-deployment", strategy.BlueGreenParams.Post, w)
+			}
+		}
 	case deployapi.DeploymentStrategyTypeCustom:
 		fmt.Fprintf(w, "\t  Image:\t%s\n", strategy.CustomParams.Image)
 
@@ -280,7 +300,11 @@ func printDeploymentRc(deployment *kapi.ReplicationController, client deployment
 	}
 	timeAt := strings.ToLower(formatRelativeTime(deployment.CreationTimestamp.Time))
 	fmt.Fprintf(w, "\tCreated:\t%s ago\n", timeAt)
-	fmt.Fprintf(w, "\tStatus:\t%s\n", deployutil.DeploymentStatusFor(deployment))
+	status := string(deployutil.DeploymentStatusFor(deployment))
+	if replicas, paused := deployutil.DeploymentPausedAt(deployment); paused && !deployutil.IsTerminatedDeployment(deployment) {
+		status = fmt.Sprintf("%s (paused at %d replicas)", status, replicas)
+	}
+	fmt.Fprintf(w, "\tStatus:\t%s\n", status)
 	fmt.Fprintf(w, "\tReplicas:\t%d current / %d desired\n", deployment.Status.Replicas, deployment.Spec.Replicas)
 
 	if verbose {
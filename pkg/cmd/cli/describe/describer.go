@@ -142,6 +142,14 @@ func (d *BuildDescriber) Describe(namespace, name string) (string, error) {
 			status += " (" + build.Status.Message + ")"
 		}
 		formatString(out, "Status", status)
+		for i, ref := range build.Status.OutputDockerImageReferences {
+			if i == 0 {
+				formatString(out, "Pushed Images", ref)
+			} else {
+				formatString(out, "", ref)
+			}
+		}
+		describeBuildStages(build.Status.Stages, out)
 		kctl.DescribeEvents(events, out)
 
 		return nil
@@ -167,6 +175,26 @@ func describeBuildDuration(build *buildapi.Build) string {
 	return fmt.Sprintf("%v", build.Status.Duration)
 }
 
+// describeBuildStages lists the stages reported by the runner of a pipeline build
+func describeBuildStages(stages []buildapi.StageInfo, out *tabwriter.Writer) {
+	if len(stages) == 0 {
+		return
+	}
+	fmt.Fprintf(out, "Stages:\n")
+	fmt.Fprintf(out, "  Name\tStatus\tStarted\tDuration\n")
+	fmt.Fprintf(out, "  ----\t------\t-------\t--------\n")
+	for _, stage := range stages {
+		started, duration := "<none>", "<none>"
+		if stage.StartTimestamp != nil {
+			started = stage.StartTimestamp.Time.Format(time.RFC1123Z)
+			if stage.CompletionTimestamp != nil {
+				duration = stage.CompletionTimestamp.Rfc3339Copy().Time.Sub(stage.StartTimestamp.Rfc3339Copy().Time).String()
+			}
+		}
+		fmt.Fprintf(out, "  %s\t%s\t%s\t%s\n", stage.Name, stage.Phase, started, duration)
+	}
+}
+
 // BuildConfigDescriber generates information about a buildConfig
 type BuildConfigDescriber struct {
 	client.Interface
@@ -248,6 +276,8 @@ func describeBuildSpec(p buildapi.BuildSpec, out *tabwriter.Writer) {
 		describeSourceStrategy(p.Strategy.SourceStrategy, out)
 	case p.Strategy.CustomStrategy != nil:
 		describeCustomStrategy(p.Strategy.CustomStrategy, out)
+	case p.Strategy.PipelineStrategy != nil:
+		describePipelineStrategy(p.Strategy.PipelineStrategy, out)
 	}
 
 	if p.Output.To != nil {
@@ -258,6 +288,14 @@ func describeBuildSpec(p buildapi.BuildSpec, out *tabwriter.Writer) {
 		formatString(out, "Push Secret", p.Output.PushSecret.Name)
 	}
 
+	for _, target := range p.Output.AdditionalTargets {
+		to := fmt.Sprintf("%s %s", target.To.Kind, nameAndNamespace(target.To.Namespace, target.To.Name))
+		if target.PushSecret != nil {
+			to += fmt.Sprintf(" (push secret %s)", target.PushSecret.Name)
+		}
+		formatString(out, "Also output to", to)
+	}
+
 	if p.Revision != nil && p.Revision.Git != nil {
 		buildDescriber := &BuildDescriber{}
 
@@ -332,6 +370,21 @@ func describeCustomStrategy(s *buildapi.CustomBuildStrategy, out *tabwriter.Writ
 	}
 }
 
+func describePipelineStrategy(s *buildapi.PipelineBuildStrategy, out *tabwriter.Writer) {
+	if len(s.Jenkinsfile) != 0 {
+		formatString(out, "Jenkinsfile", "inline")
+	} else if len(s.JenkinsfilePath) != 0 {
+		formatString(out, "Jenkinsfile Path", s.JenkinsfilePath)
+	}
+	for i, env := range s.Env {
+		if i == 0 {
+			formatString(out, "Environment", formatEnv(env))
+		} else {
+			formatString(out, "", formatEnv(env))
+		}
+	}
+}
+
 // DescribeTriggers generates information about the triggers associated with a buildconfig
 func (d *BuildConfigDescriber) DescribeTriggers(bc *buildapi.BuildConfig, out *tabwriter.Writer) {
 	describeBuildTriggers(bc.Spec.Triggers, out)
@@ -352,7 +405,7 @@ func describeBuildTriggers(triggers []buildapi.BuildTriggerPolicy, w *tabwriter.
 
 	for _, t := range triggers {
 		switch t.Type {
-		case buildapi.GitHubWebHookBuildTriggerType, buildapi.GenericWebHookBuildTriggerType:
+		case buildapi.GitHubWebHookBuildTriggerType, buildapi.GenericWebHookBuildTriggerType, buildapi.GitLabWebHookBuildTriggerType, buildapi.BitbucketWebHookBuildTriggerType:
 			continue
 		case buildapi.ConfigChangeBuildTriggerType:
 			labels = append(labels, "Config")
@@ -393,6 +446,15 @@ func (d *BuildConfigDescriber) Describe(namespace, name string) (string, error)
 		} else {
 			formatString(out, "Latest Version", strconv.Itoa(buildConfig.Status.LastVersion))
 		}
+		if len(buildConfig.Spec.RunPolicy) > 0 {
+			formatString(out, "Run Policy", string(buildConfig.Spec.RunPolicy))
+		}
+		if buildConfig.Spec.SuccessfulBuildsHistoryLimit != nil {
+			formatString(out, "Successful Builds History Limit", *buildConfig.Spec.SuccessfulBuildsHistoryLimit)
+		}
+		if buildConfig.Spec.FailedBuildsHistoryLimit != nil {
+			formatString(out, "Failed Builds History Limit", *buildConfig.Spec.FailedBuildsHistoryLimit)
+		}
 		describeBuildSpec(buildConfig.Spec.BuildSpec, out)
 		d.DescribeTriggers(buildConfig, out)
 		if len(buildList.Items) == 0 {
@@ -596,8 +658,26 @@ func (d *RouteDescriber) Describe(namespace, name string) (string, error) {
 	return tabbedString(func(out *tabwriter.Writer) error {
 		formatMeta(out, route.ObjectMeta)
 		formatString(out, "Host", route.Spec.Host)
+		if route.Spec.WildcardPolicy == routeapi.WildcardPolicySubdomain {
+			formatString(out, "Wildcard Policy", fmt.Sprintf("%s (*.%s)", route.Spec.WildcardPolicy, routeapi.WildcardSubdomain(route.Spec.Host)))
+		}
+		for i, ingress := range route.Status.Ingress {
+			if i == 0 {
+				formatString(out, "Router Status", routeIngressStatus(ingress))
+			} else {
+				fmt.Fprintf(out, "\t%s\n", routeIngressStatus(ingress))
+			}
+		}
 		formatString(out, "Path", route.Spec.Path)
 		formatString(out, "Service", route.Spec.To.Name)
+		if len(route.Spec.AlternateBackends) > 0 {
+			formatString(out, "Weight", routeBackendWeight(route.Spec.To))
+			backends := []string{}
+			for _, backend := range route.Spec.AlternateBackends {
+				backends = append(backends, fmt.Sprintf("%s (weight %d)", backend.Name, routeBackendWeight(backend)))
+			}
+			formatString(out, "Alternate Backends", strings.Join(backends, ", "))
+		}
 
 		ends := "<none>"
 		if endsErr != nil {
@@ -638,6 +718,38 @@ func (d *RouteDescriber) Describe(namespace, name string) (string, error) {
 	})
 }
 
+// routeIngressStatus describes whether a router has admitted or rejected a route
+func routeIngressStatus(ingress routeapi.RouteIngress) string {
+	for _, condition := range ingress.Conditions {
+		if condition.Type != routeapi.RouteAdmitted {
+			continue
+		}
+		since := ""
+		if condition.LastTransitionTime != nil {
+			since = fmt.Sprintf(" %s ago", formatRelativeTime(condition.LastTransitionTime.Time))
+		}
+		switch condition.Status {
+		case kapi.ConditionTrue:
+			return fmt.Sprintf("exposed on router %s as %s%s", ingress.RouterName, ingress.Host, since)
+		case kapi.ConditionFalse:
+			status := fmt.Sprintf("rejected by router %s%s: %s", ingress.RouterName, since, condition.Reason)
+			if len(condition.Message) > 0 {
+				status += fmt.Sprintf(" (%s)", condition.Message)
+			}
+			return status
+		}
+	}
+	return fmt.Sprintf("pending on router %s", ingress.RouterName)
+}
+
+// routeBackendWeight returns the weight of a route backend, applying the default when none is set
+func routeBackendWeight(backend routeapi.RouteTargetReference) int32 {
+	if backend.Weight == nil {
+		return routeapi.DefaultRouteTargetWeight
+	}
+	return *backend.Weight
+}
+
 // ProjectDescriber generates information about a Project
 type ProjectDescriber struct {
 	osClient   client.Interface
@@ -438,10 +438,32 @@ func printRoute(route *routeapi.Route, w io.Writer, opts kctl.PrintOptions) erro
 		}
 	}
 	_, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
-		route.Name, route.Spec.Host, route.Spec.Path, route.Spec.To.Name, labels.Set(route.Labels), insecurePolicy, tlsTerm)
+		route.Name, route.Spec.Host, route.Spec.Path, routeServices(route), labels.Set(route.Labels), insecurePolicy, tlsTerm)
 	return err
 }
 
+// routeServices returns the services a route sends traffic to, with the share of traffic
+// each receives when the route has alternate backends.
+func routeServices(route *routeapi.Route) string {
+	if len(route.Spec.AlternateBackends) == 0 {
+		return route.Spec.To.Name
+	}
+	backends := append([]routeapi.RouteTargetReference{route.Spec.To}, route.Spec.AlternateBackends...)
+	total := int32(0)
+	for _, backend := range backends {
+		total += routeBackendWeight(backend)
+	}
+	services := []string{}
+	for _, backend := range backends {
+		share := int32(0)
+		if total > 0 {
+			share = routeBackendWeight(backend) * 100 / total
+		}
+		services = append(services, fmt.Sprintf("%s(%d%%)", backend.Name, share))
+	}
+	return strings.Join(services, ",")
+}
+
 func printRouteList(routeList *routeapi.RouteList, w io.Writer, opts kctl.PrintOptions) error {
 	for _, route := range routeList.Items {
 		if err := printRoute(&route, w, opts); err != nil {
@@ -335,6 +335,7 @@ func getMarkerScanners(logsCommandName, securityPolicyCommandFormat string) []os
 		routeanalysis.FindMissingPortMapping,
 		routeanalysis.FindMissingTLSTerminationType,
 		routeanalysis.FindPathBasedPassthroughRoutes,
+		routeanalysis.FindRouteAdmissionFailures,
 		// We disable this feature by default and we don't have a capability detection for this sort of thing.  Disable this check for now.
 		// kubeanalysis.FindUnmountableSecrets,
 	}
@@ -568,6 +569,12 @@ func describeBuildInPipeline(f formatter, build *buildapi.BuildConfig, baseImage
 			return fmt.Sprintf("bc/%s custom build ", build.Name)
 		}
 		return fmt.Sprintf("bc/%s custom build of %s", build.Name, source)
+	case build.Spec.Strategy.PipelineStrategy != nil:
+		source, ok := describeSourceInPipeline(&build.Spec.Source)
+		if !ok {
+			return fmt.Sprintf("bc/%s pipeline build", build.Name)
+		}
+		return fmt.Sprintf("bc/%s pipeline build of %s", build.Name, source)
 	default:
 		return fmt.Sprintf("bc/%s unrecognized build", build.Name)
 	}
@@ -0,0 +1,33 @@
+package image
+
+import (
+	"io"
+
+	"github.com/spf13/cobra"
+
+	cmdutil "github.com/openshift/origin/pkg/cmd/util"
+)
+
+// ImageRecommendedName is the recommended command name
+const ImageRecommendedName = "image"
+
+const imageLong = `
+Manage images in remote registries
+
+These commands operate directly on Docker registries that implement the v2 API, using the
+credentials from your local Docker configuration.`
+
+// NewCmdImage groups the commands that work with images in remote registries
+func NewCmdImage(name, fullName string, out io.Writer) *cobra.Command {
+	// Parent command to which all subcommands are added.
+	cmds := &cobra.Command{
+		Use:   name,
+		Short: "Manage images in remote registries",
+		Long:  imageLong,
+		Run:   cmdutil.DefaultSubCommandRun(out),
+	}
+
+	cmds.AddCommand(NewCmdMirrorImage(MirrorImageRecommendedName, fullName+" "+MirrorImageRecommendedName, out))
+
+	return cmds
+}
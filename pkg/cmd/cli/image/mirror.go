@@ -0,0 +1,343 @@
+package image
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/docker/distribution"
+	"github.com/docker/distribution/digest"
+	"github.com/docker/distribution/manifest/schema1"
+	"github.com/docker/libtrust"
+	"github.com/golang/glog"
+	"github.com/spf13/cobra"
+	gocontext "golang.org/x/net/context"
+
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	kerrors "k8s.io/kubernetes/pkg/util/errors"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	imageapi "github.com/openshift/origin/pkg/image/api"
+	"github.com/openshift/origin/pkg/image/importer"
+)
+
+// MirrorImageRecommendedName is the recommended command name
+const MirrorImageRecommendedName = "mirror"
+
+const (
+	mirrorImageLong = `
+Copy images between registries
+
+Copies the manifest and layers of a source image to one or more destinations in registries that
+implement the Docker v2 API. Each destination is tagged with its own tag, or with the tag of the
+source if it has none. Layers that already exist in a destination are skipped, and layers of a
+source in the same registry are mounted from the source repository instead of copied when the
+registry supports it.
+
+Images are read and written with the credentials of your local Docker configuration. Signed
+manifests name the repository and tag they were pushed to, so a manifest written to a different
+repository or tag is signed again with a new key.
+
+To mirror many images at once, pass --filename with a file that holds one mapping per line in the
+form SRC DST [DST...]. Blank lines and lines starting with # are ignored.`
+
+	mirrorImageExample = `  # Copy an image from the Docker Hub to another registry
+  $ %[1]s docker.io/library/busybox:latest myregistry.com/mirror/busybox:latest
+
+  # Copy an image to several tags
+  $ %[1]s docker.io/openshift/origin:v1.2.0 myregistry.com/openshift/origin:v1.2.0 myregistry.com/openshift/origin:latest
+
+  # Copy the images listed in a file
+  $ %[1]s --filename=mappings.txt`
+)
+
+// Mapping is a source image and the destinations it is copied to.
+type Mapping struct {
+	Source       imageapi.DockerImageReference
+	Destinations []imageapi.DockerImageReference
+}
+
+// MirrorImageOptions holds the options for copying images between registries
+type MirrorImageOptions struct {
+	Mappings  []Mapping
+	Filenames []string
+	Insecure  bool
+
+	Retriever importer.RepositoryRetriever
+	Out       io.Writer
+
+	// signingKey signs manifests written to a different repository or tag than their source.
+	signingKey libtrust.PrivateKey
+}
+
+// NewCmdMirrorImage implements the OpenShift cli image mirror command
+func NewCmdMirrorImage(name, fullName string, out io.Writer) *cobra.Command {
+	opts := &MirrorImageOptions{}
+
+	cmd := &cobra.Command{
+		Use:     fmt.Sprintf("%s SRC DST [DST...]", name),
+		Short:   "Copy images between registries",
+		Long:    mirrorImageLong,
+		Example: fmt.Sprintf(mirrorImageExample, fullName),
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := opts.Complete(args, out); err != nil {
+				kcmdutil.CheckErr(kcmdutil.UsageError(cmd, "%s", err.Error()))
+			}
+			kcmdutil.CheckErr(opts.Run())
+		},
+	}
+
+	cmd.Flags().StringSliceVarP(&opts.Filenames, "filename", "f", opts.Filenames, "A file of mappings from a source image to its destinations, one per line.")
+	cmd.Flags().BoolVar(&opts.Insecure, "insecure", opts.Insecure, "Allow registries to be accessed over HTTP or without verifying their certificates.")
+
+	return cmd
+}
+
+// Complete the options for image mirror
+func (o *MirrorImageOptions) Complete(args []string, out io.Writer) error {
+	switch {
+	case len(args) == 1:
+		return errors.New("at least one destination must be specified")
+	case len(args) > 1:
+		mapping, err := parseMapping(args)
+		if err != nil {
+			return err
+		}
+		o.Mappings = append(o.Mappings, mapping)
+	}
+	for _, filename := range o.Filenames {
+		f, err := os.Open(filename)
+		if err != nil {
+			return err
+		}
+		mappings, err := parseMappings(f)
+		f.Close()
+		if err != nil {
+			return fmt.Errorf("%s: %v", filename, err)
+		}
+		o.Mappings = append(o.Mappings, mappings...)
+	}
+	if len(o.Mappings) == 0 {
+		return errors.New("a source and destination or --filename must be specified")
+	}
+	o.Out = out
+
+	insecureTransport, err := kclient.TransportFor(&kclient.Config{Insecure: true})
+	if err != nil {
+		return err
+	}
+	o.Retriever = importer.NewContext(http.DefaultTransport, insecureTransport).
+		WithActions("pull", "push").
+		WithCredentials(importer.NewLocalCredentials())
+	return nil
+}
+
+// parseMappings reads one mapping of the form SRC DST [DST...] per line, skipping blank lines and
+// comments.
+func parseMappings(r io.Reader) ([]Mapping, error) {
+	var mappings []Mapping
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if len(text) == 0 || strings.HasPrefix(text, "#") {
+			continue
+		}
+		fields := strings.Fields(text)
+		if len(fields) < 2 {
+			return nil, fmt.Errorf("line %d: a source and at least one destination must be specified", line)
+		}
+		mapping, err := parseMapping(fields)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", line, err)
+		}
+		mappings = append(mappings, mapping)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return mappings, nil
+}
+
+// parseMapping returns the mapping of the first image reference to the remaining ones. A source without
+// a tag or digest refers to the latest tag, and a destination without a tag uses the tag of the source.
+func parseMapping(refs []string) (Mapping, error) {
+	src, err := imageapi.ParseDockerImageReference(refs[0])
+	if err != nil {
+		return Mapping{}, fmt.Errorf("invalid source %q: %v", refs[0], err)
+	}
+	if len(src.Tag) == 0 && len(src.ID) == 0 {
+		src.Tag = imageapi.DefaultImageTag
+	}
+	mapping := Mapping{Source: src}
+	for _, s := range refs[1:] {
+		dst, err := imageapi.ParseDockerImageReference(s)
+		if err != nil {
+			return Mapping{}, fmt.Errorf("invalid destination %q: %v", s, err)
+		}
+		if len(dst.ID) > 0 {
+			return Mapping{}, fmt.Errorf("destination %q may not specify a digest", s)
+		}
+		if len(dst.Tag) == 0 {
+			if len(src.ID) > 0 {
+				return Mapping{}, fmt.Errorf("destination %q must specify a tag when the source is referenced by digest", s)
+			}
+			dst.Tag = src.Tag
+		}
+		mapping.Destinations = append(mapping.Destinations, dst)
+	}
+	return mapping, nil
+}
+
+// Run copies each source image to its destinations. All mappings are attempted, and the errors of
+// those that failed are returned together.
+func (o *MirrorImageOptions) Run() error {
+	ctx := gocontext.Background()
+	var errs []error
+	for _, mapping := range o.Mappings {
+		if err := o.mirror(ctx, mapping); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return kerrors.NewAggregate(errs)
+}
+
+// mirror copies the source image of mapping to each of its destinations.
+func (o *MirrorImageOptions) mirror(ctx gocontext.Context, mapping Mapping) error {
+	src := mapping.Source.DockerClientDefaults()
+	srcRepo, err := o.Retriever.Repository(ctx, src.RegistryURL(), src.RepositoryName(), o.Insecure)
+	if err != nil {
+		return fmt.Errorf("unable to connect to the registry of %s: %v", mapping.Source.Exact(), err)
+	}
+	manifests, err := srcRepo.Manifests(ctx)
+	if err != nil {
+		return err
+	}
+	var manifest *schema1.SignedManifest
+	if len(src.ID) > 0 {
+		dgst, err := digest.ParseDigest(src.ID)
+		if err != nil {
+			return fmt.Errorf("invalid digest for %s: %v", mapping.Source.Exact(), err)
+		}
+		manifest, err = manifests.Get(dgst)
+	} else {
+		manifest, err = manifests.GetByTag(src.Tag)
+	}
+	if err != nil {
+		return fmt.Errorf("unable to retrieve image %s: %v", mapping.Source.Exact(), err)
+	}
+
+	// layers are copied once to each destination repository, no matter how many tags it receives
+	copied := sets.NewString()
+	for _, dst := range mapping.Destinations {
+		dst = dst.DockerClientDefaults()
+		dstRepo, err := o.Retriever.Repository(ctx, dst.RegistryURL(), dst.RepositoryName(), o.Insecure)
+		if err != nil {
+			return fmt.Errorf("unable to connect to the registry of %s: %v", dst.Exact(), err)
+		}
+		if key := dst.AsRepository().Exact(); !copied.Has(key) {
+			if err := copyLayers(ctx, manifest, src, srcRepo, dst, dstRepo); err != nil {
+				return fmt.Errorf("unable to copy the layers of %s to %s: %v", mapping.Source.Exact(), dst.Exact(), err)
+			}
+			copied.Insert(key)
+		}
+		if err := o.putManifest(ctx, manifest, dst, dstRepo); err != nil {
+			return fmt.Errorf("unable to push the manifest of %s to %s: %v", mapping.Source.Exact(), dst.Exact(), err)
+		}
+		fmt.Fprintf(o.Out, "%s -> %s\n", mapping.Source.Exact(), dst.Exact())
+	}
+	return nil
+}
+
+// copyLayers ensures that each layer of manifest exists in the destination repository. Layers are
+// mounted from the source repository when both are in the same registry and the registry supports it,
+// and copied otherwise.
+func copyLayers(ctx gocontext.Context, manifest *schema1.SignedManifest, src imageapi.DockerImageReference, srcRepo distribution.Repository, dst imageapi.DockerImageReference, dstRepo distribution.Repository) error {
+	mounter, canMount := dstRepo.(importer.BlobMounter)
+	canMount = canMount && src.Registry == dst.Registry && src.RepositoryName() != dst.RepositoryName()
+
+	srcBlobs, dstBlobs := srcRepo.Blobs(ctx), dstRepo.Blobs(ctx)
+	seen := sets.NewString()
+	for _, layer := range manifest.FSLayers {
+		dgst := layer.BlobSum
+		if seen.Has(dgst.String()) {
+			continue
+		}
+		seen.Insert(dgst.String())
+
+		if _, err := dstBlobs.Stat(ctx, dgst); err == nil {
+			glog.V(4).Infof("Layer %s already exists in %s", dgst, dst.Exact())
+			continue
+		}
+		if canMount {
+			mounted, err := mounter.MountBlob(ctx, src.RepositoryName(), dgst)
+			if err != nil {
+				glog.V(4).Infof("Unable to mount layer %s, copying it instead: %v", dgst, err)
+			}
+			if mounted {
+				glog.V(4).Infof("Mounted layer %s from %s into %s", dgst, src.Exact(), dst.Exact())
+				continue
+			}
+		}
+		if err := copyBlob(ctx, dgst, srcBlobs, dstBlobs); err != nil {
+			return err
+		}
+		glog.V(4).Infof("Copied layer %s from %s to %s", dgst, src.Exact(), dst.Exact())
+	}
+	return nil
+}
+
+// copyBlob streams the blob dgst from one blob store to another.
+func copyBlob(ctx gocontext.Context, dgst digest.Digest, from, to distribution.BlobStore) error {
+	desc, err := from.Stat(ctx, dgst)
+	if err != nil {
+		return fmt.Errorf("unable to find layer %s: %v", dgst, err)
+	}
+	r, err := from.Open(ctx, dgst)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	w, err := to.Create(ctx)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(w, r); err != nil {
+		w.Cancel(ctx)
+		return fmt.Errorf("unable to upload layer %s: %v", dgst, err)
+	}
+	if _, err := w.Commit(ctx, desc); err != nil {
+		return fmt.Errorf("unable to upload layer %s: %v", dgst, err)
+	}
+	return nil
+}
+
+// putManifest writes manifest to the tag of dst, signing it again if its repository or tag differ.
+func (o *MirrorImageOptions) putManifest(ctx gocontext.Context, manifest *schema1.SignedManifest, dst imageapi.DockerImageReference, dstRepo distribution.Repository) error {
+	if name := dst.RepositoryName(); manifest.Name != name || manifest.Tag != dst.Tag {
+		if o.signingKey == nil {
+			key, err := libtrust.GenerateECP256PrivateKey()
+			if err != nil {
+				return err
+			}
+			o.signingKey = key
+		}
+		m := manifest.Manifest
+		m.Name, m.Tag = name, dst.Tag
+		signed, err := schema1.Sign(&m, o.signingKey)
+		if err != nil {
+			return err
+		}
+		manifest = signed
+	}
+	manifests, err := dstRepo.Manifests(ctx)
+	if err != nil {
+		return err
+	}
+	return manifests.Put(manifest)
+}
@@ -0,0 +1,292 @@
+package image
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/docker/distribution/digest"
+	"github.com/docker/distribution/manifest"
+	"github.com/docker/distribution/manifest/schema1"
+	"github.com/docker/libtrust"
+
+	"github.com/openshift/origin/pkg/image/importer"
+)
+
+func TestParseMappings(t *testing.T) {
+	tests := map[string]struct {
+		input    string
+		expected [][]string
+		err      string
+	}{
+		"mappings with comments": {
+			input: `
+# mirror the etcd images
+quay.io/coreos/etcd:v2.2.2 myregistry.com/coreos/etcd myregistry.com/coreos/etcd:latest
+
+docker.io/library/busybox myregistry.com/library/busybox:1
+`,
+			expected: [][]string{
+				{"quay.io/coreos/etcd:v2.2.2", "myregistry.com/coreos/etcd:v2.2.2", "myregistry.com/coreos/etcd:latest"},
+				{"docker.io/library/busybox:latest", "myregistry.com/library/busybox:1"},
+			},
+		},
+		"source by digest": {
+			input:    "quay.io/coreos/etcd@sha256:958101d3b5b4b3e2e2e6d1d9f1a6fb0ba4e2df40c4d0ea3a07dbce2a1fc57e8b myregistry.com/coreos/etcd:stable",
+			expected: [][]string{{"quay.io/coreos/etcd@sha256:958101d3b5b4b3e2e2e6d1d9f1a6fb0ba4e2df40c4d0ea3a07dbce2a1fc57e8b", "myregistry.com/coreos/etcd:stable"}},
+		},
+		"destination without a tag for a digest": {
+			input: "quay.io/coreos/etcd@sha256:958101d3b5b4b3e2e2e6d1d9f1a6fb0ba4e2df40c4d0ea3a07dbce2a1fc57e8b myregistry.com/coreos/etcd",
+			err:   "line 1: destination \"myregistry.com/coreos/etcd\" must specify a tag",
+		},
+		"destination with a digest": {
+			input: "quay.io/coreos/etcd myregistry.com/coreos/etcd@sha256:958101d3b5b4b3e2e2e6d1d9f1a6fb0ba4e2df40c4d0ea3a07dbce2a1fc57e8b",
+			err:   "may not specify a digest",
+		},
+		"missing destination": {
+			input: "\nquay.io/coreos/etcd\n",
+			err:   "line 2: a source and at least one destination must be specified",
+		},
+		"invalid source": {
+			input: "quay.io/coreos/etcd/server/v2 myregistry.com/coreos/etcd",
+			err:   "invalid source",
+		},
+	}
+
+	for name, test := range tests {
+		mappings, err := parseMappings(strings.NewReader(test.input))
+		if len(test.err) > 0 {
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Errorf("%s: expected error containing %q, got %v", name, test.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		var actual [][]string
+		for _, mapping := range mappings {
+			refs := []string{mapping.Source.Exact()}
+			for _, dst := range mapping.Destinations {
+				refs = append(refs, dst.Exact())
+			}
+			actual = append(actual, refs)
+		}
+		if fmt.Sprintf("%v", actual) != fmt.Sprintf("%v", test.expected) {
+			t.Errorf("%s: expected %v, got %v", name, test.expected, actual)
+		}
+	}
+}
+
+// fakeRegistry is a minimal V2 registry that stores blobs and manifests in memory.
+type fakeRegistry struct {
+	lock sync.Mutex
+	// mount is true if the registry supports cross repository blob mounts
+	mount bool
+	// blobs holds the content of each blob by repository and digest
+	blobs map[string]map[string][]byte
+	// manifests holds each manifest by repository and tag
+	manifests map[string]map[string][]byte
+	// uploaded and mounted record the "repository@digest" of each blob that was written
+	uploaded, mounted []string
+}
+
+func newFakeRegistry(mount bool) *fakeRegistry {
+	return &fakeRegistry{
+		mount:     mount,
+		blobs:     make(map[string]map[string][]byte),
+		manifests: make(map[string]map[string][]byte),
+	}
+}
+
+func (r *fakeRegistry) put(store map[string]map[string][]byte, repo, key string, data []byte) {
+	if store[repo] == nil {
+		store[repo] = make(map[string][]byte)
+	}
+	store[repo][key] = data
+}
+
+func (r *fakeRegistry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	// uploads stream from the source registry, which may be this one, so read them before locking
+	data, _ := ioutil.ReadAll(req.Body)
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	w.Header().Set("Docker-Distribution-API-Version", "registry/2.0")
+	p := req.URL.Path
+	if p == "/v2/" {
+		return
+	}
+	var repo, kind, rest string
+	for _, k := range []string{"/blobs/uploads/", "/blobs/", "/manifests/"} {
+		if i := strings.Index(p, k); i != -1 {
+			repo, kind, rest = strings.TrimPrefix(p[:i], "/v2/"), k, p[i+len(k):]
+			break
+		}
+	}
+
+	switch {
+	case kind == "/manifests/" && req.Method == "GET":
+		manifest, ok := r.manifests[repo][rest]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write(manifest)
+	case kind == "/manifests/" && req.Method == "PUT":
+		r.put(r.manifests, repo, rest, data)
+		w.WriteHeader(http.StatusCreated)
+	case kind == "/blobs/":
+		blob, ok := r.blobs[repo][rest]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Length", fmt.Sprintf("%d", len(blob)))
+		if req.Method == "GET" {
+			w.Write(blob)
+		}
+	case kind == "/blobs/uploads/" && req.Method == "POST":
+		dgst, from := req.URL.Query().Get("mount"), req.URL.Query().Get("from")
+		if blob, ok := r.blobs[from][dgst]; ok && r.mount {
+			r.put(r.blobs, repo, dgst, blob)
+			r.mounted = append(r.mounted, repo+"@"+dgst)
+			w.WriteHeader(http.StatusCreated)
+			return
+		}
+		w.Header().Set("Location", "/v2/"+repo+"/blobs/uploads/1")
+		w.WriteHeader(http.StatusAccepted)
+	case kind == "/blobs/uploads/" && req.Method == "PATCH":
+		r.put(r.blobs, repo, "upload", data)
+		w.Header().Set("Location", p)
+		w.Header().Set("Range", fmt.Sprintf("0-%d", len(data)-1))
+		w.WriteHeader(http.StatusAccepted)
+	case kind == "/blobs/uploads/" && req.Method == "PUT":
+		dgst := req.URL.Query().Get("digest")
+		r.put(r.blobs, repo, dgst, r.blobs[repo]["upload"])
+		r.uploaded = append(r.uploaded, repo+"@"+dgst)
+		w.WriteHeader(http.StatusCreated)
+	case kind == "/blobs/uploads/" && req.Method == "DELETE":
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestMirrorImage(t *testing.T) {
+	layers := [][]byte{[]byte("layer one"), []byte("layer two")}
+	m := &schema1.Manifest{
+		Versioned: manifest.Versioned{SchemaVersion: 1},
+		Name:      "coreos/etcd",
+		Tag:       "v2",
+	}
+	for _, layer := range layers {
+		dgst, _ := digest.FromBytes(layer)
+		m.FSLayers = append(m.FSLayers, schema1.FSLayer{BlobSum: dgst})
+		m.History = append(m.History, schema1.History{V1Compatibility: "{}"})
+	}
+	// a repeated layer is only copied once
+	m.FSLayers = append(m.FSLayers, m.FSLayers[0])
+	m.History = append(m.History, m.History[0])
+	key, err := libtrust.GenerateECP256PrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	signed, err := schema1.Sign(m, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		sameRegistry bool
+		mount        bool
+		uploaded     int
+		mounted      int
+	}{
+		"another registry": {
+			uploaded: 2,
+		},
+		"same registry with mount": {
+			sameRegistry: true,
+			mount:        true,
+			mounted:      2,
+		},
+		"same registry without mount": {
+			sameRegistry: true,
+			uploaded:     2,
+		},
+	}
+
+	for name, test := range tests {
+		source := newFakeRegistry(test.mount)
+		for i, layer := range layers {
+			source.put(source.blobs, "coreos/etcd", m.FSLayers[i].BlobSum.String(), layer)
+		}
+		source.put(source.manifests, "coreos/etcd", "v2", signed.Raw)
+		sourceServer := httptest.NewServer(source)
+
+		target, targetServer := source, sourceServer
+		if !test.sameRegistry {
+			target = newFakeRegistry(test.mount)
+			targetServer = httptest.NewServer(target)
+		}
+		sourceURL, _ := url.Parse(sourceServer.URL)
+		targetURL, _ := url.Parse(targetServer.URL)
+
+		mapping, err := parseMapping([]string{
+			sourceURL.Host + "/coreos/etcd:v2",
+			targetURL.Host + "/mirror/etcd",
+			targetURL.Host + "/mirror/etcd:latest",
+		})
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		out := &bytes.Buffer{}
+		opts := &MirrorImageOptions{
+			Mappings:  []Mapping{mapping},
+			Insecure:  true,
+			Retriever: importer.NewContext(http.DefaultTransport, http.DefaultTransport).WithActions("pull", "push").WithCredentials(importer.NoCredentials),
+			Out:       out,
+		}
+		err = opts.Run()
+		sourceServer.Close()
+		targetServer.Close()
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+
+		if len(target.uploaded) != test.uploaded || len(target.mounted) != test.mounted {
+			t.Errorf("%s: expected %d uploaded and %d mounted layers, got %v and %v", name, test.uploaded, test.mounted, target.uploaded, target.mounted)
+		}
+		for i, layer := range layers {
+			if data := target.blobs["mirror/etcd"][m.FSLayers[i].BlobSum.String()]; !bytes.Equal(data, layer) {
+				t.Errorf("%s: layer %d was not copied: %q", name, i, data)
+			}
+		}
+		for _, tag := range []string{"v2", "latest"} {
+			pushed := &schema1.SignedManifest{}
+			if err := json.Unmarshal(target.manifests["mirror/etcd"][tag], pushed); err != nil {
+				t.Errorf("%s: unable to read manifest %s: %v", name, tag, err)
+				continue
+			}
+			if pushed.Name != "mirror/etcd" || pushed.Tag != tag {
+				t.Errorf("%s: expected manifest for mirror/etcd:%s, got %s:%s", name, tag, pushed.Name, pushed.Tag)
+			}
+			if _, err := schema1.Verify(pushed); err != nil {
+				t.Errorf("%s: manifest %s has an invalid signature: %v", name, tag, err)
+			}
+		}
+		if lines := strings.Count(out.String(), "\n"); lines != 2 {
+			t.Errorf("%s: expected a line per destination, got %q", name, out.String())
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package dockerregistry
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/docker/distribution/configuration"
+	"github.com/docker/distribution/context"
+	"github.com/docker/distribution/registry/storage"
+	"github.com/docker/distribution/registry/storage/driver/factory"
+	"github.com/docker/docker/pkg/units"
+
+	"github.com/openshift/origin/pkg/dockerregistry/server"
+)
+
+// ExecutePrune removes the data in the registry storage described by configFile that is not referenced by
+// any image in the API, and writes a report of the removed data to out. If dryRun is true the data is only
+// reported. The registry may be running while it is pruned as long as it is in read-only mode
+// (storage.maintenance.readonly.enabled), otherwise layers of pushes in progress would be removed.
+func ExecutePrune(configFile io.Reader, dryRun bool, out io.Writer) {
+	config, err := configuration.Parse(configFile)
+	if err != nil {
+		log.Fatalf("Error parsing configuration file: %s", err)
+	}
+
+	ctx := context.Background()
+	ctx, err = configureLogging(ctx, config)
+	if err != nil {
+		log.Fatalf("error configuring logger: %v", err)
+	}
+
+	driver, err := factory.Create(config.Storage.Type(), config.Storage.Parameters())
+	if err != nil {
+		log.Fatalf("Error creating storage driver: %v", err)
+	}
+	registry, err := storage.NewRegistry(ctx, driver, storage.EnableDelete, storage.RemoveParentsOnDelete)
+	if err != nil {
+		log.Fatalf("Error creating registry: %v", err)
+	}
+	registryClient, err := server.NewRegistryOpenShiftClient()
+	if err != nil {
+		log.Fatalf("Error creating OpenShift client: %v", err)
+	}
+
+	report, err := server.HardPrune(ctx, registry, registryClient, dryRun)
+	if err != nil {
+		log.Fatalf("Error pruning the registry storage: %v", err)
+	}
+	printPruneReport(report, dryRun, out)
+}
+
+// printPruneReport writes the data removed from each repository and the space freed in storage to out.
+func printPruneReport(report *server.PruneReport, dryRun bool, out io.Writer) {
+	w := tabwriter.NewWriter(out, 10, 4, 3, ' ', 0)
+	fmt.Fprintln(w, "REPOSITORY\tMANIFESTS\tLAYERS\tSIZE")
+	for _, repo := range report.Repositories {
+		fmt.Fprintf(w, "%s\t%d\t%d\t%s\n", repo.Name, len(repo.Manifests), len(repo.Layers), units.BytesSize(float64(repo.Size)))
+	}
+	w.Flush()
+
+	verb := "Deleted"
+	if dryRun {
+		verb = "Would delete"
+	}
+	fmt.Fprintf(out, "\n%s %d blobs, freeing %s\n", verb, len(report.Blobs), units.BytesSize(float64(report.Size)))
+}
@@ -40,6 +40,10 @@ type F5RouterOptions struct {
 
 // F5Router is the config necessary to start an F5 router plugin.
 type F5Router struct {
+	// RouterName is the name the router identifies itself with in the status
+	// of the routes it admits.
+	RouterName string
+
 	// Host specifies the hostname or IP address of the F5 BIG-IP host.
 	Host string
 
@@ -76,6 +80,7 @@ type F5Router struct {
 
 // Bind binds F5Router arguments to flags
 func (o *F5Router) Bind(flag *pflag.FlagSet) {
+	flag.StringVar(&o.RouterName, "name", util.Env("ROUTER_SERVICE_NAME", "public"), "The name the router will identify itself with in the route status")
 	flag.StringVar(&o.Host, "f5-host", util.Env("ROUTER_EXTERNAL_HOST_HOSTNAME", ""), "The host of F5 BIG-IP's management interface")
 	flag.StringVar(&o.Username, "f5-username", util.Env("ROUTER_EXTERNAL_HOST_USERNAME", ""), "The username for F5 BIG-IP's management utility")
 	flag.StringVar(&o.Password, "f5-password", util.Env("ROUTER_EXTERNAL_HOST_PASSWORD", ""), "The password for F5 BIG-IP's management utility")
@@ -167,13 +172,14 @@ func (o *F5RouterOptions) Run() error {
 		return err
 	}
 
-	plugin := controller.NewUniqueHost(f5Plugin, o.RouteSelectionFunc())
-
 	oc, kc, err := o.Config.Clients()
 	if err != nil {
 		return err
 	}
 
+	statusPlugin := controller.NewStatusAdmitter(f5Plugin, oc, o.RouterName)
+	plugin := controller.NewUniqueHost(statusPlugin, o.RouteSelectionFunc(), o.RouterSelection.AllowWildcardRoutes, statusPlugin)
+
 	factory := o.RouterSelection.NewFactory(oc, kc)
 	controller := factory.Create(plugin)
 	controller.Run()
@@ -3,19 +3,25 @@ package router
 import (
 	"errors"
 	"fmt"
+	"net"
+	"net/http"
 	"strconv"
 	"time"
 
 	"github.com/golang/glog"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/client/record"
 	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
 	ktypes "k8s.io/kubernetes/pkg/types"
 
 	"github.com/openshift/origin/pkg/cmd/util"
 	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
 	"github.com/openshift/origin/pkg/router/controller"
+	"github.com/openshift/origin/pkg/router/metrics"
 	"github.com/openshift/origin/pkg/util/proc"
 	"github.com/openshift/origin/pkg/version"
 	templateplugin "github.com/openshift/origin/plugins/router/template"
@@ -45,6 +51,7 @@ type TemplateRouterOptions struct {
 }
 
 type TemplateRouter struct {
+	RouterName         string
 	WorkingDir         string
 	TemplateFile       string
 	ReloadScript       string
@@ -54,6 +61,7 @@ type TemplateRouter struct {
 }
 
 func (o *TemplateRouter) Bind(flag *pflag.FlagSet) {
+	flag.StringVar(&o.RouterName, "name", util.Env("ROUTER_SERVICE_NAME", "public"), "The name the router will identify itself with in the route status")
 	flag.StringVar(&o.WorkingDir, "working-dir", "/var/lib/containers/router", "The working directory for the router plugin")
 	flag.StringVar(&o.DefaultCertificate, "default-certificate", util.Env("DEFAULT_CERTIFICATE", ""), "A path to default certificate to use for routes that don't expose a TLS server cert; in PEM format")
 	flag.StringVar(&o.TemplateFile, "template", util.Env("TEMPLATE_FILE", ""), "The path to the template file to use")
@@ -76,12 +84,19 @@ type RouterStats struct {
 	StatsUsername   string
 
 	StatsPort int
+
+	MetricsPortString string
+	MetricsSource     string
+
+	MetricsPort int
 }
 
 func (o *RouterStats) Bind(flag *pflag.FlagSet) {
 	flag.StringVar(&o.StatsPortString, "stats-port", util.Env("STATS_PORT", ""), "If the underlying router implementation can provide statistics this is a hint to expose it on this port.")
 	flag.StringVar(&o.StatsPassword, "stats-password", util.Env("STATS_PASSWORD", ""), "If the underlying router implementation can provide statistics this is the requested password for auth.")
 	flag.StringVar(&o.StatsUsername, "stats-user", util.Env("STATS_USERNAME", ""), "If the underlying router implementation can provide statistics this is the requested username for auth.")
+	flag.StringVar(&o.MetricsPortString, "metrics-port", util.Env("ROUTER_METRICS_PORT", ""), "If set, the router and the statistics of its routes are exposed in Prometheus format on this port at /metrics.")
+	flag.StringVar(&o.MetricsSource, "metrics-source", util.Env("ROUTER_METRICS_SOURCE", "unix:///var/lib/haproxy/run/haproxy.sock"), "The HAProxy statistics to expose as metrics, either the stats socket as unix:///path or the URL of the stats page.")
 }
 
 // NewCommndTemplateRouter provides CLI handler for the template router backend
@@ -135,6 +150,14 @@ func (o *TemplateRouterOptions) Complete() error {
 		o.StatsPort = statsPort
 	}
 
+	if len(o.MetricsPortString) > 0 {
+		metricsPort, err := strconv.Atoi(o.MetricsPortString)
+		if err != nil {
+			return fmt.Errorf("metrics port is not valid: %v", err)
+		}
+		o.MetricsPort = metricsPort
+	}
+
 	return o.RouterSelection.Complete()
 }
 
@@ -146,11 +169,40 @@ func (o *TemplateRouterOptions) Validate() error {
 	if len(o.ReloadScript) == 0 {
 		return errors.New("reload script must be specified")
 	}
+
+	if o.MetricsPort < 0 || o.MetricsPort > 65535 {
+		return fmt.Errorf("metrics port %d is not a valid port", o.MetricsPort)
+	}
 	return nil
 }
 
 // Run launches a template router using the provided options. It never exits.
 func (o *TemplateRouterOptions) Run() error {
+	oc, kc, err := o.Config.Clients()
+	if err != nil {
+		return err
+	}
+
+	if o.MetricsPort > 0 {
+		collector, err := metrics.NewHAProxyCollector(o.MetricsSource)
+		if err != nil {
+			return err
+		}
+		prometheus.MustRegister(collector)
+
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", prometheus.Handler())
+		listen := net.JoinHostPort("", strconv.Itoa(o.MetricsPort))
+		go func() {
+			glog.Fatal(http.ListenAndServe(listen, mux))
+		}()
+		glog.Infof("Serving router metrics on %s", listen)
+	}
+
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartRecordingToSink(kc.Events(""))
+	recorder := eventBroadcaster.NewRecorder(kapi.EventSource{Component: "router", Host: o.RouterName})
+
 	pluginCfg := templateplugin.TemplatePluginConfig{
 		WorkingDir:         o.WorkingDir,
 		TemplatePath:       o.TemplateFile,
@@ -162,6 +214,7 @@ func (o *TemplateRouterOptions) Run() error {
 		StatsPassword:      o.StatsPassword,
 		PeerService:        o.RouterService,
 		IncludeUDP:         o.RouterSelection.IncludeUDP,
+		EventRecorder:      recorder,
 	}
 
 	templatePlugin, err := templateplugin.NewTemplatePlugin(pluginCfg)
@@ -169,12 +222,8 @@ func (o *TemplateRouterOptions) Run() error {
 		return err
 	}
 
-	plugin := controller.NewUniqueHost(templatePlugin, o.RouteSelectionFunc())
-
-	oc, kc, err := o.Config.Clients()
-	if err != nil {
-		return err
-	}
+	statusPlugin := controller.NewStatusAdmitter(templatePlugin, oc, o.RouterName)
+	plugin := controller.NewUniqueHost(statusPlugin, o.RouteSelectionFunc(), o.RouterSelection.AllowWildcardRoutes, statusPlugin)
 
 	factory := o.RouterSelection.NewFactory(oc, kc)
 	controller := factory.Create(plugin)
@@ -190,6 +190,15 @@ func GetMasterFileReferences(config *MasterConfig) []*string {
 			case (*OpenIDIdentityProvider):
 				refs = append(refs, &provider.CA)
 
+			case (*GitHubIdentityProvider):
+				refs = append(refs, &provider.CA)
+
+			case (*GitLabIdentityProvider):
+				refs = append(refs, &provider.CA)
+
+			case (*SAMLIdentityProvider):
+				refs = append(refs, &provider.Certificate)
+
 			}
 		}
 
@@ -219,6 +228,17 @@ func GetMasterFileReferences(config *MasterConfig) []*string {
 		}
 	}
 
+	if config.BuildLogArchiveConfig != nil {
+		refs = append(refs, &config.BuildLogArchiveConfig.Directory)
+	}
+
+	for i := range config.ImagePolicyConfig.RegistryMirrors {
+		mirrors := config.ImagePolicyConfig.RegistryMirrors[i].Mirrors
+		for j := range mirrors {
+			refs = append(refs, &mirrors[j].CA)
+		}
+	}
+
 	if config.KubernetesMasterConfig != nil {
 		refs = append(refs, &config.KubernetesMasterConfig.SchedulerConfigFile)
 
@@ -475,7 +495,9 @@ func IsIdentityProviderType(provider runtime.EmbeddedObject) bool {
 		(*KeystonePasswordIdentityProvider),
 		(*OpenIDIdentityProvider),
 		(*GitHubIdentityProvider),
-		(*GoogleIdentityProvider):
+		(*GitLabIdentityProvider),
+		(*GoogleIdentityProvider),
+		(*SAMLIdentityProvider):
 
 		return true
 	}
@@ -488,6 +510,7 @@ func IsOAuthIdentityProvider(provider IdentityProvider) bool {
 	case
 		(*OpenIDIdentityProvider),
 		(*GitHubIdentityProvider),
+		(*GitLabIdentityProvider),
 		(*GoogleIdentityProvider):
 
 		return true
@@ -36,8 +36,10 @@ func init() {
 		&KeystonePasswordIdentityProvider{},
 		&RequestHeaderIdentityProvider{},
 		&GitHubIdentityProvider{},
+		&GitLabIdentityProvider{},
 		&GoogleIdentityProvider{},
 		&OpenIDIdentityProvider{},
+		&SAMLIdentityProvider{},
 		&GrantConfig{},
 		&AdmissionPluginConfig{},
 
@@ -54,8 +56,10 @@ func (*LDAPPasswordIdentityProvider) IsAnAPIObject()      {}
 func (*KeystonePasswordIdentityProvider) IsAnAPIObject()  {}
 func (*RequestHeaderIdentityProvider) IsAnAPIObject()     {}
 func (*GitHubIdentityProvider) IsAnAPIObject()            {}
+func (*GitLabIdentityProvider) IsAnAPIObject()            {}
 func (*GoogleIdentityProvider) IsAnAPIObject()            {}
 func (*OpenIDIdentityProvider) IsAnAPIObject()            {}
+func (*SAMLIdentityProvider) IsAnAPIObject()              {}
 func (*GrantConfig) IsAnAPIObject()                       {}
 func (*AdmissionPluginConfig) IsAnAPIObject()             {}
 
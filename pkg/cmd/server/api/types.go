@@ -219,6 +219,12 @@ type MasterConfig struct {
 	AssetConfig *AssetConfig
 	// DNSConfig, if present start the DNS server in this process
 	DNSConfig *DNSConfig
+	// BuildLogArchiveConfig, if present archive the logs of finished builds so they remain available
+	// after the build pods are deleted
+	BuildLogArchiveConfig *BuildLogArchiveConfig
+	// PipelineBuildConfig, if present enables the Pipeline build strategy. Pipeline builds fail when it is
+	// not set.
+	PipelineBuildConfig *PipelineBuildConfig
 
 	// ServiceAccountConfig holds options related to service accounts
 	ServiceAccountConfig ServiceAccountConfig
@@ -258,6 +264,30 @@ type ImagePolicyConfig struct {
 	// MaxScheduledImageImportsPerMinute is the maximum number of image streams that will be imported in the background per minute.
 	// The default value is 60. Set to -1 for unlimited.
 	MaxScheduledImageImportsPerMinute int `json:"maxScheduledImageImportsPerMinute"`
+	// RegistryMirrors lists the mirrors of remote registries or repositories that are tried in order when images are imported,
+	// before falling back to the source. The source pull spec is still recorded on the image stream.
+	RegistryMirrors []RegistryMirrorConfig
+}
+
+// RegistryMirrorConfig maps a registry or repository to its mirrors.
+type RegistryMirrorConfig struct {
+	// Source is the registry host, optionally followed by a repository prefix, whose images are mirrored, e.g. docker.io or
+	// quay.io/coreos.
+	Source string
+	// Mirrors are the locations of the mirrors of Source, in the order they are tried.
+	Mirrors []RegistryMirror
+}
+
+// RegistryMirror is a location serving the same images as a mirrored registry or repository.
+type RegistryMirror struct {
+	// Location is the registry host of the mirror, optionally followed by the repository prefix that replaces the mirrored
+	// prefix, e.g. mirror.example.com:5000/dockerhub.
+	Location string
+	// Insecure allows the mirror to be accessed over HTTP or without verifying its certificate.
+	Insecure bool
+	// CA is the path of a file containing the certificate authorities used to verify the certificate of the mirror. The system
+	// certificate authorities are used if empty.
+	CA string
 }
 
 type ProjectConfig struct {
@@ -422,6 +452,26 @@ type DNSConfig struct {
 	BindNetwork string
 }
 
+// BuildLogArchiveConfig holds the location the logs of finished builds are archived to.
+type BuildLogArchiveConfig struct {
+	// Directory holds the archived logs, one file per build. It should be on persistent storage that is
+	// shared by all masters.
+	Directory string
+	// MaxBytesPerBuild is the maximum size of an archived log. Only the end of longer logs is archived.
+	// 0 means no limit.
+	MaxBytesPerBuild int64
+}
+
+// PipelineBuildConfig holds the configuration of the Pipeline build strategy.
+type PipelineBuildConfig struct {
+	// RunnerImage is the pull spec of the pipeline runner image that executes the pipelines of Pipeline
+	// builds. The runner receives the build in the BUILD environment variable and the pipeline definition in
+	// PIPELINE_DEFINITION or PIPELINE_DEFINITION_PATH. It reports the stages of the pipeline by updating
+	// status.stages through the builds/details subresource, and its exit code decides whether the build
+	// completed or failed.
+	RunnerImage string
+}
+
 type AssetConfig struct {
 	ServingInfo HTTPServingInfo
 
@@ -667,6 +717,32 @@ type RequestHeaderIdentityProvider struct {
 type GitHubIdentityProvider struct {
 	unversioned.TypeMeta
 
+	// ClientID is the oauth client ID
+	ClientID string
+	// ClientSecret is the oauth client secret
+	ClientSecret string
+	// Organizations optionally restricts which organizations are allowed to log in
+	Organizations []string
+	// Teams optionally restricts which teams are allowed to log in. Format is <org>/<team>.
+	Teams []string
+	// Hostname is the optional domain (e.g. "mycompany.com") for use with a hosted instance of GitHub Enterprise.
+	// It must match the GitHub Enterprise settings value that is configured at /setup/settings#hostname.
+	Hostname string
+	// CA is the optional trusted certificate authority bundle to use when making requests to the server.
+	// If empty, the default system roots are used. This can only be configured when hostname is set to a non-empty value.
+	CA string
+}
+
+type GitLabIdentityProvider struct {
+	unversioned.TypeMeta
+
+	// CA is the optional trusted certificate authority bundle to use when making requests to the server
+	// If empty, the default system roots are used
+	CA string
+
+	// URL is the oauth server base URL
+	URL string
+
 	// ClientID is the oauth client ID
 	ClientID string
 	// ClientSecret is the oauth client secret
@@ -710,6 +786,44 @@ type OpenIDIdentityProvider struct {
 	Claims OpenIDClaims
 }
 
+type SAMLIdentityProvider struct {
+	unversioned.TypeMeta
+
+	// SSOURL is the single sign-on URL of the identity provider, to which authentication requests are sent
+	SSOURL string
+	// SSOBinding is the binding used to send authentication requests to the SSO URL, HTTP-Redirect or HTTP-POST
+	SSOBinding SAMLBindingType
+	// IdentityProviderEntityID is the optional entity ID of the identity provider. If set, assertions must be issued by it.
+	IdentityProviderEntityID string
+	// Certificate is a file containing the PEM-encoded certificates the identity provider signs responses or assertions with
+	Certificate string
+
+	// Attributes mappings
+	Attributes SAMLAttributes
+}
+
+type SAMLBindingType string
+
+const (
+	// SAMLBindingHTTPRedirect sends authentication requests in the query of a redirect
+	SAMLBindingHTTPRedirect SAMLBindingType = "HTTP-Redirect"
+	// SAMLBindingHTTPPOST sends authentication requests in a form posted by the browser
+	SAMLBindingHTTPPOST SAMLBindingType = "HTTP-POST"
+)
+
+var ValidSAMLBindingTypes = sets.NewString(string(SAMLBindingHTTPRedirect), string(SAMLBindingHTTPPOST))
+
+type SAMLAttributes struct {
+	// ID is the list of attributes whose values should be used as the user ID. If empty, the NameID of the assertion subject is used.
+	ID []string
+	// PreferredUsername is the list of attributes whose values should be used as the preferred username.
+	PreferredUsername []string
+	// Name is the list of attributes whose values should be used as the display name.
+	Name []string
+	// Email is the list of attributes whose values should be used as the email address.
+	Email []string
+}
+
 type OpenIDURLs struct {
 	// Authorize is the oauth authorization URL
 	Authorize string
@@ -148,6 +148,11 @@ func init() {
 				obj.MappingMethod = "claim"
 			}
 		},
+		func(obj *SAMLIdentityProvider) {
+			if len(obj.SSOBinding) == 0 {
+				obj.SSOBinding = SAMLBindingHTTPRedirect
+			}
+		},
 	)
 	if err != nil {
 		// If one of the conversion functions is malformed, detect it immediately.
@@ -28,8 +28,10 @@ func init() {
 		&KeystonePasswordIdentityProvider{},
 		&RequestHeaderIdentityProvider{},
 		&GitHubIdentityProvider{},
+		&GitLabIdentityProvider{},
 		&GoogleIdentityProvider{},
 		&OpenIDIdentityProvider{},
+		&SAMLIdentityProvider{},
 		&GrantConfig{},
 		&AdmissionPluginConfig{},
 
@@ -48,8 +50,10 @@ func (*LDAPPasswordIdentityProvider) IsAnAPIObject()      {}
 func (*KeystonePasswordIdentityProvider) IsAnAPIObject()  {}
 func (*RequestHeaderIdentityProvider) IsAnAPIObject()     {}
 func (*GitHubIdentityProvider) IsAnAPIObject()            {}
+func (*GitLabIdentityProvider) IsAnAPIObject()            {}
 func (*GoogleIdentityProvider) IsAnAPIObject()            {}
 func (*OpenIDIdentityProvider) IsAnAPIObject()            {}
+func (*SAMLIdentityProvider) IsAnAPIObject()              {}
 func (*GrantConfig) IsAnAPIObject()                       {}
 func (*AdmissionPluginConfig) IsAnAPIObject()             {}
 
@@ -171,6 +171,12 @@ type MasterConfig struct {
 	AssetConfig *AssetConfig `json:"assetConfig"`
 	// DNSConfig, if present start the DNS server in this process
 	DNSConfig *DNSConfig `json:"dnsConfig"`
+	// BuildLogArchiveConfig, if present archive the logs of finished builds so they remain available
+	// after the build pods are deleted
+	BuildLogArchiveConfig *BuildLogArchiveConfig `json:"buildLogArchiveConfig"`
+	// PipelineBuildConfig, if present enables the Pipeline build strategy. Pipeline builds fail when it is
+	// not set.
+	PipelineBuildConfig *PipelineBuildConfig `json:"pipelineBuildConfig"`
 
 	// ServiceAccountConfig holds options related to service accounts
 	ServiceAccountConfig ServiceAccountConfig `json:"serviceAccountConfig"`
@@ -210,6 +216,30 @@ type ImagePolicyConfig struct {
 	// MaxScheduledImageImportsPerMinute is the maximum number of scheduled image streams that will be imported in the
 	// background per minute. The default value is 60. Set to -1 for unlimited.
 	MaxScheduledImageImportsPerMinute int `json:"maxScheduledImageImportsPerMinute"`
+	// RegistryMirrors lists the mirrors of remote registries or repositories that are tried in order when images are
+	// imported, before falling back to the source. The source pull spec is still recorded on the image stream.
+	RegistryMirrors []RegistryMirrorConfig `json:"registryMirrors"`
+}
+
+// RegistryMirrorConfig maps a registry or repository to its mirrors.
+type RegistryMirrorConfig struct {
+	// Source is the registry host, optionally followed by a repository prefix, whose images are mirrored, e.g. docker.io
+	// or quay.io/coreos.
+	Source string `json:"source"`
+	// Mirrors are the locations of the mirrors of Source, in the order they are tried.
+	Mirrors []RegistryMirror `json:"mirrors"`
+}
+
+// RegistryMirror is a location serving the same images as a mirrored registry or repository.
+type RegistryMirror struct {
+	// Location is the registry host of the mirror, optionally followed by the repository prefix that replaces the
+	// mirrored prefix, e.g. mirror.example.com:5000/dockerhub.
+	Location string `json:"location"`
+	// Insecure allows the mirror to be accessed over HTTP or without verifying its certificate.
+	Insecure bool `json:"insecure"`
+	// CA is the path of a file containing the certificate authorities used to verify the certificate of the mirror. The
+	// system certificate authorities are used if empty.
+	CA string `json:"ca"`
 }
 
 type ProjectConfig struct {
@@ -376,6 +406,26 @@ type DNSConfig struct {
 	BindNetwork string `json:"bindNetwork"`
 }
 
+// BuildLogArchiveConfig holds the location the logs of finished builds are archived to.
+type BuildLogArchiveConfig struct {
+	// Directory holds the archived logs, one file per build. It should be on persistent storage that is
+	// shared by all masters.
+	Directory string `json:"directory"`
+	// MaxBytesPerBuild is the maximum size of an archived log. Only the end of longer logs is archived.
+	// 0 means no limit.
+	MaxBytesPerBuild int64 `json:"maxBytesPerBuild"`
+}
+
+// PipelineBuildConfig holds the configuration of the Pipeline build strategy.
+type PipelineBuildConfig struct {
+	// RunnerImage is the pull spec of the pipeline runner image that executes the pipelines of Pipeline
+	// builds. The runner receives the build in the BUILD environment variable and the pipeline definition in
+	// PIPELINE_DEFINITION or PIPELINE_DEFINITION_PATH. It reports the stages of the pipeline by updating
+	// status.stages through the builds/details subresource, and its exit code decides whether the build
+	// completed or failed.
+	RunnerImage string `json:"runnerImage"`
+}
+
 type AssetConfig struct {
 	ServingInfo HTTPServingInfo `json:"servingInfo"`
 
@@ -620,6 +670,32 @@ type RequestHeaderIdentityProvider struct {
 type GitHubIdentityProvider struct {
 	unversioned.TypeMeta `json:",inline"`
 
+	// ClientID is the oauth client ID
+	ClientID string `json:"clientID"`
+	// ClientSecret is the oauth client secret
+	ClientSecret string `json:"clientSecret"`
+	// Organizations optionally restricts which organizations are allowed to log in
+	Organizations []string `json:"organizations"`
+	// Teams optionally restricts which teams are allowed to log in. Format is <org>/<team>.
+	Teams []string `json:"teams"`
+	// Hostname is the optional domain (e.g. "mycompany.com") for use with a hosted instance of GitHub Enterprise.
+	// It must match the GitHub Enterprise settings value that is configured at /setup/settings#hostname.
+	Hostname string `json:"hostname"`
+	// CA is the optional trusted certificate authority bundle to use when making requests to the server.
+	// If empty, the default system roots are used. This can only be configured when hostname is set to a non-empty value.
+	CA string `json:"ca"`
+}
+
+type GitLabIdentityProvider struct {
+	unversioned.TypeMeta `json:",inline"`
+
+	// CA is the optional trusted certificate authority bundle to use when making requests to the server
+	// If empty, the default system roots are used
+	CA string `json:"ca"`
+
+	// URL is the oauth server base URL
+	URL string `json:"url"`
+
 	// ClientID is the oauth client ID
 	ClientID string `json:"clientID"`
 	// ClientSecret is the oauth client secret
@@ -663,6 +739,42 @@ type OpenIDIdentityProvider struct {
 	Claims OpenIDClaims `json:"claims"`
 }
 
+type SAMLIdentityProvider struct {
+	unversioned.TypeMeta `json:",inline"`
+
+	// SSOURL is the single sign-on URL of the identity provider, to which authentication requests are sent
+	SSOURL string `json:"ssoURL"`
+	// SSOBinding is the binding used to send authentication requests to the SSO URL, HTTP-Redirect or HTTP-POST
+	SSOBinding SAMLBindingType `json:"ssoBinding"`
+	// IdentityProviderEntityID is the optional entity ID of the identity provider. If set, assertions must be issued by it.
+	IdentityProviderEntityID string `json:"identityProviderEntityID"`
+	// Certificate is a file containing the PEM-encoded certificates the identity provider signs responses or assertions with
+	Certificate string `json:"certificate"`
+
+	// Attributes mappings
+	Attributes SAMLAttributes `json:"attributes"`
+}
+
+type SAMLBindingType string
+
+const (
+	// SAMLBindingHTTPRedirect sends authentication requests in the query of a redirect
+	SAMLBindingHTTPRedirect SAMLBindingType = "HTTP-Redirect"
+	// SAMLBindingHTTPPOST sends authentication requests in a form posted by the browser
+	SAMLBindingHTTPPOST SAMLBindingType = "HTTP-POST"
+)
+
+type SAMLAttributes struct {
+	// ID is the list of attributes whose values should be used as the user ID. If empty, the NameID of the assertion subject is used.
+	ID []string `json:"id"`
+	// PreferredUsername is the list of attributes whose values should be used as the preferred username.
+	PreferredUsername []string `json:"preferredUsername"`
+	// Name is the list of attributes whose values should be used as the display name.
+	Name []string `json:"name"`
+	// Email is the list of attributes whose values should be used as the email address.
+	Email []string `json:"email"`
+}
+
 type OpenIDURLs struct {
 	// Authorize is the oauth authorization URL
 	Authorize string `json:"authorize"`
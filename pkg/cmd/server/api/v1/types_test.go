@@ -90,6 +90,9 @@ assetConfig:
     maxRequestsInFlight: 0
     namedCertificates: null
     requestTimeoutSeconds: 0
+buildLogArchiveConfig:
+  directory: ""
+  maxBytesPerBuild: 0
 controllerLeaseTTL: 0
 controllers: ""
 corsAllowedOrigins: null
@@ -132,6 +135,12 @@ imagePolicyConfig:
   disableScheduledImport: false
   maxImagesBulkImportedPerRepository: 0
   maxScheduledImageImportsPerMinute: 0
+  registryMirrors:
+  - mirrors:
+    - ca: ""
+      insecure: false
+      location: ""
+    source: ""
   scheduledImageImportMinimumIntervalSeconds: 0
 kind: MasterConfig
 kubeletClientInfo:
@@ -258,9 +267,24 @@ oauthConfig:
     name: ""
     provider:
       apiVersion: v1
+      ca: ""
       clientID: ""
       clientSecret: ""
+      hostname: ""
       kind: GitHubIdentityProvider
+      organizations: null
+      teams: null
+  - challenge: false
+    login: false
+    mappingMethod: ""
+    name: ""
+    provider:
+      apiVersion: v1
+      ca: ""
+      clientID: ""
+      clientSecret: ""
+      kind: GitLabIdentityProvider
+      url: ""
   - challenge: false
     login: false
     mappingMethod: ""
@@ -292,6 +316,22 @@ oauthConfig:
         authorize: ""
         token: ""
         userInfo: ""
+  - challenge: false
+    login: false
+    mappingMethod: ""
+    name: ""
+    provider:
+      apiVersion: v1
+      attributes:
+        email: null
+        id: null
+        name: null
+        preferredUsername: null
+      certificate: ""
+      identityProviderEntityID: ""
+      kind: SAMLIdentityProvider
+      ssoBinding: ""
+      ssoURL: ""
   masterCA: null
   masterPublicURL: ""
   masterURL: ""
@@ -306,6 +346,8 @@ oauthConfig:
     accessTokenMaxAgeSeconds: 0
     authorizeTokenMaxAgeSeconds: 0
 pauseControllers: false
+pipelineBuildConfig:
+  runnerImage: ""
 policyConfig:
   bootstrapPolicyFile: ""
   openshiftInfrastructureNamespace: ""
@@ -388,8 +430,10 @@ func TestMasterConfig(t *testing.T) {
 				{Provider: runtime.EmbeddedObject{Object: &internal.RequestHeaderIdentityProvider{}}},
 				{Provider: runtime.EmbeddedObject{Object: &internal.KeystonePasswordIdentityProvider{}}},
 				{Provider: runtime.EmbeddedObject{Object: &internal.GitHubIdentityProvider{}}},
+				{Provider: runtime.EmbeddedObject{Object: &internal.GitLabIdentityProvider{}}},
 				{Provider: runtime.EmbeddedObject{Object: &internal.GoogleIdentityProvider{}}},
 				{Provider: runtime.EmbeddedObject{Object: &internal.OpenIDIdentityProvider{}}},
+				{Provider: runtime.EmbeddedObject{Object: &internal.SAMLIdentityProvider{}}},
 			},
 			SessionConfig: &internal.SessionConfig{},
 			Templates:     &internal.OAuthTemplates{},
@@ -397,7 +441,9 @@ func TestMasterConfig(t *testing.T) {
 		AssetConfig: &internal.AssetConfig{
 			Extensions: []internal.AssetExtensionsConfig{{}},
 		},
-		DNSConfig: &internal.DNSConfig{},
+		DNSConfig:             &internal.DNSConfig{},
+		BuildLogArchiveConfig: &internal.BuildLogArchiveConfig{},
+		PipelineBuildConfig:   &internal.PipelineBuildConfig{},
 		AdmissionConfig: internal.AdmissionConfig{
 			PluginConfig: map[string]internal.AdmissionPluginConfig{ // test config as an embedded object
 				"plugin": {
@@ -406,6 +452,9 @@ func TestMasterConfig(t *testing.T) {
 			},
 			PluginOrderOverride: []string{"plugin"}, // explicitly set this field because the it's omitempty
 		},
+		ImagePolicyConfig: internal.ImagePolicyConfig{
+			RegistryMirrors: []internal.RegistryMirrorConfig{{Mirrors: []internal.RegistryMirror{{}}}},
+		},
 	}
 	serializedConfig, err := writeYAML(config)
 	if err != nil {
@@ -106,6 +106,20 @@ func ValidateMasterConfig(config *api.MasterConfig, fldPath *field.Path) Validat
 		}
 	}
 
+	if config.BuildLogArchiveConfig != nil {
+		archivePath := fldPath.Child("buildLogArchiveConfig")
+		if len(config.BuildLogArchiveConfig.Directory) == 0 {
+			validationResults.AddErrors(field.Required(archivePath.Child("directory")))
+		}
+		if config.BuildLogArchiveConfig.MaxBytesPerBuild < 0 {
+			validationResults.AddErrors(field.Invalid(archivePath.Child("maxBytesPerBuild"), config.BuildLogArchiveConfig.MaxBytesPerBuild, "must be greater than or equal to 0"))
+		}
+	}
+
+	if config.PipelineBuildConfig != nil && len(config.PipelineBuildConfig.RunnerImage) == 0 {
+		validationResults.AddErrors(field.Required(fldPath.Child("pipelineBuildConfig", "runnerImage")))
+	}
+
 	if config.EtcdConfig != nil {
 		etcdConfigErrs := ValidateEtcdConfig(config.EtcdConfig, fldPath.Child("etcdConfig"))
 		validationResults.Append(etcdConfigErrs)
@@ -397,9 +411,49 @@ func ValidateImagePolicyConfig(config api.ImagePolicyConfig, fldPath *field.Path
 	if config.MaxScheduledImageImportsPerMinute == 0 || config.MaxScheduledImageImportsPerMinute < -1 {
 		errs = append(errs, field.Invalid(fldPath.Child("maxScheduledImageImportsPerMinute"), config.MaxScheduledImageImportsPerMinute, "must be a positive integer or -1"))
 	}
+
+	sources := sets.NewString()
+	for i, mirrorConfig := range config.RegistryMirrors {
+		mirrorPath := fldPath.Child("registryMirrors").Index(i)
+		switch source := strings.TrimSuffix(mirrorConfig.Source, "/"); {
+		case len(source) == 0:
+			errs = append(errs, field.Required(mirrorPath.Child("source")))
+		case sources.Has(source):
+			errs = append(errs, field.Duplicate(mirrorPath.Child("source"), mirrorConfig.Source))
+		default:
+			sources.Insert(source)
+			errs = append(errs, validateRegistryLocation(source, mirrorPath.Child("source"))...)
+		}
+		if len(mirrorConfig.Mirrors) == 0 {
+			errs = append(errs, field.Required(mirrorPath.Child("mirrors")))
+		}
+		for j, mirror := range mirrorConfig.Mirrors {
+			if len(mirror.Location) == 0 {
+				errs = append(errs, field.Required(mirrorPath.Child("mirrors").Index(j).Child("location")))
+			} else {
+				errs = append(errs, validateRegistryLocation(mirror.Location, mirrorPath.Child("mirrors").Index(j).Child("location"))...)
+			}
+			if len(mirror.CA) > 0 {
+				errs = append(errs, ValidateFile(mirror.CA, mirrorPath.Child("mirrors").Index(j).Child("ca"))...)
+			}
+		}
+	}
 	return errs
 }
 
+// validateRegistryLocation checks that location is a registry host optionally followed by a repository prefix.
+func validateRegistryLocation(location string, fldPath *field.Path) field.ErrorList {
+	if strings.Contains(location, "://") {
+		return field.ErrorList{field.Invalid(fldPath, location, "must be a registry host optionally followed by a repository prefix, without a scheme")}
+	}
+	for _, segment := range strings.Split(strings.TrimSuffix(location, "/"), "/") {
+		if len(segment) == 0 {
+			return field.ErrorList{field.Invalid(fldPath, location, "must not contain empty path segments")}
+		}
+	}
+	return nil
+}
+
 func ValidateKubeletConnectionInfo(config api.KubeletConnectionInfo, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
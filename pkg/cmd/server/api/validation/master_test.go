@@ -253,3 +253,74 @@ func TestValidateAdmissionPluginConfig(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateImagePolicyConfigRegistryMirrors(t *testing.T) {
+	mirrorsPath := field.NewPath("registryMirrors")
+	tests := []struct {
+		label    string
+		mirrors  []api.RegistryMirrorConfig
+		expected field.ErrorList
+	}{
+		{
+			label: "valid mirrors",
+			mirrors: []api.RegistryMirrorConfig{
+				{Source: "docker.io", Mirrors: []api.RegistryMirror{{Location: "mirror.example.com:5000/hub"}, {Location: "other.example.com"}}},
+				{Source: "quay.io/coreos", Mirrors: []api.RegistryMirror{{Location: "mirror.example.com:5000/coreos", Insecure: true}}},
+			},
+			expected: field.ErrorList{},
+		},
+		{
+			label: "missing source and mirrors",
+			mirrors: []api.RegistryMirrorConfig{
+				{},
+			},
+			expected: field.ErrorList{
+				field.Required(mirrorsPath.Index(0).Child("source")),
+				field.Required(mirrorsPath.Index(0).Child("mirrors")),
+			},
+		},
+		{
+			label: "duplicate source",
+			mirrors: []api.RegistryMirrorConfig{
+				{Source: "docker.io", Mirrors: []api.RegistryMirror{{Location: "mirror.example.com"}}},
+				{Source: "docker.io/", Mirrors: []api.RegistryMirror{{Location: "mirror.example.com"}}},
+			},
+			expected: field.ErrorList{
+				field.Duplicate(mirrorsPath.Index(1).Child("source"), "docker.io/"),
+			},
+		},
+		{
+			label: "invalid locations",
+			mirrors: []api.RegistryMirrorConfig{
+				{Source: "https://docker.io", Mirrors: []api.RegistryMirror{{Location: "mirror.example.com//hub"}, {}}},
+			},
+			expected: field.ErrorList{
+				field.Invalid(mirrorsPath.Index(0).Child("source"), "https://docker.io", "must be a registry host optionally followed by a repository prefix, without a scheme"),
+				field.Invalid(mirrorsPath.Index(0).Child("mirrors").Index(0).Child("location"), "mirror.example.com//hub", "must not contain empty path segments"),
+				field.Required(mirrorsPath.Index(0).Child("mirrors").Index(1).Child("location")),
+			},
+		},
+		{
+			label: "missing CA file",
+			mirrors: []api.RegistryMirrorConfig{
+				{Source: "docker.io", Mirrors: []api.RegistryMirror{{Location: "mirror.example.com", CA: "/does/not/exist"}}},
+			},
+			expected: field.ErrorList{
+				field.Invalid(mirrorsPath.Index(0).Child("mirrors").Index(0).Child("ca"), "/does/not/exist", "could not read file"),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		config := api.ImagePolicyConfig{
+			MaxImagesBulkImportedPerRepository:         5,
+			ScheduledImageImportMinimumIntervalSeconds: 60,
+			MaxScheduledImageImportsPerMinute:          60,
+			RegistryMirrors:                            test.mirrors,
+		}
+		results := ValidateImagePolicyConfig(config, nil)
+		if !kapi.Semantic.DeepEqual(test.expected, results) {
+			t.Errorf("%s: unexpected validation results; diff:\n%v", test.label, util.ObjectDiff(test.expected, results))
+		}
+	}
+}
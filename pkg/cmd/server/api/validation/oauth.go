@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"k8s.io/kubernetes/pkg/util/sets"
+	utilvalidation "k8s.io/kubernetes/pkg/util/validation"
 	"k8s.io/kubernetes/pkg/util/validation/field"
 
 	"github.com/openshift/origin/pkg/auth/authenticator/redirector"
@@ -171,7 +172,10 @@ func ValidateIdentityProvider(identityProvider api.IdentityProvider, fldPath *fi
 			validationResults.Append(ValidateKeystoneIdentityProvider(provider, identityProvider, providerPath))
 
 		case (*api.GitHubIdentityProvider):
-			validationResults.AddErrors(ValidateOAuthIdentityProvider(provider.ClientID, provider.ClientSecret, identityProvider.UseAsChallenger)...)
+			validationResults.AddErrors(ValidateGitHubIdentityProvider(provider, identityProvider)...)
+
+		case (*api.GitLabIdentityProvider):
+			validationResults.AddErrors(ValidateGitLabIdentityProvider(provider, identityProvider)...)
 
 		case (*api.GoogleIdentityProvider):
 			validationResults.AddErrors(ValidateOAuthIdentityProvider(provider.ClientID, provider.ClientSecret, identityProvider.UseAsChallenger)...)
@@ -179,6 +183,9 @@ func ValidateIdentityProvider(identityProvider api.IdentityProvider, fldPath *fi
 		case (*api.OpenIDIdentityProvider):
 			validationResults.AddErrors(ValidateOpenIDIdentityProvider(provider, identityProvider)...)
 
+		case (*api.SAMLIdentityProvider):
+			validationResults.AddErrors(ValidateSAMLIdentityProvider(provider, identityProvider)...)
+
 		}
 	}
 
@@ -286,6 +293,52 @@ func ValidateOAuthIdentityProvider(clientID, clientSecret string, challenge bool
 	return allErrs
 }
 
+func ValidateGitHubIdentityProvider(provider *api.GitHubIdentityProvider, identityProvider api.IdentityProvider) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, ValidateOAuthIdentityProvider(provider.ClientID, provider.ClientSecret, identityProvider.UseAsChallenger)...)
+
+	providerPath := field.NewPath("provider")
+	for i, organization := range provider.Organizations {
+		if len(organization) == 0 {
+			allErrs = append(allErrs, field.Invalid(providerPath.Child("organizations").Index(i), organization, "must not be empty"))
+		}
+	}
+	for i, team := range provider.Teams {
+		if parts := strings.Split(team, "/"); len(parts) != 2 || len(parts[0]) == 0 || len(parts[1]) == 0 {
+			allErrs = append(allErrs, field.Invalid(providerPath.Child("teams").Index(i), team, "must be in the format <org>/<team>"))
+		}
+	}
+
+	if len(provider.Hostname) != 0 && !utilvalidation.IsDNS1123Subdomain(provider.Hostname) {
+		allErrs = append(allErrs, field.Invalid(providerPath.Child("hostname"), provider.Hostname, "must be a valid DNS subdomain"))
+	}
+	if len(provider.CA) != 0 {
+		if len(provider.Hostname) == 0 {
+			allErrs = append(allErrs, field.Invalid(providerPath.Child("ca"), provider.CA, "may only be set when hostname is set"))
+		}
+		allErrs = append(allErrs, ValidateFile(provider.CA, providerPath.Child("ca"))...)
+	}
+
+	return allErrs
+}
+
+func ValidateGitLabIdentityProvider(provider *api.GitLabIdentityProvider, identityProvider api.IdentityProvider) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, ValidateOAuthIdentityProvider(provider.ClientID, provider.ClientSecret, identityProvider.UseAsChallenger)...)
+
+	providerPath := field.NewPath("provider")
+	_, urlErrs := ValidateSecureURL(provider.URL, providerPath.Child("url"))
+	allErrs = append(allErrs, urlErrs...)
+
+	if len(provider.CA) != 0 {
+		allErrs = append(allErrs, ValidateFile(provider.CA, providerPath.Child("ca"))...)
+	}
+
+	return allErrs
+}
+
 func ValidateOpenIDIdentityProvider(provider *api.OpenIDIdentityProvider, identityProvider api.IdentityProvider) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -322,6 +375,32 @@ func ValidateOpenIDIdentityProvider(provider *api.OpenIDIdentityProvider, identi
 	return allErrs
 }
 
+func ValidateSAMLIdentityProvider(provider *api.SAMLIdentityProvider, identityProvider api.IdentityProvider) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	// SAML only defines browser based single sign on, there is no way to answer a challenge
+	if identityProvider.UseAsChallenger {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("challenge"), identityProvider.UseAsChallenger, "SAML providers cannot be used for challenges"))
+	}
+
+	providerPath := field.NewPath("provider")
+	_, urlErrs := ValidateSecureURL(provider.SSOURL, providerPath.Child("ssoURL"))
+	allErrs = append(allErrs, urlErrs...)
+
+	if !api.ValidSAMLBindingTypes.Has(string(provider.SSOBinding)) {
+		allErrs = append(allErrs, field.Invalid(providerPath.Child("ssoBinding"), provider.SSOBinding, fmt.Sprintf("must be one of: %v", api.ValidSAMLBindingTypes.List())))
+	}
+
+	// Responses are only trusted when signed by the identity provider
+	if len(provider.Certificate) == 0 {
+		allErrs = append(allErrs, field.Required(providerPath.Child("certificate")))
+	} else {
+		allErrs = append(allErrs, ValidateFile(provider.Certificate, providerPath.Child("certificate"))...)
+	}
+
+	return allErrs
+}
+
 func validateGrantConfig(config api.GrantConfig, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
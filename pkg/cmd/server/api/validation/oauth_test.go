@@ -0,0 +1,99 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/openshift/origin/pkg/cmd/server/api"
+)
+
+func TestValidateGitHubIdentityProvider(t *testing.T) {
+	testCases := map[string]struct {
+		provider     api.GitHubIdentityProvider
+		expectedErrs int
+	}{
+		"valid": {
+			provider: api.GitHubIdentityProvider{ClientID: "id", ClientSecret: "secret", Organizations: []string{"myorg"}, Teams: []string{"myorg/myteam"}, Hostname: "github.example.com"},
+		},
+		"missing client": {
+			provider:     api.GitHubIdentityProvider{},
+			expectedErrs: 2,
+		},
+		"invalid organizations and teams": {
+			provider:     api.GitHubIdentityProvider{ClientID: "id", ClientSecret: "secret", Organizations: []string{""}, Teams: []string{"myteam", "myorg/", "a/b/c"}},
+			expectedErrs: 4,
+		},
+		"invalid hostname": {
+			provider:     api.GitHubIdentityProvider{ClientID: "id", ClientSecret: "secret", Hostname: "https://github.example.com"},
+			expectedErrs: 1,
+		},
+		"ca without hostname": {
+			provider:     api.GitHubIdentityProvider{ClientID: "id", ClientSecret: "secret", CA: "/does/not/exist"},
+			expectedErrs: 2,
+		},
+	}
+
+	for k, tc := range testCases {
+		errs := ValidateGitHubIdentityProvider(&tc.provider, api.IdentityProvider{UseAsLogin: true})
+		if len(errs) != tc.expectedErrs {
+			t.Errorf("%s: expected %d errors, got %v", k, tc.expectedErrs, errs)
+		}
+	}
+}
+
+func TestValidateGitLabIdentityProvider(t *testing.T) {
+	testCases := map[string]struct {
+		provider     api.GitLabIdentityProvider
+		expectedErrs int
+	}{
+		"valid": {
+			provider: api.GitLabIdentityProvider{ClientID: "id", ClientSecret: "secret", URL: "https://gitlab.example.com"},
+		},
+		"insecure url": {
+			provider:     api.GitLabIdentityProvider{ClientID: "id", ClientSecret: "secret", URL: "http://gitlab.example.com"},
+			expectedErrs: 1,
+		},
+		"missing url and client": {
+			provider:     api.GitLabIdentityProvider{},
+			expectedErrs: 4,
+		},
+	}
+
+	for k, tc := range testCases {
+		errs := ValidateGitLabIdentityProvider(&tc.provider, api.IdentityProvider{UseAsLogin: true})
+		if len(errs) != tc.expectedErrs {
+			t.Errorf("%s: expected %d errors, got %v", k, tc.expectedErrs, errs)
+		}
+	}
+}
+
+func TestValidateSAMLIdentityProvider(t *testing.T) {
+	testCases := map[string]struct {
+		provider        api.SAMLIdentityProvider
+		useAsChallenger bool
+		expectedErrs    int
+	}{
+		"valid": {
+			provider: api.SAMLIdentityProvider{SSOURL: "https://idp.example.com/sso", SSOBinding: api.SAMLBindingHTTPPOST, Certificate: "oauth_test.go"},
+		},
+		"insecure url and unknown binding": {
+			provider:     api.SAMLIdentityProvider{SSOURL: "http://idp.example.com/sso", SSOBinding: "SOAP", Certificate: "oauth_test.go"},
+			expectedErrs: 2,
+		},
+		"missing certificate": {
+			provider:     api.SAMLIdentityProvider{SSOURL: "https://idp.example.com/sso", SSOBinding: api.SAMLBindingHTTPRedirect},
+			expectedErrs: 1,
+		},
+		"challenger": {
+			provider:        api.SAMLIdentityProvider{SSOURL: "https://idp.example.com/sso", SSOBinding: api.SAMLBindingHTTPRedirect, Certificate: "oauth_test.go"},
+			useAsChallenger: true,
+			expectedErrs:    1,
+		},
+	}
+
+	for k, tc := range testCases {
+		errs := ValidateSAMLIdentityProvider(&tc.provider, api.IdentityProvider{UseAsLogin: true, UseAsChallenger: tc.useAsChallenger})
+		if len(errs) != tc.expectedErrs {
+			t.Errorf("%s: expected %d errors, got %v", k, tc.expectedErrs, errs)
+		}
+	}
+}
@@ -102,7 +102,7 @@ func GetBootstrapClusterRoles() []authorizationapi.ClusterRole {
 			Rules: []authorizationapi.PolicyRule{
 				{
 					Verbs:     sets.NewString("get", "list", "watch", "create", "update", "patch", "delete"),
-					Resources: sets.NewString(authorizationapi.OpenshiftExposedGroupName, authorizationapi.PermissionGrantingGroupName, authorizationapi.KubeExposedGroupName, "projects", "secrets", "pods/attach", "pods/proxy", "pods/exec", "pods/portforward", authorizationapi.DockerBuildResource, authorizationapi.SourceBuildResource, authorizationapi.CustomBuildResource, "deploymentconfigs/scale"),
+					Resources: sets.NewString(authorizationapi.OpenshiftExposedGroupName, authorizationapi.PermissionGrantingGroupName, authorizationapi.KubeExposedGroupName, "projects", "secrets", "pods/attach", "pods/proxy", "pods/exec", "pods/portforward", authorizationapi.DockerBuildResource, authorizationapi.SourceBuildResource, authorizationapi.CustomBuildResource, authorizationapi.PipelineBuildResource, "deploymentconfigs/scale"),
 				},
 				{
 					APIGroups: []string{authorizationapi.APIGroupExtensions},
@@ -127,7 +127,7 @@ func GetBootstrapClusterRoles() []authorizationapi.ClusterRole {
 			Rules: []authorizationapi.PolicyRule{
 				{
 					Verbs:     sets.NewString("get", "list", "watch", "create", "update", "patch", "delete"),
-					Resources: sets.NewString(authorizationapi.OpenshiftExposedGroupName, authorizationapi.KubeExposedGroupName, "secrets", "pods/attach", "pods/proxy", "pods/exec", "pods/portforward", authorizationapi.DockerBuildResource, authorizationapi.SourceBuildResource, authorizationapi.CustomBuildResource, "deploymentconfigs/scale"),
+					Resources: sets.NewString(authorizationapi.OpenshiftExposedGroupName, authorizationapi.KubeExposedGroupName, "secrets", "pods/attach", "pods/proxy", "pods/exec", "pods/portforward", authorizationapi.DockerBuildResource, authorizationapi.SourceBuildResource, authorizationapi.CustomBuildResource, authorizationapi.PipelineBuildResource, "deploymentconfigs/scale"),
 				},
 				{
 					APIGroups: []string{authorizationapi.APIGroupExtensions},
@@ -262,6 +262,21 @@ func GetBootstrapClusterRoles() []authorizationapi.ClusterRole {
 				},
 			},
 		},
+		{
+			ObjectMeta: kapi.ObjectMeta{
+				Name: ImageSignerRoleName,
+			},
+			Rules: []authorizationapi.PolicyRule{
+				{
+					Verbs:     sets.NewString("get", "list"),
+					Resources: sets.NewString("images"),
+				},
+				{
+					Verbs:     sets.NewString("update"),
+					Resources: sets.NewString("images/signatures"),
+				},
+			},
+		},
 		{
 			ObjectMeta: kapi.ObjectMeta{
 				Name: DeployerRoleName,
@@ -290,6 +305,11 @@ func GetBootstrapClusterRoles() []authorizationapi.ClusterRole {
 					Verbs:     sets.NewString("get"),
 					Resources: sets.NewString("pods/log"),
 				},
+				{
+					// BlueGreenDeploymentStrategy.switchService
+					Verbs:     sets.NewString("get", "update"),
+					Resources: sets.NewString("services"),
+				},
 			},
 		},
 		{
@@ -324,6 +344,14 @@ func GetBootstrapClusterRoles() []authorizationapi.ClusterRole {
 					Verbs:     sets.NewString("list", "watch"),
 					Resources: sets.NewString("routes", "endpoints"),
 				},
+				{
+					Verbs:     sets.NewString("update"),
+					Resources: sets.NewString("routes/status"),
+				},
+				{
+					Verbs:     sets.NewString("create", "update", "patch"),
+					Resources: sets.NewString("events"),
+				},
 			},
 		},
 		{
@@ -332,7 +360,7 @@ func GetBootstrapClusterRoles() []authorizationapi.ClusterRole {
 			},
 			Rules: []authorizationapi.PolicyRule{
 				{
-					Verbs:     sets.NewString("get", "delete"),
+					Verbs:     sets.NewString("get", "list", "delete"),
 					Resources: sets.NewString("images"),
 				},
 				{
@@ -347,6 +375,11 @@ func GetBootstrapClusterRoles() []authorizationapi.ClusterRole {
 					Verbs:     sets.NewString("create"),
 					Resources: sets.NewString("imagestreammappings"),
 				},
+				{
+					// this is used by checkQuota in pkg/dockerregistry/server/quotablobstore.go
+					Verbs:     sets.NewString("list"),
+					Resources: sets.NewString("resourcequotas"),
+				},
 			},
 		},
 		{
@@ -10,8 +10,11 @@ import (
 
 	"k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/util"
+	"k8s.io/kubernetes/pkg/util/sets"
 
 	"github.com/openshift/origin/pkg/api/v1"
+	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
+	"github.com/openshift/origin/pkg/authorization/rulevalidation"
 )
 
 func TestOpenshiftRoles(t *testing.T) {
@@ -50,6 +53,32 @@ func TestBootstrapClusterRoles(t *testing.T) {
 	testObjects(t, list, "bootstrap_cluster_roles.yaml")
 }
 
+// TestDeployerRole ensures the deployer service account can perform the
+// API calls of the deployment strategies.
+func TestDeployerRole(t *testing.T) {
+	var deployerRole *authorizationapi.ClusterRole
+	roles := GetBootstrapClusterRoles()
+	for i := range roles {
+		if roles[i].Name == DeployerRoleName {
+			deployerRole = &roles[i]
+		}
+	}
+	if deployerRole == nil {
+		t.Fatalf("no %s role found", DeployerRoleName)
+	}
+
+	required := []authorizationapi.PolicyRule{
+		{Verbs: sets.NewString("get", "list", "update"), Resources: sets.NewString("replicationcontrollers")},
+		{Verbs: sets.NewString("get", "list", "watch", "create"), Resources: sets.NewString("pods")},
+		{Verbs: sets.NewString("get"), Resources: sets.NewString("pods/log")},
+		// the blue-green strategy switches the selector of the service
+		{Verbs: sets.NewString("get", "update"), Resources: sets.NewString("services")},
+	}
+	if covers, missing := rulevalidation.Covers(deployerRole.Rules, required); !covers {
+		t.Errorf("the %s role does not allow %v", DeployerRoleName, missing)
+	}
+}
+
 func testObjects(t *testing.T, list *api.List, fixtureFilename string) {
 	filename := filepath.Join("../../../../test/fixtures/bootstrappolicy", fixtureFilename)
 	expectedYAML, err := ioutil.ReadFile(filename)
@@ -13,6 +13,7 @@ import (
 	"k8s.io/kubernetes/pkg/auth/user"
 	client "k8s.io/kubernetes/pkg/client/unversioned"
 
+	authapi "github.com/openshift/origin/pkg/auth/api"
 	oauthenticator "github.com/openshift/origin/pkg/auth/authenticator"
 	"github.com/openshift/origin/pkg/auth/authenticator/anonymous"
 	"github.com/openshift/origin/pkg/auth/authenticator/request/bearertoken"
@@ -109,6 +110,10 @@ func (n NodeAuthorizerAttributesGetter) GetRequestAttributes(u user.Info, r *htt
 	namespace := ""
 	userName := u.GetName()
 	groups := u.GetGroups()
+	scopes := []string{}
+	if scoped, ok := u.(authapi.ScopedUserInfo); ok {
+		scopes = scoped.GetScopes()
+	}
 
 	apiVerb := ""
 	switch r.Method {
@@ -139,9 +144,9 @@ func (n NodeAuthorizerAttributesGetter) GetRequestAttributes(u user.Info, r *htt
 	}
 	// TODO: handle other things like /healthz/*? not sure if "non-resource" urls on the kubelet make sense to authorize against master non-resource URL policy
 
-	glog.V(2).Infof("Node request attributes: namespace=%s, user=%s, groups=%v, attrs=%#v", namespace, userName, groups, attrs)
+	glog.V(2).Infof("Node request attributes: namespace=%s, user=%s, groups=%v, scopes=%v, attrs=%#v", namespace, userName, groups, scopes, attrs)
 
-	return authzadapter.KubernetesAuthorizerAttributes(namespace, userName, groups, attrs)
+	return authzadapter.KubernetesAuthorizerAttributes(namespace, userName, groups, scopes, attrs)
 }
 
 func newAuthorizer(c *oclient.Client, cacheTTL time.Duration, cacheSize int) (kauthorizer.Authorizer, error) {
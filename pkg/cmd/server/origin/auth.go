@@ -39,11 +39,14 @@ import (
 	"github.com/openshift/origin/pkg/auth/ldaputil"
 	"github.com/openshift/origin/pkg/auth/oauth/external"
 	"github.com/openshift/origin/pkg/auth/oauth/external/github"
+	"github.com/openshift/origin/pkg/auth/oauth/external/gitlab"
 	"github.com/openshift/origin/pkg/auth/oauth/external/google"
 	"github.com/openshift/origin/pkg/auth/oauth/external/openid"
 	"github.com/openshift/origin/pkg/auth/oauth/handlers"
 	"github.com/openshift/origin/pkg/auth/oauth/registry"
+	"github.com/openshift/origin/pkg/auth/saml"
 	"github.com/openshift/origin/pkg/auth/server/csrf"
+	"github.com/openshift/origin/pkg/auth/server/errorpage"
 	"github.com/openshift/origin/pkg/auth/server/grant"
 	"github.com/openshift/origin/pkg/auth/server/login"
 	"github.com/openshift/origin/pkg/auth/server/selectprovider"
@@ -62,6 +65,7 @@ import (
 	clientauthetcd "github.com/openshift/origin/pkg/oauth/registry/oauthclientauthorization/etcd"
 	"github.com/openshift/origin/pkg/oauth/server/osinserver"
 	"github.com/openshift/origin/pkg/oauth/server/osinserver/registrystorage"
+	saoauth "github.com/openshift/origin/pkg/serviceaccounts/oauthclient"
 )
 
 const (
@@ -87,6 +91,8 @@ func (c *AuthConfig) InstallAPI(container *restful.Container) []string {
 	authorizeTokenRegistry := authorizetokenregistry.NewRegistry(authorizeTokenStorage)
 	clientStorage := clientetcd.NewREST(c.EtcdHelper)
 	clientRegistry := clientregistry.NewRegistry(clientStorage)
+	// service accounts act as OAuth clients for the authorize and grant flows
+	combinedClientRegistry := saoauth.NewServiceAccountOAuthClientRegistry(clientRegistry, c.ServiceAccountTokenGetter)
 	clientAuthStorage := clientauthetcd.NewREST(c.EtcdHelper)
 	clientAuthRegistry := clientauthregistry.NewRegistry(clientAuthStorage)
 
@@ -95,7 +101,7 @@ func (c *AuthConfig) InstallAPI(container *restful.Container) []string {
 		glog.Fatal(err)
 	}
 
-	storage := registrystorage.New(accessTokenRegistry, authorizeTokenRegistry, clientRegistry, registry.NewUserConversion())
+	storage := registrystorage.New(accessTokenRegistry, authorizeTokenRegistry, combinedClientRegistry, registry.NewUserConversion())
 	config := osinserver.NewDefaultServerConfig()
 	if c.Options.TokenConfig.AuthorizeTokenMaxAgeSeconds > 0 {
 		config.AuthorizationExpiration = c.Options.TokenConfig.AuthorizeTokenMaxAgeSeconds
@@ -105,12 +111,14 @@ func (c *AuthConfig) InstallAPI(container *restful.Container) []string {
 	}
 
 	grantChecker := registry.NewClientAuthorizationGrantChecker(clientAuthRegistry)
-	grantHandler := c.getGrantHandler(mux, authRequestHandler, clientRegistry, clientAuthRegistry)
+	grantHandler := c.getGrantHandler(mux, authRequestHandler, combinedClientRegistry, clientAuthRegistry)
 
 	server := osinserver.New(
 		config,
 		storage,
 		osinserver.AuthorizeHandlers{
+			handlers.NewScopeValidator(),
+			saoauth.NewScopeRestriction(),
 			handlers.NewAuthorizeAuthenticator(
 				authRequestHandler,
 				authHandler,
@@ -293,23 +301,32 @@ func (c *AuthConfig) getAuthorizeAuthenticationHandlers(mux cmdutil.Mux) (authen
 
 // getGrantHandler returns the object that handles approving or rejecting grant requests
 func (c *AuthConfig) getGrantHandler(mux cmdutil.Mux, auth authenticator.Request, clientregistry clientregistry.Registry, authregistry clientauthregistry.Registry) handlers.GrantHandler {
+	// Users are always prompted to approve service account clients, so the grant page is served for
+	// them whatever the grant method is. Other clients may only use it with the prompt method.
+	promptClientRegistry := clientregistry
+	if c.Options.GrantConfig.Method != configapi.GrantHandlerPrompt {
+		promptClientRegistry = saoauth.NewServiceAccountOnlyOAuthClientRegistry(clientregistry)
+	}
+	grantServer := grant.NewGrant(c.getCSRF(), auth, grant.DefaultFormRenderer, promptClientRegistry, authregistry)
+	grantServer.Install(mux, OpenShiftApprovePrefix)
+	promptGrant := handlers.NewRedirectGrant(OpenShiftApprovePrefix)
+
+	var standardGrant handlers.GrantHandler
 	switch c.Options.GrantConfig.Method {
 	case configapi.GrantHandlerDeny:
-		return handlers.NewEmptyGrant()
+		standardGrant = handlers.NewEmptyGrant()
 
 	case configapi.GrantHandlerAuto:
-		return handlers.NewAutoGrant()
+		standardGrant = handlers.NewAutoGrant()
 
 	case configapi.GrantHandlerPrompt:
-		grantServer := grant.NewGrant(c.getCSRF(), auth, grant.DefaultFormRenderer, clientregistry, authregistry)
-		grantServer.Install(mux, OpenShiftApprovePrefix)
-		return handlers.NewRedirectGrant(OpenShiftApprovePrefix)
+		standardGrant = promptGrant
 
 	default:
 		glog.Fatalf("No grant handler found that matches %v.  The oauth server cannot start!", c.Options.GrantConfig.Method)
 	}
 
-	return nil
+	return saoauth.NewServiceAccountGrantHandler(promptGrant, standardGrant)
 }
 
 // getAuthenticationFinalizer returns an authentication finalizer which is called just prior to writing a response to an authorization request
@@ -392,7 +409,8 @@ func (c *AuthConfig) getAuthenticationHandler(mux cmdutil.Mux, errorHandler hand
 			oauthSuccessHandler := handlers.AuthenticationSuccessHandlers{c.SessionAuth, state}
 
 			// If the specified errorHandler doesn't handle the login error, let the state error handler attempt to propagate specific errors back to the token requester
-			oauthErrorHandler := handlers.AuthenticationErrorHandlers{errorHandler, state}
+			// Users the provider denies access to (e.g. because they are not members of an allowed organization) are shown an error page
+			oauthErrorHandler := handlers.AuthenticationErrorHandlers{errorHandler, state, errorpage.NewErrorPage(errorpage.NewErrorPageRenderer())}
 
 			callbackPath := path.Join(OpenShiftOAuthCallbackPrefix, identityProvider.Name)
 			oauthHandler, err := external.NewExternalOAuthRedirector(oauthProvider, state, c.Options.MasterPublicURL+callbackPath, oauthSuccessHandler, oauthErrorHandler, identityMapper)
@@ -407,6 +425,62 @@ func (c *AuthConfig) getAuthenticationHandler(mux cmdutil.Mux, errorHandler hand
 			if identityProvider.UseAsChallenger {
 				return nil, errors.New("oauth identity providers cannot issue challenges")
 			}
+		} else if samlProvider, isSAML := identityProvider.Provider.Object.(*configapi.SAMLIdentityProvider); isSAML {
+			certificates, err := cmdutil.CertificatesFromFile(samlProvider.Certificate)
+			if err != nil {
+				return nil, fmt.Errorf("error loading certificates for SAML identity provider %s: %v", identityProvider.Name, err)
+			}
+
+			// The relay state combines CSRF and return URL handling, the same way the OAuth state does
+			state := external.CSRFRedirectingState(c.getCSRF())
+
+			// SAML auth requires
+			// 1. a session success handler (to remember you logged in)
+			// 2. a state success handler (to go back to the URL encoded in the relay state)
+			if c.SessionAuth == nil {
+				return nil, errors.New("SessionAuth is required for SAML-based login")
+			}
+			samlSuccessHandler := handlers.AuthenticationSuccessHandlers{c.SessionAuth, state}
+
+			// Responses the identity provider rejected, or that fail verification, are shown an error page
+			samlErrorHandler := handlers.AuthenticationErrorHandlers{errorHandler, errorpage.NewErrorPage(errorpage.NewErrorPageRenderer())}
+
+			binding := saml.BindingHTTPRedirect
+			if samlProvider.SSOBinding == configapi.SAMLBindingHTTPPOST {
+				binding = saml.BindingHTTPPOST
+			}
+
+			// Responses are posted to the callback path, the service provider metadata is published below it
+			callbackPath := path.Join(OpenShiftOAuthCallbackPrefix, identityProvider.Name)
+			metadataPath := path.Join(callbackPath, "metadata")
+			config := saml.Config{
+				ProviderName:                identityProvider.Name,
+				EntityID:                    c.Options.MasterPublicURL + metadataPath,
+				AssertionConsumerServiceURL: c.Options.MasterPublicURL + callbackPath,
+				SSOURL:                      samlProvider.SSOURL,
+				SSOBinding:                  binding,
+				IdentityProviderEntityID:    samlProvider.IdentityProviderEntityID,
+				Certificates:                certificates,
+				IDAttributes:                samlProvider.Attributes.ID,
+				PreferredUsernameAttributes: samlProvider.Attributes.PreferredUsername,
+				EmailAttributes:             samlProvider.Attributes.Email,
+				NameAttributes:              samlProvider.Attributes.Name,
+			}
+			// Responses may be posted to any master, so the pending requests and used assertions are kept in etcd
+			samlStore := saml.NewEtcdStore(c.EtcdClient, path.Join(c.EtcdPrefix, "saml", identityProvider.Name))
+			samlHandler, err := saml.NewHandler(config, state, samlStore, samlSuccessHandler, samlErrorHandler, identityMapper)
+			if err != nil {
+				return nil, fmt.Errorf("unexpected error: %v", err)
+			}
+
+			mux.Handle(callbackPath, samlHandler)
+			mux.Handle(metadataPath, http.HandlerFunc(samlHandler.ServeMetadata))
+			if identityProvider.UseAsLogin {
+				redirectors[identityProvider.Name] = samlHandler
+			}
+			if identityProvider.UseAsChallenger {
+				return nil, errors.New("SAML identity providers cannot issue challenges")
+			}
 		} else if requestHeaderProvider, isRequestHeader := identityProvider.Provider.Object.(*configapi.RequestHeaderIdentityProvider); isRequestHeader {
 			// We might be redirecting to an external site, we need to fully resolve the request URL to the public master
 			baseRequestURL, err := url.Parse(c.Options.MasterPublicURL + OpenShiftOAuthAPIPrefix + osinserver.AuthorizePath)
@@ -445,7 +519,18 @@ func (c *AuthConfig) getAuthenticationHandler(mux cmdutil.Mux, errorHandler hand
 func (c *AuthConfig) getOAuthProvider(identityProvider configapi.IdentityProvider) (external.Provider, error) {
 	switch provider := identityProvider.Provider.Object.(type) {
 	case (*configapi.GitHubIdentityProvider):
-		return github.NewProvider(identityProvider.Name, provider.ClientID, provider.ClientSecret), nil
+		transport, err := cmdutil.TransportFor(provider.CA, "", "")
+		if err != nil {
+			return nil, err
+		}
+		return github.NewProvider(identityProvider.Name, provider.ClientID, provider.ClientSecret, provider.Hostname, transport, provider.Organizations, provider.Teams), nil
+
+	case (*configapi.GitLabIdentityProvider):
+		transport, err := cmdutil.TransportFor(provider.CA, "", "")
+		if err != nil {
+			return nil, err
+		}
+		return gitlab.NewProvider(identityProvider.Name, transport, provider.URL, provider.ClientID, provider.ClientSecret)
 
 	case (*configapi.GoogleIdentityProvider):
 		return google.NewProvider(identityProvider.Name, provider.ClientID, provider.ClientSecret, provider.HostedDomain)
@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"net/url"
 
+	etcdclient "github.com/coreos/go-etcd/etcd"
 	"github.com/pborman/uuid"
 
 	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/controller/serviceaccount"
 	"k8s.io/kubernetes/pkg/storage"
 
 	"github.com/openshift/origin/pkg/auth/server/session"
@@ -34,9 +36,16 @@ type AuthConfig struct {
 	// EtcdHelper should normally be used for storage functions.
 	EtcdBackends []storage.Interface
 
+	// EtcdClient stores the state shared by the masters that is not held by API objects, under EtcdPrefix
+	EtcdClient *etcdclient.Client
+	EtcdPrefix string
+
 	UserRegistry     userregistry.Registry
 	IdentityRegistry identityregistry.Registry
 
+	// ServiceAccountTokenGetter reads the service accounts that act as OAuth clients
+	ServiceAccountTokenGetter serviceaccount.ServiceAccountTokenGetter
+
 	SessionAuth *session.Authenticator
 }
 
@@ -85,6 +94,11 @@ func BuildAuthConfig(options configapi.MasterConfig) (*AuthConfig, error) {
 		assetPublicURLs = []string{options.OAuthConfig.AssetPublicURL, "http://localhost:9000", "https://localhost:9000"}
 	}
 
+	kubeClient, _, err := configapi.GetKubeClient(options.MasterClients.OpenShiftLoopbackKubeConfig)
+	if err != nil {
+		return nil, err
+	}
+
 	userStorage := useretcd.NewREST(etcdHelper)
 	userRegistry := userregistry.NewRegistry(userStorage)
 	identityStorage := identityetcd.NewREST(etcdHelper)
@@ -95,11 +109,15 @@ func BuildAuthConfig(options configapi.MasterConfig) (*AuthConfig, error) {
 
 		AssetPublicAddresses: assetPublicURLs,
 		EtcdHelper:           etcdHelper,
+		EtcdClient:           client,
+		EtcdPrefix:           options.EtcdStorageConfig.OpenShiftStoragePrefix,
 		EtcdBackends:         etcdBackends,
 
 		IdentityRegistry: identityRegistry,
 		UserRegistry:     userRegistry,
 
+		ServiceAccountTokenGetter: serviceaccount.NewGetterFromClient(kubeClient),
+
 		SessionAuth: sessionAuth,
 	}
 
@@ -34,6 +34,7 @@ import (
 	authnregistry "github.com/openshift/origin/pkg/auth/oauth/registry"
 	"github.com/openshift/origin/pkg/auth/userregistry/identitymapper"
 	"github.com/openshift/origin/pkg/authorization/authorizer"
+	authorizerscope "github.com/openshift/origin/pkg/authorization/authorizer/scope"
 	policycache "github.com/openshift/origin/pkg/authorization/cache"
 	policyclient "github.com/openshift/origin/pkg/authorization/client"
 	clusterpolicyregistry "github.com/openshift/origin/pkg/authorization/registry/clusterpolicy"
@@ -45,6 +46,7 @@ import (
 	policybindingregistry "github.com/openshift/origin/pkg/authorization/registry/policybinding"
 	policybindingetcd "github.com/openshift/origin/pkg/authorization/registry/policybinding/etcd"
 	"github.com/openshift/origin/pkg/authorization/rulevalidation"
+	"github.com/openshift/origin/pkg/build/logarchive"
 	osclient "github.com/openshift/origin/pkg/client"
 	oadmission "github.com/openshift/origin/pkg/cmd/server/admission"
 	configapi "github.com/openshift/origin/pkg/cmd/server/api"
@@ -169,7 +171,7 @@ func BuildMasterConfig(options configapi.MasterConfig) (*MasterConfig, error) {
 	kubeletClientConfig := configapi.GetKubeletClientConfig(options)
 
 	// in-order list of plug-ins that should intercept admission decisions (origin only intercepts)
-	admissionControlPluginNames := []string{"OriginNamespaceLifecycle", "BuildByStrategy"}
+	admissionControlPluginNames := []string{"OriginNamespaceLifecycle", "BuildByStrategy", "OriginImageStreamQuota"}
 	if len(options.AdmissionConfig.PluginOrderOverride) > 0 {
 		admissionControlPluginNames = options.AdmissionConfig.PluginOrderOverride
 	}
@@ -368,7 +370,8 @@ func newAuthorizer(policyClient policyclient.ReadOnlyPolicyClient, projectReques
 		rulevalidation.ClusterPolicyGetter(policyClient),
 		rulevalidation.ClusterBindingLister(policyClient),
 	), authorizer.NewForbiddenMessageResolver(projectRequestDenyMessage))
-	return authorizer
+	// users authenticated with a scoped token are limited to what their scopes allow
+	return authorizerscope.NewAuthorizer(authorizer, rulevalidation.ClusterPolicyGetter(policyClient))
 }
 
 func newAuthorizationAttributeBuilder(requestContextMapper kapi.RequestContextMapper) authorizer.AuthorizationAttributeBuilder {
@@ -450,6 +453,15 @@ func (c *MasterConfig) BuildPodControllerClients() (*osclient.Client, *kclient.C
 	return c.PrivilegedLoopbackOpenShiftClient, c.PrivilegedLoopbackKubernetesClient
 }
 
+// BuildLogArchiveStore returns the store of the logs of finished builds, or nil if build logs are not archived
+func (c *MasterConfig) BuildLogArchiveStore() logarchive.Store {
+	config := c.Options.BuildLogArchiveConfig
+	if config == nil {
+		return nil
+	}
+	return logarchive.NewDirectoryStore(config.Directory, config.MaxBytesPerBuild)
+}
+
 // BuildImageChangeTriggerControllerClients returns the build image change trigger controller client objects
 func (c *MasterConfig) BuildImageChangeTriggerControllerClients() (*osclient.Client, *kclient.Client) {
 	return c.PrivilegedLoopbackOpenShiftClient, c.PrivilegedLoopbackKubernetesClient
@@ -470,6 +482,11 @@ func (c *MasterConfig) ImageImportControllerClient() *osclient.Client {
 	return c.PrivilegedLoopbackOpenShiftClient
 }
 
+// ImageQuotaClients returns the clients used to admit and record the image usage of projects
+func (c *MasterConfig) ImageQuotaClients() (*osclient.Client, *kclient.Client) {
+	return c.PrivilegedLoopbackOpenShiftClient, c.PrivilegedLoopbackKubernetesClient
+}
+
 // DeploymentConfigScaleClient returns the client used by the Scale subresource registry
 func (c *MasterConfig) DeploymentConfigScaleClient() *kclient.Client {
 	return c.PrivilegedLoopbackKubernetesClient
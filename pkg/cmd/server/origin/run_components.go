@@ -21,6 +21,7 @@ import (
 	buildclient "github.com/openshift/origin/pkg/build/client"
 	buildcontrollerfactory "github.com/openshift/origin/pkg/build/controller/factory"
 	buildstrategy "github.com/openshift/origin/pkg/build/controller/strategy"
+	"github.com/openshift/origin/pkg/build/logarchive"
 	cmdutil "github.com/openshift/origin/pkg/cmd/util"
 	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
 	configchangecontroller "github.com/openshift/origin/pkg/deploy/controller/configchange"
@@ -30,6 +31,7 @@ import (
 	imagechangecontroller "github.com/openshift/origin/pkg/deploy/controller/imagechange"
 	"github.com/openshift/origin/pkg/dns"
 	imagecontroller "github.com/openshift/origin/pkg/image/controller"
+	imagequota "github.com/openshift/origin/pkg/image/quota"
 	projectcontroller "github.com/openshift/origin/pkg/project/controller"
 	securitycontroller "github.com/openshift/origin/pkg/security/controller"
 	"github.com/openshift/origin/pkg/security/mcs"
@@ -219,11 +221,27 @@ func (c *MasterConfig) RunBuildController() {
 			Codec: interfaces.Codec,
 		},
 	}
+	// Pipeline builds are only run when a pipeline runner image is configured
+	if config := c.Options.PipelineBuildConfig; config != nil {
+		factory.PipelineBuildStrategy = &buildstrategy.PipelineBuildStrategy{
+			Image: config.RunnerImage,
+			// TODO: this will be set to --storage-version (the internal schema we use)
+			Codec: interfaces.Codec,
+		}
+	}
+
+	if store := c.BuildLogArchiveStore(); store != nil {
+		factory.LogArchiver = logarchive.NewArchiver(store, kclient)
+	}
 
 	controller := factory.Create()
 	controller.Run()
 	deleteController := factory.CreateDeleteController()
 	deleteController.Run()
+	if factory.LogArchiver != nil {
+		logArchiveController := factory.CreateLogArchiveController()
+		logArchiveController.Run()
+	}
 }
 
 // RunBuildPodController starts the build/pod status sync loop for build status
@@ -365,6 +383,12 @@ func (c *MasterConfig) RunImageImportController() {
 	}
 }
 
+// RunImageQuotaController starts the controller that records the image usage of projects in their quotas.
+func (c *MasterConfig) RunImageQuotaController() {
+	osclient, kclient := c.ImageQuotaClients()
+	imagequota.NewUsageController(kclient, osclient, 30*time.Second).Run(util.NeverStop)
+}
+
 // RunSecurityAllocationController starts the security allocation controller process.
 func (c *MasterConfig) RunSecurityAllocationController() {
 	alloc := c.Options.ProjectConfig.SecurityAllocator
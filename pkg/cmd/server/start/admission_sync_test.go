@@ -25,6 +25,7 @@ var admissionPluginsNotUsedByKube = sets.NewString(
 	"BuildDefaults",            // from origin, only needed for managing builds, not kubernetes resources
 	"BuildOverrides",           // from origin, only needed for managing builds, not kubernetes resources
 	"OriginNamespaceLifecycle", // from origin, only needed for rejecting openshift resources, so not needed by kube
+	"OriginImageStreamQuota",   // from origin, only needed for limiting image streams, not kubernetes resources
 	"ProjectRequestLimit",      // from origin, used for limiting project requests by user (online use case)
 
 	"NamespaceExists",  // superceded by NamespaceLifecycle
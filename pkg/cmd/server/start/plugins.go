@@ -6,6 +6,8 @@ import (
 	_ "github.com/openshift/origin/pkg/build/admission/defaults"
 	_ "github.com/openshift/origin/pkg/build/admission/overrides"
 	_ "github.com/openshift/origin/pkg/build/admission/strategyrestrictions"
+	_ "github.com/openshift/origin/pkg/image/admission/imagestreamquota"
+	_ "github.com/openshift/origin/pkg/image/admission/signaturepolicy"
 	_ "github.com/openshift/origin/pkg/project/admission/lifecycle"
 	_ "github.com/openshift/origin/pkg/project/admission/nodeenv"
 	_ "github.com/openshift/origin/pkg/project/admission/requestlimit"
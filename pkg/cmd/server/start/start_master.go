@@ -332,7 +332,7 @@ func BuildKubernetesMasterConfig(openshiftConfig *origin.MasterConfig) (*kuberne
 	if openshiftConfig.Options.KubernetesMasterConfig == nil {
 		return nil, nil
 	}
-	kubeConfig, err := kubernetes.BuildKubernetesMasterConfig(openshiftConfig.Options, openshiftConfig.RequestContextMapper, openshiftConfig.KubeClient(), openshiftConfig.ProjectCache)
+	kubeConfig, err := kubernetes.BuildKubernetesMasterConfig(openshiftConfig.Options, openshiftConfig.RequestContextMapper, openshiftConfig.KubeClient(), openshiftConfig.PrivilegedLoopbackOpenShiftClient, openshiftConfig.ProjectCache)
 	return kubeConfig, err
 }
 
@@ -582,6 +582,7 @@ func startControllers(oc *origin.MasterConfig, kc *kubernetes.MasterConfig) erro
 	oc.RunDeploymentConfigChangeController()
 	oc.RunDeploymentImageChangeTriggerController()
 	oc.RunImageImportController()
+	oc.RunImageQuotaController()
 	oc.RunOriginNamespaceController()
 	oc.RunSDNController()
 
@@ -34,6 +34,8 @@ type DeploymentStrategy struct {
 	RecreateParams *RecreateDeploymentStrategyParams
 	// RollingParams are the input to the Rolling deployment strategy.
 	RollingParams *RollingDeploymentStrategyParams
+	// BlueGreenParams are the input to the BlueGreen deployment strategy.
+	BlueGreenParams *BlueGreenDeploymentStrategyParams
 	// Resources contains resource requirements to execute the deployment
 	Resources kapi.ResourceRequirements
 	// Labels is a set of key, value pairs added to custom deployer and lifecycle pre/post hook pods.
@@ -52,6 +54,9 @@ const (
 	DeploymentStrategyTypeCustom DeploymentStrategyType = "Custom"
 	// DeploymentStrategyTypeRolling uses the Kubernetes RollingUpdater.
 	DeploymentStrategyTypeRolling DeploymentStrategyType = "Rolling"
+	// DeploymentStrategyTypeBlueGreen brings up the new deployment alongside the old one
+	// before switching a service to it.
+	DeploymentStrategyTypeBlueGreen DeploymentStrategyType = "BlueGreen"
 )
 
 // CustomDeploymentStrategyParams are the input to the Custom deployment strategy.
@@ -82,6 +87,28 @@ type RecreateDeploymentStrategyParams struct {
 	Post *LifecycleHook
 }
 
+// BlueGreenDeploymentStrategyParams are the input to the BlueGreen deployment strategy.
+type BlueGreenDeploymentStrategyParams struct {
+	// ServiceName is the name of the service that is switched to the new
+	// deployment once all of its replicas are ready.
+	ServiceName string
+	// TimeoutSeconds is the time to wait for the replicas of the new deployment
+	// to become ready before giving up. If the value is nil, a default will be used.
+	TimeoutSeconds *int64
+	// VerificationSeconds is the time to wait after the service is switched to
+	// the new deployment before the old deployment is scaled down. Cancelling
+	// the deployment within this window switches the service back to the old
+	// deployment. If the value is nil, a default will be used.
+	VerificationSeconds *int64
+	// Pre is a lifecycle hook which is executed before the strategy manipulates
+	// the deployment. All LifecycleHookFailurePolicy values are supported.
+	Pre *LifecycleHook
+	// Post is a lifecycle hook which is executed after the strategy has
+	// finished all deployment logic. The LifecycleHookFailurePolicyAbort policy
+	// is NOT supported.
+	Post *LifecycleHook
+}
+
 // LifecycleHook defines a specific deployment lifecycle action.
 type LifecycleHook struct {
 	// FailurePolicy specifies what action to take if the hook fails.
@@ -157,6 +184,14 @@ type RollingDeploymentStrategyParams struct {
 	// If negative, the scale order will be down/up instead of up/down.
 	// DEPRECATED: Use MaxUnavailable/MaxSurge instead.
 	UpdatePercent *int
+	// PausePoints are the sizes of the new deployment at which the update
+	// pauses until it is resumed with `oc deploy --resume`, or aborted with
+	// `oc deploy --abort`. Each value can be an absolute number (ex: 1) or a
+	// percentage of the desired replicas (ex: 10%). Absolute number is
+	// calculated from percentage by rounding up. Pause points are ignored for
+	// the first deployment of a config. The time a deployment is paused does
+	// not count against the deadline of its deployer pod.
+	PausePoints []intstr.IntOrString
 	// Pre is a lifecycle hook which is executed before the deployment process
 	// begins. All LifecycleHookFailurePolicy values are supported.
 	Pre *LifecycleHook
@@ -173,6 +208,10 @@ const (
 	DefaultRollingIntervalSeconds int64 = 1
 	// DefaultRollingUpdatePeriodSeconds is the default PeriodSeconds for RollingDeploymentStrategyParams.
 	DefaultRollingUpdatePeriodSeconds int64 = 1
+	// DefaultBlueGreenTimeoutSeconds is the default TimeoutSeconds for BlueGreenDeploymentStrategyParams.
+	DefaultBlueGreenTimeoutSeconds int64 = 10 * 60
+	// DefaultBlueGreenVerificationSeconds is the default VerificationSeconds for BlueGreenDeploymentStrategyParams.
+	DefaultBlueGreenVerificationSeconds int64 = 60
 )
 
 // These constants represent keys used for correlating objects related to deployments.
@@ -224,12 +263,18 @@ const (
 	// DeploymentReplicasAnnotation is for internal use only and is for
 	// detecting external modifications to deployment replica counts.
 	DeploymentReplicasAnnotation = "openshift.io/deployment.replicas"
+	// DeploymentPausedAnnotation is set by the deployer on a deployment that is
+	// paused at one of the pause points of a rolling update. The annotation value
+	// is the number of replicas of the deployment at the pause point. Removing
+	// the annotation resumes the deployment.
+	DeploymentPausedAnnotation = "openshift.io/deployment.paused"
 )
 
 // These constants represent the various reasons for cancelling a deployment
 // or for a deployment being placed in a failed state
 const (
 	DeploymentCancelledByUser                 = "The deployment was cancelled by the user"
+	DeploymentAbortedByUser                   = "The deployment was aborted by the user at a pause point"
 	DeploymentCancelledNewerDeploymentExists  = "The deployment was cancelled as a newer deployment was found running"
 	DeploymentFailedUnrelatedDeploymentExists = "The deployment failed as an unrelated pod with the same name as this deployment is already running"
 	DeploymentFailedDeployerPodNoLongerExists = "The deployment failed as the deployer pod no longer exists"
@@ -53,6 +53,7 @@ func convert_v1_RollingDeploymentStrategyParams_To_api_RollingDeploymentStrategy
 	out.IntervalSeconds = in.IntervalSeconds
 	out.TimeoutSeconds = in.TimeoutSeconds
 	out.UpdatePercent = in.UpdatePercent
+	out.PausePoints = in.PausePoints
 
 	if in.Pre != nil {
 		if err := s.Convert(&in.Pre, &out.Pre, 0); err != nil {
@@ -88,6 +89,7 @@ func convert_api_RollingDeploymentStrategyParams_To_v1_RollingDeploymentStrategy
 	out.IntervalSeconds = in.IntervalSeconds
 	out.TimeoutSeconds = in.TimeoutSeconds
 	out.UpdatePercent = in.UpdatePercent
+	out.PausePoints = in.PausePoints
 
 	if in.Pre != nil {
 		if err := s.Convert(&in.Pre, &out.Pre, 0); err != nil {
@@ -52,6 +52,7 @@ func convert_v1beta3_RollingDeploymentStrategyParams_To_api_RollingDeploymentStr
 	out.IntervalSeconds = in.IntervalSeconds
 	out.TimeoutSeconds = in.TimeoutSeconds
 	out.UpdatePercent = in.UpdatePercent
+	out.PausePoints = in.PausePoints
 
 	if in.Pre != nil {
 		if err := s.Convert(&in.Pre, &out.Pre, 0); err != nil {
@@ -87,6 +88,7 @@ func convert_api_RollingDeploymentStrategyParams_To_v1beta3_RollingDeploymentStr
 	out.IntervalSeconds = in.IntervalSeconds
 	out.TimeoutSeconds = in.TimeoutSeconds
 	out.UpdatePercent = in.UpdatePercent
+	out.PausePoints = in.PausePoints
 
 	if in.Pre != nil {
 		if err := s.Convert(&in.Pre, &out.Pre, 0); err != nil {
@@ -38,12 +38,23 @@ func init() {
 			if obj.Type == DeploymentStrategyTypeRecreate && obj.RecreateParams == nil {
 				obj.RecreateParams = &RecreateDeploymentStrategyParams{}
 			}
+			if obj.Type == DeploymentStrategyTypeBlueGreen && obj.BlueGreenParams == nil {
+				obj.BlueGreenParams = &BlueGreenDeploymentStrategyParams{}
+			}
 		},
 		func(obj *RecreateDeploymentStrategyParams) {
 			if obj.TimeoutSeconds == nil {
 				obj.TimeoutSeconds = mkintp(deployapi.DefaultRollingTimeoutSeconds)
 			}
 		},
+		func(obj *BlueGreenDeploymentStrategyParams) {
+			if obj.TimeoutSeconds == nil {
+				obj.TimeoutSeconds = mkintp(deployapi.DefaultBlueGreenTimeoutSeconds)
+			}
+			if obj.VerificationSeconds == nil {
+				obj.VerificationSeconds = mkintp(deployapi.DefaultBlueGreenVerificationSeconds)
+			}
+		},
 		func(obj *RollingDeploymentStrategyParams) {
 			if obj.IntervalSeconds == nil {
 				obj.IntervalSeconds = mkintp(deployapi.DefaultRollingIntervalSeconds)
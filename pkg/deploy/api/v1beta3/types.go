@@ -34,6 +34,8 @@ type DeploymentStrategy struct {
 	RecreateParams *RecreateDeploymentStrategyParams `json:"recreateParams,omitempty" description:"input to the Recreate deployment strategy"`
 	// RollingParams are the input to the Rolling deployment strategy.
 	RollingParams *RollingDeploymentStrategyParams `json:"rollingParams,omitempty" description:"input to the Rolling deployment strategy"`
+	// BlueGreenParams are the input to the BlueGreen deployment strategy.
+	BlueGreenParams *BlueGreenDeploymentStrategyParams `json:"blueGreenParams,omitempty" description:"input to the BlueGreen deployment strategy"`
 	// Compute resource requirements to execute the deployment
 	Resources kapi.ResourceRequirements `json:"resources,omitempty" description:"resource requirements to execute the deployment"`
 	// Labels is a set of key, value pairs added to custom deployer and lifecycle pre/post hook pods.
@@ -52,6 +54,9 @@ const (
 	DeploymentStrategyTypeCustom DeploymentStrategyType = "Custom"
 	// DeploymentStrategyTypeRolling uses the Kubernetes RollingUpdater.
 	DeploymentStrategyTypeRolling DeploymentStrategyType = "Rolling"
+	// DeploymentStrategyTypeBlueGreen brings up the new deployment alongside the old one
+	// before switching a service to it.
+	DeploymentStrategyTypeBlueGreen DeploymentStrategyType = "BlueGreen"
 )
 
 // CustomParams are the input to the Custom deployment strategy.
@@ -82,6 +87,28 @@ type RecreateDeploymentStrategyParams struct {
 	Post *LifecycleHook `json:"post,omitempty" description:"a hook executed after the strategy finishes the deployment"`
 }
 
+// BlueGreenDeploymentStrategyParams are the input to the BlueGreen deployment strategy.
+type BlueGreenDeploymentStrategyParams struct {
+	// ServiceName is the name of the service that is switched to the new
+	// deployment once all of its replicas are ready.
+	ServiceName string `json:"serviceName" description:"the name of the service that is switched to the new deployment once all of its replicas are ready"`
+	// TimeoutSeconds is the time to wait for the replicas of the new deployment
+	// to become ready before giving up. If the value is nil, a default will be used.
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty" description:"the time to wait for the replicas of the new deployment to become ready before giving up"`
+	// VerificationSeconds is the time to wait after the service is switched to
+	// the new deployment before the old deployment is scaled down. Cancelling
+	// the deployment within this window switches the service back to the old
+	// deployment. If the value is nil, a default will be used.
+	VerificationSeconds *int64 `json:"verificationSeconds,omitempty" description:"the time to wait after switching the service before the old deployment is scaled down"`
+	// Pre is a lifecycle hook which is executed before the strategy manipulates
+	// the deployment. All LifecycleHookFailurePolicy values are supported.
+	Pre *LifecycleHook `json:"pre,omitempty" description:"a hook executed before the strategy starts the deployment"`
+	// Post is a lifecycle hook which is executed after the strategy has
+	// finished all deployment logic. The LifecycleHookFailurePolicyAbort policy
+	// is NOT supported.
+	Post *LifecycleHook `json:"post,omitempty" description:"a hook executed after the strategy finishes the deployment"`
+}
+
 // Handler defines a specific deployment lifecycle action.
 type LifecycleHook struct {
 	// FailurePolicy specifies what action to take if the hook fails.
@@ -162,6 +189,14 @@ type RollingDeploymentStrategyParams struct {
 	// If negative, the scale order will be down/up instead of up/down.
 	// DEPRECATED: Use MaxUnavailable/MaxSurge instead.
 	UpdatePercent *int `json:"updatePercent,omitempty" description:"the percentage of replicas to scale up or down each interval (negative value switches scale order to down/up instead of up/down)"`
+	// PausePoints are the sizes of the new deployment at which the update
+	// pauses until it is resumed with `oc deploy --resume`, or aborted with
+	// `oc deploy --abort`. Each value can be an absolute number (ex: 1) or a
+	// percentage of the desired replicas (ex: 10%). Absolute number is
+	// calculated from percentage by rounding up. Pause points are ignored for
+	// the first deployment of a config. The time a deployment is paused does
+	// not count against the deadline of its deployer pod.
+	PausePoints []intstr.IntOrString `json:"pausePoints,omitempty" description:"sizes of the new deployment at which the update pauses until it is resumed; values can be an absolute number or a percentage of the desired replicas"`
 	// Pre is a lifecycle hook which is executed before the deployment process
 	// begins. All LifecycleHookFailurePolicy values are supported.
 	Pre *LifecycleHook `json:"pre,omitempty" description:"a hook executed before the strategy starts the deployment"`
@@ -93,6 +93,12 @@ func validateDeploymentStrategy(strategy *deployapi.DeploymentStrategy, fldPath
 		} else {
 			errs = append(errs, validateRollingParams(strategy.RollingParams, fldPath.Child("rollingParams"))...)
 		}
+	case deployapi.DeploymentStrategyTypeBlueGreen:
+		if strategy.BlueGreenParams == nil {
+			errs = append(errs, field.Required(fldPath.Child("blueGreenParams")))
+		} else {
+			errs = append(errs, validateBlueGreenParams(strategy.BlueGreenParams, fldPath.Child("blueGreenParams"))...)
+		}
 	case deployapi.DeploymentStrategyTypeCustom:
 		if strategy.CustomParams == nil {
 			errs = append(errs, field.Required(fldPath.Child("customParams")))
@@ -139,6 +145,33 @@ func validateRecreateParams(params *deployapi.RecreateDeploymentStrategyParams,
 	return errs
 }
 
+func validateBlueGreenParams(params *deployapi.BlueGreenDeploymentStrategyParams, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if len(params.ServiceName) == 0 {
+		errs = append(errs, field.Required(fldPath.Child("serviceName")))
+	} else if ok, msg := validation.ValidateServiceName(params.ServiceName, false); !ok {
+		errs = append(errs, field.Invalid(fldPath.Child("serviceName"), params.ServiceName, msg))
+	}
+
+	if params.TimeoutSeconds != nil && *params.TimeoutSeconds < 1 {
+		errs = append(errs, field.Invalid(fldPath.Child("timeoutSeconds"), *params.TimeoutSeconds, "must be >0"))
+	}
+
+	if params.VerificationSeconds != nil && *params.VerificationSeconds < 0 {
+		errs = append(errs, field.Invalid(fldPath.Child("verificationSeconds"), *params.VerificationSeconds, "must be >=0"))
+	}
+
+	if params.Pre != nil {
+		errs = append(errs, validateLifecycleHook(params.Pre, fldPath.Child("pre"))...)
+	}
+	if params.Post != nil {
+		errs = append(errs, validateLifecycleHook(params.Post, fldPath.Child("post"))...)
+	}
+
+	return errs
+}
+
 func validateLifecycleHook(hook *deployapi.LifecycleHook, fldPath *field.Path) field.ErrorList {
 	errs := field.ErrorList{}
 
@@ -237,6 +270,17 @@ func validateRollingParams(params *deployapi.RollingDeploymentStrategyParams, fl
 	// Validate that MaxUnavailable is not more than 100%.
 	errs = append(errs, IsNotMoreThan100Percent(params.MaxUnavailable, fldPath.Child("maxUnavailable"))...)
 
+	for i, point := range params.PausePoints {
+		pointPath := fldPath.Child("pausePoints").Index(i)
+		errs = append(errs, ValidatePositiveIntOrPercent(point, pointPath)...)
+		if getIntOrPercentValue(point) == 0 {
+			errs = append(errs, field.Invalid(pointPath, point, "must be greater than 0"))
+		}
+		if value, isPercent := getPercentValue(point); isPercent && value >= 100 {
+			errs = append(errs, field.Invalid(pointPath, point, "must be less than 100%"))
+		}
+	}
+
 	if params.Pre != nil {
 		errs = append(errs, validateLifecycleHook(params.Pre, fldPath.Child("pre"))...)
 	}
@@ -41,6 +41,31 @@ func rollingConfig(interval, updatePeriod, timeout int) api.DeploymentConfig {
 	}
 }
 
+func rollingConfigPausePoints(points ...intstr.IntOrString) api.DeploymentConfig {
+	config := rollingConfig(1, 1, 1)
+	config.Spec.Strategy.RollingParams.PausePoints = points
+	return config
+}
+
+func blueGreenConfig(serviceName string, timeout, verification int) api.DeploymentConfig {
+	return api.DeploymentConfig{
+		ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+		Spec: api.DeploymentConfigSpec{
+			Triggers: manualTrigger(),
+			Strategy: api.DeploymentStrategy{
+				Type: api.DeploymentStrategyTypeBlueGreen,
+				BlueGreenParams: &api.BlueGreenDeploymentStrategyParams{
+					ServiceName:         serviceName,
+					TimeoutSeconds:      mkint64p(timeout),
+					VerificationSeconds: mkint64p(verification),
+				},
+			},
+			Template: test.OkPodTemplate(),
+			Selector: test.OkSelector(),
+		},
+	}
+}
+
 func rollingConfigMax(maxSurge, maxUnavailable intstr.IntOrString) api.DeploymentConfig {
 	return api.DeploymentConfig{
 		ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
@@ -453,6 +478,56 @@ func TestValidateDeploymentConfigMissingFields(t *testing.T) {
 			field.ErrorTypeInvalid,
 			"spec.strategy.rollingParams.maxSurge",
 		},
+		"zero spec.strategy.rollingParams.pausePoints[1]": {
+			rollingConfigPausePoints(intstr.FromInt(1), intstr.FromInt(0)),
+			field.ErrorTypeInvalid,
+			"spec.strategy.rollingParams.pausePoints[1]",
+		},
+		"negative spec.strategy.rollingParams.pausePoints[0]": {
+			rollingConfigPausePoints(intstr.FromInt(-1)),
+			field.ErrorTypeInvalid,
+			"spec.strategy.rollingParams.pausePoints[0]",
+		},
+		"invalid percent spec.strategy.rollingParams.pausePoints[0]": {
+			rollingConfigPausePoints(intstr.FromString("foo")),
+			field.ErrorTypeInvalid,
+			"spec.strategy.rollingParams.pausePoints[0]",
+		},
+		"invalid upper bound percent spec.strategy.rollingParams.pausePoints[0]": {
+			rollingConfigPausePoints(intstr.FromString("100%")),
+			field.ErrorTypeInvalid,
+			"spec.strategy.rollingParams.pausePoints[0]",
+		},
+		"valid spec.strategy.rollingParams.pausePoints": {
+			rollingConfigPausePoints(intstr.FromInt(1), intstr.FromString("50%")),
+			"",
+			"",
+		},
+		"missing spec.strategy.blueGreenParams.serviceName": {
+			blueGreenConfig("", 1, 0),
+			field.ErrorTypeRequired,
+			"spec.strategy.blueGreenParams.serviceName",
+		},
+		"invalid spec.strategy.blueGreenParams.serviceName": {
+			blueGreenConfig("Frontend_Service", 1, 0),
+			field.ErrorTypeInvalid,
+			"spec.strategy.blueGreenParams.serviceName",
+		},
+		"invalid spec.strategy.blueGreenParams.timeoutSeconds": {
+			blueGreenConfig("frontend", 0, 0),
+			field.ErrorTypeInvalid,
+			"spec.strategy.blueGreenParams.timeoutSeconds",
+		},
+		"invalid spec.strategy.blueGreenParams.verificationSeconds": {
+			blueGreenConfig("frontend", 1, -1),
+			field.ErrorTypeInvalid,
+			"spec.strategy.blueGreenParams.verificationSeconds",
+		},
+		"valid spec.strategy.blueGreenParams": {
+			blueGreenConfig("frontend", 1, 0),
+			"",
+			"",
+		},
 	}
 
 	for testName, v := range errorCases {
@@ -2,6 +2,7 @@ package deployment
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/golang/glog"
 
@@ -115,7 +116,8 @@ func (c *DeploymentController) Handle(deployment *kapi.ReplicationController) er
 	case deployapi.DeploymentStatusPending, deployapi.DeploymentStatusRunning:
 		// If the deployer pod has vanished, consider the deployment a failure.
 		deployerPodName := deployutil.DeployerPodNameForDeployment(deployment.Name)
-		if _, err := c.podClient.getPod(deployment.Namespace, deployerPodName); err != nil {
+		deployerPod, err := c.podClient.getPod(deployment.Namespace, deployerPodName)
+		if err != nil {
 			if kerrors.IsNotFound(err) {
 				nextStatus = deployapi.DeploymentStatusFailed
 				deployment.Annotations[deployapi.DeploymentStatusAnnotation] = string(nextStatus)
@@ -154,6 +156,10 @@ func (c *DeploymentController) Handle(deployment *kapi.ReplicationController) er
 				}
 			}
 			c.recorder.Eventf(deployment, kapi.EventTypeNormal, "Cancelled", "Cancelled deployment")
+		} else if deployerPod != nil {
+			if err := c.syncDeployerPodDeadline(deployment, deployerPod); err != nil {
+				return err
+			}
 		}
 	case deployapi.DeploymentStatusFailed:
 		// Check for test deployment and ensure the deployment scale matches
@@ -270,6 +276,33 @@ func (c *DeploymentController) makeDeployerPod(deployment *kapi.ReplicationContr
 	return pod, nil
 }
 
+// syncDeployerPodDeadline lifts the deadline of the deployer pod while the deployment
+// is paused at a pause point of a rolling update, since the deployment waits for the
+// user to resume it. Once it is resumed, the deployer pod is given the full
+// MaxDeploymentDurationSeconds again to complete the deployment.
+func (c *DeploymentController) syncDeployerPodDeadline(deployment *kapi.ReplicationController, deployerPod *kapi.Pod) error {
+	_, paused := deployutil.DeploymentPausedAt(deployment)
+	if paused == (deployerPod.Spec.ActiveDeadlineSeconds == nil) {
+		return nil
+	}
+
+	var deadline *int64
+	if !paused {
+		// The deadline is measured from the start of the pod.
+		seconds := deployapi.MaxDeploymentDurationSeconds
+		if deployerPod.Status.StartTime != nil {
+			seconds += int64(time.Since(deployerPod.Status.StartTime.Time).Seconds())
+		}
+		deadline = &seconds
+	}
+	deployerPod.Spec.ActiveDeadlineSeconds = deadline
+	if _, err := c.podClient.updatePod(deployerPod.Namespace, deployerPod); err != nil {
+		return fmt.Errorf("couldn't update the deadline of deployer pod %s for deployment %s: %v", deployerPod.Name, deployutil.LabelForDeployment(deployment), err)
+	}
+	glog.V(4).Infof("Updated the deadline of deployer pod %s for deployment %s (paused: %t)", deployerPod.Name, deployutil.LabelForDeployment(deployment), paused)
+	return nil
+}
+
 // deploymentClient abstracts access to deployments.
 type deploymentClient interface {
 	getDeployment(namespace, name string) (*kapi.ReplicationController, error)
@@ -5,10 +5,12 @@ import (
 	"reflect"
 	"sort"
 	"testing"
+	"time"
 
 	kapi "k8s.io/kubernetes/pkg/api"
 	kerrors "k8s.io/kubernetes/pkg/api/errors"
 	"k8s.io/kubernetes/pkg/api/resource"
+	"k8s.io/kubernetes/pkg/api/unversioned"
 	"k8s.io/kubernetes/pkg/client/record"
 
 	api "github.com/openshift/origin/pkg/api/latest"
@@ -326,7 +328,7 @@ func TestHandle_noop(t *testing.T) {
 				return nil, nil
 			},
 			getPodFunc: func(namespace, name string) (*kapi.Pod, error) {
-				return &kapi.Pod{}, nil
+				return ttlNonZeroPod(), nil
 			},
 		},
 		makeContainer: func(strategy *deployapi.DeploymentStrategy) (*kapi.Container, error) {
@@ -726,6 +728,75 @@ func TestHandle_cancelPendingRunning(t *testing.T) {
 	}
 }
 
+// TestHandle_pausedDeployerPodDeadline ensures that the deadline of the deployer
+// pod is lifted while the deployment is paused, and restored once it is resumed.
+func TestHandle_pausedDeployerPodDeadline(t *testing.T) {
+	var deployerPod *kapi.Pod
+	updatedPods := []kapi.Pod{}
+
+	controller := &DeploymentController{
+		decodeConfig: func(deployment *kapi.ReplicationController) (*deployapi.DeploymentConfig, error) {
+			return deployutil.DecodeDeploymentConfig(deployment, api.Codec)
+		},
+		deploymentClient: &deploymentClientImpl{
+			updateDeploymentFunc: func(namespace string, deployment *kapi.ReplicationController) (*kapi.ReplicationController, error) {
+				t.Errorf("unexpected call to updateDeployment")
+				return nil, nil
+			},
+		},
+		podClient: &podClientImpl{
+			getPodFunc: func(namespace, name string) (*kapi.Pod, error) {
+				return deployerPod, nil
+			},
+			updatePodFunc: func(namespace string, pod *kapi.Pod) (*kapi.Pod, error) {
+				updatedPods = append(updatedPods, *pod)
+				return pod, nil
+			},
+		},
+		makeContainer: func(strategy *deployapi.DeploymentStrategy) (*kapi.Container, error) {
+			return okContainer(), nil
+		},
+		recorder: &record.FakeRecorder{},
+	}
+
+	deployment, _ := deployutil.MakeDeployment(deploytest.OkDeploymentConfig(1), kapi.Codec)
+	deployment.Annotations[deployapi.DeploymentStatusAnnotation] = string(deployapi.DeploymentStatusRunning)
+
+	// a running deployment keeps the deadline
+	deployerPod = ttlNonZeroPod()
+	if err := controller.Handle(deployment); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(updatedPods) != 0 {
+		t.Fatalf("expected no pod updates, got %#v", updatedPods)
+	}
+
+	// a paused deployment has no deadline
+	deployment.Annotations[deployapi.DeploymentPausedAnnotation] = "1"
+	if err := controller.Handle(deployment); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(updatedPods) != 1 || updatedPods[0].Spec.ActiveDeadlineSeconds != nil {
+		t.Fatalf("expected the deadline to be lifted, got %#v", updatedPods)
+	}
+
+	// a resumed deployment gets the deadline back, measured from the pod start
+	updatedPods = []kapi.Pod{}
+	deployerPod.Spec.ActiveDeadlineSeconds = nil
+	started := unversioned.NewTime(time.Now().Add(-time.Hour))
+	deployerPod.Status.StartTime = &started
+	delete(deployment.Annotations, deployapi.DeploymentPausedAnnotation)
+	if err := controller.Handle(deployment); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(updatedPods) != 1 || updatedPods[0].Spec.ActiveDeadlineSeconds == nil {
+		t.Fatalf("expected the deadline to be restored, got %#v", updatedPods)
+	}
+	if e, a := deployapi.MaxDeploymentDurationSeconds+3600, *updatedPods[0].Spec.ActiveDeadlineSeconds; a < e || a > e+60 {
+		t.Errorf("expected ActiveDeadlineSeconds %d, got %d", e, a)
+	}
+}
+
 // TestHandle_deployerPodDisappeared ensures that a pending/running deployment
 // is failed when its deployer pod vanishes.
 func TestHandle_deployerPodDisappeared(t *testing.T) {
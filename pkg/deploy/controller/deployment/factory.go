@@ -141,7 +141,7 @@ func (factory *DeploymentControllerFactory) makeContainer(strategy *deployapi.De
 
 	// Every strategy type should be handled here.
 	switch strategy.Type {
-	case deployapi.DeploymentStrategyTypeRecreate, deployapi.DeploymentStrategyTypeRolling:
+	case deployapi.DeploymentStrategyTypeRecreate, deployapi.DeploymentStrategyTypeRolling, deployapi.DeploymentStrategyTypeBlueGreen:
 		// Use the factory-configured image.
 		return &kapi.Container{
 			Image: factory.DeployerImage,
@@ -226,6 +226,15 @@ func (c *DeploymentConfigController) reconcileDeployments(existingDeployments *k
 		glog.V(4).Infof("Synced deploymentConfig %q replicas from %d to %d based on %s", deployutil.LabelForDeploymentConfig(config), oldReplicas, activeReplicas, source)
 	}
 
+	// Switch the service of a blue-green config back to the active deployment
+	// before other deployments are scaled down, so traffic isn't dropped when a
+	// deployment fails or is cancelled after the service was switched to it.
+	if activeDeployment != nil {
+		if err := c.reconcileService(config, activeDeployment); err != nil {
+			return err
+		}
+	}
+
 	// Reconcile deployments. The active deployment follows the config, and all
 	// other deployments should be scaled to zero.
 	for _, deployment := range existingDeployments.Items {
@@ -262,3 +271,31 @@ func (c *DeploymentConfigController) reconcileDeployments(existingDeployments *k
 	}
 	return nil
 }
+
+// reconcileService points the service of a config using the blue-green
+// strategy at the active deployment.
+func (c *DeploymentConfigController) reconcileService(config *deployapi.DeploymentConfig, activeDeployment *kapi.ReplicationController) error {
+	params := config.Spec.Strategy.BlueGreenParams
+	if config.Spec.Strategy.Type != deployapi.DeploymentStrategyTypeBlueGreen || params == nil || len(params.ServiceName) == 0 {
+		return nil
+	}
+	service, err := c.kubeClient.Services(config.Namespace).Get(params.ServiceName)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			glog.V(4).Infof("Service %q of deploymentConfig %q does not exist", params.ServiceName, deployutil.LabelForDeploymentConfig(config))
+			return nil
+		}
+		return err
+	}
+	if !deployutil.SwitchServiceSelector(service, activeDeployment.Name) {
+		return nil
+	}
+	if _, err := c.kubeClient.Services(config.Namespace).Update(service); err != nil {
+		c.recorder.Eventf(config, kapi.EventTypeWarning, "ServiceSwitchFailed",
+			"Failed to switch service %q to deployment %q: %s", params.ServiceName, activeDeployment.Name, err)
+		return err
+	}
+	c.recorder.Eventf(config, kapi.EventTypeNormal, "ServiceSwitched",
+		"Switched service %q to deployment %q", params.ServiceName, activeDeployment.Name)
+	return nil
+}
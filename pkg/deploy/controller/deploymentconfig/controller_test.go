@@ -696,3 +696,59 @@ func TestHandleScenarios(t *testing.T) {
 func newint(i int) *int {
 	return &i
 }
+
+func TestHandleBlueGreenSwitchesServiceToActiveDeployment(t *testing.T) {
+	config := deploytest.OkDeploymentConfig(2)
+	config.Spec.Strategy = deployapi.DeploymentStrategy{
+		Type:            deployapi.DeploymentStrategyTypeBlueGreen,
+		BlueGreenParams: &deployapi.BlueGreenDeploymentStrategyParams{ServiceName: "frontend"},
+	}
+
+	active, _ := deployutil.MakeDeployment(deploytest.OkDeploymentConfig(1), kapi.Codec)
+	active.Annotations[deployapi.DeploymentStatusAnnotation] = string(deployapi.DeploymentStatusComplete)
+	cancelled, _ := deployutil.MakeDeployment(config, kapi.Codec)
+	cancelled.Annotations[deployapi.DeploymentStatusAnnotation] = string(deployapi.DeploymentStatusFailed)
+	cancelled.Annotations[deployapi.DeploymentCancelledAnnotation] = deployapi.DeploymentCancelledAnnotationValue
+
+	// the service was switched to the cancelled deployment before it failed
+	service := &kapi.Service{
+		ObjectMeta: kapi.ObjectMeta{Name: "frontend", Namespace: config.Namespace},
+		Spec: kapi.ServiceSpec{
+			Selector: map[string]string{deployapi.DeploymentLabel: cancelled.Name},
+		},
+	}
+
+	kc := &ktestclient.Fake{}
+	kc.AddReactor("list", "replicationcontrollers", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+		return true, &kapi.ReplicationControllerList{Items: []kapi.ReplicationController{*active, *cancelled}}, nil
+	})
+	kc.AddReactor("update", "replicationcontrollers", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+		return true, action.(ktestclient.UpdateAction).GetObject(), nil
+	})
+	kc.AddReactor("get", "services", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+		return true, service, nil
+	})
+	updated := []*kapi.Service{}
+	kc.AddReactor("update", "services", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+		service := action.(ktestclient.UpdateAction).GetObject().(*kapi.Service)
+		updated = append(updated, service)
+		return true, service, nil
+	})
+
+	controller := &DeploymentConfigController{
+		kubeClient: kc,
+		osClient:   &testclient.Fake{},
+		codec:      kapi.Codec,
+		recorder:   &record.FakeRecorder{},
+	}
+	if err := controller.Handle(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(updated) != 1 {
+		t.Fatalf("expected the service to be updated once, got %d updates", len(updated))
+	}
+	if e, a := active.Name, updated[0].Spec.Selector[deployapi.DeploymentLabel]; e != a {
+		t.Errorf("expected the service to select %s, got %s", e, a)
+	}
+}
@@ -0,0 +1,226 @@
+package bluegreen
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/golang/glog"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/kubectl"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util"
+	"k8s.io/kubernetes/pkg/util/wait"
+
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	strat "github.com/openshift/origin/pkg/deploy/strategy"
+	stratsupport "github.com/openshift/origin/pkg/deploy/strategy/support"
+	deployutil "github.com/openshift/origin/pkg/deploy/util"
+)
+
+// BlueGreenDeploymentStrategy brings up the new deployment at full size
+// alongside the old one. Once all replicas of the new deployment are ready,
+// the named service is switched to the new deployment, and after the
+// verification window has passed the old deployment is scaled down to 0.
+//
+// Cancelling the deployment during the verification window leaves the old
+// deployment running, and the deployment config controller switches the
+// service back to it.
+type BlueGreenDeploymentStrategy struct {
+	// getReplicationController knows how to get a replication controller.
+	getReplicationController func(namespace, name string) (*kapi.ReplicationController, error)
+	// getService knows how to get a service.
+	getService func(namespace, name string) (*kapi.Service, error)
+	// updateService knows how to update a service.
+	updateService func(namespace string, service *kapi.Service) (*kapi.Service, error)
+	// getUpdateAcceptor returns an UpdateAcceptor to verify the replicas of
+	// the new deployment.
+	getUpdateAcceptor func(timeout time.Duration) strat.UpdateAcceptor
+	// scaler is used to scale replication controllers.
+	scaler kubectl.Scaler
+	// codec is used to decode DeploymentConfigs contained in deployments.
+	codec runtime.Codec
+	// hookExecutor can execute a lifecycle hook.
+	hookExecutor hookExecutor
+	// retryTimeout is how long to wait for the replica count update or the
+	// service update to succeed before giving up.
+	retryTimeout time.Duration
+	// retryPeriod is how often to try updating the replica count or the
+	// service.
+	retryPeriod time.Duration
+	// verificationInterval is how often to check whether the deployment was
+	// cancelled during the verification window.
+	verificationInterval time.Duration
+}
+
+// AcceptorInterval is how often the UpdateAcceptor should check for
+// readiness.
+const AcceptorInterval = 1 * time.Second
+
+// NewBlueGreenDeploymentStrategy makes a BlueGreenDeploymentStrategy backed
+// by a real HookExecutor and client.
+func NewBlueGreenDeploymentStrategy(client kclient.Interface, codec runtime.Codec) *BlueGreenDeploymentStrategy {
+	scaler, _ := kubectl.ScalerFor(kapi.Kind("ReplicationController"), client)
+	return &BlueGreenDeploymentStrategy{
+		getReplicationController: func(namespace, name string) (*kapi.ReplicationController, error) {
+			return client.ReplicationControllers(namespace).Get(name)
+		},
+		getService: func(namespace, name string) (*kapi.Service, error) {
+			return client.Services(namespace).Get(name)
+		},
+		updateService: func(namespace string, service *kapi.Service) (*kapi.Service, error) {
+			return client.Services(namespace).Update(service)
+		},
+		getUpdateAcceptor: func(timeout time.Duration) strat.UpdateAcceptor {
+			return stratsupport.NewAcceptNewlyObservedReadyPods(client, timeout, AcceptorInterval)
+		},
+		scaler:               scaler,
+		codec:                codec,
+		hookExecutor:         stratsupport.NewHookExecutor(client, os.Stdout, codec),
+		retryTimeout:         120 * time.Second,
+		retryPeriod:          1 * time.Second,
+		verificationInterval: 1 * time.Second,
+	}
+}
+
+// Deploy scales up to alongside from, switches the service of the strategy
+// to to, and scales down from once the verification window has passed.
+func (s *BlueGreenDeploymentStrategy) Deploy(from *kapi.ReplicationController, to *kapi.ReplicationController, desiredReplicas int) error {
+	config, err := deployutil.DecodeDeploymentConfig(to, s.codec)
+	if err != nil {
+		return fmt.Errorf("couldn't decode config from deployment %s: %v", to.Name, err)
+	}
+
+	params := config.Spec.Strategy.BlueGreenParams
+	if params == nil {
+		return fmt.Errorf("the deployment config %s has no blue-green parameters", deployutil.LabelForDeploymentConfig(config))
+	}
+	retryParams := kubectl.NewRetryParams(s.retryPeriod, s.retryTimeout)
+	waitParams := kubectl.NewRetryParams(s.retryPeriod, s.retryTimeout)
+
+	// Execute any pre-hook.
+	if params.Pre != nil {
+		if err := s.hookExecutor.Execute(params.Pre, to, "prehook"); err != nil {
+			return fmt.Errorf("Pre hook failed: %s", err)
+		}
+		glog.Infof("Pre hook finished")
+	}
+
+	// Scale up the to deployment alongside the from deployment and wait for
+	// all of its replicas to become ready.
+	if desiredReplicas > 0 {
+		glog.Infof("Scaling %s to %d", deployutil.LabelForDeployment(to), desiredReplicas)
+		updatedTo, err := s.scaleAndWait(to, desiredReplicas, retryParams, waitParams)
+		if err != nil {
+			return fmt.Errorf("couldn't scale %s to %d: %v", deployutil.LabelForDeployment(to), desiredReplicas, err)
+		}
+		to = updatedTo
+
+		glog.Infof("Performing acceptance check of %s", deployutil.LabelForDeployment(to))
+		updateAcceptor := s.getUpdateAcceptor(time.Duration(*params.TimeoutSeconds) * time.Second)
+		if err := updateAcceptor.Accept(to); err != nil {
+			return fmt.Errorf("update acceptor rejected %s: %v", deployutil.LabelForDeployment(to), err)
+		}
+	}
+
+	// Switch the service to the to deployment.
+	glog.Infof("Switching service %s/%s to %s", to.Namespace, params.ServiceName, deployutil.LabelForDeployment(to))
+	if err := s.switchService(to.Namespace, params.ServiceName, to.Name); err != nil {
+		return fmt.Errorf("couldn't switch service %s/%s to %s: %v", to.Namespace, params.ServiceName, deployutil.LabelForDeployment(to), err)
+	}
+
+	if from != nil {
+		// Keep the from deployment around until the verification window has
+		// passed so the switch can be reverted by cancelling the deployment.
+		if params.VerificationSeconds != nil && *params.VerificationSeconds > 0 {
+			glog.Infof("Waiting %d seconds to verify %s before scaling down %s", *params.VerificationSeconds, deployutil.LabelForDeployment(to), deployutil.LabelForDeployment(from))
+			if err := s.waitForVerification(to, time.Duration(*params.VerificationSeconds)*time.Second); err != nil {
+				return err
+			}
+		}
+
+		glog.Infof("Scaling %s down to zero", deployutil.LabelForDeployment(from))
+		if _, err := s.scaleAndWait(from, 0, retryParams, waitParams); err != nil {
+			return fmt.Errorf("couldn't scale %s to 0: %v", deployutil.LabelForDeployment(from), err)
+		}
+	}
+
+	// Execute any post-hook. Errors are logged and ignored.
+	if params.Post != nil {
+		if err := s.hookExecutor.Execute(params.Post, to, "posthook"); err != nil {
+			util.HandleError(fmt.Errorf("post hook failed: %s", err))
+		}
+		glog.Infof("Post hook finished")
+	}
+
+	glog.Infof("Deployment %s successfully made active", to.Name)
+	return nil
+}
+
+// switchService points the selector of the named service at the pods of the
+// named deployment.
+func (s *BlueGreenDeploymentStrategy) switchService(namespace, serviceName, deploymentName string) error {
+	return wait.Poll(s.retryPeriod, s.retryTimeout, func() (bool, error) {
+		service, err := s.getService(namespace, serviceName)
+		if err != nil {
+			glog.Infof("couldn't look up service %s/%s: %v", namespace, serviceName, err)
+			return false, nil
+		}
+		if !deployutil.SwitchServiceSelector(service, deploymentName) {
+			return true, nil
+		}
+		if _, err := s.updateService(namespace, service); err != nil {
+			glog.Infof("couldn't update service %s/%s: %v", namespace, serviceName, err)
+			return false, nil
+		}
+		return true, nil
+	})
+}
+
+// waitForVerification waits for the verification window to pass, returning
+// an error if the deployment is cancelled in the meantime.
+func (s *BlueGreenDeploymentStrategy) waitForVerification(deployment *kapi.ReplicationController, window time.Duration) error {
+	err := wait.Poll(s.verificationInterval, window, func() (bool, error) {
+		existing, err := s.getReplicationController(deployment.Namespace, deployment.Name)
+		if err != nil {
+			glog.V(4).Infof("couldn't look up deployment %s: %v", deployutil.LabelForDeployment(deployment), err)
+			return false, nil
+		}
+		if deployutil.IsDeploymentCancelled(existing) {
+			return false, fmt.Errorf("deployment %s was cancelled during verification", deployutil.LabelForDeployment(deployment))
+		}
+		return false, nil
+	})
+	if err == wait.ErrWaitTimeout {
+		return nil
+	}
+	return err
+}
+
+func (s *BlueGreenDeploymentStrategy) scaleAndWait(deployment *kapi.ReplicationController, replicas int, retry *kubectl.RetryParams, wait *kubectl.RetryParams) (*kapi.ReplicationController, error) {
+	if err := s.scaler.Scale(deployment.Namespace, deployment.Name, uint(replicas), &kubectl.ScalePrecondition{Size: -1, ResourceVersion: ""}, retry, wait); err != nil {
+		return nil, err
+	}
+	updatedDeployment, err := s.getReplicationController(deployment.Namespace, deployment.Name)
+	if err != nil {
+		return nil, err
+	}
+	return updatedDeployment, nil
+}
+
+// hookExecutor knows how to execute a deployment lifecycle hook.
+type hookExecutor interface {
+	Execute(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error
+}
+
+// hookExecutorImpl is a pluggable hookExecutor.
+type hookExecutorImpl struct {
+	executeFunc func(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error
+}
+
+// Execute executes the provided lifecycle hook
+func (i *hookExecutorImpl) Execute(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error {
+	return i.executeFunc(hook, deployment, label)
+}
@@ -0,0 +1,273 @@
+package bluegreen
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	api "github.com/openshift/origin/pkg/api/latest"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	deploytest "github.com/openshift/origin/pkg/deploy/api/test"
+	scalertest "github.com/openshift/origin/pkg/deploy/scaler/test"
+	"github.com/openshift/origin/pkg/deploy/strategy"
+	deployutil "github.com/openshift/origin/pkg/deploy/util"
+)
+
+// testStrategy returns a strategy backed by the provided deployments and a
+// service named "frontend", and records the updates of the service.
+func testStrategy(scaler *scalertest.FakeScaler, deployments map[string]*kapi.ReplicationController, updates *[]*kapi.Service) *BlueGreenDeploymentStrategy {
+	service := &kapi.Service{
+		ObjectMeta: kapi.ObjectMeta{Name: "frontend", Namespace: kapi.NamespaceDefault},
+		Spec: kapi.ServiceSpec{
+			Selector: map[string]string{deployapi.DeploymentConfigLabel: "config"},
+		},
+	}
+	return &BlueGreenDeploymentStrategy{
+		codec:                api.Codec,
+		retryTimeout:         1 * time.Second,
+		retryPeriod:          1 * time.Millisecond,
+		verificationInterval: 1 * time.Millisecond,
+		getReplicationController: func(namespace, name string) (*kapi.ReplicationController, error) {
+			deployment, ok := deployments[name]
+			if !ok {
+				return nil, fmt.Errorf("unexpected deployment %s", name)
+			}
+			return deployment, nil
+		},
+		getService: func(namespace, name string) (*kapi.Service, error) {
+			if name != service.Name {
+				return nil, fmt.Errorf("unexpected service %s", name)
+			}
+			copied := *service
+			copied.Spec.Selector = map[string]string{}
+			for k, v := range service.Spec.Selector {
+				copied.Spec.Selector[k] = v
+			}
+			return &copied, nil
+		},
+		updateService: func(namespace string, updated *kapi.Service) (*kapi.Service, error) {
+			service = updated
+			*updates = append(*updates, updated)
+			return updated, nil
+		},
+		getUpdateAcceptor: getUpdateAcceptor,
+		scaler:            scaler,
+	}
+}
+
+func TestBlueGreen_deploy(t *testing.T) {
+	config := deploytest.OkDeploymentConfig(1)
+	config.Spec.Strategy = blueGreenParams(0, "", "")
+	from, _ := deployutil.MakeDeployment(config, kapi.Codec)
+	config.Status.LatestVersion = 2
+	to, _ := deployutil.MakeDeployment(config, kapi.Codec)
+
+	scaler := &scalertest.FakeScaler{}
+	updates := []*kapi.Service{}
+	strategy := testStrategy(scaler, map[string]*kapi.ReplicationController{from.Name: from, to.Name: to}, &updates)
+
+	if err := strategy.Deploy(from, to, 3); err != nil {
+		t.Fatalf("unexpected deploy error: %#v", err)
+	}
+
+	if e, a := 2, len(scaler.Events); e != a {
+		t.Fatalf("expected %d scale calls, got %d", e, a)
+	}
+	if e, a := to.Name, scaler.Events[0].Name; e != a {
+		t.Errorf("expected %s to be scaled first, got %s", e, a)
+	}
+	if e, a := uint(3), scaler.Events[0].Size; e != a {
+		t.Errorf("expected scale up to %d, got %d", e, a)
+	}
+	if e, a := from.Name, scaler.Events[1].Name; e != a {
+		t.Errorf("expected %s to be scaled down, got %s", e, a)
+	}
+	if e, a := uint(0), scaler.Events[1].Size; e != a {
+		t.Errorf("expected scale down to %d, got %d", e, a)
+	}
+
+	if e, a := 1, len(updates); e != a {
+		t.Fatalf("expected %d service updates, got %d", e, a)
+	}
+	if e, a := to.Name, updates[0].Spec.Selector[deployapi.DeploymentLabel]; e != a {
+		t.Errorf("expected the service to select %s, got %s", e, a)
+	}
+	if e, a := "config", updates[0].Spec.Selector[deployapi.DeploymentConfigLabel]; e != a {
+		t.Errorf("expected the existing selector to be preserved, got %s", a)
+	}
+}
+
+func TestBlueGreen_initialDeployment(t *testing.T) {
+	config := deploytest.OkDeploymentConfig(1)
+	config.Spec.Strategy = blueGreenParams(30, "", "")
+	deployment, _ := deployutil.MakeDeployment(config, kapi.Codec)
+
+	scaler := &scalertest.FakeScaler{}
+	updates := []*kapi.Service{}
+	strategy := testStrategy(scaler, map[string]*kapi.ReplicationController{deployment.Name: deployment}, &updates)
+
+	if err := strategy.Deploy(nil, deployment, 2); err != nil {
+		t.Fatalf("unexpected deploy error: %#v", err)
+	}
+	if e, a := 1, len(scaler.Events); e != a {
+		t.Fatalf("expected %d scale calls, got %d", e, a)
+	}
+	if e, a := uint(2), scaler.Events[0].Size; e != a {
+		t.Errorf("expected scale up to %d, got %d", e, a)
+	}
+	if e, a := 1, len(updates); e != a {
+		t.Fatalf("expected %d service updates, got %d", e, a)
+	}
+}
+
+func TestBlueGreen_acceptorFail(t *testing.T) {
+	config := deploytest.OkDeploymentConfig(1)
+	config.Spec.Strategy = blueGreenParams(0, "", "")
+	from, _ := deployutil.MakeDeployment(config, kapi.Codec)
+	config.Status.LatestVersion = 2
+	to, _ := deployutil.MakeDeployment(config, kapi.Codec)
+
+	scaler := &scalertest.FakeScaler{}
+	updates := []*kapi.Service{}
+	bg := testStrategy(scaler, map[string]*kapi.ReplicationController{from.Name: from, to.Name: to}, &updates)
+	bg.getUpdateAcceptor = func(timeout time.Duration) strategy.UpdateAcceptor {
+		return &testAcceptor{
+			acceptFn: func(deployment *kapi.ReplicationController) error {
+				return fmt.Errorf("rejected")
+			},
+		}
+	}
+
+	if err := bg.Deploy(from, to, 2); err == nil {
+		t.Fatalf("expected a deployment failure")
+	}
+	if e, a := 1, len(scaler.Events); e != a {
+		t.Fatalf("expected %d scale calls, got %d", e, a)
+	}
+	if len(updates) != 0 {
+		t.Errorf("expected the service not to be switched, got %#v", updates)
+	}
+}
+
+func TestBlueGreen_cancelledDuringVerification(t *testing.T) {
+	config := deploytest.OkDeploymentConfig(1)
+	config.Spec.Strategy = blueGreenParams(30, "", "")
+	from, _ := deployutil.MakeDeployment(config, kapi.Codec)
+	config.Status.LatestVersion = 2
+	to, _ := deployutil.MakeDeployment(config, kapi.Codec)
+
+	cancelled, _ := deployutil.MakeDeployment(config, kapi.Codec)
+	cancelled.Annotations[deployapi.DeploymentCancelledAnnotation] = deployapi.DeploymentCancelledAnnotationValue
+
+	scaler := &scalertest.FakeScaler{}
+	updates := []*kapi.Service{}
+	strategy := testStrategy(scaler, map[string]*kapi.ReplicationController{from.Name: from, to.Name: to}, &updates)
+	getReplicationController := strategy.getReplicationController
+	strategy.getReplicationController = func(namespace, name string) (*kapi.ReplicationController, error) {
+		// the deployment is cancelled once the service has been switched
+		if name == to.Name && len(updates) > 0 {
+			return cancelled, nil
+		}
+		return getReplicationController(namespace, name)
+	}
+
+	if err := strategy.Deploy(from, to, 2); err == nil {
+		t.Fatalf("expected a deployment failure")
+	}
+	if e, a := 1, len(scaler.Events); e != a {
+		t.Fatalf("expected %d scale calls, got %d", e, a)
+	}
+	if e, a := to.Name, scaler.Events[0].Name; e != a {
+		t.Errorf("expected %s to be scaled, got %s", e, a)
+	}
+}
+
+func TestBlueGreen_deploymentHooks(t *testing.T) {
+	config := deploytest.OkDeploymentConfig(1)
+	config.Spec.Strategy = blueGreenParams(0, deployapi.LifecycleHookFailurePolicyAbort, deployapi.LifecycleHookFailurePolicyAbort)
+	deployment, _ := deployutil.MakeDeployment(config, kapi.Codec)
+
+	tests := []struct {
+		failOn   string
+		expected []string
+		fail     bool
+	}{
+		{expected: []string{"prehook", "posthook"}},
+		{failOn: "prehook", expected: []string{"prehook"}, fail: true},
+		// post hook failures are ignored
+		{failOn: "posthook", expected: []string{"prehook", "posthook"}},
+	}
+
+	for _, test := range tests {
+		scaler := &scalertest.FakeScaler{}
+		updates := []*kapi.Service{}
+		strategy := testStrategy(scaler, map[string]*kapi.ReplicationController{deployment.Name: deployment}, &updates)
+		executed := []string{}
+		strategy.hookExecutor = &hookExecutorImpl{
+			executeFunc: func(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error {
+				executed = append(executed, label)
+				if label == test.failOn {
+					return fmt.Errorf("hook execution failure")
+				}
+				return nil
+			},
+		}
+
+		err := strategy.Deploy(nil, deployment, 1)
+		if test.fail && err == nil {
+			t.Errorf("%s: expected a deployment failure", test.failOn)
+		}
+		if !test.fail && err != nil {
+			t.Errorf("%s: unexpected deploy error: %v", test.failOn, err)
+		}
+		if fmt.Sprintf("%v", executed) != fmt.Sprintf("%v", test.expected) {
+			t.Errorf("%s: expected hooks %v to be executed, got %v", test.failOn, test.expected, executed)
+		}
+	}
+}
+
+func blueGreenParams(verification int64, preFailurePolicy, postFailurePolicy deployapi.LifecycleHookFailurePolicy) deployapi.DeploymentStrategy {
+	var pre, post *deployapi.LifecycleHook
+	if len(preFailurePolicy) > 0 {
+		pre = &deployapi.LifecycleHook{
+			FailurePolicy: preFailurePolicy,
+			ExecNewPod:    &deployapi.ExecNewPodHook{},
+		}
+	}
+	if len(postFailurePolicy) > 0 {
+		post = &deployapi.LifecycleHook{
+			FailurePolicy: postFailurePolicy,
+			ExecNewPod:    &deployapi.ExecNewPodHook{},
+		}
+	}
+	timeout := int64(30)
+	return deployapi.DeploymentStrategy{
+		Type: deployapi.DeploymentStrategyTypeBlueGreen,
+		BlueGreenParams: &deployapi.BlueGreenDeploymentStrategyParams{
+			ServiceName:         "frontend",
+			TimeoutSeconds:      &timeout,
+			VerificationSeconds: &verification,
+
+			Pre:  pre,
+			Post: post,
+		},
+	}
+}
+
+func getUpdateAcceptor(timeout time.Duration) strategy.UpdateAcceptor {
+	return &testAcceptor{
+		acceptFn: func(deployment *kapi.ReplicationController) error {
+			return nil
+		},
+	}
+}
+
+type testAcceptor struct {
+	acceptFn func(*kapi.ReplicationController) error
+}
+
+func (t *testAcceptor) Accept(deployment *kapi.ReplicationController) error {
+	return t.acceptFn(deployment)
+}
@@ -2,7 +2,11 @@ package rolling
 
 import (
 	"fmt"
+	"math"
 	"os"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/golang/glog"
@@ -13,6 +17,7 @@ import (
 	"k8s.io/kubernetes/pkg/kubectl"
 	"k8s.io/kubernetes/pkg/runtime"
 	"k8s.io/kubernetes/pkg/util"
+	"k8s.io/kubernetes/pkg/util/intstr"
 	"k8s.io/kubernetes/pkg/util/wait"
 
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
@@ -25,6 +30,10 @@ import (
 // https://github.com/kubernetes/kubernetes/issues/7851
 const sourceIdAnnotation = "kubectl.kubernetes.io/update-source-id"
 
+// originalReplicasAnnotation is the replica count the rolling updater records for the
+// old deployment before it is scaled down.
+const originalReplicasAnnotation = "kubectl.kubernetes.io/original-replicas"
+
 const DefaultApiRetryPeriod = 1 * time.Second
 const DefaultApiRetryTimeout = 10 * time.Second
 
@@ -49,6 +58,9 @@ type RollingDeploymentStrategy struct {
 	client kclient.Interface
 	// rollingUpdate knows how to perform a rolling update.
 	rollingUpdate func(config *kubectl.RollingUpdaterConfig) error
+	// scale scales a deployment to the given number of replicas, and waits
+	// for the replication controller to observe the new size.
+	scale func(deployment *kapi.ReplicationController, replicas int, retry, wait *kubectl.RetryParams) error
 	// codec is used to access the encoded config on a deployment.
 	codec runtime.Codec
 	// hookExecutor can execute a lifecycle hook.
@@ -77,6 +89,7 @@ const AcceptorInterval = 1 * time.Second
 
 // NewRollingDeploymentStrategy makes a new RollingDeploymentStrategy.
 func NewRollingDeploymentStrategy(namespace string, client kclient.Interface, codec runtime.Codec, initialStrategy acceptingDeploymentStrategy) *RollingDeploymentStrategy {
+	scaler, _ := kubectl.ScalerFor(kapi.Kind("ReplicationController"), client)
 	return &RollingDeploymentStrategy{
 		codec:           codec,
 		initialStrategy: initialStrategy,
@@ -87,6 +100,9 @@ func NewRollingDeploymentStrategy(namespace string, client kclient.Interface, co
 			updater := kubectl.NewRollingUpdater(namespace, client)
 			return updater.Update(config)
 		},
+		scale: func(deployment *kapi.ReplicationController, replicas int, retry, wait *kubectl.RetryParams) error {
+			return scaler.Scale(deployment.Namespace, deployment.Name, uint(replicas), &kubectl.ScalePrecondition{Size: -1, ResourceVersion: ""}, retry, wait)
+		},
 		hookExecutor: stratsupport.NewHookExecutor(client, os.Stdout, codec),
 		getUpdateAcceptor: func(timeout time.Duration) strat.UpdateAcceptor {
 			return stratsupport.NewAcceptNewlyObservedReadyPods(client, timeout, AcceptorInterval)
@@ -186,6 +202,17 @@ func (s *RollingDeploymentStrategy) Deploy(from *kapi.ReplicationController, to
 		return err
 	}
 
+	// Scale up to each pause point and wait for the deployment to be resumed.
+	// The rolling updater picks up from the resulting replica counts.
+	if points := pausePoints(params.PausePoints, desiredReplicas); len(points) > 0 {
+		if from, err = s.pauseAtPoints(from, to, points, params, updateAcceptor); err != nil {
+			return err
+		}
+		if to, err = s.client.ReplicationControllers(to.Namespace).Get(to.Name); err != nil {
+			return err
+		}
+	}
+
 	// HACK: There's a validation in the rolling updater which assumes that when
 	// an existing RC is supplied, it will have >0 replicas- a validation which
 	// is then disregarded as the desired count is obtained from the annotation
@@ -225,6 +252,135 @@ func (s *RollingDeploymentStrategy) Deploy(from *kapi.ReplicationController, to
 	return nil
 }
 
+// pauseAtPoints scales the new deployment up to each of the pause points,
+// scaling the old deployment down by as many replicas, and waits at each point
+// until the deployment is resumed. The updated old deployment is returned.
+func (s *RollingDeploymentStrategy) pauseAtPoints(from, to *kapi.ReplicationController, points []int, params *deployapi.RollingDeploymentStrategyParams, updateAcceptor strat.UpdateAcceptor) (*kapi.ReplicationController, error) {
+	interval := time.Duration(*params.IntervalSeconds) * time.Second
+	timeout := time.Duration(*params.TimeoutSeconds) * time.Second
+	retryParams := kubectl.NewRetryParams(interval, timeout)
+	waitParams := kubectl.NewRetryParams(interval, timeout)
+
+	// Record the original size of the old deployment for the rolling updater,
+	// which would otherwise take its size after the pause points.
+	original := from.Spec.Replicas
+	if value, ok := from.Annotations[originalReplicasAnnotation]; ok {
+		if replicas, err := strconv.Atoi(value); err == nil {
+			original = replicas
+		}
+	} else {
+		if from.Annotations == nil {
+			from.Annotations = map[string]string{}
+		}
+		from.Annotations[originalReplicasAnnotation] = strconv.Itoa(original)
+		updated, err := s.client.ReplicationControllers(from.Namespace).Update(from)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't record the original replicas of %s: %v", deployutil.LabelForDeployment(from), err)
+		}
+		from = updated
+	}
+
+	for _, replicas := range points {
+		// A retried deployment may already be past some of the pause points.
+		if to.Spec.Replicas >= replicas {
+			continue
+		}
+
+		glog.Infof("Scaling %s up to %d replicas", deployutil.LabelForDeployment(to), replicas)
+		if err := s.scale(to, replicas, retryParams, waitParams); err != nil {
+			return nil, fmt.Errorf("couldn't scale %s to %d: %v", deployutil.LabelForDeployment(to), replicas, err)
+		}
+		to.Spec.Replicas = replicas
+		if err := updateAcceptor.Accept(to); err != nil {
+			return nil, fmt.Errorf("update acceptor rejected %s: %v", deployutil.LabelForDeployment(to), err)
+		}
+
+		if remaining := original - replicas; remaining < from.Spec.Replicas {
+			if remaining < 0 {
+				remaining = 0
+			}
+			glog.Infof("Scaling %s down to %d replicas", deployutil.LabelForDeployment(from), remaining)
+			if err := s.scale(from, remaining, retryParams, waitParams); err != nil {
+				return nil, fmt.Errorf("couldn't scale %s to %d: %v", deployutil.LabelForDeployment(from), remaining, err)
+			}
+			from.Spec.Replicas = remaining
+		}
+
+		if err := s.waitForResume(to, replicas, interval); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.client.ReplicationControllers(from.Namespace).Get(from.Name)
+}
+
+// waitForResume marks the deployment as paused at the given number of replicas,
+// and waits for the mark to be removed. An error is returned if the deployment is
+// cancelled while it is paused.
+func (s *RollingDeploymentStrategy) waitForResume(deployment *kapi.ReplicationController, replicas int, interval time.Duration) error {
+	err := wait.Poll(s.apiRetryPeriod, s.apiRetryTimeout, func() (bool, error) {
+		existing, err := s.client.ReplicationControllers(deployment.Namespace).Get(deployment.Name)
+		if err != nil {
+			glog.Infof("couldn't look up deployment %s: %v", deployutil.LabelForDeployment(deployment), err)
+			return false, nil
+		}
+		existing.Annotations[deployapi.DeploymentPausedAnnotation] = strconv.Itoa(replicas)
+		if _, err := s.client.ReplicationControllers(existing.Namespace).Update(existing); err != nil {
+			glog.Infof("couldn't pause deployment %s: %v", deployutil.LabelForDeployment(deployment), err)
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return fmt.Errorf("couldn't pause deployment %s: %v", deployutil.LabelForDeployment(deployment), err)
+	}
+
+	glog.Infof("Paused %s at %d replicas, waiting for the deployment to be resumed", deployutil.LabelForDeployment(deployment), replicas)
+	return wait.PollInfinite(interval, func() (bool, error) {
+		existing, err := s.client.ReplicationControllers(deployment.Namespace).Get(deployment.Name)
+		if err != nil {
+			if kerrors.IsNotFound(err) {
+				return false, err
+			}
+			glog.V(4).Infof("couldn't look up deployment %s: %v", deployutil.LabelForDeployment(deployment), err)
+			return false, nil
+		}
+		if deployutil.IsDeploymentCancelled(existing) {
+			return false, fmt.Errorf("deployment %s was cancelled while paused at %d replicas", deployutil.LabelForDeployment(deployment), replicas)
+		}
+		if _, paused := deployutil.DeploymentPausedAt(existing); paused {
+			return false, nil
+		}
+		glog.Infof("Resumed %s", deployutil.LabelForDeployment(deployment))
+		return true, nil
+	})
+}
+
+// pausePoints returns the replica counts of the pause points for the desired
+// number of replicas in ascending order. Pause points at or above the desired
+// replicas are dropped, as the deployment completes there.
+func pausePoints(points []intstr.IntOrString, desiredReplicas int) []int {
+	replicas := []int{}
+	seen := map[int]bool{}
+	for _, point := range points {
+		value := point.IntValue()
+		if point.Type == intstr.String {
+			percent, err := strconv.Atoi(strings.TrimSuffix(point.StrVal, "%"))
+			if err != nil {
+				continue
+			}
+			value = int(math.Ceil(float64(desiredReplicas) * float64(percent) / 100))
+		}
+		if value <= 0 || value >= desiredReplicas || seen[value] {
+			continue
+		}
+		seen[value] = true
+		replicas = append(replicas, value)
+	}
+	sort.Ints(replicas)
+	return replicas
+}
+
 // rollingUpdaterWriter is an io.Writer that delegates to glog.
 type rollingUpdaterWriter struct{}
 
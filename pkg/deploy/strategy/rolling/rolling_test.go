@@ -2,6 +2,7 @@ package rolling
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 	"time"
 
@@ -9,6 +10,7 @@ import (
 	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
 	"k8s.io/kubernetes/pkg/kubectl"
 	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/intstr"
 
 	api "github.com/openshift/origin/pkg/api/latest"
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
@@ -211,6 +213,103 @@ func TestRolling_deployRollingHooks(t *testing.T) {
 	}
 }
 
+func TestRolling_deployRollingPausePoints(t *testing.T) {
+	for _, cancel := range []bool{false, true} {
+		latestConfig := deploytest.OkDeploymentConfig(1)
+		latestConfig.Spec.Strategy = deploytest.OkRollingStrategy()
+		latest, _ := deployutil.MakeDeployment(latestConfig, kapi.Codec)
+		latest.Spec.Replicas = 4
+		config := deploytest.OkDeploymentConfig(2)
+		config.Spec.Strategy = deploytest.OkRollingStrategy()
+		config.Spec.Strategy.RollingParams.PausePoints = []intstr.IntOrString{intstr.FromString("25%"), intstr.FromInt(8)}
+		deployment, _ := deployutil.MakeDeployment(config, kapi.Codec)
+		deployment.Spec.Replicas = 0
+
+		deployments := map[string]*kapi.ReplicationController{
+			latest.Name:     latest,
+			deployment.Name: deployment,
+		}
+		pausedAt := ""
+
+		fake := &ktestclient.Fake{}
+		fake.AddReactor("get", "replicationcontrollers", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+			name := action.(ktestclient.GetAction).GetName()
+			return true, deployments[name], nil
+		})
+		fake.AddReactor("update", "replicationcontrollers", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+			updated := action.(ktestclient.UpdateAction).GetObject().(*kapi.ReplicationController)
+			// resume or cancel the deployment as soon as it is paused
+			if value, ok := updated.Annotations[deployapi.DeploymentPausedAnnotation]; ok {
+				pausedAt = value
+				delete(updated.Annotations, deployapi.DeploymentPausedAnnotation)
+				if cancel {
+					updated.Annotations[deployapi.DeploymentCancelledAnnotation] = deployapi.DeploymentCancelledAnnotationValue
+				}
+			}
+			deployments[updated.Name] = updated
+			return true, updated, nil
+		})
+
+		scaled := []string{}
+		var rollingConfig *kubectl.RollingUpdaterConfig
+		strategy := &RollingDeploymentStrategy{
+			codec:  api.Codec,
+			client: fake,
+			rollingUpdate: func(config *kubectl.RollingUpdaterConfig) error {
+				rollingConfig = config
+				return nil
+			},
+			scale: func(deployment *kapi.ReplicationController, replicas int, retry, wait *kubectl.RetryParams) error {
+				scaled = append(scaled, fmt.Sprintf("%s=%d", deployment.Name, replicas))
+				deployments[deployment.Name].Spec.Replicas = replicas
+				return nil
+			},
+			getUpdateAcceptor: getUpdateAcceptor,
+			apiRetryPeriod:    1 * time.Millisecond,
+			apiRetryTimeout:   10 * time.Millisecond,
+		}
+
+		err := strategy.Deploy(latest, deployment, 4)
+		if e, a := "1", pausedAt; e != a {
+			t.Errorf("expected the deployment to pause at %s replicas, got %q", e, a)
+		}
+		if e, a := fmt.Sprintf("[%s=1 %s=3]", deployment.Name, latest.Name), fmt.Sprintf("%v", scaled); e != a {
+			t.Errorf("expected scaling %s, got %s", e, a)
+		}
+		if cancel {
+			if err == nil {
+				t.Errorf("expected an error for the cancelled deployment")
+			}
+			if rollingConfig != nil {
+				t.Errorf("unexpected rolling update of the cancelled deployment")
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if rollingConfig == nil {
+			t.Fatalf("expected rolling update to be invoked")
+		}
+		if e, a := 3, rollingConfig.OldRc.Spec.Replicas; e != a {
+			t.Errorf("expected rollingConfig.OldRc.Spec.Replicas %d, got %d", e, a)
+		}
+		if e, a := "4", rollingConfig.OldRc.Annotations[originalReplicasAnnotation]; e != a {
+			t.Errorf("expected the original replicas %s to be recorded, got %q", e, a)
+		}
+	}
+}
+
+func TestPausePoints(t *testing.T) {
+	points := []intstr.IntOrString{intstr.FromString("50%"), intstr.FromInt(1), intstr.FromString("10%"), intstr.FromInt(10), intstr.FromString("99%")}
+	if e, a := []int{1, 5}, pausePoints(points, 10); !reflect.DeepEqual(e, a) {
+		t.Errorf("expected pause points %v, got %v", e, a)
+	}
+	if e, a := []int{}, pausePoints(points, 1); !reflect.DeepEqual(e, a) {
+		t.Errorf("expected pause points %v, got %v", e, a)
+	}
+}
+
 // TestRolling_deployInitialHooks can go away once the rolling strategy
 // supports initial deployments.
 func TestRolling_deployInitialHooks(t *testing.T) {
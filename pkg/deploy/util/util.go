@@ -255,6 +255,25 @@ func IsDeploymentCancelled(deployment *api.ReplicationController) bool {
 	return strings.EqualFold(value, deployapi.DeploymentCancelledAnnotationValue)
 }
 
+// DeploymentPausedAt returns the number of replicas at which the deployment is paused,
+// and false if the deployment is not paused.
+func DeploymentPausedAt(obj runtime.Object) (int, bool) {
+	return intAnnotationFor(obj, deployapi.DeploymentPausedAnnotation)
+}
+
+// SwitchServiceSelector points the selector of service at the pods of the named
+// deployment, and returns false if it already selected them.
+func SwitchServiceSelector(service *api.Service, deploymentName string) bool {
+	if service.Spec.Selector[deployapi.DeploymentLabel] == deploymentName {
+		return false
+	}
+	if service.Spec.Selector == nil {
+		service.Spec.Selector = map[string]string{}
+	}
+	service.Spec.Selector[deployapi.DeploymentLabel] = deploymentName
+	return true
+}
+
 // IsTerminatedDeployment returns true if the passed deployment has terminated (either
 // complete or failed).
 func IsTerminatedDeployment(deployment *api.ReplicationController) bool {
@@ -0,0 +1,218 @@
+package server
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/docker/distribution"
+	"github.com/docker/distribution/context"
+	"github.com/docker/distribution/digest"
+	"github.com/docker/distribution/registry/storage"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	"github.com/openshift/origin/pkg/client"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+// RepositoryPruneReport describes the data of a repository that is not referenced by any image.
+type RepositoryPruneReport struct {
+	// Name is the name of the repository.
+	Name string
+	// Manifests are the manifest revisions of images that no longer exist.
+	Manifests []digest.Digest
+	// Layers are the layer links to blobs not referenced by any image.
+	Layers []digest.Digest
+	// Size is the total size of the manifests and layers.
+	Size int64
+}
+
+// PruneReport describes the data found by a hard prune of the registry storage.
+type PruneReport struct {
+	// Repositories lists the repositories with unreferenced manifests or layers.
+	Repositories []RepositoryPruneReport
+	// Blobs are the blobs in storage not referenced by any image.
+	Blobs []digest.Digest
+	// Size is the total size of Blobs, which is the space freed by the prune.
+	Size int64
+}
+
+// HardPrune walks the storage of registry and removes manifest revisions of images that no longer exist in
+// the API, layer links and signatures of those manifests, and blobs that are not referenced by any image.
+// If dryRun is true nothing is removed and the data that would be removed is reported. The registry must not
+// accept pushes while it is pruned, since layers uploaded for an image that has not been created yet are
+// unreferenced.
+func HardPrune(ctx context.Context, registry distribution.Namespace, images client.ImagesInterfacer, dryRun bool) (*PruneReport, error) {
+	list, err := images.Images().List(kapi.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list images: %v", err)
+	}
+	manifests := sets.NewString()
+	blobs := sets.NewString()
+	for i := range list.Items {
+		image := &list.Items[i]
+		if err := imageapi.ImageWithMetadata(image); err != nil {
+			return nil, fmt.Errorf("unable to read the manifest of image %s: %v", image.Name, err)
+		}
+		manifests.Insert(image.Name)
+		blobs.Insert(image.Name)
+		for _, layer := range image.DockerImageLayers {
+			blobs.Insert(layer.Name)
+		}
+	}
+
+	report := &PruneReport{}
+	repositories, err := storageRepositories(ctx, registry)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range repositories {
+		repoReport, err := pruneRepository(ctx, registry, name, manifests, blobs, dryRun)
+		if err != nil {
+			return nil, fmt.Errorf("unable to prune repository %s: %v", name, err)
+		}
+		if len(repoReport.Manifests) > 0 || len(repoReport.Layers) > 0 {
+			report.Repositories = append(report.Repositories, *repoReport)
+		}
+	}
+
+	// signatures of the remaining manifests were added to blobs while walking the repositories
+	enumerator, err := storage.RegistryBlobEnumerator(registry)
+	if err != nil {
+		return nil, err
+	}
+	var orphaned []digest.Digest
+	err = enumerator.Enumerate(ctx, func(dgst digest.Digest) error {
+		if !blobs.Has(dgst.String()) {
+			orphaned = append(orphaned, dgst)
+		}
+		return nil
+	})
+	if err != nil && err != io.EOF {
+		return nil, fmt.Errorf("unable to enumerate blobs: %v", err)
+	}
+	deleter, err := storage.RegistryBlobDeleter(registry)
+	if err != nil {
+		return nil, err
+	}
+	for _, dgst := range orphaned {
+		report.Size += blobSize(ctx, registry, dgst)
+		report.Blobs = append(report.Blobs, dgst)
+		if dryRun {
+			continue
+		}
+		if err := deleter.Delete(ctx, dgst); err != nil {
+			return nil, fmt.Errorf("unable to delete blob %s: %v", dgst, err)
+		}
+	}
+	return report, nil
+}
+
+// pruneRepository removes the manifest revisions in the named repository that are not in manifests and the
+// layer links to blobs not in blobs. The signatures of the remaining revisions are added to blobs.
+func pruneRepository(ctx context.Context, registry distribution.Namespace, name string, manifests, blobs sets.String, dryRun bool) (*RepositoryPruneReport, error) {
+	report := &RepositoryPruneReport{Name: name}
+	repo, err := registry.Repository(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	bs := repo.Blobs(ctx)
+	enumerator, ok := bs.(distribution.BlobEnumerator)
+	if !ok {
+		return nil, fmt.Errorf("the layers of repository %s cannot be enumerated", name)
+	}
+	layers := sets.NewString()
+	err = enumerator.Enumerate(ctx, func(dgst digest.Digest) error {
+		layers.Insert(dgst.String())
+		return nil
+	})
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	ms, err := repo.Manifests(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// include revisions whose data is missing from storage
+	if err := storage.EnumerateAllDigests(ms); err != nil {
+		return nil, err
+	}
+	revisions, err := ms.Enumerate()
+	if err != nil {
+		return nil, err
+	}
+	for _, dgst := range revisions {
+		// the enumeration includes the layer links, which are pruned below
+		if layers.Has(dgst.String()) {
+			continue
+		}
+		if manifests.Has(dgst.String()) {
+			signatures, err := repo.Signatures().Enumerate(dgst)
+			if err != nil && err != io.EOF {
+				return nil, err
+			}
+			for _, signature := range signatures {
+				blobs.Insert(signature.String())
+			}
+			continue
+		}
+		report.Size += blobSize(ctx, registry, dgst)
+		report.Manifests = append(report.Manifests, dgst)
+		if dryRun {
+			continue
+		}
+		if err := ms.Delete(dgst); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, layer := range layers.List() {
+		if blobs.Has(layer) {
+			continue
+		}
+		dgst := digest.Digest(layer)
+		report.Size += blobSize(ctx, registry, dgst)
+		report.Layers = append(report.Layers, dgst)
+		if dryRun {
+			continue
+		}
+		if err := bs.Delete(ctx, dgst); err != nil {
+			return nil, err
+		}
+	}
+	return report, nil
+}
+
+// storageRepositories returns the names of all repositories in the storage of registry.
+func storageRepositories(ctx context.Context, registry distribution.Namespace) ([]string, error) {
+	var names []string
+	last := ""
+	for {
+		page := make([]string, 100)
+		n, err := registry.Repositories(ctx, page, last)
+		names = append(names, page[:n]...)
+		if err == io.EOF {
+			return names, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to list repositories: %v", err)
+		}
+		if n == 0 {
+			return names, nil
+		}
+		last = page[n-1]
+	}
+}
+
+// blobSize returns the size of the blob dgst, or zero if the blob no longer exists.
+func blobSize(ctx context.Context, registry distribution.Namespace, dgst digest.Digest) int64 {
+	desc, err := registry.Blobs().Stat(ctx, dgst)
+	if err != nil {
+		context.GetLogger(ctx).Debugf("Unable to determine the size of blob %s: %v", dgst, err)
+		return 0
+	}
+	return desc.Size
+}
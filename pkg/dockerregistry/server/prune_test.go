@@ -0,0 +1,124 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/docker/distribution"
+	"github.com/docker/distribution/context"
+	"github.com/docker/distribution/digest"
+	"github.com/docker/distribution/manifest"
+	"github.com/docker/distribution/manifest/schema1"
+	"github.com/docker/distribution/registry/storage"
+	"github.com/docker/distribution/registry/storage/driver/inmemory"
+	"github.com/docker/libtrust"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	"github.com/openshift/origin/pkg/client/testclient"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+// putImage stores a manifest with the given layers in repo and returns the image it describes.
+func putImage(t *testing.T, ctx context.Context, repo distribution.Repository, tag string, layers ...string) *imageapi.Image {
+	m := &schema1.Manifest{
+		Versioned: manifest.Versioned{SchemaVersion: 1},
+		Name:      repo.Name(),
+		Tag:       tag,
+	}
+	for _, layer := range layers {
+		desc, err := repo.Blobs(ctx).Put(ctx, "application/octet-stream", []byte(layer))
+		if err != nil {
+			t.Fatal(err)
+		}
+		m.FSLayers = append(m.FSLayers, schema1.FSLayer{BlobSum: desc.Digest})
+		m.History = append(m.History, schema1.History{V1Compatibility: "{}"})
+	}
+	key, err := libtrust.GenerateECP256PrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	signed, err := schema1.Sign(m, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ms, err := repo.Manifests(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ms.Put(signed); err != nil {
+		t.Fatal(err)
+	}
+	payload, _ := signed.Payload()
+	dgst, _ := digest.FromBytes(payload)
+	return &imageapi.Image{ObjectMeta: kapi.ObjectMeta{Name: dgst.String()}, DockerImageManifest: string(signed.Raw)}
+}
+
+func TestHardPrune(t *testing.T) {
+	ctx := context.Background()
+	registry, err := storage.NewRegistry(ctx, inmemory.New(), storage.EnableDelete, storage.RemoveParentsOnDelete)
+	if err != nil {
+		t.Fatal(err)
+	}
+	app, err := registry.Repository(ctx, "ns/app")
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := registry.Repository(ctx, "ns/other")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	kept := putImage(t, ctx, app, "v1", "shared layer", "kept layer")
+	deleted := putImage(t, ctx, app, "v2", "shared layer", "deleted layer")
+	putImage(t, ctx, other, "latest", "shared layer", "orphaned layer")
+	client := testclient.NewSimpleFake(&imageapi.ImageList{Items: []imageapi.Image{*kept}})
+
+	report, err := HardPrune(ctx, registry, client, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(report.Repositories) != 2 {
+		t.Fatalf("expected both repositories to be reported, got %#v", report.Repositories)
+	}
+	appReport, otherReport := report.Repositories[0], report.Repositories[1]
+	if appReport.Name != "ns/app" || len(appReport.Manifests) != 1 || appReport.Manifests[0].String() != deleted.Name || len(appReport.Layers) != 1 {
+		t.Errorf("unexpected report for ns/app: %#v", appReport)
+	}
+	if appReport.Size != int64(len("deleted layer"))+blobSize(ctx, registry, appReport.Manifests[0]) {
+		t.Errorf("unexpected size for ns/app: %d", appReport.Size)
+	}
+	if otherReport.Name != "ns/other" || len(otherReport.Manifests) != 1 || len(otherReport.Layers) != 1 {
+		t.Errorf("unexpected report for ns/other: %#v", otherReport)
+	}
+	// the two deleted manifests, their signatures and the two unreferenced layers
+	if len(report.Blobs) != 6 || report.Size == 0 {
+		t.Errorf("unexpected blobs reported: %d blobs of %d bytes", len(report.Blobs), report.Size)
+	}
+	if _, err := registry.Blobs().Stat(ctx, report.Blobs[0]); err != nil {
+		t.Errorf("expected a dry run not to delete blobs: %v", err)
+	}
+
+	if _, err := HardPrune(ctx, registry, client, false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, dgst := range report.Blobs {
+		if _, err := registry.Blobs().Stat(ctx, dgst); err == nil {
+			t.Errorf("expected blob %s to be deleted", dgst)
+		}
+	}
+	ms, err := app.Manifests(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ms.Get(digest.Digest(kept.Name)); err != nil {
+		t.Errorf("expected the manifest of the remaining image to be served: %v", err)
+	}
+
+	report, err = HardPrune(ctx, registry, client, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(report.Repositories) != 0 || len(report.Blobs) != 0 {
+		t.Errorf("expected nothing left to prune, got %#v", report)
+	}
+}
@@ -121,16 +121,57 @@ func (r *pullthroughBlobStore) ServeBlob(ctx context.Context, w http.ResponseWri
 		return err
 	}
 
+	defer remoteReader.Close()
+
 	setResponseHeaders(w, desc.Size, desc.MediaType, dgst)
 
+	var out io.Writer = w
+	var mirror *mirrorWriter
+	if r.repo.mirrorPullthrough {
+		if bw, err := r.BlobStore.Create(ctx); err != nil {
+			context.GetLogger(r.repo.ctx).Errorf("Unable to mirror blob %q to local storage: %v", dgst.String(), err)
+		} else {
+			mirror = &mirrorWriter{BlobWriter: bw}
+			defer bw.Cancel(ctx)
+			out = io.MultiWriter(w, mirror)
+		}
+	}
+
 	context.GetLogger(r.repo.ctx).Infof("Copying %d bytes of type %q for %q", desc.Size, desc.MediaType, dgst.String())
-	if _, err := io.CopyN(w, remoteReader, desc.Size); err != nil {
+	if _, err := io.CopyN(out, remoteReader, desc.Size); err != nil {
 		context.GetLogger(r.repo.ctx).Errorf("Failed copying content from remote store %q: %v", dgst.String(), err)
 		return err
 	}
+
+	if mirror != nil {
+		if mirror.err != nil {
+			context.GetLogger(r.repo.ctx).Errorf("Failed mirroring blob %q to local storage: %v", dgst.String(), mirror.err)
+			return nil
+		}
+		if _, err := mirror.Commit(ctx, distribution.Descriptor{Digest: dgst, Size: desc.Size, MediaType: desc.MediaType}); err != nil {
+			context.GetLogger(r.repo.ctx).Errorf("Failed committing mirrored blob %q to local storage: %v", dgst.String(), err)
+			return nil
+		}
+		delete(r.digestToStore, dgst.String())
+		context.GetLogger(r.repo.ctx).Infof("Mirrored blob %q to local storage", dgst.String())
+	}
 	return nil
 }
 
+// mirrorWriter writes to a local blob while a remote blob is served. A failure to write locally is
+// recorded instead of returned so that it does not interrupt serving the blob.
+type mirrorWriter struct {
+	distribution.BlobWriter
+	err error
+}
+
+func (w *mirrorWriter) Write(p []byte) (int, error) {
+	if w.err == nil {
+		_, w.err = w.BlobWriter.Write(p)
+	}
+	return len(p), nil
+}
+
 // findCandidateRepository looks in search for a particular blob, referring to previously cached items
 func (r *pullthroughBlobStore) findCandidateRepository(ctx context.Context, search map[string]*imageapi.DockerImageReference, cachedLayers []string, dgst digest.Digest, retriever importer.RepositoryRetriever) (distribution.Descriptor, error) {
 	// no possible remote locations to search, exit early
@@ -199,6 +240,31 @@ func identifyCandidateRepositories(is *imageapi.ImageStream, localRegistry strin
 			search[ref.AsRepository().Exact()] = &ref
 		}
 	}
+	if primary {
+		// tags with the Local reference policy record the integrated registry in their events, and tags
+		// pulled through before being imported have no events, so search the repositories recorded in the
+		// spec as well
+		var specRefs []string
+		if len(is.Spec.DockerImageRepository) > 0 {
+			specRefs = append(specRefs, is.Spec.DockerImageRepository)
+		}
+		for _, tagRef := range is.Spec.Tags {
+			if tagRef.From != nil && tagRef.From.Kind == "DockerImage" {
+				specRefs = append(specRefs, tagRef.From.Name)
+			}
+		}
+		for _, spec := range specRefs {
+			ref, err := imageapi.ParseDockerImageReference(spec)
+			if err != nil {
+				continue
+			}
+			if len(localRegistry) != 0 && localRegistry == ref.Registry {
+				continue
+			}
+			ref = ref.DockerClientDefaults()
+			search[ref.AsRepository().Exact()] = &ref
+		}
+	}
 	return search
 }
 
@@ -0,0 +1,107 @@
+package server
+
+import (
+	"os"
+
+	"github.com/docker/distribution"
+	"github.com/docker/distribution/context"
+	"github.com/docker/distribution/registry/api/errcode"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/resource"
+
+	imageapi "github.com/openshift/origin/pkg/image/api"
+	imagequota "github.com/openshift/origin/pkg/image/quota"
+)
+
+// quotaBlobStore rejects blobs that would exceed the image storage quota of the project of repo. Usage is
+// only checked here, it is recorded when the manifest referencing the blobs is tagged into an image stream.
+type quotaBlobStore struct {
+	distribution.BlobStore
+
+	repo *repository
+}
+
+var _ distribution.BlobStore = &quotaBlobStore{}
+
+// Put rejects p if it does not fit in the image storage quota.
+func (bs *quotaBlobStore) Put(ctx context.Context, mediaType string, p []byte) (distribution.Descriptor, error) {
+	if err := bs.repo.checkQuota(storageUsage(int64(len(p)))); err != nil {
+		return distribution.Descriptor{}, err
+	}
+	return bs.BlobStore.Put(ctx, mediaType, p)
+}
+
+// Create rejects new uploads once the image storage quota is used up, and otherwise returns a writer that
+// checks the size of the blob when it is committed.
+func (bs *quotaBlobStore) Create(ctx context.Context) (distribution.BlobWriter, error) {
+	if err := bs.repo.checkQuota(storageUsage(1)); err != nil {
+		return nil, err
+	}
+	bw, err := bs.BlobStore.Create(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &quotaBlobWriter{BlobWriter: bw, repo: bs.repo}, nil
+}
+
+// Resume returns a writer for the upload id that checks the size of the blob when it is committed.
+func (bs *quotaBlobStore) Resume(ctx context.Context, id string) (distribution.BlobWriter, error) {
+	bw, err := bs.BlobStore.Resume(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &quotaBlobWriter{BlobWriter: bw, repo: bs.repo}, nil
+}
+
+// quotaBlobWriter rejects a blob on commit if it does not fit in the image storage quota.
+type quotaBlobWriter struct {
+	distribution.BlobWriter
+
+	repo *repository
+}
+
+// Commit completes the upload if the written blob fits in the image storage quota.
+func (bw *quotaBlobWriter) Commit(ctx context.Context, provisional distribution.Descriptor) (distribution.Descriptor, error) {
+	size := provisional.Size
+	if size == 0 {
+		offset, err := bw.Seek(0, os.SEEK_CUR)
+		if err != nil {
+			return distribution.Descriptor{}, err
+		}
+		if size, err = bw.Seek(0, os.SEEK_END); err != nil {
+			return distribution.Descriptor{}, err
+		}
+		if _, err := bw.Seek(offset, os.SEEK_SET); err != nil {
+			return distribution.Descriptor{}, err
+		}
+	}
+	if err := bw.repo.checkQuota(storageUsage(size)); err != nil {
+		return distribution.Descriptor{}, err
+	}
+	return bw.BlobWriter.Commit(ctx, provisional)
+}
+
+// storageUsage returns the usage of a blob of size bytes.
+func storageUsage(size int64) kapi.ResourceList {
+	return kapi.ResourceList{imageapi.ResourceImageStorage: *resource.NewQuantity(size, resource.BinarySI)}
+}
+
+// checkQuota returns a denied error if adding usage to the project of r would exceed any of its quotas.
+// Quotas that cannot be retrieved are not enforced, the usage is still checked by the API when the image
+// is tagged into an image stream.
+func (r *repository) checkQuota(usage kapi.ResourceList) error {
+	if r.quotaClient == nil {
+		return nil
+	}
+	quotas, err := r.quotaClient.ResourceQuotas(r.namespace).List(kapi.ListOptions{})
+	if err != nil {
+		context.GetLogger(r.ctx).Errorf("Error listing quotas of %s: %v", r.namespace, err)
+		return nil
+	}
+	if err := imagequota.Check(quotas.Items, usage); err != nil {
+		context.GetLogger(r.ctx).Infof("Rejecting push to %s/%s: %v", r.namespace, r.name, err)
+		return errcode.ErrorCodeDenied.WithDetail(err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package server
+
+import (
+	"os"
+	"testing"
+
+	"github.com/docker/distribution"
+	"github.com/docker/distribution/context"
+	"github.com/docker/distribution/registry/api/errcode"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/resource"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+type fakeBlobStore struct {
+	distribution.BlobStore
+	writer *fakeBlobWriter
+}
+
+func (bs *fakeBlobStore) Create(ctx context.Context) (distribution.BlobWriter, error) {
+	return bs.writer, nil
+}
+
+func (bs *fakeBlobStore) Resume(ctx context.Context, id string) (distribution.BlobWriter, error) {
+	return bs.writer, nil
+}
+
+type fakeBlobWriter struct {
+	distribution.BlobWriter
+	size, offset int64
+	committed    bool
+}
+
+func (bw *fakeBlobWriter) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case os.SEEK_CUR:
+		bw.offset += offset
+	case os.SEEK_END:
+		bw.offset = bw.size + offset
+	case os.SEEK_SET:
+		bw.offset = offset
+	}
+	return bw.offset, nil
+}
+
+func (bw *fakeBlobWriter) Commit(ctx context.Context, provisional distribution.Descriptor) (distribution.Descriptor, error) {
+	bw.committed = true
+	return provisional, nil
+}
+
+func TestQuotaBlobStore(t *testing.T) {
+	tests := map[string]struct {
+		hard, used int64
+		size       int64
+		createErr  bool
+		commitErr  bool
+	}{
+		"within quota": {
+			hard: 100,
+			used: 50,
+			size: 50,
+		},
+		"blob exceeds quota": {
+			hard:      100,
+			used:      50,
+			size:      51,
+			commitErr: true,
+		},
+		"quota used up": {
+			hard:      100,
+			used:      100,
+			createErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		quota := kapi.ResourceQuota{
+			ObjectMeta: kapi.ObjectMeta{Namespace: "test", Name: "images"},
+			Spec:       kapi.ResourceQuotaSpec{Hard: kapi.ResourceList{imageapi.ResourceImageStorage: *resource.NewQuantity(test.hard, resource.BinarySI)}},
+			Status:     kapi.ResourceQuotaStatus{Used: kapi.ResourceList{imageapi.ResourceImageStorage: *resource.NewQuantity(test.used, resource.BinarySI)}},
+		}
+		client := &ktestclient.Fake{}
+		client.AddReactor("list", "resourcequotas", func(action ktestclient.Action) (bool, runtime.Object, error) {
+			return true, &kapi.ResourceQuotaList{Items: []kapi.ResourceQuota{quota}}, nil
+		})
+
+		ctx := context.Background()
+		writer := &fakeBlobWriter{size: test.size, offset: test.size / 2}
+		repo := &repository{ctx: ctx, namespace: "test", name: "app", quotaClient: client}
+		bs := &quotaBlobStore{BlobStore: &fakeBlobStore{writer: writer}, repo: repo}
+
+		bw, err := bs.Create(ctx)
+		if test.createErr {
+			if codeErr, ok := err.(errcode.Error); !ok || codeErr.Code != errcode.ErrorCodeDenied {
+				t.Errorf("%s: expected a denied error, got %v", name, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+
+		_, err = bw.Commit(ctx, distribution.Descriptor{})
+		if test.commitErr {
+			if codeErr, ok := err.(errcode.Error); !ok || codeErr.Code != errcode.ErrorCodeDenied {
+				t.Errorf("%s: expected a denied error, got %v", name, err)
+			}
+			if writer.committed {
+				t.Errorf("%s: expected the blob not to be committed", name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if !writer.committed || writer.offset != test.size/2 {
+			t.Errorf("%s: expected the blob to be committed at the original offset, got %#v", name, writer)
+		}
+	}
+}
@@ -12,11 +12,13 @@ import (
 	"github.com/docker/distribution/context"
 	"github.com/docker/distribution/digest"
 	"github.com/docker/distribution/manifest/schema1"
+	"github.com/docker/distribution/registry/api/errcode"
 	repomw "github.com/docker/distribution/registry/middleware/repository"
 	"github.com/docker/libtrust"
 
 	kapi "k8s.io/kubernetes/pkg/api"
 	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/resource"
 	kclient "k8s.io/kubernetes/pkg/client/unversioned"
 
 	"github.com/openshift/origin/pkg/client"
@@ -65,6 +67,13 @@ type repository struct {
 	// if true, the repository will check remote references in the image stream to support pulling "through"
 	// from a remote repository
 	pullthrough bool
+	// if true, blobs served through pullthrough are also written to the local storage so that later pulls
+	// are served locally
+	mirrorPullthrough bool
+	// quotaClient is set when pushes are checked against the image quotas of the project
+	quotaClient kclient.ResourceQuotasNamespacer
+	// registryMirrors are tried in order before the remote registries they mirror during pullthrough
+	registryMirrors importer.RegistryMirrors
 	// cachedLayers remembers a mapping of layer digest to repositories recently seen with that image to avoid
 	// having to check every potential upstream repository when a blob request is made. The cache is useful only
 	// when session affinity is on for the registry, but in practice the first pull will fill the cache.
@@ -86,12 +95,37 @@ func newRepository(ctx context.Context, repo distribution.Repository, options ma
 			pullthrough = b
 		}
 	}
+	mirrorPullthrough := false
+	if value, ok := options["mirrorpullthrough"]; ok {
+		if b, ok := value.(bool); ok {
+			mirrorPullthrough = b
+		}
+	}
+
+	enforceQuota := false
+	if value, ok := options["enforcequota"]; ok {
+		if b, ok := value.(bool); ok {
+			enforceQuota = b
+		}
+	}
+
+	registryMirrors, err := parseRegistryMirrors(options["registrymirrors"])
+	if err != nil {
+		return nil, err
+	}
 
 	registryClient, err := NewRegistryOpenShiftClient()
 	if err != nil {
 		return nil, err
 	}
 
+	var quotaClient kclient.ResourceQuotasNamespacer
+	if enforceQuota {
+		if quotaClient, err = NewRegistryKubeClient(); err != nil {
+			return nil, err
+		}
+	}
+
 	nameParts := strings.SplitN(repo.Name(), "/", 2)
 	if len(nameParts) != 2 {
 		return nil, fmt.Errorf("invalid repository name %q: it must be of the format <project>/<name>", repo.Name())
@@ -100,13 +134,16 @@ func newRepository(ctx context.Context, repo distribution.Repository, options ma
 	return &repository{
 		Repository: repo,
 
-		ctx:            ctx,
-		registryClient: registryClient,
-		registryAddr:   registryAddr,
-		namespace:      nameParts[0],
-		name:           nameParts[1],
-		pullthrough:    pullthrough,
-		cachedLayers:   cachedLayers,
+		ctx:               ctx,
+		registryClient:    registryClient,
+		registryAddr:      registryAddr,
+		namespace:         nameParts[0],
+		name:              nameParts[1],
+		pullthrough:       pullthrough,
+		mirrorPullthrough: mirrorPullthrough,
+		quotaClient:       quotaClient,
+		registryMirrors:   registryMirrors,
+		cachedLayers:      cachedLayers,
 	}, nil
 }
 
@@ -120,20 +157,29 @@ func (r *repository) Manifests(ctx context.Context, options ...distribution.Mani
 	return &repo, nil
 }
 
-// Blobs returns a blob store which can delegate to remote repositories.
+// Blobs returns a blob store which can delegate to remote repositories and rejects blobs that exceed
+// the image quota of the project.
 func (r *repository) Blobs(ctx context.Context) distribution.BlobStore {
-	if !r.pullthrough {
-		return r.Repository.Blobs(ctx)
-	}
-
 	repo := repository(*r)
 	repo.ctx = ctx
-	return &pullthroughBlobStore{
-		BlobStore: r.Repository.Blobs(ctx),
 
-		repo:          &repo,
-		digestToStore: make(map[string]distribution.BlobStore),
+	bs := r.Repository.Blobs(ctx)
+	if r.pullthrough {
+		bs = &pullthroughBlobStore{
+			BlobStore: bs,
+
+			repo:          &repo,
+			digestToStore: make(map[string]distribution.BlobStore),
+		}
+	}
+	if r.quotaClient != nil {
+		bs = &quotaBlobStore{
+			BlobStore: bs,
+
+			repo: &repo,
+		}
 	}
+	return bs
 }
 
 // Tags lists the tags under the named repository.
@@ -172,6 +218,11 @@ func (r *repository) ExistsByTag(tag string) (bool, error) {
 // Get retrieves the manifest with digest `dgst`.
 func (r *repository) Get(dgst digest.Digest) (*schema1.SignedManifest, error) {
 	if _, err := r.getImageStreamImage(dgst); err != nil {
+		if kerrors.IsNotFound(err) && r.pullthrough {
+			if manifest, pullErr := r.pullthroughMissingDigest(dgst); pullErr == nil {
+				return manifest, nil
+			}
+		}
 		context.GetLogger(r.ctx).Errorf("Error retrieving ImageStreamImage %s/%s@%s: %v", r.namespace, r.name, dgst.String(), err)
 		return nil, err
 	}
@@ -182,8 +233,20 @@ func (r *repository) Get(dgst digest.Digest) (*schema1.SignedManifest, error) {
 		return nil, err
 	}
 
-	ref := imageapi.DockerImageReference{Namespace: r.namespace, Name: r.name, Registry: r.registryAddr}
-	return r.manifestFromImageWithCachedLayers(image, ref.DockerClientDefaults().Exact())
+	cacheName := r.cacheName()
+
+	// images imported with the Local reference policy are served through the integrated registry
+	// without their manifest having been pushed to it
+	if len(image.DockerImageManifest) == 0 && r.pullthrough {
+		remoteRef, err := r.remoteReference(image.DockerImageReference, dgst)
+		if err != nil {
+			context.GetLogger(r.ctx).Errorf("Error locating image %s for pullthrough: %v", dgst.String(), err)
+			return nil, err
+		}
+		return r.pullthroughManifest(remoteRef, cacheName)
+	}
+
+	return r.manifestFromImageWithCachedLayers(image, cacheName)
 }
 
 // Enumerate retrieves digests of manifest revisions in particular repository
@@ -202,6 +265,11 @@ func (r *repository) GetByTag(tag string, options ...distribution.ManifestServic
 	// find the image mapped to this tag
 	imageStreamTag, err := r.getImageStreamTag(tag)
 	if err != nil {
+		if kerrors.IsNotFound(err) && r.pullthrough {
+			if manifest, pullErr := r.pullthroughMissingTag(tag, options...); pullErr == nil {
+				return manifest, nil
+			}
+		}
 		// TODO: typed errors
 		context.GetLogger(r.ctx).Errorf("Error getting ImageStreamTag %q: %v", tag, err)
 		return nil, err
@@ -228,6 +296,9 @@ func (r *repository) GetByTag(tag string, options ...distribution.ManifestServic
 		return nil, err
 	}
 
+	// the image stream tag may reference the image through the integrated registry, so prefer the
+	// location the image was imported from
+	source := image.DockerImageReference
 	if localImage, err := r.getImage(dgst); err != nil {
 		// if the image is managed by OpenShift and we cannot load the image, report an error
 		if image.Annotations[imageapi.ManagedByOpenShiftAnnotation] == "true" {
@@ -239,6 +310,9 @@ func (r *repository) GetByTag(tag string, options ...distribution.ManifestServic
 		if len(localImage.DockerImageManifest) > 0 {
 			return r.manifestFromImageWithCachedLayers(localImage, cacheName)
 		}
+		if len(localImage.DockerImageReference) > 0 {
+			source = localImage.DockerImageReference
+		}
 	}
 
 	// allow pullthrough to be disabled
@@ -252,25 +326,117 @@ func (r *repository) GetByTag(tag string, options ...distribution.ManifestServic
 		return nil, referenceErr
 	}
 
-	return r.pullthroughGetByTag(image, ref, cacheName, options...)
+	remoteRef, err := r.remoteReference(source, dgst)
+	if err != nil {
+		context.GetLogger(r.ctx).Errorf("Error locating image %q for pullthrough: %v", source, err)
+		return nil, err
+	}
+
+	return r.pullthroughManifest(remoteRef, cacheName, options...)
 }
 
-// pullthroughGetByTag attempts to load the given image manifest from the remote server defined by ref, using cacheName to store any cached layers.
-func (r *repository) pullthroughGetByTag(image *imageapi.Image, ref imageapi.DockerImageReference, cacheName string, options ...distribution.ManifestServiceOption) (*schema1.SignedManifest, error) {
+// pullthroughMissingTag loads the manifest for a tag that has not been imported yet from the remote
+// repository recorded on the image stream, and records it as an image in the stream so later pulls are
+// served from the image API.
+func (r *repository) pullthroughMissingTag(tag string, options ...distribution.ManifestServiceOption) (*schema1.SignedManifest, error) {
+	is, err := r.getImageStream()
+	if err != nil {
+		return nil, err
+	}
+	ref, ok := upstreamReference(is, tag, r.registryAddr)
+	if !ok {
+		return nil, fmt.Errorf("image stream %s/%s has no remote repository for tag %q", r.namespace, r.name, tag)
+	}
+
+	manifest, err := r.pullthroughManifest(ref, r.cacheName(), options...)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := manifest.Payload()
+	if err != nil {
+		return nil, err
+	}
+	dgst, err := digest.FromBytes(payload)
+	if err != nil {
+		return nil, err
+	}
+	ref.Tag, ref.ID = "", dgst.String()
+
+	ism := imageapi.ImageStreamMapping{
+		ObjectMeta: kapi.ObjectMeta{
+			Namespace: r.namespace,
+			Name:      r.name,
+		},
+		Tag: tag,
+		Image: imageapi.Image{
+			ObjectMeta: kapi.ObjectMeta{
+				Name: dgst.String(),
+			},
+			DockerImageReference: ref.Exact(),
+			DockerImageManifest:  string(manifest.Raw),
+		},
+	}
+	// the manifest can still be served if it cannot be recorded, it will be fetched again on the next pull
+	if err := r.registryClient.ImageStreamMappings(r.namespace).Create(&ism); err != nil {
+		context.GetLogger(r.ctx).Errorf("Error creating ImageStreamMapping for image %q: %v", ref.Exact(), err)
+	}
+	return manifest, nil
+}
+
+// pullthroughMissingDigest searches the remote repositories referenced by the image stream for the
+// manifest with digest dgst. Without a tag the manifest cannot be recorded in the image stream, so it is
+// served without creating an image.
+func (r *repository) pullthroughMissingDigest(dgst digest.Digest) (*schema1.SignedManifest, error) {
+	is, err := r.getImageStream()
+	if err != nil {
+		return nil, err
+	}
+
+	search := identifyCandidateRepositories(is, r.registryAddr, true)
+	for k, ref := range identifyCandidateRepositories(is, r.registryAddr, false) {
+		search[k] = ref
+	}
+	for _, ref := range search {
+		remoteRef := *ref
+		remoteRef.Tag, remoteRef.ID = "", dgst.String()
+		if manifest, err := r.pullthroughManifest(remoteRef, r.cacheName()); err == nil {
+			return manifest, nil
+		}
+	}
+	return nil, distribution.ErrManifestBlobUnknown{Digest: dgst}
+}
+
+// remoteReference returns the reference to the image with digest dgst in the remote repository at
+// pullSpec, or an error if pullSpec is in the integrated registry and so cannot be pulled through.
+func (r *repository) remoteReference(pullSpec string, dgst digest.Digest) (imageapi.DockerImageReference, error) {
+	ref, err := imageapi.ParseDockerImageReference(pullSpec)
+	if err != nil {
+		return ref, err
+	}
+	if ref.Registry == r.registryAddr {
+		return ref, distribution.ErrManifestBlobUnknown{Digest: dgst}
+	}
+	ref.Tag, ref.ID = "", dgst.String()
+	return ref, nil
+}
+
+// pullthroughManifest attempts to load the image manifest from the remote server defined by ref, using cacheName to store any cached layers.
+func (r *repository) pullthroughManifest(ref imageapi.DockerImageReference, cacheName string, options ...distribution.ManifestServiceOption) (*schema1.SignedManifest, error) {
 	defaultRef := ref.DockerClientDefaults()
 
 	retriever := r.importContext()
 
 	repo, err := retriever.Repository(r.ctx, defaultRef.RegistryURL(), defaultRef.RepositoryName(), false)
 	if err != nil {
-		context.GetLogger(r.ctx).Errorf("Error getting remote repository for image %q: %v", image.DockerImageReference, err)
+		context.GetLogger(r.ctx).Errorf("Error getting remote repository for image %q: %v", ref.Exact(), err)
 		return nil, err
 	}
 
 	// get a manifest context
 	manifests, err := repo.Manifests(r.ctx)
 	if err != nil {
-		context.GetLogger(r.ctx).Errorf("Error getting manifests for image %q: %v", image.DockerImageReference, err)
+		context.GetLogger(r.ctx).Errorf("Error getting manifests for image %q: %v", ref.Exact(), err)
 		return nil, err
 	}
 
@@ -278,12 +444,12 @@ func (r *repository) pullthroughGetByTag(image *imageapi.Image, ref imageapi.Doc
 	if len(ref.ID) > 0 {
 		dgst, err := digest.ParseDigest(ref.ID)
 		if err != nil {
-			context.GetLogger(r.ctx).Errorf("Error getting manifests for image %q: %v", image.DockerImageReference, err)
+			context.GetLogger(r.ctx).Errorf("Error getting manifests for image %q: %v", ref.Exact(), err)
 			return nil, err
 		}
 		manifest, err := manifests.Get(dgst)
 		if err != nil {
-			context.GetLogger(r.ctx).Errorf("Error getting manifest from remote server for image %q: %v", image.DockerImageReference, err)
+			context.GetLogger(r.ctx).Errorf("Error getting manifest from remote server for image %q: %v", ref.Exact(), err)
 			return nil, err
 		}
 		r.rememberLayers(manifest, cacheName)
@@ -293,7 +459,7 @@ func (r *repository) pullthroughGetByTag(image *imageapi.Image, ref imageapi.Doc
 	// fetch this by tag
 	manifest, err := manifests.GetByTag(ref.Tag, options...)
 	if err != nil {
-		context.GetLogger(r.ctx).Errorf("Error getting manifest from remote server for image %q: %v", image.DockerImageReference, err)
+		context.GetLogger(r.ctx).Errorf("Error getting manifest from remote server for image %q: %v", ref.Exact(), err)
 		return nil, err
 	}
 
@@ -342,12 +508,22 @@ func (r *repository) Put(manifest *schema1.SignedManifest) error {
 			return err
 		}
 
+		if kerrors.IsForbidden(err) {
+			context.GetLogger(r.ctx).Errorf("Error creating ImageStreamMapping: %s", err)
+			return errcode.ErrorCodeDenied.WithDetail(err.Error())
+		}
+
 		status := statusErr.ErrStatus
 		if status.Code != http.StatusNotFound || status.Details.Kind != "imageStream" || status.Details.Name != r.name {
 			context.GetLogger(r.ctx).Errorf("Error creating ImageStreamMapping: %s", err)
 			return err
 		}
 
+		if err := r.checkQuota(kapi.ResourceList{imageapi.ResourceImageStreams: *resource.NewQuantity(1, resource.DecimalSI)}); err != nil {
+			context.GetLogger(r.ctx).Errorf("Error auto provisioning image stream: %s", err)
+			return err
+		}
+
 		stream := imageapi.ImageStream{
 			ObjectMeta: kapi.ObjectMeta{
 				Name: r.name,
@@ -368,6 +544,9 @@ func (r *repository) Put(manifest *schema1.SignedManifest) error {
 		// try to create the ISM again
 		if err := r.registryClient.ImageStreamMappings(r.namespace).Create(&ism); err != nil {
 			context.GetLogger(r.ctx).Errorf("Error creating image stream mapping: %s", err)
+			if kerrors.IsForbidden(err) {
+				return errcode.ErrorCodeDenied.WithDetail(err.Error())
+			}
 			return err
 		}
 	}
@@ -408,7 +587,110 @@ func (r *repository) importContext() importer.RepositoryRetriever {
 		secrets = &kapi.SecretList{}
 	}
 	credentials := importer.NewCredentialsForSecrets(secrets.Items)
-	return importer.NewContext(secureTransport, insecureTransport).WithCredentials(credentials)
+	return importer.NewContext(secureTransport, insecureTransport).WithMirrors(r.registryMirrors).WithCredentials(credentials)
+}
+
+// parseRegistryMirrors reads the registrymirrors middleware option, a list of entries each naming a source
+// registry or repository prefix and the mirrors that are tried in order before it:
+//
+//	registrymirrors:
+//	  - source: docker.io
+//	    mirrors:
+//	      - location: mirror.example.com:5000/dockerhub
+//	        insecure: false
+//	        ca: /etc/registry/mirror-ca.crt
+func parseRegistryMirrors(value interface{}) (importer.RegistryMirrors, error) {
+	if value == nil {
+		return nil, nil
+	}
+	entries, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("registrymirrors must be a list of sources and their mirrors")
+	}
+	mirrors := make(importer.RegistryMirrors)
+	for i, entry := range entries {
+		fields, ok := stringMap(entry)
+		if !ok {
+			return nil, fmt.Errorf("registrymirrors[%d] must have a source and mirrors", i)
+		}
+		source, _ := fields["source"].(string)
+		if len(source) == 0 {
+			return nil, fmt.Errorf("registrymirrors[%d].source is required", i)
+		}
+		list, _ := fields["mirrors"].([]interface{})
+		if len(list) == 0 {
+			return nil, fmt.Errorf("registrymirrors[%d].mirrors is required", i)
+		}
+		for j, item := range list {
+			mirrorFields, ok := stringMap(item)
+			if !ok {
+				return nil, fmt.Errorf("registrymirrors[%d].mirrors[%d] must have a location", i, j)
+			}
+			location, _ := mirrorFields["location"].(string)
+			if len(location) == 0 {
+				return nil, fmt.Errorf("registrymirrors[%d].mirrors[%d].location is required", i, j)
+			}
+			insecure, _ := mirrorFields["insecure"].(bool)
+			ca, _ := mirrorFields["ca"].(string)
+			mirror, err := importer.NewRegistryMirror(location, insecure, ca)
+			if err != nil {
+				return nil, err
+			}
+			mirrors[source] = append(mirrors[source], mirror)
+		}
+	}
+	return mirrors, nil
+}
+
+// stringMap returns value as a map with string keys, converting the maps with arbitrary keys produced when
+// the configuration is parsed from YAML.
+func stringMap(value interface{}) (map[string]interface{}, bool) {
+	switch t := value.(type) {
+	case map[string]interface{}:
+		return t, true
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(t))
+		for k, v := range t {
+			key, ok := k.(string)
+			if !ok {
+				return nil, false
+			}
+			m[key] = v
+		}
+		return m, true
+	}
+	return nil, false
+}
+
+// cacheName returns the name of r in the integrated registry, under which pulled layers are cached.
+func (r *repository) cacheName() string {
+	ref := imageapi.DockerImageReference{Namespace: r.namespace, Name: r.name, Registry: r.registryAddr}
+	return ref.DockerClientDefaults().Exact()
+}
+
+// upstreamReference returns the remote location of tag recorded on the image stream, either by a spec tag
+// referencing a Docker image or by the repository the stream tracks. It returns false if neither is
+// recorded outside of localRegistry.
+func upstreamReference(is *imageapi.ImageStream, tag, localRegistry string) (imageapi.DockerImageReference, bool) {
+	if tagRef, ok := is.Spec.Tags[tag]; ok {
+		if tagRef.From == nil || tagRef.From.Kind != "DockerImage" {
+			return imageapi.DockerImageReference{}, false
+		}
+		ref, err := imageapi.ParseDockerImageReference(tagRef.From.Name)
+		if err != nil || (len(localRegistry) != 0 && ref.Registry == localRegistry) {
+			return imageapi.DockerImageReference{}, false
+		}
+		return ref, true
+	}
+	if len(is.Spec.DockerImageRepository) == 0 {
+		return imageapi.DockerImageReference{}, false
+	}
+	ref, err := imageapi.ParseDockerImageReference(is.Spec.DockerImageRepository)
+	if err != nil || (len(localRegistry) != 0 && ref.Registry == localRegistry) {
+		return imageapi.DockerImageReference{}, false
+	}
+	ref.Tag, ref.ID = tag, ""
+	return ref, true
 }
 
 // getImageStream retrieves the ImageStream for r.
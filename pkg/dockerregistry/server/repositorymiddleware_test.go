@@ -0,0 +1,169 @@
+package server
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+func TestUpstreamReference(t *testing.T) {
+	tests := map[string]struct {
+		spec     imageapi.ImageStreamSpec
+		tag      string
+		expected string
+	}{
+		"spec tag": {
+			spec: imageapi.ImageStreamSpec{
+				Tags: map[string]imageapi.TagReference{
+					"latest": {From: &kapi.ObjectReference{Kind: "DockerImage", Name: "docker.io/library/busybox:1.24"}},
+				},
+			},
+			tag:      "latest",
+			expected: "docker.io/library/busybox:1.24",
+		},
+		"spec tag referencing another tag": {
+			spec: imageapi.ImageStreamSpec{
+				DockerImageRepository: "docker.io/library/busybox",
+				Tags: map[string]imageapi.TagReference{
+					"latest": {From: &kapi.ObjectReference{Kind: "ImageStreamTag", Name: "busybox:1.24"}},
+				},
+			},
+			tag: "latest",
+		},
+		"spec tag in the integrated registry": {
+			spec: imageapi.ImageStreamSpec{
+				Tags: map[string]imageapi.TagReference{
+					"latest": {From: &kapi.ObjectReference{Kind: "DockerImage", Name: "172.30.1.1:5000/ns/busybox:latest"}},
+				},
+			},
+			tag: "latest",
+		},
+		"tracked repository": {
+			spec:     imageapi.ImageStreamSpec{DockerImageRepository: "registry.example.com/ns/app"},
+			tag:      "v1",
+			expected: "registry.example.com/ns/app:v1",
+		},
+		"nothing recorded": {
+			tag: "v1",
+		},
+	}
+
+	for name, test := range tests {
+		is := &imageapi.ImageStream{Spec: test.spec}
+		ref, ok := upstreamReference(is, test.tag, "172.30.1.1:5000")
+		if ok != (len(test.expected) > 0) {
+			t.Errorf("%s: unexpected result %t for %#v", name, ok, ref)
+			continue
+		}
+		if ok && ref.Exact() != test.expected {
+			t.Errorf("%s: expected %s, got %s", name, test.expected, ref.Exact())
+		}
+	}
+}
+
+func TestIdentifyCandidateRepositories(t *testing.T) {
+	is := &imageapi.ImageStream{
+		Spec: imageapi.ImageStreamSpec{
+			DockerImageRepository: "registry.example.com/ns/tracked",
+			Tags: map[string]imageapi.TagReference{
+				"local":    {From: &kapi.ObjectReference{Kind: "DockerImage", Name: "registry.example.com/ns/local:v1"}, ReferencePolicy: imageapi.TagReferencePolicy{Type: imageapi.LocalTagReferencePolicy}},
+				"internal": {From: &kapi.ObjectReference{Kind: "DockerImage", Name: "172.30.1.1:5000/ns/internal:v1"}},
+				"other":    {From: &kapi.ObjectReference{Kind: "ImageStreamTag", Name: "other:v1"}},
+			},
+		},
+		Status: imageapi.ImageStreamStatus{
+			Tags: map[string]imageapi.TagEventList{
+				"latest": {Items: []imageapi.TagEvent{
+					{DockerImageReference: "registry.example.com/ns/current@sha256:4986bf8c15363d1c5d15512d5266f8777bfba4974ac56e3270e7760f6f0a8125"},
+					{DockerImageReference: "registry.example.com/ns/previous@sha256:4986bf8c15363d1c5d15512d5266f8777bfba4974ac56e3270e7760f6f0a8125"},
+				}},
+				"local": {Items: []imageapi.TagEvent{
+					{DockerImageReference: "172.30.1.1:5000/ns/stream@sha256:4986bf8c15363d1c5d15512d5266f8777bfba4974ac56e3270e7760f6f0a8125"},
+				}},
+			},
+		},
+	}
+
+	tests := map[bool][]string{
+		true: {
+			"registry.example.com/ns/current",
+			"registry.example.com/ns/local",
+			"registry.example.com/ns/tracked",
+		},
+		false: {
+			"registry.example.com/ns/previous",
+		},
+	}
+	for primary, expected := range tests {
+		var found []string
+		for k := range identifyCandidateRepositories(is, "172.30.1.1:5000", primary) {
+			found = append(found, k)
+		}
+		sort.Strings(found)
+		if !reflect.DeepEqual(expected, found) {
+			t.Errorf("primary=%t: expected %v, got %v", primary, expected, found)
+		}
+	}
+}
+
+func TestParseRegistryMirrors(t *testing.T) {
+	tests := map[string]struct {
+		value    interface{}
+		expected map[string][]string
+		err      bool
+	}{
+		"not set": {},
+		"mirrors parsed from YAML": {
+			value: []interface{}{
+				map[interface{}]interface{}{
+					"source": "docker.io",
+					"mirrors": []interface{}{
+						map[interface{}]interface{}{"location": "mirror.example.com/hub", "insecure": true},
+						map[interface{}]interface{}{"location": "other.example.com"},
+					},
+				},
+			},
+			expected: map[string][]string{"docker.io": {"mirror.example.com/hub", "other.example.com"}},
+		},
+		"not a list": {
+			value: "docker.io=mirror.example.com",
+			err:   true,
+		},
+		"missing source": {
+			value: []interface{}{
+				map[interface{}]interface{}{"mirrors": []interface{}{map[interface{}]interface{}{"location": "mirror.example.com"}}},
+			},
+			err: true,
+		},
+		"missing location": {
+			value: []interface{}{
+				map[interface{}]interface{}{"source": "docker.io", "mirrors": []interface{}{map[interface{}]interface{}{"insecure": true}}},
+			},
+			err: true,
+		},
+	}
+
+	for name, test := range tests {
+		mirrors, err := parseRegistryMirrors(test.value)
+		if test.err != (err != nil) {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		locations := make(map[string][]string)
+		for source, list := range mirrors {
+			for _, mirror := range list {
+				locations[source] = append(locations[source], mirror.Location)
+			}
+		}
+		if len(test.expected) == 0 && len(locations) == 0 {
+			continue
+		}
+		if !reflect.DeepEqual(test.expected, locations) {
+			t.Errorf("%s: expected %v, got %v", name, test.expected, locations)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package imagestreamquota
+
+import (
+	"io"
+
+	"k8s.io/kubernetes/pkg/admission"
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/resource"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+
+	imageapi "github.com/openshift/origin/pkg/image/api"
+	imagequota "github.com/openshift/origin/pkg/image/quota"
+)
+
+func init() {
+	admission.RegisterPlugin("OriginImageStreamQuota", func(c kclient.Interface, config io.Reader) (admission.Interface, error) {
+		return NewImageStreamQuota(imagequota.NewAdmitter(c)), nil
+	})
+}
+
+// imageStreamQuota is an implementation of admission.Interface that rejects new image
+// streams beyond the openshift.io/imagestreams quota of a project.
+type imageStreamQuota struct {
+	*admission.Handler
+	quota imagequota.Admitter
+}
+
+// NewImageStreamQuota returns an admission plugin that admits the creation of image
+// streams against the image quotas of their project.
+func NewImageStreamQuota(quota imagequota.Admitter) admission.Interface {
+	return &imageStreamQuota{
+		Handler: admission.NewHandler(admission.Create),
+		quota:   quota,
+	}
+}
+
+// Admit records a new image stream in the image quotas of its project, and rejects it
+// if the project already has as many image streams as a quota allows.
+func (q *imageStreamQuota) Admit(a admission.Attributes) error {
+	if a.GetResource() != imageapi.Resource("imagestreams") || len(a.GetSubresource()) > 0 {
+		return nil
+	}
+	if _, ok := a.GetObject().(*imageapi.ImageStream); !ok {
+		return nil
+	}
+	return q.quota.Admit(a.GetNamespace(), kapi.ResourceList{
+		imageapi.ResourceImageStreams: *resource.NewQuantity(1, resource.DecimalSI),
+	})
+}
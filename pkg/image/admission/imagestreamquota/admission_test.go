@@ -0,0 +1,65 @@
+package imagestreamquota
+
+import (
+	"fmt"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/admission"
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+// fakeQuotaAdmitter records the usage it is asked to admit, and rejects it with err if set.
+type fakeQuotaAdmitter struct {
+	err   error
+	usage []kapi.ResourceList
+}
+
+func (f *fakeQuotaAdmitter) Admit(namespace string, usage kapi.ResourceList) error {
+	f.usage = append(f.usage, usage)
+	return f.err
+}
+
+func TestAdmit(t *testing.T) {
+	stream := &imageapi.ImageStream{ObjectMeta: kapi.ObjectMeta{Namespace: "test", Name: "stream"}}
+	tests := []struct {
+		name        string
+		resource    string
+		subresource string
+		err         error
+		counted     bool
+	}{
+		{name: "image stream", resource: "imagestreams", counted: true},
+		{name: "exceeded", resource: "imagestreams", err: kerrors.NewForbidden("ResourceQuota", "quota", fmt.Errorf("exceeded")), counted: true},
+		{name: "status", resource: "imagestreams", subresource: "status"},
+		{name: "other resource", resource: "imagestreammappings"},
+	}
+
+	for _, test := range tests {
+		quota := &fakeQuotaAdmitter{err: test.err}
+		handler := NewImageStreamQuota(quota)
+		err := handler.Admit(admission.NewAttributesRecord(stream, imageapi.Kind("ImageStream"), stream.Namespace, stream.Name, imageapi.Resource(test.resource), test.subresource, admission.Create, nil))
+		if err != test.err {
+			t.Errorf("%s: expected error %v, got %v", test.name, test.err, err)
+		}
+		if !test.counted {
+			if len(quota.usage) != 0 {
+				t.Errorf("%s: expected no usage, got %v", test.name, quota.usage)
+			}
+			continue
+		}
+		if len(quota.usage) != 1 {
+			t.Errorf("%s: expected the image stream to be counted, got %v", test.name, quota.usage)
+			continue
+		}
+		if count := quota.usage[0][imageapi.ResourceImageStreams]; count.Value() != 1 {
+			t.Errorf("%s: expected one image stream, got %v", test.name, quota.usage[0])
+		}
+	}
+
+	if NewImageStreamQuota(&fakeQuotaAdmitter{}).Handles(admission.Update) {
+		t.Errorf("expected updates not to be handled")
+	}
+}
@@ -0,0 +1,158 @@
+package signaturepolicy
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"k8s.io/kubernetes/pkg/admission"
+	kapi "k8s.io/kubernetes/pkg/api"
+	apierrors "k8s.io/kubernetes/pkg/api/errors"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	"github.com/openshift/origin/pkg/client"
+	oadmission "github.com/openshift/origin/pkg/cmd/server/admission"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+	"github.com/openshift/origin/pkg/image/signature"
+	"github.com/openshift/origin/pkg/project/cache"
+)
+
+func init() {
+	admission.RegisterPlugin("ImageSignaturePolicy", func(c kclient.Interface, config io.Reader) (admission.Interface, error) {
+		return NewImageSignaturePolicy(c), nil
+	})
+}
+
+// imageSignaturePolicy is an implementation of admission.Interface that rejects pods
+// running images without a trusted signature in projects that require signed images.
+type imageSignaturePolicy struct {
+	*admission.Handler
+	kclient kclient.Interface
+	client  client.Interface
+	cache   *cache.ProjectCache
+}
+
+var _ = oadmission.WantsOpenshiftClient(&imageSignaturePolicy{})
+var _ = oadmission.WantsProjectCache(&imageSignaturePolicy{})
+var _ = oadmission.Validator(&imageSignaturePolicy{})
+
+// NewImageSignaturePolicy returns an admission plugin that enforces the image signature
+// policy of projects on the pods created or updated in them.
+func NewImageSignaturePolicy(c kclient.Interface) admission.Interface {
+	return &imageSignaturePolicy{
+		Handler: admission.NewHandler(admission.Create, admission.Update),
+		kclient: c,
+	}
+}
+
+// Admit rejects pods in projects annotated with the Require image signature policy
+// unless every container runs an image referenced by digest that has a trusted
+// signature for the repository of the image. On updates, only the containers whose
+// image changed are verified. Pods are rejected until the project cache is synced.
+func (p *imageSignaturePolicy) Admit(a admission.Attributes) error {
+	if a.GetResource() != kapi.Resource("pods") || len(a.GetSubresource()) > 0 {
+		return nil
+	}
+	pod, ok := a.GetObject().(*kapi.Pod)
+	if !ok {
+		return nil
+	}
+
+	if !p.cache.Running() {
+		return apierrors.NewForbidden(a.GetResource().Resource, pod.Name, fmt.Errorf("the image signature policy of the project is not yet known"))
+	}
+	namespace, err := p.cache.GetNamespace(a.GetNamespace())
+	if err != nil {
+		return apierrors.NewForbidden(a.GetResource().Resource, pod.Name, err)
+	}
+	if namespace.Annotations[imageapi.ImageSignaturePolicyAnnotation] != imageapi.ImageSignaturePolicyRequire {
+		return nil
+	}
+	issuers := sets.NewString()
+	for _, issuer := range strings.Split(namespace.Annotations[imageapi.ImageSignatureIssuersAnnotation], ",") {
+		if issuer = strings.TrimSpace(issuer); len(issuer) > 0 {
+			issuers.Insert(issuer)
+		}
+	}
+
+	previous := map[string]string{}
+	if a.GetOperation() == admission.Update {
+		existing, err := p.kclient.Pods(a.GetNamespace()).Get(pod.Name)
+		if err != nil {
+			return apierrors.NewForbidden(a.GetResource().Resource, pod.Name, err)
+		}
+		for _, container := range existing.Spec.Containers {
+			previous[container.Name] = container.Image
+		}
+	}
+
+	for _, container := range pod.Spec.Containers {
+		if image, ok := previous[container.Name]; ok && image == container.Image {
+			continue
+		}
+		if err := p.verifyImage(container.Image, issuers); err != nil {
+			return apierrors.NewForbidden(a.GetResource().Resource, pod.Name, fmt.Errorf("container %s: %v", container.Name, err))
+		}
+	}
+	return nil
+}
+
+// verifyImage returns an error unless the image spec references an image by digest that
+// has a trusted signature from one of issuers, or from any issuer if issuers is empty.
+func (p *imageSignaturePolicy) verifyImage(spec string, issuers sets.String) error {
+	ref, err := imageapi.ParseDockerImageReference(spec)
+	if err != nil {
+		return fmt.Errorf("image %q is not a valid image reference: %v", spec, err)
+	}
+	if len(ref.ID) == 0 {
+		return fmt.Errorf("image %q must be referenced by digest to verify its signatures", spec)
+	}
+
+	image, err := p.client.Images().Get(ref.ID)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return fmt.Errorf("image %q has no signatures", spec)
+		}
+		return err
+	}
+
+	for i := range image.Signatures {
+		sig := &image.Signatures[i]
+		if !signature.IsTrusted(sig) {
+			continue
+		}
+		if issuers.Len() > 0 && !issuers.Has(sig.Issuer) {
+			continue
+		}
+		if len(sig.Identity) > 0 {
+			identity, err := imageapi.ParseDockerImageReference(sig.Identity)
+			if err != nil || !identity.AsRepository().Equal(ref.AsRepository()) {
+				continue
+			}
+		}
+		return nil
+	}
+	return fmt.Errorf("image %q has no trusted signature allowed by the policy of the project", spec)
+}
+
+func (p *imageSignaturePolicy) SetOpenshiftClient(c client.Interface) {
+	p.client = c
+}
+
+func (p *imageSignaturePolicy) SetProjectCache(c *cache.ProjectCache) {
+	p.cache = c
+}
+
+func (p *imageSignaturePolicy) Validate() error {
+	if p.kclient == nil {
+		return fmt.Errorf("image signature policy plugin needs a Kubernetes client")
+	}
+	if p.client == nil {
+		return fmt.Errorf("image signature policy plugin needs an OpenShift client")
+	}
+	if p.cache == nil {
+		return fmt.Errorf("image signature policy plugin needs a project cache")
+	}
+	return nil
+}
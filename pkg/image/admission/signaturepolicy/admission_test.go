@@ -0,0 +1,207 @@
+package signaturepolicy
+
+import (
+	"testing"
+
+	"k8s.io/kubernetes/pkg/admission"
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/client/cache"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	"github.com/openshift/origin/pkg/client/testclient"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+	projectcache "github.com/openshift/origin/pkg/project/cache"
+)
+
+const (
+	signedDigest   = "sha256:4986bf8c15363d1c5d15512d5266f8777bfba4974ac56e3270e7760f6f0a8125"
+	unsignedDigest = "sha256:0000000000000000000000000000000000000000000000000000000000000000"
+)
+
+func trustedSignature(issuer, identity string, status kapi.ConditionStatus) imageapi.ImageSignature {
+	return imageapi.ImageSignature{
+		Name:     "sig",
+		Type:     imageapi.ImageSignatureTypeAtomicImageV1,
+		Content:  []byte("signed"),
+		Issuer:   issuer,
+		Identity: identity,
+		Conditions: []imageapi.SignatureCondition{
+			{Type: imageapi.SignatureTrusted, Status: status},
+		},
+	}
+}
+
+func TestAdmit(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		image       string
+		signatures  []imageapi.ImageSignature
+		admit       bool
+	}{
+		{
+			name:  "no policy",
+			image: "registry.example.com/ns/app:latest",
+			admit: true,
+		},
+		{
+			name:        "other policy",
+			annotations: map[string]string{imageapi.ImageSignaturePolicyAnnotation: "Ignore"},
+			image:       "registry.example.com/ns/app:latest",
+			admit:       true,
+		},
+		{
+			name:        "tag reference",
+			annotations: map[string]string{imageapi.ImageSignaturePolicyAnnotation: imageapi.ImageSignaturePolicyRequire},
+			image:       "registry.example.com/ns/app:latest",
+		},
+		{
+			name:        "unknown image",
+			annotations: map[string]string{imageapi.ImageSignaturePolicyAnnotation: imageapi.ImageSignaturePolicyRequire},
+			image:       "registry.example.com/ns/app@" + unsignedDigest,
+		},
+		{
+			name:        "no signatures",
+			annotations: map[string]string{imageapi.ImageSignaturePolicyAnnotation: imageapi.ImageSignaturePolicyRequire},
+			image:       "registry.example.com/ns/app@" + signedDigest,
+		},
+		{
+			name:        "untrusted signature",
+			annotations: map[string]string{imageapi.ImageSignaturePolicyAnnotation: imageapi.ImageSignaturePolicyRequire},
+			image:       "registry.example.com/ns/app@" + signedDigest,
+			signatures:  []imageapi.ImageSignature{trustedSignature("", "", kapi.ConditionFalse)},
+		},
+		{
+			name:        "trusted signature",
+			annotations: map[string]string{imageapi.ImageSignaturePolicyAnnotation: imageapi.ImageSignaturePolicyRequire},
+			image:       "registry.example.com/ns/app@" + signedDigest,
+			signatures:  []imageapi.ImageSignature{trustedSignature("", "", kapi.ConditionTrue)},
+			admit:       true,
+		},
+		{
+			name:        "trusted signature for the repository",
+			annotations: map[string]string{imageapi.ImageSignaturePolicyAnnotation: imageapi.ImageSignaturePolicyRequire},
+			image:       "registry.example.com/ns/app@" + signedDigest,
+			signatures:  []imageapi.ImageSignature{trustedSignature("", "registry.example.com/ns/app:v1", kapi.ConditionTrue)},
+			admit:       true,
+		},
+		{
+			name:        "trusted signature for another repository",
+			annotations: map[string]string{imageapi.ImageSignaturePolicyAnnotation: imageapi.ImageSignaturePolicyRequire},
+			image:       "registry.example.com/ns/app@" + signedDigest,
+			signatures:  []imageapi.ImageSignature{trustedSignature("", "registry.example.com/other/app:v1", kapi.ConditionTrue)},
+		},
+		{
+			name: "allowed issuer",
+			annotations: map[string]string{
+				imageapi.ImageSignaturePolicyAnnotation:  imageapi.ImageSignaturePolicyRequire,
+				imageapi.ImageSignatureIssuersAnnotation: "Release Engineering, Security Team",
+			},
+			image:      "registry.example.com/ns/app@" + signedDigest,
+			signatures: []imageapi.ImageSignature{trustedSignature("Security Team", "", kapi.ConditionTrue)},
+			admit:      true,
+		},
+		{
+			name: "disallowed issuer",
+			annotations: map[string]string{
+				imageapi.ImageSignaturePolicyAnnotation:  imageapi.ImageSignaturePolicyRequire,
+				imageapi.ImageSignatureIssuersAnnotation: "Release Engineering",
+			},
+			image:      "registry.example.com/ns/app@" + signedDigest,
+			signatures: []imageapi.ImageSignature{trustedSignature("Someone Else", "", kapi.ConditionTrue)},
+		},
+	}
+
+	for _, test := range tests {
+		project := &kapi.Namespace{ObjectMeta: kapi.ObjectMeta{Name: "testProject", Annotations: test.annotations}}
+		projectStore := projectcache.NewCacheStore(cache.IndexFuncToKeyFuncAdapter(cache.MetaNamespaceIndexFunc))
+		projectStore.Add(project)
+
+		osClient := &testclient.Fake{}
+		osClient.AddReactor("get", "images", func(action ktestclient.Action) (bool, runtime.Object, error) {
+			name := action.(ktestclient.GetAction).GetName()
+			if name != signedDigest {
+				return true, nil, kerrors.NewNotFound("image", name)
+			}
+			return true, &imageapi.Image{ObjectMeta: kapi.ObjectMeta{Name: name}, Signatures: test.signatures}, nil
+		})
+
+		handler := NewImageSignaturePolicy(ktestclient.NewSimpleFake()).(*imageSignaturePolicy)
+		handler.SetOpenshiftClient(osClient)
+		handler.SetProjectCache(projectcache.NewFake((&ktestclient.Fake{}).Namespaces(), projectStore, ""))
+		if err := handler.Validate(); err != nil {
+			t.Fatalf("unexpected validation error: %v", err)
+		}
+
+		pod := &kapi.Pod{
+			ObjectMeta: kapi.ObjectMeta{Name: "testPod", Namespace: project.Name},
+			Spec: kapi.PodSpec{
+				Containers: []kapi.Container{{Name: "app", Image: test.image}},
+			},
+		}
+		err := handler.Admit(admission.NewAttributesRecord(pod, kapi.Kind("Pod"), project.Name, pod.Name, kapi.Resource("pods"), "", admission.Create, nil))
+		if test.admit && err != nil {
+			t.Errorf("%s: expected the pod to be admitted: %v", test.name, err)
+		}
+		if !test.admit {
+			if err == nil {
+				t.Errorf("%s: expected the pod to be rejected", test.name)
+			} else if !kerrors.IsForbidden(err) {
+				t.Errorf("%s: expected a forbidden error, got %v", test.name, err)
+			}
+		}
+	}
+}
+
+func TestAdmitUpdate(t *testing.T) {
+	project := &kapi.Namespace{ObjectMeta: kapi.ObjectMeta{
+		Name:        "testProject",
+		Annotations: map[string]string{imageapi.ImageSignaturePolicyAnnotation: imageapi.ImageSignaturePolicyRequire},
+	}}
+	projectStore := projectcache.NewCacheStore(cache.IndexFuncToKeyFuncAdapter(cache.MetaNamespaceIndexFunc))
+	projectStore.Add(project)
+
+	// the pod was created before the policy was set
+	existing := &kapi.Pod{
+		ObjectMeta: kapi.ObjectMeta{Name: "testPod", Namespace: project.Name},
+		Spec: kapi.PodSpec{
+			Containers: []kapi.Container{{Name: "app", Image: "registry.example.com/ns/app:latest"}},
+		},
+	}
+	handler := NewImageSignaturePolicy(ktestclient.NewSimpleFake(existing)).(*imageSignaturePolicy)
+	handler.SetOpenshiftClient(&testclient.Fake{})
+	handler.SetProjectCache(projectcache.NewFake((&ktestclient.Fake{}).Namespaces(), projectStore, ""))
+
+	// updates that keep the images are admitted
+	pod := *existing
+	pod.Labels = map[string]string{"a": "b"}
+	if err := handler.Admit(admission.NewAttributesRecord(&pod, kapi.Kind("Pod"), project.Name, pod.Name, kapi.Resource("pods"), "", admission.Update, nil)); err != nil {
+		t.Errorf("expected the pod to be admitted: %v", err)
+	}
+
+	// updates to unsigned images are rejected
+	pod.Spec.Containers = []kapi.Container{{Name: "app", Image: "registry.example.com/ns/app:v2"}}
+	err := handler.Admit(admission.NewAttributesRecord(&pod, kapi.Kind("Pod"), project.Name, pod.Name, kapi.Resource("pods"), "", admission.Update, nil))
+	if err == nil || !kerrors.IsForbidden(err) {
+		t.Errorf("expected a forbidden error, got %v", err)
+	}
+}
+
+func TestAdmitCacheNotRunning(t *testing.T) {
+	handler := NewImageSignaturePolicy(ktestclient.NewSimpleFake()).(*imageSignaturePolicy)
+	handler.SetOpenshiftClient(&testclient.Fake{})
+	handler.SetProjectCache(projectcache.NewFake((&ktestclient.Fake{}).Namespaces(), nil, ""))
+
+	pod := &kapi.Pod{
+		ObjectMeta: kapi.ObjectMeta{Name: "testPod", Namespace: "testProject"},
+		Spec: kapi.PodSpec{
+			Containers: []kapi.Container{{Name: "app", Image: "registry.example.com/ns/app:latest"}},
+		},
+	}
+	err := handler.Admit(admission.NewAttributesRecord(pod, kapi.Kind("Pod"), "testProject", pod.Name, kapi.Resource("pods"), "", admission.Create, nil))
+	if err == nil || !kerrors.IsForbidden(err) {
+		t.Errorf("expected a forbidden error while the project cache is not running, got %v", err)
+	}
+}
@@ -680,6 +680,26 @@ func MostAccuratePullSpec(pullSpec string, id, tag string) (string, bool) {
 	return ref.MostSpecific().Exact(), true
 }
 
+// ResolveReferencePolicy returns the pull spec a tag event should record for the image with the given
+// ID, which was located at pullSpec. If the tag has the Local reference policy and the stream has a
+// repository in the integrated registry, the image is referenced by ID in that repository so that it is
+// pulled through the integrated registry. Otherwise, or if id is not a digest the integrated registry
+// can serve, pullSpec is returned unchanged.
+func ResolveReferencePolicy(stream *ImageStream, tagRef TagReference, pullSpec, id string) string {
+	if tagRef.ReferencePolicy.Type != LocalTagReferencePolicy {
+		return pullSpec
+	}
+	if _, err := digest.ParseDigest(id); err != nil {
+		return pullSpec
+	}
+	ref, err := ParseDockerImageReference(stream.Status.DockerImageRepository)
+	if err != nil || len(ref.Registry) == 0 {
+		return pullSpec
+	}
+	ref.Tag, ref.ID = "", id
+	return ref.Exact()
+}
+
 // ShortDockerImageID returns a short form of the provided DockerImage ID for display
 func ShortDockerImageID(image *DockerImage, length int) string {
 	id := image.ID
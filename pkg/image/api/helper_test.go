@@ -1302,3 +1302,29 @@ func TestPrioritizeTags(t *testing.T) {
 		t.Errorf("unexpected order: %v", tags)
 	}
 }
+
+func TestResolveReferencePolicy(t *testing.T) {
+	stream := &ImageStream{Status: ImageStreamStatus{DockerImageRepository: "172.30.1.1:5000/ns/app"}}
+	local := TagReference{ReferencePolicy: TagReferencePolicy{Type: LocalTagReferencePolicy}}
+	source := TagReference{ReferencePolicy: TagReferencePolicy{Type: SourceTagReferencePolicy}}
+
+	tests := []struct {
+		name     string
+		stream   *ImageStream
+		tagRef   TagReference
+		id       string
+		expected string
+	}{
+		{name: "source", stream: stream, tagRef: source, id: "sha256:4986bf8c15363d1c5d15512d5266f8777bfba4974ac56e3270e7760f6f0a8125", expected: "docker.io/library/app@sha256:4986bf8c15363d1c5d15512d5266f8777bfba4974ac56e3270e7760f6f0a8125"},
+		{name: "default", stream: stream, tagRef: TagReference{}, id: "sha256:4986bf8c15363d1c5d15512d5266f8777bfba4974ac56e3270e7760f6f0a8125", expected: "docker.io/library/app@sha256:4986bf8c15363d1c5d15512d5266f8777bfba4974ac56e3270e7760f6f0a8125"},
+		{name: "local", stream: stream, tagRef: local, id: "sha256:4986bf8c15363d1c5d15512d5266f8777bfba4974ac56e3270e7760f6f0a8125", expected: "172.30.1.1:5000/ns/app@sha256:4986bf8c15363d1c5d15512d5266f8777bfba4974ac56e3270e7760f6f0a8125"},
+		{name: "local without an image", stream: stream, tagRef: local, expected: "docker.io/library/app@sha256:4986bf8c15363d1c5d15512d5266f8777bfba4974ac56e3270e7760f6f0a8125"},
+		{name: "local with a v1 image ID", stream: stream, tagRef: local, id: "d0a28ab59a", expected: "docker.io/library/app@sha256:4986bf8c15363d1c5d15512d5266f8777bfba4974ac56e3270e7760f6f0a8125"},
+		{name: "local without a registry", stream: &ImageStream{}, tagRef: local, id: "sha256:4986bf8c15363d1c5d15512d5266f8777bfba4974ac56e3270e7760f6f0a8125", expected: "docker.io/library/app@sha256:4986bf8c15363d1c5d15512d5266f8777bfba4974ac56e3270e7760f6f0a8125"},
+	}
+	for _, test := range tests {
+		if actual := ResolveReferencePolicy(test.stream, test.tagRef, "docker.io/library/app@sha256:4986bf8c15363d1c5d15512d5266f8777bfba4974ac56e3270e7760f6f0a8125", test.id); actual != test.expected {
+			t.Errorf("%s: expected %q, got %q", test.name, test.expected, actual)
+		}
+	}
+}
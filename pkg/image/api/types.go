@@ -30,6 +30,30 @@ const (
 
 	// DefaultImageTag is used when an image tag is needed and the configuration does not specify a tag to use.
 	DefaultImageTag = "latest"
+
+	// ImageSignaturePolicyAnnotation may be set on a project to require the images of its pods to
+	// have trusted signatures. The only supported value is ImageSignaturePolicyRequire.
+	ImageSignaturePolicyAnnotation = "openshift.io/image-signature-policy"
+
+	// ImageSignatureIssuersAnnotation may be set on a project with a signature policy to a comma
+	// separated list of the issuers whose signatures are accepted. Signatures of any trusted
+	// issuer are accepted if it is not set.
+	ImageSignatureIssuersAnnotation = "openshift.io/image-signature-issuers"
+)
+
+// ImageSignaturePolicyRequire is the value of ImageSignaturePolicyAnnotation that requires pods to
+// reference images by digest and the images to have a trusted signature.
+const ImageSignaturePolicyRequire = "Require"
+
+const (
+	// ResourceImageStreams is the resource quota name for the number of image streams in a project.
+	ResourceImageStreams kapi.ResourceName = "openshift.io/imagestreams"
+	// ResourceImageStreamTags is the resource quota name for the number of tags in the status of the
+	// image streams in a project.
+	ResourceImageStreamTags kapi.ResourceName = "openshift.io/imagestreamtags"
+	// ResourceImageStorage is the resource quota name for the total size in bytes of the images
+	// referenced by the image streams in a project.
+	ResourceImageStorage kapi.ResourceName = "openshift.io/imagestorage"
 )
 
 // Image is an immutable representation of a Docker image and metadata at a point in time.
@@ -47,6 +71,58 @@ type Image struct {
 	DockerImageManifest string
 	// DockerImageLayers represents the layers in the image. May not be set if the image does not define that data.
 	DockerImageLayers []ImageLayer
+	// Signatures holds the signatures of the image. They may only be changed through the
+	// images/signatures subresource.
+	Signatures []ImageSignature
+}
+
+const (
+	// ImageSignatureTypeAtomicImageV1 is the type of simple signing signatures. The content is an
+	// OpenPGP signed JSON document claiming the manifest digest and the identity of the image.
+	ImageSignatureTypeAtomicImageV1 = "atomic"
+	// ImageSignatureTypeGPG is the type of detached OpenPGP signatures of the image manifest.
+	ImageSignatureTypeGPG = "gpg"
+)
+
+// ImageSignature holds a signature of an image and the result of its verification.
+type ImageSignature struct {
+	// Name uniquely identifies the signature within the image.
+	Name string
+	// Type of the signature blob, either atomic or gpg.
+	Type string
+	// Content is the signature blob.
+	Content []byte
+	// Issuer is the user ID of the key that created the signature.
+	Issuer string
+	// Identity is the image reference the signature was created for, e.g. registry/namespace/name:tag.
+	Identity string
+	// Conditions represent the latest observations of the state of the signature.
+	Conditions []SignatureCondition
+}
+
+// SignatureConditionType is the type of a condition of an image signature.
+type SignatureConditionType string
+
+// These are valid conditions of image signatures.
+const (
+	// SignatureTrusted with status True means the signature was verified against a trusted key.
+	SignatureTrusted SignatureConditionType = "Trusted"
+)
+
+// SignatureCondition describes an image signature condition of particular kind at particular probe time.
+type SignatureCondition struct {
+	// Type of signature condition, currently only Trusted.
+	Type SignatureConditionType
+	// Status of the condition, one of True, False, Unknown.
+	Status kapi.ConditionStatus
+	// LastProbeTime is the last time the condition was checked.
+	LastProbeTime unversioned.Time
+	// LastTransitionTime is the last time the condition transitioned from one status to another.
+	LastTransitionTime unversioned.Time
+	// Reason is a brief machine readable explanation for the condition's last transition.
+	Reason string
+	// Message is a human readable description of the details about last transition, complementing reason.
+	Message string
 }
 
 // ImageLayer represents a single layer of the image. Some images may have multiple layers. Some may have none.
@@ -109,6 +185,8 @@ type TagReference struct {
 	Generation *int64
 	// ImportPolicy is information that controls how images may be imported by the server.
 	ImportPolicy TagImportPolicy
+	// ReferencePolicy defines how other components should consume the image.
+	ReferencePolicy TagReferencePolicy
 }
 
 type TagImportPolicy struct {
@@ -118,6 +196,30 @@ type TagImportPolicy struct {
 	Scheduled bool
 }
 
+// TagReferencePolicyType describes how pull-specs for images in an image stream tag are generated when
+// image change triggers are fired.
+type TagReferencePolicyType string
+
+const (
+	// SourceTagReferencePolicy indicates the image's original location should be used when the image stream tag
+	// is resolved into other resources (builds and deployment configurations).
+	SourceTagReferencePolicy TagReferencePolicyType = "Source"
+	// LocalTagReferencePolicy indicates the image should prefer to pull via the local integrated registry,
+	// falling back to the remote location if the integrated registry has not been configured. The reference will
+	// use the internal DNS name or registry service IP.
+	LocalTagReferencePolicy TagReferencePolicyType = "Local"
+)
+
+// TagReferencePolicy describes how pull-specs for images in this image stream tag are generated when
+// image change triggers in deployment configs or builds are resolved. This allows the image stream
+// author to control how images are accessed.
+type TagReferencePolicy struct {
+	// Type determines how the image pull spec should be transformed when the image stream tag is used in
+	// deployment config triggers or new builds. The default value is Source, indicating the original
+	// location of the image should be used (if imported). An empty value is treated as Source.
+	Type TagReferencePolicyType
+}
+
 // ImageStreamStatus contains information about the state of this image stream.
 type ImageStreamStatus struct {
 	// DockerImageRepository represents the effective location this stream may be accessed at. May be empty until the server
@@ -30,6 +30,43 @@ type Image struct {
 	DockerImageManifest string `json:"dockerImageManifest,omitempty" description:"raw JSON of the manifest"`
 	// DockerImageLayers represents the layers in the image. May not be set if the image does not define that data.
 	DockerImageLayers []ImageLayer `json:"dockerImageLayers" description:"a list of the image layers from lowest to highest"`
+	// Signatures holds the signatures of the image.
+	Signatures []ImageSignature `json:"signatures,omitempty" description:"the signatures of the image, which may only be changed through the images/signatures subresource"`
+}
+
+// ImageSignature holds a signature of an image and the result of its verification.
+type ImageSignature struct {
+	// Name uniquely identifies the signature within the image.
+	Name string `json:"name" description:"uniquely identifies the signature within the image"`
+	// Type of the signature blob, either atomic or gpg.
+	Type string `json:"type" description:"the type of the signature blob, either atomic or gpg"`
+	// Content is the signature blob.
+	Content []byte `json:"content" description:"the signature blob"`
+	// Issuer is the user ID of the key that created the signature.
+	Issuer string `json:"issuer,omitempty" description:"the user ID of the key that created the signature"`
+	// Identity is the image reference the signature was created for, e.g. registry/namespace/name:tag.
+	Identity string `json:"identity,omitempty" description:"the image reference the signature was created for"`
+	// Conditions represent the latest observations of the state of the signature.
+	Conditions []SignatureCondition `json:"conditions,omitempty" description:"the latest observations of the state of the signature"`
+}
+
+// SignatureConditionType is the type of a condition of an image signature.
+type SignatureConditionType string
+
+// SignatureCondition describes an image signature condition of particular kind at particular probe time.
+type SignatureCondition struct {
+	// Type of signature condition, currently only Trusted.
+	Type SignatureConditionType `json:"type" description:"type of signature condition, currently only Trusted"`
+	// Status of the condition, one of True, False, Unknown.
+	Status kapi.ConditionStatus `json:"status" description:"status of the condition, one of True, False, Unknown"`
+	// LastProbeTime is the last time the condition was checked.
+	LastProbeTime unversioned.Time `json:"lastProbeTime,omitempty" description:"the last time the condition was checked"`
+	// LastTransitionTime is the last time the condition transitioned from one status to another.
+	LastTransitionTime unversioned.Time `json:"lastTransitionTime,omitempty" description:"the last time the condition transitioned from one status to another"`
+	// Reason is a brief machine readable explanation for the condition's last transition.
+	Reason string `json:"reason,omitempty" description:"a brief machine readable explanation for the condition's last transition"`
+	// Message is a human readable description of the details about last transition, complementing reason.
+	Message string `json:"message,omitempty" description:"a human readable description of the details about last transition"`
 }
 
 // ImageLayer represents a single layer of the image. Some images may have multiple layers. Some may have none.
@@ -85,6 +122,8 @@ type TagReference struct {
 	Generation *int64 `json:"generation" description:"the generation of the image stream this was updated to"`
 	// Import is information that controls how images may be imported by the server.
 	ImportPolicy TagImportPolicy `json:"importPolicy,omitempty" description:"attributes controlling how this reference is imported"`
+	// ReferencePolicy defines how other components should consume the image
+	ReferencePolicy TagReferencePolicy `json:"referencePolicy,omitempty" description:"defines how other components should consume the image"`
 }
 
 type TagImportPolicy struct {
@@ -94,6 +133,30 @@ type TagImportPolicy struct {
 	Scheduled bool `json:"scheduled,omitempty" description:"if true, the server will periodically check to ensure this tag is up to date"`
 }
 
+// TagReferencePolicyType describes how pull-specs for images in an image stream tag are generated when
+// image change triggers are fired.
+type TagReferencePolicyType string
+
+const (
+	// SourceTagReferencePolicy indicates the image's original location should be used when the image stream tag
+	// is resolved into other resources (builds and deployment configurations).
+	SourceTagReferencePolicy TagReferencePolicyType = "Source"
+	// LocalTagReferencePolicy indicates the image should prefer to pull via the local integrated registry,
+	// falling back to the remote location if the integrated registry has not been configured. The reference will
+	// use the internal DNS name or registry service IP.
+	LocalTagReferencePolicy TagReferencePolicyType = "Local"
+)
+
+// TagReferencePolicy describes how pull-specs for images in this image stream tag are generated when
+// image change triggers in deployment configs or builds are resolved. This allows the image stream
+// author to control how images are accessed.
+type TagReferencePolicy struct {
+	// Type determines how the image pull spec should be transformed when the image stream tag is used in
+	// deployment config triggers or new builds. The default value is Source, indicating the original
+	// location of the image should be used (if imported).
+	Type TagReferencePolicyType `json:"type" description:"determines how the image pull spec is resolved, either Source (the default) or Local to pull through the integrated registry"`
+}
+
 // ImageStreamStatus contains information about the state of this image stream.
 type ImageStreamStatus struct {
 	// DockerImageRepository represents the effective location this stream may be accessed at.
@@ -7,6 +7,7 @@ import (
 	"github.com/docker/distribution/reference"
 	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/validation"
+	"k8s.io/kubernetes/pkg/util/sets"
 	"k8s.io/kubernetes/pkg/util/validation/field"
 
 	oapi "github.com/openshift/origin/pkg/api"
@@ -56,6 +57,42 @@ func validateImage(image *api.Image, fldPath *field.Path) field.ErrorList {
 		}
 	}
 
+	result = append(result, validateImageSignatures(image.Signatures, fldPath.Child("signatures"))...)
+
+	return result
+}
+
+func validateImageSignatures(signatures []api.ImageSignature, fldPath *field.Path) field.ErrorList {
+	result := field.ErrorList{}
+
+	names := sets.NewString()
+	for i, signature := range signatures {
+		idxPath := fldPath.Index(i)
+		switch {
+		case len(signature.Name) == 0:
+			result = append(result, field.Required(idxPath.Child("name")))
+		case names.Has(signature.Name):
+			result = append(result, field.Duplicate(idxPath.Child("name"), signature.Name))
+		default:
+			names.Insert(signature.Name)
+		}
+		switch signature.Type {
+		case api.ImageSignatureTypeAtomicImageV1, api.ImageSignatureTypeGPG:
+		case "":
+			result = append(result, field.Required(idxPath.Child("type")))
+		default:
+			result = append(result, field.NotSupported(idxPath.Child("type"), signature.Type, []string{api.ImageSignatureTypeAtomicImageV1, api.ImageSignatureTypeGPG}))
+		}
+		if len(signature.Content) == 0 {
+			result = append(result, field.Required(idxPath.Child("content")))
+		}
+		if len(signature.Identity) > 0 {
+			if _, err := api.ParseDockerImageReference(signature.Identity); err != nil {
+				result = append(result, field.Invalid(idxPath.Child("identity"), signature.Identity, err.Error()))
+			}
+		}
+	}
+
 	return result
 }
 
@@ -103,6 +140,11 @@ func ValidateImageStream(stream *api.ImageStream) field.ErrorList {
 				result = append(result, field.Invalid(field.NewPath("spec", "tags").Key(tag).Child("from", "kind"), tagRef.From.Kind, "valid values are 'DockerImage', 'ImageStreamImage', 'ImageStreamTag'"))
 			}
 		}
+		switch tagRef.ReferencePolicy.Type {
+		case "", api.SourceTagReferencePolicy, api.LocalTagReferencePolicy:
+		default:
+			result = append(result, field.NotSupported(field.NewPath("spec", "tags").Key(tag).Child("referencePolicy", "type"), tagRef.ReferencePolicy.Type, []string{string(api.SourceTagReferencePolicy), string(api.LocalTagReferencePolicy)}))
+		}
 	}
 	for tag, history := range stream.Status.Tags {
 		for i, tagEvent := range history.Items {
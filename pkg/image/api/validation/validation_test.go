@@ -47,6 +47,42 @@ func TestValidateImageMissingFields(t *testing.T) {
 			field.ErrorTypeRequired,
 			"dockerImageReference",
 		},
+		"missing signature name": {
+			api.Image{ObjectMeta: kapi.ObjectMeta{Name: "foo"}, DockerImageReference: "ref", Signatures: []api.ImageSignature{
+				{Type: api.ImageSignatureTypeGPG, Content: []byte("sig")},
+			}},
+			field.ErrorTypeRequired,
+			"signatures[0].name",
+		},
+		"duplicate signature name": {
+			api.Image{ObjectMeta: kapi.ObjectMeta{Name: "foo"}, DockerImageReference: "ref", Signatures: []api.ImageSignature{
+				{Name: "a", Type: api.ImageSignatureTypeGPG, Content: []byte("sig")},
+				{Name: "a", Type: api.ImageSignatureTypeAtomicImageV1, Content: []byte("sig")},
+			}},
+			field.ErrorTypeDuplicate,
+			"signatures[1].name",
+		},
+		"unsupported signature type": {
+			api.Image{ObjectMeta: kapi.ObjectMeta{Name: "foo"}, DockerImageReference: "ref", Signatures: []api.ImageSignature{
+				{Name: "a", Type: "x509", Content: []byte("sig")},
+			}},
+			field.ErrorTypeNotSupported,
+			"signatures[0].type",
+		},
+		"missing signature content": {
+			api.Image{ObjectMeta: kapi.ObjectMeta{Name: "foo"}, DockerImageReference: "ref", Signatures: []api.ImageSignature{
+				{Name: "a", Type: api.ImageSignatureTypeGPG},
+			}},
+			field.ErrorTypeRequired,
+			"signatures[0].content",
+		},
+		"invalid signature identity": {
+			api.Image{ObjectMeta: kapi.ObjectMeta{Name: "foo"}, DockerImageReference: "ref", Signatures: []api.ImageSignature{
+				{Name: "a", Type: api.ImageSignatureTypeGPG, Content: []byte("sig"), Identity: "registry/ns/name/extra:tag"},
+			}},
+			field.ErrorTypeInvalid,
+			"signatures[0].identity",
+		},
 	}
 
 	for k, v := range errorCases {
@@ -331,6 +367,22 @@ func TestValidateImageStream(t *testing.T) {
 				field.Invalid(field.NewPath("spec", "tags").Key("otherimage").Child("importPolicy", "scheduled"), true, "only tags pointing to Docker repositories may be scheduled for background import"),
 			},
 		},
+		"invalid reference policy": {
+			namespace: "namespace",
+			name:      "foo",
+			specTags: map[string]api.TagReference{
+				"tag": {
+					From: &kapi.ObjectReference{
+						Kind: "DockerImage",
+						Name: "abc",
+					},
+					ReferencePolicy: api.TagReferencePolicy{Type: "Mirror"},
+				},
+			},
+			expected: field.ErrorList{
+				field.NotSupported(field.NewPath("spec", "tags").Key("tag").Child("referencePolicy", "type"), api.TagReferencePolicyType("Mirror"), []string{"Source", "Local"}),
+			},
+		},
 		"valid": {
 			namespace: "namespace",
 			name:      "foo",
@@ -340,6 +392,7 @@ func TestValidateImageStream(t *testing.T) {
 						Kind: "DockerImage",
 						Name: "abc",
 					},
+					ReferencePolicy: api.TagReferencePolicy{Type: api.LocalTagReferencePolicy},
 				},
 				"other": {
 					From: &kapi.ObjectReference{
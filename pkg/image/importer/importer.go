@@ -619,16 +619,37 @@ type Context struct {
 	Transport         http.RoundTripper
 	InsecureTransport http.RoundTripper
 	Challenges        auth.ChallengeManager
+	// Mirrors are tried in order before the registries or repositories they mirror.
+	Mirrors RegistryMirrors
+	// Actions are the access requested from registries that use token authentication. Defaults to pull.
+	Actions []string
+}
+
+// WithMirrors returns a copy of the context that reads from mirrors before the registries they mirror.
+func (c Context) WithMirrors(mirrors RegistryMirrors) Context {
+	c.Mirrors = mirrors
+	return c
+}
+
+// WithActions returns a copy of the context that requests actions from registries that use token
+// authentication, for example pull and push to write to a repository.
+func (c Context) WithActions(actions ...string) Context {
+	c.Actions = actions
+	return c
 }
 
 func (c Context) WithCredentials(credentials auth.CredentialStore) RepositoryRetriever {
-	return &repositoryRetriever{
+	retriever := &repositoryRetriever{
 		context:     c,
 		credentials: credentials,
 
 		pings:    make(map[url.URL]error),
 		redirect: make(map[url.URL]*url.URL),
 	}
+	if len(c.Mirrors) == 0 {
+		return retriever
+	}
+	return newMirroredRetriever(c, credentials, retriever)
 }
 
 type repositoryRetriever struct {
@@ -665,17 +686,30 @@ func (r *repositoryRetriever) Repository(ctx gocontext.Context, registry *url.UR
 		}
 	}
 
+	actions := r.context.Actions
+	if len(actions) == 0 {
+		actions = []string{"pull"}
+	}
 	rt := transport.NewTransport(
 		t,
 		// TODO: slightly smarter authorizer that retries unauthenticated requests
 		// TODO: make multiple attempts if the first credential fails
 		auth.NewAuthorizer(
 			r.context.Challenges,
-			auth.NewTokenHandler(t, r.credentials, repoName, "pull"),
+			auth.NewTokenHandler(t, r.credentials, repoName, actions...),
 			auth.NewBasicHandler(r.credentials),
 		),
 	)
-	return registryclient.NewRepository(context.Context(ctx), repoName, src.String(), rt)
+	repo, err := registryclient.NewRepository(context.Context(ctx), repoName, src.String(), rt)
+	if err != nil {
+		return nil, err
+	}
+	return &mountableRepository{
+		Repository: repo,
+		client:     &http.Client{Transport: rt},
+		registry:   src,
+		name:       repoName,
+	}, nil
 }
 
 func (r *repositoryRetriever) ping(registry url.URL, insecure bool, transport http.RoundTripper) (*url.URL, error) {
@@ -0,0 +1,311 @@
+package importer
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/golang/glog"
+	gocontext "golang.org/x/net/context"
+
+	"github.com/docker/distribution"
+	"github.com/docker/distribution/context"
+	"github.com/docker/distribution/digest"
+	"github.com/docker/distribution/manifest/schema1"
+	"github.com/docker/distribution/registry/client/auth"
+
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+
+	"github.com/openshift/origin/pkg/image/api"
+)
+
+// RegistryMirror is a registry, optionally followed by a repository prefix, that serves the same content as
+// the registry or repository it mirrors.
+type RegistryMirror struct {
+	// Location is the host of the mirror, optionally followed by the repository prefix that replaces the
+	// mirrored prefix, e.g. mirror.example.com:5000/dockerhub.
+	Location string
+	// Insecure allows the mirror to be accessed over HTTP or without verifying its certificate.
+	Insecure bool
+	// Transport is used to connect to the mirror. If nil, the transport of the context is used.
+	Transport http.RoundTripper
+}
+
+// NewRegistryMirror returns a mirror at location. If caFile is set, the certificate of the mirror is verified
+// against the certificate authorities it contains.
+func NewRegistryMirror(location string, insecure bool, caFile string) (RegistryMirror, error) {
+	mirror := RegistryMirror{Location: location, Insecure: insecure}
+	if len(caFile) == 0 {
+		return mirror, nil
+	}
+	transport, err := kclient.TransportFor(&kclient.Config{TLSClientConfig: kclient.TLSClientConfig{CAFile: caFile}})
+	if err != nil {
+		return mirror, fmt.Errorf("unable to configure a transport for mirror %s: %v", location, err)
+	}
+	mirror.Transport = transport
+	return mirror, nil
+}
+
+// RegistryMirrors maps a registry, optionally followed by a repository prefix, to the mirrors that are tried
+// in order before it.
+type RegistryMirrors map[string][]RegistryMirror
+
+// mirrorFor returns the mirrors of the repository repoName in registry, and the part of the repository
+// name that follows the mirrored prefix. The longest matching prefix is used.
+func (m RegistryMirrors) mirrorFor(registry, repoName string) ([]RegistryMirror, string) {
+	name := normalizeRegistry(registry) + "/" + repoName
+	var match string
+	for source := range m {
+		prefix := normalizeSource(source)
+		if name != prefix && !strings.HasPrefix(name, prefix+"/") {
+			continue
+		}
+		if len(prefix) > len(normalizeSource(match)) {
+			match = source
+		}
+	}
+	if len(match) == 0 {
+		return nil, ""
+	}
+	return m[match], strings.TrimPrefix(strings.TrimPrefix(name, normalizeSource(match)), "/")
+}
+
+// normalizeRegistry returns the canonical name of a registry host.
+func normalizeRegistry(registry string) string {
+	if api.IsRegistryDockerHub(registry) {
+		return api.DockerDefaultRegistry
+	}
+	return registry
+}
+
+// normalizeSource returns the canonical form of a mirrored registry or repository prefix.
+func normalizeSource(source string) string {
+	source = strings.TrimSuffix(source, "/")
+	parts := strings.SplitN(source, "/", 2)
+	parts[0] = normalizeRegistry(parts[0])
+	return strings.Join(parts, "/")
+}
+
+// splitLocation returns the registry host and repository prefix of a mirror location.
+func splitLocation(location string) (string, string) {
+	parts := strings.SplitN(strings.TrimSuffix(location, "/"), "/", 2)
+	if len(parts) == 1 {
+		return parts[0], ""
+	}
+	return parts[0], parts[1]
+}
+
+// mirroredRetriever returns repositories that read content from the configured mirrors in order before
+// falling back to the source repository.
+type mirroredRetriever struct {
+	source  RepositoryRetriever
+	mirrors RegistryMirrors
+	// retrievers holds the retriever for each mirror location so that pings are remembered.
+	retrievers map[string]RepositoryRetriever
+}
+
+func newMirroredRetriever(c Context, credentials auth.CredentialStore, source RepositoryRetriever) *mirroredRetriever {
+	r := &mirroredRetriever{
+		source:     source,
+		mirrors:    c.Mirrors,
+		retrievers: make(map[string]RepositoryRetriever),
+	}
+	for _, mirrors := range c.Mirrors {
+		for _, mirror := range mirrors {
+			if _, ok := r.retrievers[mirror.Location]; ok {
+				continue
+			}
+			mirrorContext := Context{
+				Transport:         c.Transport,
+				InsecureTransport: c.InsecureTransport,
+				Challenges:        c.Challenges,
+			}
+			if mirror.Transport != nil {
+				mirrorContext.Transport = mirror.Transport
+			}
+			r.retrievers[mirror.Location] = &repositoryRetriever{
+				context:     mirrorContext,
+				credentials: credentials,
+
+				pings:    make(map[url.URL]error),
+				redirect: make(map[url.URL]*url.URL),
+			}
+		}
+	}
+	return r
+}
+
+// Repository returns a repository that reads from each mirror of the named repository that can be reached
+// in order, followed by the source repository. An error is returned only if none can be reached, in which
+// case it is the error from the source.
+func (r *mirroredRetriever) Repository(ctx gocontext.Context, registry *url.URL, repoName string, insecure bool) (distribution.Repository, error) {
+	mirrors, suffix := r.mirrors.mirrorFor(registry.Host, repoName)
+	var repos []distribution.Repository
+	for _, mirror := range mirrors {
+		host, prefix := splitLocation(mirror.Location)
+		name := suffix
+		if len(prefix) > 0 {
+			name = prefix + "/" + suffix
+		}
+		mirrorURL := &url.URL{Scheme: registry.Scheme, Host: host}
+		repo, err := r.retrievers[mirror.Location].Repository(ctx, mirrorURL, name, mirror.Insecure)
+		if err != nil {
+			glog.V(4).Infof("Unable to access mirror %s of repository %s/%s: %v", mirror.Location, registry.Host, repoName, err)
+			continue
+		}
+		repos = append(repos, repo)
+	}
+
+	repo, err := r.source.Repository(ctx, registry, repoName, insecure)
+	if err != nil {
+		if len(repos) == 0 {
+			return nil, err
+		}
+		glog.V(4).Infof("Unable to access repository %s/%s, using its mirrors: %v", registry.Host, repoName, err)
+	} else {
+		repos = append(repos, repo)
+	}
+	if len(repos) == 1 {
+		return repos[0], nil
+	}
+	return &mirroredRepository{Repository: repos[0], repos: repos}, nil
+}
+
+// mirroredRepository reads manifests and blobs from the first of repos that has them. Writes go to the
+// first repository.
+type mirroredRepository struct {
+	distribution.Repository
+	repos []distribution.Repository
+}
+
+func (r *mirroredRepository) Manifests(ctx context.Context, options ...distribution.ManifestServiceOption) (distribution.ManifestService, error) {
+	var services []distribution.ManifestService
+	var lastErr error
+	for _, repo := range r.repos {
+		s, err := repo.Manifests(ctx, options...)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		services = append(services, s)
+	}
+	if len(services) == 0 {
+		return nil, lastErr
+	}
+	return &mirroredManifests{ManifestService: services[0], services: services}, nil
+}
+
+func (r *mirroredRepository) Blobs(ctx context.Context) distribution.BlobStore {
+	stores := make([]distribution.BlobStore, 0, len(r.repos))
+	for _, repo := range r.repos {
+		stores = append(stores, repo.Blobs(ctx))
+	}
+	return &mirroredBlobStore{BlobStore: stores[0], stores: stores}
+}
+
+// mirroredManifests reads manifests from the first of services that has them, returning the error from
+// the last service if none do.
+type mirroredManifests struct {
+	distribution.ManifestService
+	services []distribution.ManifestService
+}
+
+func (m *mirroredManifests) Exists(dgst digest.Digest) (bool, error) {
+	var err error
+	for _, s := range m.services {
+		var ok bool
+		if ok, err = s.Exists(dgst); err == nil && ok {
+			return true, nil
+		}
+	}
+	return false, err
+}
+
+func (m *mirroredManifests) Get(dgst digest.Digest) (*schema1.SignedManifest, error) {
+	var err error
+	for _, s := range m.services {
+		var manifest *schema1.SignedManifest
+		if manifest, err = s.Get(dgst); err == nil {
+			return manifest, nil
+		}
+	}
+	return nil, err
+}
+
+func (m *mirroredManifests) Tags() ([]string, error) {
+	var err error
+	for _, s := range m.services {
+		var tags []string
+		if tags, err = s.Tags(); err == nil {
+			return tags, nil
+		}
+	}
+	return nil, err
+}
+
+func (m *mirroredManifests) ExistsByTag(tag string) (bool, error) {
+	var err error
+	for _, s := range m.services {
+		var ok bool
+		if ok, err = s.ExistsByTag(tag); err == nil && ok {
+			return true, nil
+		}
+	}
+	return false, err
+}
+
+func (m *mirroredManifests) GetByTag(tag string, options ...distribution.ManifestServiceOption) (*schema1.SignedManifest, error) {
+	var err error
+	for _, s := range m.services {
+		var manifest *schema1.SignedManifest
+		if manifest, err = s.GetByTag(tag, options...); err == nil {
+			return manifest, nil
+		}
+	}
+	return nil, err
+}
+
+// mirroredBlobStore reads blobs from the first of stores that has them, returning the error from the last
+// store if none do.
+type mirroredBlobStore struct {
+	distribution.BlobStore
+	stores []distribution.BlobStore
+}
+
+func (b *mirroredBlobStore) Stat(ctx context.Context, dgst digest.Digest) (distribution.Descriptor, error) {
+	var err error
+	for _, s := range b.stores {
+		var desc distribution.Descriptor
+		if desc, err = s.Stat(ctx, dgst); err == nil {
+			return desc, nil
+		}
+	}
+	return distribution.Descriptor{}, err
+}
+
+func (b *mirroredBlobStore) Get(ctx context.Context, dgst digest.Digest) ([]byte, error) {
+	var err error
+	for _, s := range b.stores {
+		var data []byte
+		if data, err = s.Get(ctx, dgst); err == nil {
+			return data, nil
+		}
+	}
+	return nil, err
+}
+
+func (b *mirroredBlobStore) Open(ctx context.Context, dgst digest.Digest) (distribution.ReadSeekCloser, error) {
+	var err error
+	for _, s := range b.stores {
+		// opening a remote blob is lazy, so check that the store has it first
+		if _, err = s.Stat(ctx, dgst); err != nil {
+			continue
+		}
+		var rsc distribution.ReadSeekCloser
+		if rsc, err = s.Open(ctx, dgst); err == nil {
+			return rsc, nil
+		}
+	}
+	return nil, err
+}
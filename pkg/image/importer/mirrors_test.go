@@ -0,0 +1,158 @@
+package importer
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	gocontext "golang.org/x/net/context"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+
+	"github.com/openshift/origin/pkg/image/api"
+)
+
+func TestMirrorFor(t *testing.T) {
+	mirrors := RegistryMirrors{
+		"docker.io":             {{Location: "mirror.example.com/hub"}},
+		"docker.io/openshift":   {{Location: "mirror.example.com/openshift"}},
+		"quay.io/coreos/":       {{Location: "quay-mirror.example.com"}},
+		"registry.example.com/": {{Location: "other.example.com"}},
+	}
+	tests := []struct {
+		registry, repoName string
+		location, suffix   string
+	}{
+		{registry: "registry-1.docker.io", repoName: "library/busybox", location: "mirror.example.com/hub", suffix: "library/busybox"},
+		{registry: "docker.io", repoName: "openshift/origin", location: "mirror.example.com/openshift", suffix: "origin"},
+		{registry: "docker.io", repoName: "openshiftx/origin", location: "mirror.example.com/hub", suffix: "openshiftx/origin"},
+		{registry: "quay.io", repoName: "coreos/etcd", location: "quay-mirror.example.com", suffix: "etcd"},
+		{registry: "quay.io", repoName: "other/etcd"},
+		{registry: "registry.example.com", repoName: "ns/app", location: "other.example.com", suffix: "ns/app"},
+		{registry: "registry.example.com:5000", repoName: "ns/app"},
+	}
+	for _, test := range tests {
+		found, suffix := mirrors.mirrorFor(test.registry, test.repoName)
+		if len(test.location) == 0 {
+			if found != nil {
+				t.Errorf("%s/%s: unexpected mirrors %#v", test.registry, test.repoName, found)
+			}
+			continue
+		}
+		if len(found) != 1 || found[0].Location != test.location || suffix != test.suffix {
+			t.Errorf("%s/%s: expected %s with %q, got %#v with %q", test.registry, test.repoName, test.location, test.suffix, found, suffix)
+		}
+	}
+}
+
+// newFakeRegistry returns a server acting as a V2 registry that serves etcdManifest for each of the
+// given manifest paths, and records the paths requested from it.
+func newFakeRegistry(requested *[]string, manifests ...string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requested = append(*requested, r.URL.Path)
+		w.Header().Set("Docker-Distribution-API-Version", "registry/2.0")
+		if r.URL.Path == "/v2/" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		for _, m := range manifests {
+			if r.URL.Path == m {
+				w.Write([]byte(etcdManifest))
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+}
+
+func TestImportFromMirror(t *testing.T) {
+	tests := map[string]struct {
+		sourceDown     bool
+		mirrorHasImage bool
+		sourceHasImage bool
+		fromMirror     bool
+		err            bool
+	}{
+		"unreachable source": {
+			sourceDown:     true,
+			mirrorHasImage: true,
+			fromMirror:     true,
+		},
+		"image only in mirror": {
+			mirrorHasImage: true,
+			fromMirror:     true,
+		},
+		"image only in source": {
+			sourceHasImage: true,
+		},
+		"image in neither": {
+			err: true,
+		},
+		"unreachable source and image not in mirror": {
+			sourceDown: true,
+			err:        true,
+		},
+	}
+
+	for name, test := range tests {
+		var mirrorRequests, sourceRequests []string
+		var mirrorManifests, sourceManifests []string
+		if test.mirrorHasImage {
+			mirrorManifests = append(mirrorManifests, "/v2/cache/coreos/etcd/manifests/latest")
+		}
+		if test.sourceHasImage {
+			sourceManifests = append(sourceManifests, "/v2/coreos/etcd/manifests/latest")
+		}
+		mirror := newFakeRegistry(&mirrorRequests, mirrorManifests...)
+		source := newFakeRegistry(&sourceRequests, sourceManifests...)
+		mirrorURL, _ := url.Parse(mirror.URL)
+		sourceURL, _ := url.Parse(source.URL)
+		if test.sourceDown {
+			source.Close()
+		}
+
+		mirrors := RegistryMirrors{
+			sourceURL.Host: {{Location: mirrorURL.Host + "/cache", Insecure: true}},
+		}
+		retriever := NewContext(http.DefaultTransport, http.DefaultTransport).WithMirrors(mirrors).WithCredentials(NoCredentials)
+		isi := &api.ImageStreamImport{
+			Spec: api.ImageStreamImportSpec{
+				Images: []api.ImageImportSpec{
+					{From: kapi.ObjectReference{Kind: "DockerImage", Name: sourceURL.Host + "/coreos/etcd:latest"}, ImportPolicy: api.TagImportPolicy{Insecure: true}},
+				},
+			},
+		}
+		if err := NewImageStreamImporter(retriever, 5, nil).Import(gocontext.Background(), isi); err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		mirror.Close()
+		source.Close()
+
+		status := isi.Status.Images[0]
+		if test.err {
+			if status.Status.Status != unversioned.StatusFailure {
+				t.Errorf("%s: expected the import to fail: %#v", name, status)
+			}
+			continue
+		}
+		if status.Status.Status != unversioned.StatusSuccess {
+			t.Errorf("%s: expected the import to succeed: %#v", name, status.Status)
+			continue
+		}
+		if !strings.HasPrefix(status.Image.DockerImageReference, sourceURL.Host+"/coreos/etcd@") {
+			t.Errorf("%s: expected the image to be recorded with its source pull spec, got %s", name, status.Image.DockerImageReference)
+		}
+		fetchedFromSource := false
+		for _, path := range sourceRequests {
+			if strings.Contains(path, "/manifests/") {
+				fetchedFromSource = true
+			}
+		}
+		if test.fromMirror == fetchedFromSource {
+			t.Errorf("%s: expected the manifest to be fetched from the mirror=%t, source requests: %v", name, test.fromMirror, sourceRequests)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package importer
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+
+	"github.com/golang/glog"
+	gocontext "golang.org/x/net/context"
+
+	"github.com/docker/distribution"
+	"github.com/docker/distribution/digest"
+)
+
+// BlobMounter is implemented by repositories that can link a blob from another repository in the same
+// registry without transferring its content.
+type BlobMounter interface {
+	// MountBlob links the blob dgst of the repository named from into this repository. It returns false
+	// if the registry does not support cross repository mounts or the blob could not be mounted.
+	MountBlob(ctx gocontext.Context, from string, dgst digest.Digest) (bool, error)
+}
+
+// mountableRepository is a remote repository that supports cross repository blob mounts.
+type mountableRepository struct {
+	distribution.Repository
+
+	client   *http.Client
+	registry url.URL
+	name     string
+}
+
+var _ BlobMounter = &mountableRepository{}
+
+func (r *mountableRepository) MountBlob(ctx gocontext.Context, from string, dgst digest.Digest) (bool, error) {
+	u := r.registry
+	u.Path = path.Join(u.Path, "v2", r.name, "blobs", "uploads") + "/"
+	u.RawQuery = url.Values{"mount": {dgst.String()}, "from": {from}}.Encode()
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return false, err
+	}
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusCreated:
+		return true, nil
+	case http.StatusAccepted:
+		// registries that do not support mounting start a regular upload instead, which is not needed
+		if header := resp.Header.Get("Location"); len(header) > 0 {
+			if location, err := u.Parse(header); err == nil {
+				r.cancelUpload(location)
+			}
+		}
+		return false, nil
+	default:
+		return false, fmt.Errorf("unable to mount blob %s from %s into %s: %s", dgst, from, r.name, resp.Status)
+	}
+}
+
+// cancelUpload discards an upload the registry started.
+func (r *mountableRepository) cancelUpload(location *url.URL) {
+	req, err := http.NewRequest("DELETE", location.String(), nil)
+	if err != nil {
+		return
+	}
+	resp, err := r.client.Do(req)
+	if err != nil {
+		glog.V(4).Infof("Unable to cancel upload %s: %v", location, err)
+		return
+	}
+	resp.Body.Close()
+}
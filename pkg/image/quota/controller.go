@@ -0,0 +1,115 @@
+package quota
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/util"
+
+	"github.com/openshift/origin/pkg/client"
+)
+
+// UsageController periodically records the image usage of each project with an image quota in the status
+// of its quotas. Usage recorded by an Admitter between syncs is replaced by the observed usage.
+type UsageController struct {
+	kubeClient kclient.ResourceQuotasNamespacer
+	client     client.Interface
+	interval   time.Duration
+
+	// sizes caches the size of images, which never changes.
+	sizes map[string]int64
+}
+
+// NewUsageController returns a controller that records image usage every interval.
+func NewUsageController(kubeClient kclient.ResourceQuotasNamespacer, client client.Interface, interval time.Duration) *UsageController {
+	return &UsageController{
+		kubeClient: kubeClient,
+		client:     client,
+		interval:   interval,
+		sizes:      make(map[string]int64),
+	}
+}
+
+// Run syncs the usage of all projects every interval until stopCh is closed.
+func (c *UsageController) Run(stopCh <-chan struct{}) {
+	go util.Until(func() {
+		if err := c.syncAll(); err != nil {
+			util.HandleError(err)
+		}
+	}, c.interval, stopCh)
+}
+
+// syncAll records the usage of every project that has a quota limiting images.
+func (c *UsageController) syncAll() error {
+	list, err := c.kubeClient.ResourceQuotas(kapi.NamespaceAll).List(kapi.ListOptions{})
+	if err != nil {
+		return err
+	}
+	byNamespace := make(map[string][]kapi.ResourceQuota)
+	for i := range list.Items {
+		if TracksImages(&list.Items[i]) {
+			byNamespace[list.Items[i].Namespace] = append(byNamespace[list.Items[i].Namespace], list.Items[i])
+		}
+	}
+	for namespace, quotas := range byNamespace {
+		if err := c.syncNamespace(namespace, quotas); err != nil {
+			util.HandleError(fmt.Errorf("unable to record the image usage of %s: %v", namespace, err))
+		}
+	}
+	return nil
+}
+
+// syncNamespace records the image usage of namespace in quotas that report a different usage.
+func (c *UsageController) syncNamespace(namespace string, quotas []kapi.ResourceQuota) error {
+	streams, err := c.client.ImageStreams(namespace).List(kapi.ListOptions{})
+	if err != nil {
+		return err
+	}
+	usage, err := Usage(streams.Items, c.imageSize)
+	if err != nil {
+		return err
+	}
+	for i := range quotas {
+		quota := &quotas[i]
+		changed := false
+		for _, name := range Resources {
+			if _, ok := quota.Spec.Hard[name]; !ok {
+				continue
+			}
+			if used, ok := quota.Status.Used[name]; ok && used.Cmp(usage[name]) == 0 {
+				continue
+			}
+			if quota.Status.Used == nil {
+				quota.Status.Used = kapi.ResourceList{}
+			}
+			quota.Status.Used[name] = usage[name]
+			changed = true
+		}
+		if !changed {
+			continue
+		}
+		glog.V(4).Infof("Recording image usage of quota %s/%s", namespace, quota.Name)
+		// a conflict means the quota changed since it was listed, it is retried on the next sync
+		if _, err := c.kubeClient.ResourceQuotas(namespace).UpdateStatus(quota); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// imageSize returns the size of the named image.
+func (c *UsageController) imageSize(name string) (int64, error) {
+	if size, ok := c.sizes[name]; ok {
+		return size, nil
+	}
+	image, err := c.client.Images().Get(name)
+	if err != nil {
+		return 0, err
+	}
+	c.sizes[name] = image.DockerImageMetadata.Size
+	return image.DockerImageMetadata.Size, nil
+}
@@ -0,0 +1,194 @@
+package quota
+
+import (
+	"fmt"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/resource"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	"github.com/openshift/origin/pkg/image/api"
+)
+
+// Resources are the quota resources that are tracked for the images of a project.
+var Resources = []kapi.ResourceName{api.ResourceImageStreams, api.ResourceImageStreamTags, api.ResourceImageStorage}
+
+// numRetries is the number of times the usage of a quota is recorded before giving up on conflicts.
+const numRetries = 10
+
+// TracksImages returns true if quota limits any of the image resources.
+func TracksImages(quota *kapi.ResourceQuota) bool {
+	for _, name := range Resources {
+		if _, ok := quota.Spec.Hard[name]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// ImageSizeFunc returns the size in bytes of the named image.
+type ImageSizeFunc func(name string) (int64, error)
+
+// Usage returns the image resources used by streams, the image streams of a project. Each image referenced
+// by the status of the streams is counted once towards the storage of the project.
+func Usage(streams []api.ImageStream, imageSize ImageSizeFunc) (kapi.ResourceList, error) {
+	tags := 0
+	images := sets.NewString()
+	for i := range streams {
+		tags += len(streams[i].Status.Tags)
+		images.Insert(referencedImages(&streams[i]).List()...)
+	}
+	storage := int64(0)
+	for _, name := range images.List() {
+		size, err := imageSize(name)
+		if err != nil {
+			if kerrors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		storage += size
+	}
+	return kapi.ResourceList{
+		api.ResourceImageStreams:    *resource.NewQuantity(int64(len(streams)), resource.DecimalSI),
+		api.ResourceImageStreamTags: *resource.NewQuantity(int64(tags), resource.DecimalSI),
+		api.ResourceImageStorage:    *resource.NewQuantity(storage, resource.BinarySI),
+	}, nil
+}
+
+// MappingUsage returns the image resources that tagging image into tag of stream adds to its project. The
+// storage of the image is counted unless stream already references it.
+func MappingUsage(stream *api.ImageStream, tag string, image *api.Image) kapi.ResourceList {
+	usage := kapi.ResourceList{}
+	if _, ok := stream.Status.Tags[tag]; !ok {
+		usage[api.ResourceImageStreamTags] = *resource.NewQuantity(1, resource.DecimalSI)
+	}
+	if !referencedImages(stream).Has(image.Name) {
+		usage[api.ResourceImageStorage] = *resource.NewQuantity(image.DockerImageMetadata.Size, resource.BinarySI)
+	}
+	return usage
+}
+
+// referencedImages returns the names of the images in the tag history of stream.
+func referencedImages(stream *api.ImageStream) sets.String {
+	images := sets.NewString()
+	for _, history := range stream.Status.Tags {
+		for _, event := range history.Items {
+			if len(event.Image) > 0 {
+				images.Insert(event.Image)
+			}
+		}
+	}
+	return images
+}
+
+// Check returns a forbidden error if adding usage to the usage recorded in any of quotas would exceed its
+// hard limits.
+func Check(quotas []kapi.ResourceQuota, usage kapi.ResourceList) error {
+	for i := range quotas {
+		if err := exceeded(&quotas[i], usage); err != nil {
+			return kerrors.NewForbidden("ResourceQuota", quotas[i].Name, err)
+		}
+	}
+	return nil
+}
+
+// exceeded returns an error describing the first hard limit of quota that adding usage would exceed.
+func exceeded(quota *kapi.ResourceQuota, usage kapi.ResourceList) error {
+	for _, name := range Resources {
+		requested, ok := usage[name]
+		if !ok || requested.Value() == 0 {
+			continue
+		}
+		hard, ok := quota.Spec.Hard[name]
+		if !ok {
+			continue
+		}
+		used := quota.Status.Used[name]
+		if used.Value()+requested.Value() > hard.Value() {
+			return fmt.Errorf("exceeded quota for %s: requested %s, used %s, limited to %s", name, requested.String(), used.String(), hard.String())
+		}
+	}
+	return nil
+}
+
+// Admitter admits image usage in a project against its quotas.
+type Admitter interface {
+	// Admit returns a forbidden error if adding usage to the namespace would exceed any of its quotas, and
+	// otherwise records usage in the quotas so that concurrent requests cannot exceed them.
+	Admit(namespace string, usage kapi.ResourceList) error
+}
+
+// NewAdmitter returns an Admitter that records usage in the status of the quotas available from client.
+func NewAdmitter(client kclient.ResourceQuotasNamespacer) Admitter {
+	return &quotaAdmitter{client: client}
+}
+
+type quotaAdmitter struct {
+	client kclient.ResourceQuotasNamespacer
+}
+
+func (a *quotaAdmitter) Admit(namespace string, usage kapi.ResourceList) error {
+	list, err := a.client.ResourceQuotas(namespace).List(kapi.ListOptions{})
+	if err != nil {
+		return err
+	}
+	var quotas []kapi.ResourceQuota
+	for i := range list.Items {
+		if TracksImages(&list.Items[i]) {
+			quotas = append(quotas, list.Items[i])
+		}
+	}
+	if err := Check(quotas, usage); err != nil {
+		return err
+	}
+
+	for i := range quotas {
+		quota := &quotas[i]
+		for retry := 1; ; retry++ {
+			if !record(quota, usage) {
+				break
+			}
+			_, err := a.client.ResourceQuotas(namespace).UpdateStatus(quota)
+			if err == nil {
+				break
+			}
+			if !kerrors.IsConflict(err) || retry == numRetries {
+				return kerrors.NewForbidden("ResourceQuota", quota.Name, fmt.Errorf("unable to record image usage at this time: %v", err))
+			}
+			time.Sleep(10 * time.Millisecond)
+			if quota, err = a.client.ResourceQuotas(namespace).Get(quota.Name); err != nil {
+				return err
+			}
+			if err := exceeded(quota, usage); err != nil {
+				return kerrors.NewForbidden("ResourceQuota", quota.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// record adds the usage of each resource limited by quota to its status, returning false if nothing
+// changed.
+func record(quota *kapi.ResourceQuota, usage kapi.ResourceList) bool {
+	changed := false
+	for _, name := range Resources {
+		requested, ok := usage[name]
+		if !ok || requested.Value() == 0 {
+			continue
+		}
+		if _, ok := quota.Spec.Hard[name]; !ok {
+			continue
+		}
+		if quota.Status.Used == nil {
+			quota.Status.Used = kapi.ResourceList{}
+		}
+		used := quota.Status.Used[name]
+		quota.Status.Used[name] = *resource.NewQuantity(used.Value()+requested.Value(), requested.Format)
+		changed = true
+	}
+	return changed
+}
@@ -0,0 +1,228 @@
+package quota
+
+import (
+	"fmt"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/resource"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	"github.com/openshift/origin/pkg/client/testclient"
+	"github.com/openshift/origin/pkg/image/api"
+)
+
+func streamWithTags(name string, tags map[string][]string) api.ImageStream {
+	stream := api.ImageStream{ObjectMeta: kapi.ObjectMeta{Namespace: "test", Name: name}, Status: api.ImageStreamStatus{Tags: map[string]api.TagEventList{}}}
+	for tag, images := range tags {
+		var events []api.TagEvent
+		for _, image := range images {
+			events = append(events, api.TagEvent{Image: image})
+		}
+		stream.Status.Tags[tag] = api.TagEventList{Items: events}
+	}
+	return stream
+}
+
+func quotaWithLimits(name string, hard, used map[kapi.ResourceName]int64) *kapi.ResourceQuota {
+	quota := &kapi.ResourceQuota{
+		ObjectMeta: kapi.ObjectMeta{Namespace: "test", Name: name},
+		Spec:       kapi.ResourceQuotaSpec{Hard: kapi.ResourceList{}},
+		Status:     kapi.ResourceQuotaStatus{Hard: kapi.ResourceList{}, Used: kapi.ResourceList{}},
+	}
+	for k, v := range hard {
+		quota.Spec.Hard[k] = *resource.NewQuantity(v, resource.DecimalSI)
+		quota.Status.Hard[k] = *resource.NewQuantity(v, resource.DecimalSI)
+	}
+	for k, v := range used {
+		quota.Status.Used[k] = *resource.NewQuantity(v, resource.DecimalSI)
+	}
+	return quota
+}
+
+func TestUsage(t *testing.T) {
+	streams := []api.ImageStream{
+		streamWithTags("one", map[string][]string{"latest": {"sha256:a", "sha256:b"}, "v1": {"sha256:a"}}),
+		streamWithTags("two", map[string][]string{"latest": {"sha256:b", "sha256:pruned"}}),
+		streamWithTags("empty", nil),
+	}
+	sizes := map[string]int64{"sha256:a": 100, "sha256:b": 10}
+	usage, err := Usage(streams, func(name string) (int64, error) {
+		size, ok := sizes[name]
+		if !ok {
+			return 0, kerrors.NewNotFound("Image", name)
+		}
+		return size, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := map[kapi.ResourceName]int64{
+		api.ResourceImageStreams:    3,
+		api.ResourceImageStreamTags: 3,
+		api.ResourceImageStorage:    110,
+	}
+	for name, value := range expected {
+		if actual := usage[name]; actual.Value() != value {
+			t.Errorf("expected %s to be %d, got %s", name, value, actual.String())
+		}
+	}
+
+	if _, err := Usage(streams, func(name string) (int64, error) { return 0, fmt.Errorf("unavailable") }); err == nil {
+		t.Errorf("expected an error when image sizes are unavailable")
+	}
+}
+
+func TestMappingUsage(t *testing.T) {
+	stream := streamWithTags("one", map[string][]string{"latest": {"sha256:a"}})
+	tests := map[string]struct {
+		tag, image    string
+		tags, storage int64
+	}{
+		"existing tag and image": {tag: "latest", image: "sha256:a"},
+		"new tag":                {tag: "v1", image: "sha256:a", tags: 1},
+		"new image":              {tag: "latest", image: "sha256:b", storage: 50},
+		"new tag and image":      {tag: "v1", image: "sha256:b", tags: 1, storage: 50},
+	}
+	for name, test := range tests {
+		image := &api.Image{ObjectMeta: kapi.ObjectMeta{Name: test.image}, DockerImageMetadata: api.DockerImage{Size: 50}}
+		usage := MappingUsage(&stream, test.tag, image)
+		tags, storage := usage[api.ResourceImageStreamTags], usage[api.ResourceImageStorage]
+		if tags.Value() != test.tags || storage.Value() != test.storage {
+			t.Errorf("%s: expected %d tags and %d bytes, got %#v", name, test.tags, test.storage, usage)
+		}
+	}
+}
+
+func TestAdmit(t *testing.T) {
+	tests := map[string]struct {
+		quotas   []*kapi.ResourceQuota
+		usage    kapi.ResourceList
+		rejected bool
+		recorded map[string]map[kapi.ResourceName]int64
+	}{
+		"no quota": {
+			usage: kapi.ResourceList{api.ResourceImageStorage: *resource.NewQuantity(10, resource.BinarySI)},
+		},
+		"quota without images": {
+			quotas: []*kapi.ResourceQuota{quotaWithLimits("pods", map[kapi.ResourceName]int64{kapi.ResourcePods: 1}, nil)},
+			usage:  kapi.ResourceList{api.ResourceImageStorage: *resource.NewQuantity(10, resource.BinarySI)},
+		},
+		"within quota": {
+			quotas: []*kapi.ResourceQuota{
+				quotaWithLimits("images", map[kapi.ResourceName]int64{api.ResourceImageStorage: 100, api.ResourceImageStreamTags: 2}, map[kapi.ResourceName]int64{api.ResourceImageStorage: 90, api.ResourceImageStreamTags: 1}),
+				quotaWithLimits("tags", map[kapi.ResourceName]int64{api.ResourceImageStreamTags: 5}, nil),
+			},
+			usage: kapi.ResourceList{
+				api.ResourceImageStorage:    *resource.NewQuantity(10, resource.BinarySI),
+				api.ResourceImageStreamTags: *resource.NewQuantity(1, resource.DecimalSI),
+			},
+			recorded: map[string]map[kapi.ResourceName]int64{
+				"images": {api.ResourceImageStorage: 100, api.ResourceImageStreamTags: 2},
+				"tags":   {api.ResourceImageStreamTags: 1},
+			},
+		},
+		"storage exceeded": {
+			quotas: []*kapi.ResourceQuota{
+				quotaWithLimits("images", map[kapi.ResourceName]int64{api.ResourceImageStorage: 100}, map[kapi.ResourceName]int64{api.ResourceImageStorage: 95}),
+			},
+			usage:    kapi.ResourceList{api.ResourceImageStorage: *resource.NewQuantity(10, resource.BinarySI)},
+			rejected: true,
+		},
+		"tags exceeded in one of several quotas": {
+			quotas: []*kapi.ResourceQuota{
+				quotaWithLimits("storage", map[kapi.ResourceName]int64{api.ResourceImageStorage: 100}, nil),
+				quotaWithLimits("tags", map[kapi.ResourceName]int64{api.ResourceImageStreamTags: 1}, map[kapi.ResourceName]int64{api.ResourceImageStreamTags: 1}),
+			},
+			usage: kapi.ResourceList{
+				api.ResourceImageStorage:    *resource.NewQuantity(10, resource.BinarySI),
+				api.ResourceImageStreamTags: *resource.NewQuantity(1, resource.DecimalSI),
+			},
+			rejected: true,
+		},
+	}
+
+	for name, test := range tests {
+		list := &kapi.ResourceQuotaList{}
+		for _, quota := range test.quotas {
+			list.Items = append(list.Items, *quota)
+		}
+		client := &ktestclient.Fake{}
+		client.AddReactor("list", "resourcequotas", func(action ktestclient.Action) (bool, runtime.Object, error) {
+			return true, list, nil
+		})
+		recorded := make(map[string]map[kapi.ResourceName]int64)
+		client.AddReactor("update", "resourcequotas", func(action ktestclient.Action) (bool, runtime.Object, error) {
+			quota := action.(ktestclient.UpdateAction).GetObject().(*kapi.ResourceQuota)
+			recorded[quota.Name] = make(map[kapi.ResourceName]int64)
+			for _, name := range Resources {
+				if used, ok := quota.Status.Used[name]; ok {
+					recorded[quota.Name][name] = used.Value()
+				}
+			}
+			return true, quota, nil
+		})
+
+		err := NewAdmitter(client).Admit("test", test.usage)
+		if test.rejected {
+			if !kerrors.IsForbidden(err) {
+				t.Errorf("%s: expected a forbidden error, got %v", name, err)
+			}
+			if len(recorded) != 0 {
+				t.Errorf("%s: expected no usage to be recorded, got %v", name, recorded)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if fmt.Sprintf("%v", recorded) != fmt.Sprintf("%v", test.recorded) {
+			t.Errorf("%s: expected usage %v to be recorded, got %v", name, test.recorded, recorded)
+		}
+	}
+}
+
+func TestUsageControllerSync(t *testing.T) {
+	quota := quotaWithLimits("images", map[kapi.ResourceName]int64{api.ResourceImageStreams: 10, api.ResourceImageStorage: 1000}, map[kapi.ResourceName]int64{api.ResourceImageStreams: 5})
+	stream := streamWithTags("one", map[string][]string{"latest": {"sha256:a"}})
+	image := &api.Image{ObjectMeta: kapi.ObjectMeta{Name: "sha256:a"}, DockerImageMetadata: api.DockerImage{Size: 300}}
+
+	kubeClient := &ktestclient.Fake{}
+	kubeClient.AddReactor("list", "resourcequotas", func(action ktestclient.Action) (bool, runtime.Object, error) {
+		return true, &kapi.ResourceQuotaList{Items: []kapi.ResourceQuota{*quota}}, nil
+	})
+	var updated *kapi.ResourceQuota
+	kubeClient.AddReactor("update", "resourcequotas", func(action ktestclient.Action) (bool, runtime.Object, error) {
+		updated = action.(ktestclient.UpdateAction).GetObject().(*kapi.ResourceQuota)
+		return true, updated, nil
+	})
+	osClient := testclient.NewSimpleFake(&api.ImageStreamList{Items: []api.ImageStream{stream}}, image)
+
+	c := NewUsageController(kubeClient, osClient, 0)
+	if err := c.syncAll(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated == nil {
+		t.Fatalf("expected the quota to be updated")
+	}
+	streams, storage := updated.Status.Used[api.ResourceImageStreams], updated.Status.Used[api.ResourceImageStorage]
+	if streams.Value() != 1 || storage.Value() != 300 {
+		t.Errorf("expected 1 image stream and 300 bytes, got %#v", updated.Status.Used)
+	}
+	if _, ok := updated.Status.Used[api.ResourceImageStreamTags]; ok {
+		t.Errorf("expected resources without limits not to be recorded: %#v", updated.Status.Used)
+	}
+
+	// an unchanged usage is not recorded again
+	*quota = *updated
+	updated = nil
+	if err := c.syncAll(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated != nil {
+		t.Errorf("expected no update, got %#v", updated.Status.Used)
+	}
+}
@@ -18,8 +18,8 @@ type REST struct {
 	*etcdgeneric.Etcd
 }
 
-// NewREST returns a new REST.
-func NewREST(s storage.Interface) *REST {
+// NewREST returns a new REST and a SignaturesREST for the signatures subresource.
+func NewREST(s storage.Interface) (*REST, *SignaturesREST) {
 	prefix := "/images"
 
 	store := &etcdgeneric.Etcd{
@@ -59,5 +59,23 @@ func NewREST(s storage.Interface) *REST {
 
 		Storage: s,
 	}
-	return &REST{store}
+	signaturesStore := *store
+	signaturesStore.UpdateStrategy = image.SignaturesStrategy
+
+	return &REST{store}, &SignaturesREST{&signaturesStore}
+}
+
+// SignaturesREST implements the REST endpoint for changing the signatures of an image.
+type SignaturesREST struct {
+	store *etcdgeneric.Etcd
+}
+
+// New returns a new Image.
+func (r *SignaturesREST) New() runtime.Object {
+	return &api.Image{}
+}
+
+// Update alters the signatures of an image.
+func (r *SignaturesREST) Update(ctx kapi.Context, obj runtime.Object) (runtime.Object, bool, error) {
+	return r.store.Update(ctx, obj)
 }
@@ -15,11 +15,16 @@ import (
 )
 
 func newStorage(t *testing.T) (*REST, *etcdtesting.EtcdTestServer) {
-	etcdStorage, server := registrytest.NewEtcdStorage(t, latest.Version.Group)
-	storage := NewREST(etcdStorage)
+	storage, _, server := newStorageWithSignatures(t)
 	return storage, server
 }
 
+func newStorageWithSignatures(t *testing.T) (*REST, *SignaturesREST, *etcdtesting.EtcdTestServer) {
+	etcdStorage, server := registrytest.NewEtcdStorage(t, latest.Version.Group)
+	storage, signaturesStorage := NewREST(etcdStorage)
+	return storage, signaturesStorage, server
+}
+
 func TestStorage(t *testing.T) {
 	storage, _ := newStorage(t)
 	image.NewRegistry(storage)
@@ -401,3 +406,57 @@ func TestUpdateResetsMetadata(t *testing.T) {
 		}
 	}
 }
+
+func TestUpdateSignatures(t *testing.T) {
+	storage, signaturesStorage, server := newStorageWithSignatures(t)
+	defer server.Terminate(t)
+
+	signature := api.ImageSignature{Name: "sig1", Type: api.ImageSignatureTypeGPG, Content: []byte("signature"), Issuer: "Build Pipeline"}
+
+	// signatures are ignored on creation of the image
+	image := validImage()
+	image.Signatures = []api.ImageSignature{signature}
+	image.Signatures[0].Conditions = []api.SignatureCondition{{Type: api.SignatureTrusted, Status: kapi.ConditionTrue}}
+	created, err := storage.Create(kapi.NewDefaultContext(), image)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(created.(*api.Image).Signatures) != 0 {
+		t.Errorf("Expected the signatures to be ignored: %#v", created.(*api.Image).Signatures)
+	}
+
+	// signatures are ignored on updates of the image
+	update := *created.(*api.Image)
+	update.Signatures = []api.ImageSignature{signature}
+	obj, _, err := storage.Update(kapi.NewDefaultContext(), &update)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if image := obj.(*api.Image); len(image.Signatures) != 0 {
+		t.Errorf("Expected the signatures to be ignored: %#v", image.Signatures)
+	}
+
+	// everything but the signatures is ignored on updates of the signatures
+	update = *obj.(*api.Image)
+	update.Signatures = []api.ImageSignature{signature}
+	update.DockerImageReference = "openshift/other"
+	update.Labels = map[string]string{"a": "b"}
+	obj, _, err = signaturesStorage.Update(kapi.NewDefaultContext(), &update)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	image = obj.(*api.Image)
+	if len(image.Signatures) != 1 || image.Signatures[0].Issuer != "Build Pipeline" || string(image.Signatures[0].Content) != "signature" {
+		t.Errorf("Unexpected signatures: %#v", image.Signatures)
+	}
+	if image.DockerImageReference != "openshift/origin" || len(image.Labels) != 0 {
+		t.Errorf("Expected the image to be unchanged: %#v", image)
+	}
+
+	// invalid signatures are rejected
+	update = *image
+	update.Signatures = []api.ImageSignature{{Name: "sig2", Type: "unknown", Content: []byte("signature")}}
+	if _, _, err := signaturesStorage.Update(kapi.NewDefaultContext(), &update); err == nil {
+		t.Errorf("Expected an invalid signature to be rejected")
+	}
+}
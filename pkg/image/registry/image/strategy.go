@@ -34,6 +34,8 @@ func (imageStrategy) NamespaceScoped() bool {
 // It extracts the latest information from the manifest (if available) and sets that onto the object.
 func (imageStrategy) PrepareForCreate(obj runtime.Object) {
 	newImage := obj.(*api.Image)
+	// signatures may only be added through the signatures subresource
+	newImage.Signatures = nil
 	// ignore errors, change in place
 	if err := api.ImageWithMetadata(newImage); err != nil {
 		util.HandleError(fmt.Errorf("Unable to update image metadata for %q: %v", newImage.Name, err))
@@ -73,6 +75,9 @@ func (imageStrategy) PrepareForUpdate(obj, old runtime.Object) {
 	newImage.DockerImageMetadataVersion = oldImage.DockerImageMetadataVersion
 	newImage.DockerImageLayers = oldImage.DockerImageLayers
 
+	// signatures may only be changed through the signatures subresource
+	newImage.Signatures = oldImage.Signatures
+
 	// allow an image update that results in the manifest matching the digest (the name)
 	newManifest := newImage.DockerImageManifest
 	newImage.DockerImageManifest = oldImage.DockerImageManifest
@@ -95,6 +100,30 @@ func (imageStrategy) ValidateUpdate(ctx kapi.Context, obj, old runtime.Object) f
 	return validation.ValidateImageUpdate(old.(*api.Image), obj.(*api.Image))
 }
 
+// signaturesStrategy implements the behavior of the images/signatures subresource.
+type signaturesStrategy struct {
+	imageStrategy
+}
+
+// SignaturesStrategy is the logic that applies when updating the signatures of
+// Image objects via the REST API.
+var SignaturesStrategy = signaturesStrategy{Strategy}
+
+// PrepareForUpdate keeps everything but the signatures of the old image.
+func (signaturesStrategy) PrepareForUpdate(obj, old runtime.Object) {
+	newImage := obj.(*api.Image)
+	oldImage := old.(*api.Image)
+
+	signatures, resourceVersion := newImage.Signatures, newImage.ResourceVersion
+	*newImage = *oldImage
+	newImage.Signatures, newImage.ResourceVersion = signatures, resourceVersion
+}
+
+// ValidateUpdate validates the signatures set through the subresource.
+func (signaturesStrategy) ValidateUpdate(ctx kapi.Context, obj, old runtime.Object) field.ErrorList {
+	return validation.ValidateImageUpdate(obj.(*api.Image), old.(*api.Image))
+}
+
 // MatchImage returns a generic matcher for a given label and field selector.
 func MatchImage(label labels.Selector, field fields.Selector) generic.Matcher {
 	return generic.MatcherFunc(func(obj runtime.Object) (bool, error) {
@@ -287,9 +287,20 @@ func tagRefChanged(old, next api.TagReference, streamNamespace string) bool {
 	if oldFrom.Name != next.From.Name {
 		return true
 	}
+	if referencePolicyType(old) != referencePolicyType(next) {
+		return true
+	}
 	return tagRefGenerationChanged(old, next)
 }
 
+// referencePolicyType returns the reference policy of the tag ref, treating an unset policy as Source.
+func referencePolicyType(ref api.TagReference) api.TagReferencePolicyType {
+	if len(ref.ReferencePolicy.Type) == 0 {
+		return api.SourceTagReferencePolicy
+	}
+	return ref.ReferencePolicy.Type
+}
+
 // tagRefGenerationChanged returns true if and only the values were set and the new generation
 // is at zero.
 func tagRefGenerationChanged(old, next api.TagReference) bool {
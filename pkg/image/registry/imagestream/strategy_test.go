@@ -896,6 +896,16 @@ func TestTagRefChanged(t *testing.T) {
 			next:     api.TagReference{From: &kapi.ObjectReference{Kind: "DockerImage", Name: "bar"}},
 			expected: true,
 		},
+		"different reference policy": {
+			old:      api.TagReference{From: &kapi.ObjectReference{Kind: "DockerImage", Name: "foo"}},
+			next:     api.TagReference{From: &kapi.ObjectReference{Kind: "DockerImage", Name: "foo"}, ReferencePolicy: api.TagReferencePolicy{Type: api.LocalTagReferencePolicy}},
+			expected: true,
+		},
+		"defaulted reference policy": {
+			old:      api.TagReference{From: &kapi.ObjectReference{Kind: "DockerImage", Name: "foo"}},
+			next:     api.TagReference{From: &kapi.ObjectReference{Kind: "DockerImage", Name: "foo"}, ReferencePolicy: api.TagReferencePolicy{Type: api.SourceTagReferencePolicy}},
+			expected: false,
+		},
 		"no kind, no name": {
 			old: api.TagReference{},
 			next: api.TagReference{
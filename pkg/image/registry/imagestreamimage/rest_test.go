@@ -36,7 +36,7 @@ func setup(t *testing.T) (*goetcd.Client, *etcdtesting.EtcdTestServer, *REST) {
 
 	etcdStorage, server := registrytest.NewEtcdStorage(t, "")
 
-	imageStorage := imageetcd.NewREST(etcdStorage)
+	imageStorage, _ := imageetcd.NewREST(etcdStorage)
 	imageStreamStorage, imageStreamStatus, internalStorage := imagestreametcd.NewREST(etcdStorage, testDefaultRegistry, &fakeSubjectAccessReviewRegistry{})
 
 	imageRegistry := image.NewRegistry(imageStorage)
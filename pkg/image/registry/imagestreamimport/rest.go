@@ -39,15 +39,17 @@ type REST struct {
 	secrets           client.ImageStreamSecretsNamespacer
 	transport         http.RoundTripper
 	insecureTransport http.RoundTripper
+	mirrors           importer.RegistryMirrors
 	clientFn          ImporterDockerRegistryFunc
 }
 
 // NewREST returns a REST storage implementation that handles importing images. The clientFn argument is optional
 // if v1 Docker Registry importing is not required. Insecure transport is optional, and both transports should not
-// include client certs unless you wish to allow the entire cluster to import using those certs.
+// include client certs unless you wish to allow the entire cluster to import using those certs. Mirrors are optional
+// and are tried in order before the registries they mirror.
 func NewREST(importFn ImporterFunc, streams imagestream.Registry, internalStreams rest.CreaterUpdater,
 	images rest.Creater, secrets client.ImageStreamSecretsNamespacer,
-	transport, insecureTransport http.RoundTripper,
+	transport, insecureTransport http.RoundTripper, mirrors importer.RegistryMirrors,
 	clientFn ImporterDockerRegistryFunc,
 ) *REST {
 	return &REST{
@@ -58,6 +60,7 @@ func NewREST(importFn ImporterFunc, streams imagestream.Registry, internalStream
 		secrets:           secrets,
 		transport:         transport,
 		insecureTransport: insecureTransport,
+		mirrors:           mirrors,
 		clientFn:          clientFn,
 	}
 }
@@ -98,7 +101,7 @@ func (r *REST) Create(ctx kapi.Context, obj runtime.Object) (runtime.Object, err
 		}
 		return secrets.Items, nil
 	})
-	importCtx := importer.NewContext(r.transport, r.insecureTransport).WithCredentials(credentials)
+	importCtx := importer.NewContext(r.transport, r.insecureTransport).WithMirrors(r.mirrors).WithCredentials(credentials)
 	imports := r.importFn(importCtx)
 	if err := imports.Import(ctx.(gocontext.Context), isi); err != nil {
 		return nil, kapierrors.NewInternalError(err)
@@ -296,21 +299,21 @@ func (r *REST) importSuccessful(
 	importedImages map[string]error, updatedImages map[string]*api.Image,
 ) (*api.Image, bool) {
 
+	if stream.Spec.Tags == nil {
+		stream.Spec.Tags = make(map[string]api.TagReference)
+	}
+	specTag, ok := stream.Spec.Tags[tag]
+
 	pullSpec, _ := api.MostAccuratePullSpec(image.DockerImageReference, image.Name, "")
 	tagEvent := api.TagEvent{
 		Created:              now,
-		DockerImageReference: pullSpec,
+		DockerImageReference: api.ResolveReferencePolicy(stream, specTag, pullSpec, image.Name),
 		Image:                image.Name,
 		Generation:           nextGeneration,
 	}
 
-	if stream.Spec.Tags == nil {
-		stream.Spec.Tags = make(map[string]api.TagReference)
-	}
-
 	// ensure the spec and status tag match the imported image
 	changed := api.DifferentTagEvent(stream, tag, tagEvent)
-	specTag, ok := stream.Spec.Tags[tag]
 	if changed || !ok {
 		specTag.From = &kapi.ObjectReference{
 			Kind: "DockerImage",
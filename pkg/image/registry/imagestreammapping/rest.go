@@ -1,16 +1,20 @@
 package imagestreammapping
 
 import (
+	"fmt"
+
 	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/errors"
 	"k8s.io/kubernetes/pkg/api/rest"
 	"k8s.io/kubernetes/pkg/api/unversioned"
 	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util"
 	"k8s.io/kubernetes/pkg/util/validation/field"
 	"k8s.io/kubernetes/pkg/util/wait"
 
 	"github.com/openshift/origin/pkg/image/api"
 	"github.com/openshift/origin/pkg/image/api/validation"
+	"github.com/openshift/origin/pkg/image/quota"
 	"github.com/openshift/origin/pkg/image/registry/image"
 	"github.com/openshift/origin/pkg/image/registry/imagestream"
 )
@@ -25,13 +29,16 @@ const maxRetriesOnConflict = 10
 type REST struct {
 	imageRegistry       image.Registry
 	imageStreamRegistry imagestream.Registry
+	quota               quota.Admitter
 }
 
-// NewREST returns a new REST.
-func NewREST(imageRegistry image.Registry, imageStreamRegistry imagestream.Registry) *REST {
+// NewREST returns a new REST. The tags and storage added to a project by a mapping are admitted
+// against its quotas by quotaAdmitter.
+func NewREST(imageRegistry image.Registry, imageStreamRegistry imagestream.Registry, quotaAdmitter quota.Admitter) *REST {
 	return &REST{
 		imageRegistry:       imageRegistry,
 		imageStreamRegistry: imageStreamRegistry,
+		quota:               quotaAdmitter,
 	}
 }
 
@@ -91,6 +98,16 @@ func (s *REST) Create(ctx kapi.Context, obj runtime.Object) (runtime.Object, err
 	if len(tag) == 0 {
 		tag = api.DefaultImageTag
 	}
+	// signatures may only be added through the signatures subresource of the image
+	image.Signatures = nil
+
+	// the size of the image is read from its manifest, which is repeated when the image is created
+	if err := api.ImageWithMetadata(&image); err != nil {
+		util.HandleError(fmt.Errorf("Unable to update image metadata for %q: %v", image.Name, err))
+	}
+	if err := s.quota.Admit(stream.Namespace, quota.MappingUsage(stream, tag, &image)); err != nil {
+		return nil, err
+	}
 
 	if err := s.imageRegistry.CreateImage(ctx, &image); err != nil && !errors.IsAlreadyExists(err) {
 		return nil, err
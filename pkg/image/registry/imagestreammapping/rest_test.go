@@ -39,17 +39,28 @@ func (f *fakeSubjectAccessReviewRegistry) CreateSubjectAccessReview(ctx kapi.Con
 	return nil, nil
 }
 
+// fakeQuotaAdmitter records the usage it is asked to admit, and rejects it with err if set.
+type fakeQuotaAdmitter struct {
+	err   error
+	usage []kapi.ResourceList
+}
+
+func (f *fakeQuotaAdmitter) Admit(namespace string, usage kapi.ResourceList) error {
+	f.usage = append(f.usage, usage)
+	return f.err
+}
+
 func setup(t *testing.T) (*etcd.Client, *etcdtesting.EtcdTestServer, *REST) {
 
 	etcdStorage, server := registrytest.NewEtcdStorage(t, "")
 
-	imageStorage := imageetcd.NewREST(etcdStorage)
+	imageStorage, _ := imageetcd.NewREST(etcdStorage)
 	imageStreamStorage, imageStreamStatus, internalStorage := imagestreametcd.NewREST(etcdStorage, testDefaultRegistry, &fakeSubjectAccessReviewRegistry{})
 
 	imageRegistry := image.NewRegistry(imageStorage)
 	imageStreamRegistry := imagestream.NewRegistry(imageStreamStorage, imageStreamStatus, internalStorage)
 
-	storage := NewREST(imageRegistry, imageStreamRegistry)
+	storage := NewREST(imageRegistry, imageStreamRegistry, &fakeQuotaAdmitter{})
 
 	return server.Client, server, storage
 }
@@ -148,6 +159,7 @@ func TestCreateSuccessWithName(t *testing.T) {
 	}
 
 	mapping := validNewMappingWithName()
+	mapping.Image.Signatures = []api.ImageSignature{{Name: "sig1", Type: api.ImageSignatureTypeGPG, Content: []byte("signature")}}
 	_, err = storage.Create(kapi.NewDefaultContext(), mapping)
 	if err != nil {
 		t.Fatalf("Unexpected error creating mapping: %#v", err)
@@ -157,6 +169,9 @@ func TestCreateSuccessWithName(t *testing.T) {
 	if err != nil {
 		t.Errorf("Unexpected error retrieving image: %#v", err)
 	}
+	if len(image.Signatures) != 0 {
+		t.Errorf("Expected the signatures of the mapping to be ignored: %#v", image.Signatures)
+	}
 	if e, a := mapping.Image.DockerImageReference, image.DockerImageReference; e != a {
 		t.Errorf("Expected %s, got %s", e, a)
 	}
@@ -173,6 +188,45 @@ func TestCreateSuccessWithName(t *testing.T) {
 	}
 }
 
+func TestCreateExceedsQuota(t *testing.T) {
+	client, server, storage := setup(t)
+	defer server.Terminate(t)
+
+	initialRepo := &api.ImageStream{
+		ObjectMeta: kapi.ObjectMeta{Namespace: "default", Name: "somerepo"},
+	}
+	_, err := client.Create(etcdtest.AddPrefix("/imagestreams/default/somerepo"), runtime.EncodeOrDie(latest.Codec, initialRepo), 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	admitter := &fakeQuotaAdmitter{err: errors.NewForbidden("ResourceQuota", "images", fmt.Errorf("exceeded quota"))}
+	storage.quota = admitter
+	mapping := validNewMappingWithName()
+	mapping.Image.DockerImageMetadata.Size = 1024
+	if _, err := storage.Create(kapi.NewDefaultContext(), mapping); !errors.IsForbidden(err) {
+		t.Fatalf("expected a forbidden error, got %v", err)
+	}
+
+	if len(admitter.usage) != 1 {
+		t.Fatalf("expected the usage of the mapping to be admitted once, got %#v", admitter.usage)
+	}
+	tags, storageUsed := admitter.usage[0][api.ResourceImageStreamTags], admitter.usage[0][api.ResourceImageStorage]
+	if tags.Value() != 1 || storageUsed.Value() != 1024 {
+		t.Errorf("expected one tag and 1024 bytes, got %#v", admitter.usage[0])
+	}
+	if _, err := storage.imageRegistry.GetImage(kapi.NewDefaultContext(), "imageID1"); !errors.IsNotFound(err) {
+		t.Errorf("expected the image not to be created, got %v", err)
+	}
+	repo, err := storage.imageStreamRegistry.GetImageStream(kapi.NewDefaultContext(), "somerepo")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(repo.Status.Tags) != 0 {
+		t.Errorf("expected no tags, got %#v", repo.Status.Tags)
+	}
+}
+
 func TestAddExistingImageWithNewTag(t *testing.T) {
 	imageID := "8d812da98d6dd61620343f1a5bf6585b34ad6ed16e5c5f7c7216a525d6aeb772"
 	existingRepo := &api.ImageStream{
@@ -436,6 +490,7 @@ func TestCreateRetryUnrecoverable(t *testing.T) {
 				return nil, errors.NewServiceUnavailable("unrecoverable error")
 			},
 		},
+		quota: &fakeQuotaAdmitter{},
 	}
 	obj, err := rest.Create(kapi.NewDefaultContext(), validNewMappingWithName())
 	if err == nil {
@@ -477,6 +532,7 @@ func TestCreateRetryConflictNoTagDiff(t *testing.T) {
 				return repo, nil
 			},
 		},
+		quota: &fakeQuotaAdmitter{},
 	}
 	obj, err := rest.Create(kapi.NewDefaultContext(), validNewMappingWithName())
 	if err != nil {
@@ -531,6 +587,7 @@ func TestCreateRetryConflictTagDiff(t *testing.T) {
 				return repo, nil
 			},
 		},
+		quota: &fakeQuotaAdmitter{},
 	}
 	obj, err := rest.Create(kapi.NewDefaultContext(), validNewMappingWithName())
 	if err == nil {
@@ -0,0 +1,220 @@
+package signature
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+// atomicSignatureType is the type claimed by the payload of simple signing signatures.
+const atomicSignatureType = "atomic container signature"
+
+// Verifier verifies the signatures of images.
+type Verifier interface {
+	// Verify checks that signature was created for image with a trusted key, and returns
+	// the user ID of the key.
+	Verify(image *imageapi.Image, signature *imageapi.ImageSignature) (string, error)
+}
+
+// gpgVerifier verifies signatures with the gpg binary against the keys of a keyring.
+type gpgVerifier struct {
+	keyring string
+	// gpg runs the gpg binary with args in a private home directory and returns the
+	// lines of its status output.
+	gpg func(args ...string) ([]string, error)
+}
+
+// NewGPGVerifier returns a Verifier that accepts signatures created with the keys of
+// the GPG public keyring at path. The gpg binary must be in the PATH.
+func NewGPGVerifier(keyring string) (Verifier, error) {
+	keyring, err := filepath.Abs(keyring)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := os.Stat(keyring); err != nil {
+		return nil, fmt.Errorf("unable to read the keyring: %v", err)
+	}
+	if _, err := exec.LookPath("gpg"); err != nil {
+		return nil, fmt.Errorf("the gpg binary is required to verify signatures: %v", err)
+	}
+	return &gpgVerifier{keyring: keyring, gpg: runGPG}, nil
+}
+
+// Verify implements Verifier.
+func (v *gpgVerifier) Verify(image *imageapi.Image, signature *imageapi.ImageSignature) (string, error) {
+	dir, err := ioutil.TempDir("", "verify-signature")
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(dir)
+
+	signatureFile := filepath.Join(dir, "signature")
+	if err := ioutil.WriteFile(signatureFile, signature.Content, 0600); err != nil {
+		return "", err
+	}
+	args := []string{"--homedir", dir, "--no-default-keyring", "--keyring", v.keyring}
+
+	var status []string
+	switch signature.Type {
+	case imageapi.ImageSignatureTypeAtomicImageV1:
+		payloadFile := filepath.Join(dir, "payload")
+		if status, err = v.gpg(append(args, "--output", payloadFile, "--decrypt", signatureFile)...); err != nil {
+			return "", err
+		}
+		payload, err := ioutil.ReadFile(payloadFile)
+		if err != nil {
+			return "", err
+		}
+		if err := verifyAtomicPayload(image, signature, payload); err != nil {
+			return "", err
+		}
+	case imageapi.ImageSignatureTypeGPG:
+		if len(image.DockerImageManifest) == 0 {
+			return "", fmt.Errorf("the image has no manifest to verify the signature against")
+		}
+		manifestFile := filepath.Join(dir, "manifest")
+		if err := ioutil.WriteFile(manifestFile, []byte(image.DockerImageManifest), 0600); err != nil {
+			return "", err
+		}
+		if status, err = v.gpg(append(args, "--verify", signatureFile, manifestFile)...); err != nil {
+			return "", err
+		}
+	default:
+		return "", fmt.Errorf("unsupported signature type %q", signature.Type)
+	}
+
+	signer, err := signerFromStatus(status)
+	if err != nil {
+		return "", err
+	}
+	if len(signature.Issuer) > 0 && signature.Issuer != signer {
+		return "", fmt.Errorf("the signature was issued by %q, not %q", signer, signature.Issuer)
+	}
+	return signer, nil
+}
+
+// runGPG runs gpg in batch mode in the home directory of the args and returns the lines
+// of its status output.
+func runGPG(args ...string) ([]string, error) {
+	var status, stderr bytes.Buffer
+	cmd := exec.Command("gpg", append([]string{"--batch", "--trust-model", "always", "--status-fd", "1"}, args...)...)
+	cmd.Stdout = &status
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("the signature could not be verified: %s", strings.TrimSpace(stderr.String()))
+	}
+	lines := []string{}
+	scanner := bufio.NewScanner(&status)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	return lines, nil
+}
+
+// signerFromStatus returns the user ID of the key of a good signature reported in the
+// status output of gpg.
+func signerFromStatus(status []string) (string, error) {
+	signer, valid := "", false
+	for _, line := range status {
+		fields := strings.SplitN(strings.TrimPrefix(line, "[GNUPG:] "), " ", 3)
+		switch fields[0] {
+		case "GOODSIG":
+			if len(fields) == 3 {
+				signer = fields[2]
+			}
+		case "VALIDSIG":
+			valid = true
+		case "BADSIG", "ERRSIG", "EXPSIG", "EXPKEYSIG", "REVKEYSIG":
+			return "", fmt.Errorf("the signature is not valid: %s", line)
+		}
+	}
+	if !valid || len(signer) == 0 {
+		return "", fmt.Errorf("no valid signature was found")
+	}
+	return signer, nil
+}
+
+// atomicPayload is the signed document of a simple signing signature.
+type atomicPayload struct {
+	Critical struct {
+		Type  string `json:"type"`
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+		Identity struct {
+			DockerReference string `json:"docker-reference"`
+		} `json:"identity"`
+	} `json:"critical"`
+}
+
+// verifyAtomicPayload checks that the signed document of a simple signing signature
+// claims the digest of image and the identity of signature.
+func verifyAtomicPayload(image *imageapi.Image, signature *imageapi.ImageSignature, data []byte) error {
+	payload := &atomicPayload{}
+	if err := json.Unmarshal(data, payload); err != nil {
+		return fmt.Errorf("the signed payload is not valid: %v", err)
+	}
+	if payload.Critical.Type != atomicSignatureType {
+		return fmt.Errorf("the signed payload has type %q, expected %q", payload.Critical.Type, atomicSignatureType)
+	}
+	if payload.Critical.Image.DockerManifestDigest != image.Name {
+		return fmt.Errorf("the signature is for image %q, not %q", payload.Critical.Image.DockerManifestDigest, image.Name)
+	}
+	if len(signature.Identity) > 0 && payload.Critical.Identity.DockerReference != signature.Identity {
+		return fmt.Errorf("the signature is for %q, not %q", payload.Critical.Identity.DockerReference, signature.Identity)
+	}
+	return nil
+}
+
+// IsTrusted returns true if the signature was verified against a trusted key.
+func IsTrusted(signature *imageapi.ImageSignature) bool {
+	for _, condition := range signature.Conditions {
+		if condition.Type == imageapi.SignatureTrusted {
+			return condition.Status == kapi.ConditionTrue
+		}
+	}
+	return false
+}
+
+// SetTrustedCondition records the result of the verification of signature at now. A nil
+// err means the signature was created by signer with a trusted key.
+func SetTrustedCondition(signature *imageapi.ImageSignature, signer string, err error, now unversioned.Time) {
+	condition := imageapi.SignatureCondition{
+		Type:          imageapi.SignatureTrusted,
+		Status:        kapi.ConditionTrue,
+		LastProbeTime: now,
+		Reason:        "Verified",
+		Message:       fmt.Sprintf("verified signature of %s", signer),
+	}
+	if err != nil {
+		condition.Status = kapi.ConditionFalse
+		condition.Reason = "VerificationFailed"
+		condition.Message = err.Error()
+	}
+
+	for i := range signature.Conditions {
+		existing := &signature.Conditions[i]
+		if existing.Type != imageapi.SignatureTrusted {
+			continue
+		}
+		condition.LastTransitionTime = existing.LastTransitionTime
+		if existing.Status != condition.Status {
+			condition.LastTransitionTime = now
+		}
+		*existing = condition
+		return
+	}
+	condition.LastTransitionTime = now
+	signature.Conditions = append(signature.Conditions, condition)
+}
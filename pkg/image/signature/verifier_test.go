@@ -0,0 +1,185 @@
+package signature
+
+import (
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+const testDigest = "sha256:4986bf8c15363d1c5d15512d5266f8777bfba4974ac56e3270e7760f6f0a8125"
+
+func TestSignerFromStatus(t *testing.T) {
+	tests := map[string]struct {
+		status []string
+		signer string
+		err    bool
+	}{
+		"good signature": {
+			status: []string{
+				"[GNUPG:] NEWSIG",
+				"[GNUPG:] GOODSIG 4B8C5B9A4B8C5B9A Image Signer <signer@example.com>",
+				"[GNUPG:] VALIDSIG 0123456789ABCDEF 2016-01-01 1451606400 0 4 0 1 8 00 0123456789ABCDEF",
+			},
+			signer: "Image Signer <signer@example.com>",
+		},
+		"bad signature": {
+			status: []string{
+				"[GNUPG:] BADSIG 4B8C5B9A4B8C5B9A Image Signer <signer@example.com>",
+			},
+			err: true,
+		},
+		"missing key": {
+			status: []string{
+				"[GNUPG:] ERRSIG 4B8C5B9A4B8C5B9A 1 8 00 1451606400 9",
+				"[GNUPG:] NO_PUBKEY 4B8C5B9A4B8C5B9A",
+			},
+			err: true,
+		},
+		"no status": {
+			err: true,
+		},
+	}
+	for name, test := range tests {
+		signer, err := signerFromStatus(test.status)
+		if test.err != (err != nil) {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if signer != test.signer {
+			t.Errorf("%s: expected signer %q, got %q", name, test.signer, signer)
+		}
+	}
+}
+
+func TestVerifyAtomicPayload(t *testing.T) {
+	image := &imageapi.Image{ObjectMeta: kapi.ObjectMeta{Name: testDigest}}
+	payload := func(signatureType, digest, reference string) []byte {
+		return []byte(fmt.Sprintf(`{"critical":{"type":%q,"image":{"docker-manifest-digest":%q},"identity":{"docker-reference":%q}},"optional":{}}`, signatureType, digest, reference))
+	}
+
+	tests := map[string]struct {
+		identity string
+		payload  []byte
+		err      bool
+	}{
+		"valid": {
+			payload: payload(atomicSignatureType, testDigest, "registry.example.com/ns/app:latest"),
+		},
+		"matching identity": {
+			identity: "registry.example.com/ns/app:latest",
+			payload:  payload(atomicSignatureType, testDigest, "registry.example.com/ns/app:latest"),
+		},
+		"mismatched identity": {
+			identity: "registry.example.com/other/app:latest",
+			payload:  payload(atomicSignatureType, testDigest, "registry.example.com/ns/app:latest"),
+			err:      true,
+		},
+		"mismatched digest": {
+			payload: payload(atomicSignatureType, "sha256:0000", "registry.example.com/ns/app:latest"),
+			err:     true,
+		},
+		"wrong type": {
+			payload: payload("something else", testDigest, ""),
+			err:     true,
+		},
+		"not json": {
+			payload: []byte("garbage"),
+			err:     true,
+		},
+	}
+	for name, test := range tests {
+		signature := &imageapi.ImageSignature{Name: "sig", Type: imageapi.ImageSignatureTypeAtomicImageV1, Identity: test.identity}
+		err := verifyAtomicPayload(image, signature, test.payload)
+		if test.err != (err != nil) {
+			t.Errorf("%s: unexpected error: %v", name, err)
+		}
+	}
+}
+
+func TestVerify(t *testing.T) {
+	goodStatus := []string{
+		"[GNUPG:] GOODSIG 4B8C5B9A4B8C5B9A Image Signer <signer@example.com>",
+		"[GNUPG:] VALIDSIG 0123456789ABCDEF",
+	}
+	image := &imageapi.Image{
+		ObjectMeta:          kapi.ObjectMeta{Name: testDigest},
+		DockerImageManifest: `{"schemaVersion": 1}`,
+	}
+
+	tests := map[string]struct {
+		signature imageapi.ImageSignature
+		err       bool
+	}{
+		"atomic signature": {
+			signature: imageapi.ImageSignature{Name: "sig", Type: imageapi.ImageSignatureTypeAtomicImageV1, Content: []byte("signed")},
+		},
+		"gpg signature": {
+			signature: imageapi.ImageSignature{Name: "sig", Type: imageapi.ImageSignatureTypeGPG, Content: []byte("detached")},
+		},
+		"matching issuer": {
+			signature: imageapi.ImageSignature{Name: "sig", Type: imageapi.ImageSignatureTypeGPG, Content: []byte("detached"), Issuer: "Image Signer <signer@example.com>"},
+		},
+		"mismatched issuer": {
+			signature: imageapi.ImageSignature{Name: "sig", Type: imageapi.ImageSignatureTypeGPG, Content: []byte("detached"), Issuer: "Someone Else"},
+			err:       true,
+		},
+		"unknown type": {
+			signature: imageapi.ImageSignature{Name: "sig", Type: "unknown", Content: []byte("detached")},
+			err:       true,
+		},
+	}
+	for name, test := range tests {
+		verifier := &gpgVerifier{
+			keyring: "/keyring",
+			gpg: func(args ...string) ([]string, error) {
+				for i, arg := range args {
+					if arg == "--output" && i+1 < len(args) {
+						payload := fmt.Sprintf(`{"critical":{"type":%q,"image":{"docker-manifest-digest":%q}}}`, atomicSignatureType, testDigest)
+						if err := ioutil.WriteFile(args[i+1], []byte(payload), 0600); err != nil {
+							return nil, err
+						}
+					}
+				}
+				return goodStatus, nil
+			},
+		}
+		signer, err := verifier.Verify(image, &test.signature)
+		if test.err != (err != nil) {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if !test.err && signer != "Image Signer <signer@example.com>" {
+			t.Errorf("%s: unexpected signer %q", name, signer)
+		}
+	}
+}
+
+func TestSetTrustedCondition(t *testing.T) {
+	first := unversioned.Now()
+	signature := &imageapi.ImageSignature{Name: "sig"}
+
+	SetTrustedCondition(signature, "signer", nil, first)
+	if !IsTrusted(signature) || len(signature.Conditions) != 1 {
+		t.Fatalf("expected the signature to be trusted: %#v", signature.Conditions)
+	}
+
+	second := unversioned.NewTime(first.Add(1))
+	SetTrustedCondition(signature, "signer", nil, second)
+	if condition := signature.Conditions[0]; !condition.LastTransitionTime.Equal(first) || !condition.LastProbeTime.Equal(second) {
+		t.Errorf("expected only the probe time to change: %#v", condition)
+	}
+
+	third := unversioned.NewTime(first.Add(2))
+	SetTrustedCondition(signature, "", fmt.Errorf("bad signature"), third)
+	if IsTrusted(signature) || len(signature.Conditions) != 1 {
+		t.Fatalf("expected the signature not to be trusted: %#v", signature.Conditions)
+	}
+	if condition := signature.Conditions[0]; !condition.LastTransitionTime.Equal(third) || condition.Message != "bad signature" {
+		t.Errorf("unexpected condition: %#v", condition)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"k8s.io/kubernetes/pkg/api/validation"
+	"k8s.io/kubernetes/pkg/controller/serviceaccount"
 	"k8s.io/kubernetes/pkg/util/validation/field"
 
 	oapi "github.com/openshift/origin/pkg/api"
@@ -104,7 +105,8 @@ func ValidateClientAuthorizationName(name string, prefix bool) (bool, string) {
 		return ok, reason
 	}
 
-	parts := strings.Split(name, ":")
+	// user names may not contain ":", but service account client names do
+	parts := strings.SplitN(name, ":", 2)
 	if len(parts) != 2 {
 		return false, "must be in the format <userName>:<clientName>"
 	}
@@ -161,6 +163,9 @@ func ValidateClientAuthorizationUpdate(newAuth *api.OAuthClientAuthorization, ol
 func ValidateClientNameField(value string, fldPath *field.Path) field.ErrorList {
 	if len(value) == 0 {
 		return field.ErrorList{field.Required(fldPath)}
+	} else if _, _, err := serviceaccount.SplitUsername(value); err == nil {
+		// service accounts act as OAuth clients under their user name
+		return field.ErrorList{}
 	} else if ok, msg := validation.NameIsDNSSubdomain(value, false); !ok {
 		return field.ErrorList{field.Invalid(fldPath, value, msg)}
 	}
@@ -97,6 +97,16 @@ func TestValidateClientAuthorization(t *testing.T) {
 		t.Errorf("expected success: %v", errs)
 	}
 
+	errs = ValidateClientAuthorization(&oapi.OAuthClientAuthorization{
+		ObjectMeta: api.ObjectMeta{Name: "myusername:system:serviceaccount:myproject:myclient"},
+		ClientName: "system:serviceaccount:myproject:myclient",
+		UserName:   "myusername",
+		UserUID:    "myuseruid",
+	})
+	if len(errs) != 0 {
+		t.Errorf("expected success for a service account client: %v", errs)
+	}
+
 	errorCases := map[string]struct {
 		A oapi.OAuthClientAuthorization
 		T field.ErrorType
@@ -1,6 +1,7 @@
 package osinserver
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/RangelReale/osin"
@@ -19,6 +20,24 @@ type AuthorizeHandler interface {
 	HandleAuthorize(ar *osin.AuthorizeRequest, w http.ResponseWriter) (handled bool, err error)
 }
 
+// AuthorizeError is returned by an AuthorizeHandler to reject an authorize request with an OAuth
+// error that is redirected to the client, rather than shown to the user
+type AuthorizeError struct {
+	// Code is the OAuth error code, for instance osin.E_INVALID_SCOPE
+	Code string
+	// Description explains the error to the developer of the client
+	Description string
+}
+
+// NewInvalidScopeError returns an AuthorizeError rejecting the requested scopes
+func NewInvalidScopeError(description string) *AuthorizeError {
+	return &AuthorizeError{Code: osin.E_INVALID_SCOPE, Description: description}
+}
+
+func (e *AuthorizeError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Code, e.Description)
+}
+
 type AuthorizeHandlerFunc func(ar *osin.AuthorizeRequest, w http.ResponseWriter) (bool, error)
 
 func (f AuthorizeHandlerFunc) HandleAuthorize(ar *osin.AuthorizeRequest, w http.ResponseWriter) (bool, error) {
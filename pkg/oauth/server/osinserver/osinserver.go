@@ -83,14 +83,21 @@ func (s *Server) handleAuthorize(w http.ResponseWriter, r *http.Request) {
 		} else {
 
 			handled, err := s.authorize.HandleAuthorize(ar, w)
-			if err != nil {
-				s.errorHandler.HandleError(err, w, r)
-				return
+			if authorizeErr, ok := err.(*AuthorizeError); ok {
+				// The client is told why the request was rejected
+				resp.SetErrorState(authorizeErr.Code, authorizeErr.Description, ar.State)
+				resp.SetRedirect(ar.RedirectUri)
+				resp.SetRedirectFragment(ar.Type == osin.TOKEN)
+			} else {
+				if err != nil {
+					s.errorHandler.HandleError(err, w, r)
+					return
+				}
+				if handled {
+					return
+				}
+				s.server.FinishAuthorizeRequest(resp, r, ar)
 			}
-			if handled {
-				return
-			}
-			s.server.FinishAuthorizeRequest(resp, r, ar)
 
 		}
 	}
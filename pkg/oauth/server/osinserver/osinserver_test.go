@@ -3,6 +3,7 @@ package osinserver
 import (
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
 
 	"github.com/RangelReale/osin"
@@ -139,3 +140,51 @@ func TestAuthorizeStartFlow(t *testing.T) {
 		t.Errorf("unexpected empty access token: %#v", token)
 	}
 }
+
+func TestAuthorizeErrorRedirect(t *testing.T) {
+	storage := teststorage.New()
+	storage.Clients["test"] = &osin.DefaultClient{
+		Id:          "test",
+		Secret:      "secret",
+		RedirectUri: "http://localhost/redirect",
+	}
+	oauthServer := New(
+		NewDefaultServerConfig(),
+		storage,
+		AuthorizeHandlerFunc(func(ar *osin.AuthorizeRequest, w http.ResponseWriter) (bool, error) {
+			return false, NewInvalidScopeError("unknown scope")
+		}),
+		AccessHandlerFunc(func(ar *osin.AccessRequest, w http.ResponseWriter) error {
+			t.Errorf("unexpected access request")
+			return nil
+		}),
+		NewDefaultErrorHandler(),
+	)
+	mux := http.NewServeMux()
+	oauthServer.Install(mux, "")
+
+	req, err := http.NewRequest("GET", "/authorize?response_type=code&client_id=test&scope=bad&state=abc", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+
+	if w.Code != http.StatusFound {
+		t.Fatalf("expected a redirect, got %d: %s", w.Code, w.Body.String())
+	}
+	location, err := url.Parse(w.Header().Get("Location"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if location.Host != "localhost" || location.Path != "/redirect" {
+		t.Errorf("expected a redirect to the client, got %s", location)
+	}
+	query := location.Query()
+	if query.Get("error") != osin.E_INVALID_SCOPE || query.Get("error_description") != "unknown scope" || query.Get("state") != "abc" {
+		t.Errorf("unexpected error parameters: %v", query)
+	}
+	if storage.AuthorizeData != nil {
+		t.Errorf("unexpected authorize data: %#v", storage.AuthorizeData)
+	}
+}
@@ -0,0 +1,14 @@
+package api
+
+import "strings"
+
+// WildcardSubdomain returns the subdomain covered by a wildcard route with the
+// given host, which is the host without its first label. An empty string is
+// returned if the host has a single label.
+func WildcardSubdomain(host string) string {
+	i := strings.Index(host, ".")
+	if i == -1 {
+		return ""
+	}
+	return host[i+1:]
+}
@@ -11,6 +11,18 @@ func init() {
 	err := api.Scheme.AddDefaultingFuncs(
 		func(obj *RouteSpec) {
 			obj.To.Kind = "Service"
+			if len(obj.WildcardPolicy) == 0 {
+				obj.WildcardPolicy = WildcardPolicyNone
+			}
+		},
+		func(obj *RouteTargetReference) {
+			if len(obj.Kind) == 0 {
+				obj.Kind = "Service"
+			}
+			if obj.Weight == nil {
+				weight := int32(100)
+				obj.Weight = &weight
+			}
 		},
 		func(obj *TLSConfig) {
 			if len(obj.Termination) == 0 && len(obj.DestinationCACertificate) == 0 {
@@ -34,8 +34,14 @@ type RouteSpec struct {
 	Path string `json:"path,omitempty"`
 
 	// An object the route points to. Only the Service kind is allowed, and it will
-	// be defaulted to Service.
-	To kapi.ObjectReference `json:"to"`
+	// be defaulted to Service. If the weight field is set to zero, no traffic will
+	// be sent to this service.
+	To RouteTargetReference `json:"to"`
+
+	// AlternateBackends is an extension of the 'to' field. If more than one service
+	// needs to be pointed to, then use this field. Use the weight field in
+	// RouteTargetReference to specify the relative preference of each backend.
+	AlternateBackends []RouteTargetReference `json:"alternateBackends,omitempty"`
 
 	// If specified, the port to be used by the router. Most routers will use all
 	// endpoints exposed by the service by default - set this value to instruct routers
@@ -44,6 +50,25 @@ type RouteSpec struct {
 
 	// TLS provides the ability to configure certificates and termination for the route
 	TLS *TLSConfig `json:"tls,omitempty"`
+
+	// WildcardPolicy, if set to Subdomain, exposes the route for every host in the
+	// subdomain of Host as well. Routers only admit wildcard routes when configured to.
+	WildcardPolicy WildcardPolicyType `json:"wildcardPolicy,omitempty"`
+}
+
+// RouteTargetReference specifies the target that resolve into endpoints. Only the 'Service'
+// kind is allowed. Use the 'weight' field to emphasize one target over others.
+type RouteTargetReference struct {
+	// Kind of the referent. Only the Service kind is allowed.
+	Kind string `json:"kind"`
+
+	// Name of the service the route points to.
+	Name string `json:"name"`
+
+	// Weight is a number between 0 and 256 that specifies the relative amount of
+	// traffic sent to this target compared to the other targets of the route. A
+	// weight of 0 sends no traffic to the target. Defaults to 100.
+	Weight *int32 `json:"weight,omitempty"`
 }
 
 // RoutePort defines a port mapping from a router to an endpoint in the service endpoints.
@@ -57,6 +82,48 @@ type RoutePort struct {
 // RouteStatus provides relevant info about the status of a route, including which routers
 // acknowledge it.
 type RouteStatus struct {
+	// Ingress describes the places where the route may be exposed. The list of
+	// ingress points may contain duplicate Host or RouterName values. Routes
+	// are considered live once they are `Admitted`.
+	Ingress []RouteIngress `json:"ingress"`
+}
+
+// RouteIngress holds information about the places where a route is exposed
+type RouteIngress struct {
+	// Host is the host string under which the route is exposed; this value is required
+	Host string `json:"host,omitempty"`
+	// RouterName is a name chosen by the router to identify itself; this value is required
+	RouterName string `json:"routerName,omitempty"`
+	// Conditions is the state of the route, may be empty.
+	Conditions []RouteIngressCondition `json:"conditions,omitempty"`
+}
+
+// RouteIngressConditionType is a valid value for RouteCondition
+type RouteIngressConditionType string
+
+// These are valid conditions of a route ingress.
+const (
+	// RouteAdmitted means the route is able to service requests for the provided Host
+	RouteAdmitted RouteIngressConditionType = "Admitted"
+)
+
+// RouteIngressCondition contains details for the current condition of a route on a
+// particular router.
+type RouteIngressCondition struct {
+	// Type is the type of the condition.
+	// Currently only Admitted.
+	Type RouteIngressConditionType `json:"type"`
+	// Status is the status of the condition.
+	// Can be True, False, Unknown.
+	Status kapi.ConditionStatus `json:"status"`
+	// (brief) reason for the condition's last transition, and is usually a machine and human
+	// readable constant
+	Reason string `json:"reason,omitempty"`
+	// Human readable message indicating details about last transition.
+	Message string `json:"message,omitempty"`
+	// RFC 3339 date and time at which the object was acknowledged by the router.
+	// This may be before the router exposes the route
+	LastTransitionTime *unversioned.Time `json:"lastTransitionTime,omitempty"`
 }
 
 // RouterShard has information of a routing shard and is used to
@@ -113,3 +180,14 @@ const (
 	// TLSTerminationReencrypt terminate encryption at the edge router and re-encrypt it with a new certificate supplied by the destination
 	TLSTerminationReencrypt TLSTerminationType = "reencrypt"
 )
+
+// WildcardPolicyType indicates the type of wildcard support needed by a route.
+type WildcardPolicyType string
+
+const (
+	// WildcardPolicyNone indicates the route only matches its host.
+	WildcardPolicyNone WildcardPolicyType = "None"
+	// WildcardPolicySubdomain indicates the route matches every host in the subdomain
+	// of its host, e.g. a route for www.example.com also matches *.example.com
+	WildcardPolicySubdomain WildcardPolicyType = "Subdomain"
+)
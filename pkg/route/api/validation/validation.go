@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"strings"
 
+	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/validation"
 	kval "k8s.io/kubernetes/pkg/api/validation"
 	"k8s.io/kubernetes/pkg/util/intstr"
+	"k8s.io/kubernetes/pkg/util/sets"
 	kvalidation "k8s.io/kubernetes/pkg/util/validation"
 	"k8s.io/kubernetes/pkg/util/validation/field"
 
@@ -39,6 +41,8 @@ func ValidateRoute(route *routeapi.Route) field.ErrorList {
 		result = append(result, field.Required(field.NewPath("serviceName")))
 	}
 
+	result = append(result, validateBackends(route)...)
+
 	if route.Spec.Port != nil {
 		switch target := route.Spec.Port.TargetPort; {
 		case target.Type == intstr.Int && target.IntVal == 0,
@@ -51,6 +55,8 @@ func ValidateRoute(route *routeapi.Route) field.ErrorList {
 		result = append(result, errs...)
 	}
 
+	result = append(result, validateWildcardPolicy(route, field.NewPath("wildcardPolicy"))...)
+
 	return result
 }
 
@@ -66,11 +72,105 @@ func ValidateRouteUpdate(route *routeapi.Route, older *routeapi.Route) field.Err
 // we are risking to break existing routes.
 func ValidateRouteStatusUpdate(route *routeapi.Route, older *routeapi.Route) field.ErrorList {
 	allErrs := validation.ValidateObjectMetaUpdate(&route.ObjectMeta, &older.ObjectMeta, field.NewPath("metadata"))
+	allErrs = append(allErrs, validateRouteStatus(&route.Status, field.NewPath("status"))...)
+	return allErrs
+}
 
-	// TODO: validate route status
+// validateRouteStatus tests that each ingress point of a route identifies its router and host, and
+// that its conditions are well formed.
+func validateRouteStatus(status *routeapi.RouteStatus, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for i, ingress := range status.Ingress {
+		ingressPath := fldPath.Child("ingress").Index(i)
+		if len(ingress.RouterName) == 0 {
+			allErrs = append(allErrs, field.Required(ingressPath.Child("routerName")))
+		}
+		if len(ingress.Host) == 0 {
+			allErrs = append(allErrs, field.Required(ingressPath.Child("host")))
+		}
+		for j, condition := range ingress.Conditions {
+			conditionPath := ingressPath.Child("conditions").Index(j)
+			if len(condition.Type) == 0 {
+				allErrs = append(allErrs, field.Required(conditionPath.Child("type")))
+			}
+			switch condition.Status {
+			case kapi.ConditionTrue, kapi.ConditionFalse, kapi.ConditionUnknown:
+			default:
+				allErrs = append(allErrs, field.NotSupported(conditionPath.Child("status"), condition.Status, []string{string(kapi.ConditionTrue), string(kapi.ConditionFalse), string(kapi.ConditionUnknown)}))
+			}
+		}
+	}
 	return allErrs
 }
 
+// validateBackends tests that the weights of the primary and alternate backends of a route are
+// in range, that the alternate backends are unique services and that the route sends traffic
+// to at least one of its backends.
+func validateBackends(route *routeapi.Route) field.ErrorList {
+	result := field.ErrorList{}
+
+	total := validateBackendWeight(route.Spec.To.Weight, field.NewPath("to", "weight"), &result)
+
+	services := sets.NewString(route.Spec.To.Name)
+	for i, backend := range route.Spec.AlternateBackends {
+		fldPath := field.NewPath("alternateBackends").Index(i)
+		if len(backend.Kind) > 0 && backend.Kind != "Service" {
+			result = append(result, field.NotSupported(fldPath.Child("kind"), backend.Kind, []string{"Service"}))
+		}
+		switch {
+		case len(backend.Name) == 0:
+			result = append(result, field.Required(fldPath.Child("name")))
+		case services.Has(backend.Name):
+			result = append(result, field.Duplicate(fldPath.Child("name"), backend.Name))
+		default:
+			services.Insert(backend.Name)
+		}
+		total += validateBackendWeight(backend.Weight, fldPath.Child("weight"), &result)
+	}
+
+	if total == 0 {
+		result = append(result, field.Invalid(field.NewPath("to", "weight"), 0, "the weights of the route backends must not add up to zero"))
+	}
+	return result
+}
+
+// validateBackendWeight appends an error to result if the weight is out of range, and returns
+// the weight to use when adding up the weights of the backends of a route.
+func validateBackendWeight(weight *int32, fldPath *field.Path, result *field.ErrorList) int32 {
+	if weight == nil {
+		return routeapi.DefaultRouteTargetWeight
+	}
+	if *weight < 0 || *weight > routeapi.MaxRouteTargetWeight {
+		*result = append(*result, field.Invalid(fldPath, *weight, fmt.Sprintf("weight must be between 0 and %d", routeapi.MaxRouteTargetWeight)))
+		return 0
+	}
+	return *weight
+}
+
+// validateWildcardPolicy tests that the wildcard policy is supported and that a
+// wildcard route has a host with a subdomain.
+func validateWildcardPolicy(route *routeapi.Route, fldPath *field.Path) field.ErrorList {
+	result := field.ErrorList{}
+
+	switch route.Spec.WildcardPolicy {
+	case "", routeapi.WildcardPolicyNone:
+	case routeapi.WildcardPolicySubdomain:
+		if len(route.Spec.Host) == 0 {
+			result = append(result, field.Invalid(fldPath, route.Spec.WildcardPolicy, "host must be set for wildcard routes"))
+		} else if len(routeapi.WildcardSubdomain(route.Spec.Host)) == 0 {
+			result = append(result, field.Invalid(fldPath, route.Spec.WildcardPolicy, "host must have a subdomain for wildcard routes"))
+		}
+		if route.Spec.TLS != nil && route.Spec.TLS.Termination == routeapi.TLSTerminationPassthrough {
+			result = append(result, field.Invalid(fldPath, route.Spec.WildcardPolicy, "passthrough termination does not support wildcard routes"))
+		}
+	default:
+		validValues := []string{string(routeapi.WildcardPolicyNone), string(routeapi.WildcardPolicySubdomain)}
+		result = append(result, field.NotSupported(fldPath, route.Spec.WildcardPolicy, validValues))
+	}
+
+	return result
+}
+
 // validateTLS tests fields for different types of TLS combinations are set.  Called
 // by ValidateRoute.
 func validateTLS(route *routeapi.Route, fldPath *field.Path) field.ErrorList {
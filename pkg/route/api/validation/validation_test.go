@@ -25,7 +25,7 @@ func TestValidateRoute(t *testing.T) {
 				},
 				Spec: api.RouteSpec{
 					Host: "host",
-					To: kapi.ObjectReference{
+					To: api.RouteTargetReference{
 						Name: "serviceName",
 					},
 				},
@@ -40,7 +40,7 @@ func TestValidateRoute(t *testing.T) {
 				},
 				Spec: api.RouteSpec{
 					Host: "host",
-					To: kapi.ObjectReference{
+					To: api.RouteTargetReference{
 						Name: "serviceName",
 					},
 				},
@@ -55,7 +55,7 @@ func TestValidateRoute(t *testing.T) {
 					Namespace: "foo",
 				},
 				Spec: api.RouteSpec{
-					To: kapi.ObjectReference{
+					To: api.RouteTargetReference{
 						Name: "serviceName",
 					},
 				},
@@ -71,7 +71,7 @@ func TestValidateRoute(t *testing.T) {
 				},
 				Spec: api.RouteSpec{
 					Host: "**",
-					To: kapi.ObjectReference{
+					To: api.RouteTargetReference{
 						Name: "serviceName",
 					},
 				},
@@ -100,7 +100,7 @@ func TestValidateRoute(t *testing.T) {
 				},
 				Spec: api.RouteSpec{
 					Host: "www.example.com",
-					To: kapi.ObjectReference{
+					To: api.RouteTargetReference{
 						Name: "serviceName",
 					},
 					Port: &api.RoutePort{
@@ -119,7 +119,7 @@ func TestValidateRoute(t *testing.T) {
 				},
 				Spec: api.RouteSpec{
 					Host: "www.example.com",
-					To: kapi.ObjectReference{
+					To: api.RouteTargetReference{
 						Name: "serviceName",
 					},
 					Port: &api.RoutePort{
@@ -138,7 +138,7 @@ func TestValidateRoute(t *testing.T) {
 				},
 				Spec: api.RouteSpec{
 					Host: "www.example.com",
-					To: kapi.ObjectReference{
+					To: api.RouteTargetReference{
 						Name: "serviceName",
 					},
 				},
@@ -154,7 +154,7 @@ func TestValidateRoute(t *testing.T) {
 				},
 				Spec: api.RouteSpec{
 					Host: "www.example.com",
-					To: kapi.ObjectReference{
+					To: api.RouteTargetReference{
 						Name: "serviceName",
 					},
 					Path: "/test",
@@ -171,7 +171,7 @@ func TestValidateRoute(t *testing.T) {
 				},
 				Spec: api.RouteSpec{
 					Host: "www.example.com",
-					To: kapi.ObjectReference{
+					To: api.RouteTargetReference{
 						Name: "serviceName",
 					},
 					Path: "test",
@@ -189,7 +189,7 @@ func TestValidateRoute(t *testing.T) {
 				Spec: api.RouteSpec{
 					Host: "www.example.com",
 					Path: "/test",
-					To: kapi.ObjectReference{
+					To: api.RouteTargetReference{
 						Name: "serviceName",
 					},
 					TLS: &api.TLSConfig{
@@ -210,6 +210,155 @@ func TestValidateRoute(t *testing.T) {
 	}
 }
 
+func int32Ptr(i int32) *int32 {
+	return &i
+}
+
+// TestValidateRouteBackends ensures the weights and alternate backends of a route are validated
+func TestValidateRouteBackends(t *testing.T) {
+	tests := []struct {
+		name              string
+		to                api.RouteTargetReference
+		alternateBackends []api.RouteTargetReference
+		expectedErrors    int
+	}{
+		{
+			name: "Default weights",
+			to:   api.RouteTargetReference{Name: "serviceName"},
+			alternateBackends: []api.RouteTargetReference{
+				{Name: "other"},
+			},
+			expectedErrors: 0,
+		},
+		{
+			name: "Weighted backends",
+			to:   api.RouteTargetReference{Name: "serviceName", Weight: int32Ptr(0)},
+			alternateBackends: []api.RouteTargetReference{
+				{Kind: "Service", Name: "other", Weight: int32Ptr(256)},
+				{Name: "third", Weight: int32Ptr(0)},
+			},
+			expectedErrors: 0,
+		},
+		{
+			name:           "Zero weight without alternate backends",
+			to:             api.RouteTargetReference{Name: "serviceName", Weight: int32Ptr(0)},
+			expectedErrors: 1,
+		},
+		{
+			name: "Weights add up to zero",
+			to:   api.RouteTargetReference{Name: "serviceName", Weight: int32Ptr(0)},
+			alternateBackends: []api.RouteTargetReference{
+				{Name: "other", Weight: int32Ptr(0)},
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "Weights out of range",
+			to:   api.RouteTargetReference{Name: "serviceName", Weight: int32Ptr(-1)},
+			alternateBackends: []api.RouteTargetReference{
+				{Name: "other", Weight: int32Ptr(257)},
+			},
+			expectedErrors: 3,
+		},
+		{
+			name: "Invalid alternate backends",
+			to:   api.RouteTargetReference{Name: "serviceName"},
+			alternateBackends: []api.RouteTargetReference{
+				{Name: "serviceName"},
+				{Kind: "Pod", Name: "other"},
+				{},
+			},
+			expectedErrors: 3,
+		},
+	}
+
+	for _, tc := range tests {
+		route := &api.Route{
+			ObjectMeta: kapi.ObjectMeta{
+				Name:      "name",
+				Namespace: "foo",
+			},
+			Spec: api.RouteSpec{
+				Host:              "host",
+				To:                tc.to,
+				AlternateBackends: tc.alternateBackends,
+			},
+		}
+		errs := ValidateRoute(route)
+
+		if len(errs) != tc.expectedErrors {
+			t.Errorf("Test case %s expected %d error(s), got %d. %v", tc.name, tc.expectedErrors, len(errs), errs)
+		}
+	}
+}
+
+func TestValidateRouteWildcardPolicy(t *testing.T) {
+	tests := []struct {
+		name           string
+		host           string
+		policy         api.WildcardPolicyType
+		tls            *api.TLSConfig
+		expectedErrors int
+	}{
+		{
+			name:           "No policy",
+			host:           "www.example.com",
+			expectedErrors: 0,
+		},
+		{
+			name:           "Subdomain policy",
+			host:           "www.example.com",
+			policy:         api.WildcardPolicySubdomain,
+			tls:            &api.TLSConfig{Termination: api.TLSTerminationEdge},
+			expectedErrors: 0,
+		},
+		{
+			name:           "Subdomain policy without host",
+			policy:         api.WildcardPolicySubdomain,
+			expectedErrors: 1,
+		},
+		{
+			name:           "Subdomain policy without subdomain",
+			host:           "localhost",
+			policy:         api.WildcardPolicySubdomain,
+			expectedErrors: 1,
+		},
+		{
+			name:           "Subdomain policy with passthrough termination",
+			host:           "www.example.com",
+			policy:         api.WildcardPolicySubdomain,
+			tls:            &api.TLSConfig{Termination: api.TLSTerminationPassthrough},
+			expectedErrors: 1,
+		},
+		{
+			name:           "Unknown policy",
+			host:           "www.example.com",
+			policy:         "Domain",
+			expectedErrors: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		route := &api.Route{
+			ObjectMeta: kapi.ObjectMeta{
+				Name:      "name",
+				Namespace: "foo",
+			},
+			Spec: api.RouteSpec{
+				Host:           tc.host,
+				To:             api.RouteTargetReference{Name: "serviceName"},
+				TLS:            tc.tls,
+				WildcardPolicy: tc.policy,
+			},
+		}
+		errs := ValidateRoute(route)
+
+		if len(errs) != tc.expectedErrors {
+			t.Errorf("Test case %s expected %d error(s), got %d. %v", tc.name, tc.expectedErrors, len(errs), errs)
+		}
+	}
+}
+
 func TestValidateTLS(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -530,3 +679,81 @@ func TestValidateNoTLSInsecureEdgeTerminationPolicy(t *testing.T) {
 		}
 	}
 }
+
+// TestValidateRouteStatusUpdate ensures the ingress points of a route status identify their router
+// and host and have well formed conditions
+func TestValidateRouteStatusUpdate(t *testing.T) {
+	tests := []struct {
+		name           string
+		ingress        []api.RouteIngress
+		expectedErrors int
+	}{
+		{
+			name:           "No ingress",
+			expectedErrors: 0,
+		},
+		{
+			name: "Admitted",
+			ingress: []api.RouteIngress{
+				{
+					Host:       "www.example.com",
+					RouterName: "router",
+					Conditions: []api.RouteIngressCondition{
+						{Type: api.RouteAdmitted, Status: kapi.ConditionTrue},
+					},
+				},
+			},
+			expectedErrors: 0,
+		},
+		{
+			name: "Rejected",
+			ingress: []api.RouteIngress{
+				{
+					Host:       "www.example.com",
+					RouterName: "router",
+					Conditions: []api.RouteIngressCondition{
+						{Type: api.RouteAdmitted, Status: kapi.ConditionFalse, Reason: "HostAlreadyClaimed"},
+					},
+				},
+			},
+			expectedErrors: 0,
+		},
+		{
+			name:           "No router name or host",
+			ingress:        []api.RouteIngress{{}},
+			expectedErrors: 2,
+		},
+		{
+			name: "Invalid condition",
+			ingress: []api.RouteIngress{
+				{
+					Host:       "www.example.com",
+					RouterName: "router",
+					Conditions: []api.RouteIngressCondition{
+						{Status: "Maybe"},
+					},
+				},
+			},
+			expectedErrors: 2,
+		},
+	}
+
+	for _, tc := range tests {
+		older := &api.Route{
+			ObjectMeta: kapi.ObjectMeta{
+				Name:            "name",
+				Namespace:       "foo",
+				ResourceVersion: "1",
+			},
+		}
+		route := &api.Route{
+			ObjectMeta: older.ObjectMeta,
+			Status:     api.RouteStatus{Ingress: tc.ingress},
+		}
+		errs := ValidateRouteStatusUpdate(route, older)
+
+		if len(errs) != tc.expectedErrors {
+			t.Errorf("Test case %s expected %d error(s), got %d. %v", tc.name, tc.expectedErrors, len(errs), errs)
+		}
+	}
+}
@@ -5,6 +5,8 @@ import (
 
 	"github.com/gonum/graph"
 
+	kapi "k8s.io/kubernetes/pkg/api"
+
 	osgraph "github.com/openshift/origin/pkg/api/graph"
 	kubegraph "github.com/openshift/origin/pkg/api/kubegraph/nodes"
 	routeapi "github.com/openshift/origin/pkg/route/api"
@@ -24,6 +26,8 @@ const (
 	// PathBasedPassthroughErr is returned when a path based route is passthrough
 	// terminated.
 	PathBasedPassthroughErr = "PathBasedPassthrough"
+	// RouteNotAdmittedWarning is returned when a router has rejected a route.
+	RouteNotAdmittedWarning = "RouteNotAdmitted"
 )
 
 // FindMissingPortMapping checks all routes and reports those that don't specify a port while
@@ -111,3 +115,35 @@ func FindPathBasedPassthroughRoutes(g osgraph.Graph, f osgraph.Namer) []osgraph.
 
 	return markers
 }
+
+// FindRouteAdmissionFailures reports routes that have been rejected by a router.
+func FindRouteAdmissionFailures(g osgraph.Graph, f osgraph.Namer) []osgraph.Marker {
+	markers := []osgraph.Marker{}
+
+	for _, uncastRouteNode := range g.NodesByKind(routegraph.RouteNodeKind) {
+		routeNode := uncastRouteNode.(*routegraph.RouteNode)
+
+		for _, ingress := range routeNode.Status.Ingress {
+			for _, condition := range ingress.Conditions {
+				if condition.Type != routeapi.RouteAdmitted || condition.Status != kapi.ConditionFalse {
+					continue
+				}
+
+				message := fmt.Sprintf("%s was not accepted by router %q: %s", f.ResourceName(routeNode), ingress.RouterName, condition.Reason)
+				if len(condition.Message) > 0 {
+					message = fmt.Sprintf("%s (%s)", message, condition.Message)
+				}
+				markers = append(markers, osgraph.Marker{
+					Node: routeNode,
+
+					Severity:   osgraph.WarningSeverity,
+					Key:        RouteNotAdmittedWarning,
+					Message:    message,
+					Suggestion: osgraph.Suggestion(fmt.Sprintf("oc describe %s", f.ResourceName(routeNode))),
+				})
+			}
+		}
+	}
+
+	return markers
+}
@@ -55,3 +55,19 @@ func TestPathBasedPassthroughRoutes(t *testing.T) {
 		t.Fatalf("expected %s marker key, got %s", expected, got)
 	}
 }
+
+func TestRouteAdmissionFailures(t *testing.T) {
+	g, _, err := osgraphtest.BuildGraph("../../../api/graph/test/rejected-route.yaml")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	routeedges.AddAllRouteEdges(g)
+
+	markers := FindRouteAdmissionFailures(g, osgraph.DefaultNamer)
+	if expected, got := 1, len(markers); expected != got {
+		t.Fatalf("expected %d markers, got %d", expected, got)
+	}
+	if expected, got := RouteNotAdmittedWarning, markers[0].Key; expected != got {
+		t.Fatalf("expected %s marker key, got %s", expected, got)
+	}
+}
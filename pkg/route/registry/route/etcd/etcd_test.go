@@ -43,7 +43,7 @@ func validRoute() *api.Route {
 			Name: "foo",
 		},
 		Spec: api.RouteSpec{
-			To: kapi.ObjectReference{
+			To: api.RouteTargetReference{
 				Name: "test",
 			},
 		},
@@ -109,6 +109,56 @@ func TestUpdate(t *testing.T) {
 		},
 	)
 }
+
+func TestUpdateStatus(t *testing.T) {
+	etcdStorage, server := registrytest.NewEtcdStorage(t, "")
+	defer server.Terminate(t)
+	storage, statusStorage := NewREST(etcdStorage, nil)
+
+	ctx := kapi.NewDefaultContext()
+	obj, err := storage.Create(ctx, validRoute())
+	if err != nil {
+		t.Fatalf("unable to create object: %v", err)
+	}
+
+	update := obj.(*api.Route)
+	update.Spec.Host = "ignored"
+	update.Status.Ingress = []api.RouteIngress{
+		{
+			Host:       "test",
+			RouterName: "router",
+			Conditions: []api.RouteIngressCondition{{Type: api.RouteAdmitted, Status: kapi.ConditionTrue}},
+		},
+	}
+	if _, _, err := statusStorage.Update(ctx, update); err != nil {
+		t.Fatalf("unable to update status: %v", err)
+	}
+
+	obj, err = storage.Get(ctx, "foo")
+	if err != nil {
+		t.Fatalf("unable to get object: %v", err)
+	}
+	result := obj.(*api.Route)
+	if len(result.Status.Ingress) != 1 || result.Status.Ingress[0].RouterName != "router" {
+		t.Errorf("expected status to be updated: %#v", result.Status)
+	}
+	if result.Spec.Host == "ignored" {
+		t.Errorf("expected spec to be unchanged by a status update: %#v", result.Spec)
+	}
+
+	result.Status = api.RouteStatus{}
+	if _, _, err := storage.Update(ctx, result); err != nil {
+		t.Fatalf("unable to update object: %v", err)
+	}
+	obj, err = storage.Get(ctx, "foo")
+	if err != nil {
+		t.Fatalf("unable to get object: %v", err)
+	}
+	if len(obj.(*api.Route).Status.Ingress) != 1 {
+		t.Errorf("expected status to be unchanged by a spec update: %#v", obj.(*api.Route).Status)
+	}
+}
+
 func TestList(t *testing.T) {
 	storage, server := newStorage(t, nil)
 	defer server.Terminate(t)
@@ -0,0 +1,163 @@
+package controller
+
+import (
+	"fmt"
+
+	"github.com/golang/glog"
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/util"
+	"k8s.io/kubernetes/pkg/util/sets"
+	"k8s.io/kubernetes/pkg/watch"
+
+	"github.com/openshift/origin/pkg/client"
+	routeapi "github.com/openshift/origin/pkg/route/api"
+	"github.com/openshift/origin/pkg/router"
+)
+
+// RejectionRecorder is an object capable of recording why a route was rejected
+type RejectionRecorder interface {
+	RecordRouteRejection(route *routeapi.Route, reason, message string)
+}
+
+// LogRejections writes rejection messages to the log.
+var LogRejections = logRecorder{}
+
+type logRecorder struct{}
+
+func (logRecorder) RecordRouteRejection(route *routeapi.Route, reason, message string) {
+	glog.V(4).Infof("Rejected route %s: %s: %s", routeNameKey(route), reason, message)
+}
+
+// StatusAdmitter ensures routes added to the plugin have status set.
+type StatusAdmitter struct {
+	plugin     router.Plugin
+	client     client.RoutesNamespacer
+	routerName string
+
+	// nowFn returns the current time, and may be replaced in tests.
+	nowFn func() unversioned.Time
+}
+
+// NewStatusAdmitter creates a plugin wrapper that ensures every accepted
+// route has a status field set that matches this router. Routes are admitted
+// once the wrapped plugin has handled them.
+func NewStatusAdmitter(plugin router.Plugin, oc client.RoutesNamespacer, name string) *StatusAdmitter {
+	return &StatusAdmitter{
+		plugin:     plugin,
+		client:     oc,
+		routerName: name,
+		nowFn:      unversioned.Now,
+	}
+}
+
+// findOrCreateIngress returns the ingress of the route for the named router, adding one if
+// the router has not recorded status on the route for the current host yet. Ingress entries
+// of the router for other hosts are removed. The second return value is true if the ingress
+// entries of the router were changed.
+func findOrCreateIngress(route *routeapi.Route, name string) (*routeapi.RouteIngress, bool) {
+	changed := false
+	position := -1
+	updated := route.Status.Ingress[:0]
+	for _, ingress := range route.Status.Ingress {
+		if ingress.RouterName == name {
+			if ingress.Host != route.Spec.Host || position != -1 {
+				changed = true
+				continue
+			}
+			position = len(updated)
+		}
+		updated = append(updated, ingress)
+	}
+	route.Status.Ingress = updated
+	if position != -1 {
+		return &route.Status.Ingress[position], changed
+	}
+	route.Status.Ingress = append(route.Status.Ingress, routeapi.RouteIngress{
+		RouterName: name,
+		Host:       route.Spec.Host,
+	})
+	return &route.Status.Ingress[len(route.Status.Ingress)-1], true
+}
+
+// setIngressCondition records the condition on the ingress, replacing any existing condition
+// of the same type, and returns true if the ingress was changed.
+func setIngressCondition(ingress *routeapi.RouteIngress, condition routeapi.RouteIngressCondition) bool {
+	for i := range ingress.Conditions {
+		existing := &ingress.Conditions[i]
+		if existing.Type != condition.Type {
+			continue
+		}
+		if existing.Status == condition.Status && existing.Reason == condition.Reason && existing.Message == condition.Message {
+			return false
+		}
+		*existing = condition
+		return true
+	}
+	ingress.Conditions = append(ingress.Conditions, condition)
+	return true
+}
+
+// recordIngressCondition sets the Admitted condition for this router on the route and
+// writes the route status to the server if it changed.
+func (a *StatusAdmitter) recordIngressCondition(route *routeapi.Route, status kapi.ConditionStatus, reason, message string) error {
+	// the route is shared with the cache of the controller, only a copy may be changed
+	obj, err := kapi.Scheme.Copy(route)
+	if err != nil {
+		return fmt.Errorf("unable to copy route: %v", err)
+	}
+	route = obj.(*routeapi.Route)
+
+	ingress, changed := findOrCreateIngress(route, a.routerName)
+
+	now := a.nowFn()
+	condition := routeapi.RouteIngressCondition{
+		Type:               routeapi.RouteAdmitted,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		LastTransitionTime: &now,
+	}
+	if !setIngressCondition(ingress, condition) && !changed {
+		return nil
+	}
+
+	glog.V(4).Infof("Updating status of route %s for router %s: %s %s", routeNameKey(route), a.routerName, status, reason)
+	_, err = a.client.Routes(route.Namespace).UpdateStatus(route)
+	return err
+}
+
+// HandleRoute passes the route to the wrapped plugin and records on watch add / modifications
+// whether the plugin admitted it.
+func (a *StatusAdmitter) HandleRoute(eventType watch.EventType, route *routeapi.Route) error {
+	err := a.plugin.HandleRoute(eventType, route)
+	switch eventType {
+	case watch.Added, watch.Modified:
+		if err != nil {
+			a.RecordRouteRejection(route, "RouteNotHandled", err.Error())
+			break
+		}
+		if err := a.recordIngressCondition(route, kapi.ConditionTrue, "", ""); err != nil {
+			util.HandleError(fmt.Errorf("unable to admit route %s: %v", routeNameKey(route), err))
+		}
+	}
+	return err
+}
+
+// HandleEndpoints processes watch events on the Endpoints resource.
+func (a *StatusAdmitter) HandleEndpoints(eventType watch.EventType, endpoints *kapi.Endpoints) error {
+	return a.plugin.HandleEndpoints(eventType, endpoints)
+}
+
+// HandleNamespaces limits the scope of valid routes to only those that match
+// the provided namespace list.
+func (a *StatusAdmitter) HandleNamespaces(namespaces sets.String) error {
+	return a.plugin.HandleNamespaces(namespaces)
+}
+
+// RecordRouteRejection attempts to update the route status with a reason for a route being rejected.
+func (a *StatusAdmitter) RecordRouteRejection(route *routeapi.Route, reason, message string) {
+	if err := a.recordIngressCondition(route, kapi.ConditionFalse, reason, message); err != nil {
+		util.HandleError(fmt.Errorf("unable to record rejection of route %s: %v", routeNameKey(route), err))
+	}
+}
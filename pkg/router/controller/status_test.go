@@ -0,0 +1,192 @@
+package controller
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/util/sets"
+	"k8s.io/kubernetes/pkg/watch"
+
+	"github.com/openshift/origin/pkg/client/testclient"
+	routeapi "github.com/openshift/origin/pkg/route/api"
+)
+
+type fakePlugin struct {
+	t     watch.EventType
+	route *routeapi.Route
+	err   error
+}
+
+func (p *fakePlugin) HandleRoute(t watch.EventType, route *routeapi.Route) error {
+	p.t, p.route = t, route
+	return p.err
+}
+
+func (p *fakePlugin) HandleEndpoints(watch.EventType, *kapi.Endpoints) error {
+	return nil
+}
+
+func (p *fakePlugin) HandleNamespaces(namespaces sets.String) error {
+	return nil
+}
+
+func newTestAdmitter(client *testclient.Fake, plugin *fakePlugin) *StatusAdmitter {
+	admitter := NewStatusAdmitter(plugin, client, "test")
+	admitter.nowFn = func() unversioned.Time { return unversioned.NewTime(time.Unix(10, 0)) }
+	return admitter
+}
+
+func statusUpdates(actions []ktestclient.Action) []*routeapi.Route {
+	routes := []*routeapi.Route{}
+	for _, action := range actions {
+		if action.GetVerb() == "update" && action.GetResource() == "routes" && action.GetSubresource() == "status" {
+			routes = append(routes, action.(ktestclient.UpdateAction).GetObject().(*routeapi.Route))
+		}
+	}
+	return routes
+}
+
+func admittedCondition(route *routeapi.Route, name string) *routeapi.RouteIngressCondition {
+	for _, ingress := range route.Status.Ingress {
+		if ingress.RouterName != name {
+			continue
+		}
+		for i := range ingress.Conditions {
+			if ingress.Conditions[i].Type == routeapi.RouteAdmitted {
+				return &ingress.Conditions[i]
+			}
+		}
+	}
+	return nil
+}
+
+func TestStatusAdmitsRoute(t *testing.T) {
+	client := testclient.NewSimpleFake()
+	plugin := &fakePlugin{}
+	admitter := newTestAdmitter(client, plugin)
+
+	route := &routeapi.Route{
+		ObjectMeta: kapi.ObjectMeta{Name: "route1", Namespace: "default"},
+		Spec:       routeapi.RouteSpec{Host: "route1.test.local"},
+		Status: routeapi.RouteStatus{
+			Ingress: []routeapi.RouteIngress{{Host: "other.test.local", RouterName: "other"}},
+		},
+	}
+	if err := admitter.HandleRoute(watch.Added, route); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if plugin.route != route || plugin.t != watch.Added {
+		t.Fatalf("expected route to be passed to the plugin: %#v", plugin)
+	}
+
+	updates := statusUpdates(client.Actions())
+	if len(updates) != 1 {
+		t.Fatalf("expected one status update, got %#v", client.Actions())
+	}
+	if len(updates[0].Status.Ingress) != 2 {
+		t.Fatalf("expected the ingress of other routers to be preserved: %#v", updates[0].Status)
+	}
+	ingress := updates[0].Status.Ingress[1]
+	if ingress.Host != "route1.test.local" || ingress.RouterName != "test" {
+		t.Errorf("unexpected ingress: %#v", ingress)
+	}
+	condition := admittedCondition(updates[0], "test")
+	if condition == nil || condition.Status != kapi.ConditionTrue || condition.LastTransitionTime == nil || !condition.LastTransitionTime.Time.Equal(time.Unix(10, 0)) {
+		t.Errorf("unexpected condition: %#v", condition)
+	}
+
+	if len(route.Status.Ingress) != 1 {
+		t.Errorf("expected the route passed to the admitter not to be modified: %#v", route.Status)
+	}
+
+	// an already admitted route should not be updated again
+	route = updates[0]
+	client.ClearActions()
+	if err := admitter.HandleRoute(watch.Modified, route); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updates := statusUpdates(client.Actions()); len(updates) != 0 {
+		t.Errorf("expected no status update, got %#v", updates)
+	}
+
+	// a change of host should be recorded, and the ingress for the old host removed
+	route.Spec.Host = "changed.test.local"
+	route.Status.Ingress = append(route.Status.Ingress, routeapi.RouteIngress{Host: "stale.test.local", RouterName: "test"})
+	if err := admitter.HandleRoute(watch.Modified, route); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	updates = statusUpdates(client.Actions())
+	if len(updates) != 1 || len(updates[0].Status.Ingress) != 2 || updates[0].Status.Ingress[1].Host != "changed.test.local" {
+		t.Errorf("expected status update with only the new host, got %#v", updates)
+	}
+}
+
+func TestStatusRecordsPluginError(t *testing.T) {
+	client := testclient.NewSimpleFake()
+	plugin := &fakePlugin{err: errors.New("unable to configure the backend")}
+	admitter := newTestAdmitter(client, plugin)
+
+	route := &routeapi.Route{
+		ObjectMeta: kapi.ObjectMeta{Name: "route1", Namespace: "default"},
+		Spec:       routeapi.RouteSpec{Host: "route1.test.local"},
+	}
+	if err := admitter.HandleRoute(watch.Added, route); err != plugin.err {
+		t.Fatalf("expected the error of the plugin, got %v", err)
+	}
+
+	updates := statusUpdates(client.Actions())
+	if len(updates) != 1 {
+		t.Fatalf("expected one status update, got %#v", client.Actions())
+	}
+	condition := admittedCondition(updates[0], "test")
+	if condition == nil || condition.Status != kapi.ConditionFalse || condition.Message != "unable to configure the backend" {
+		t.Errorf("unexpected condition: %#v", condition)
+	}
+}
+
+func TestStatusRecordsRejection(t *testing.T) {
+	client := testclient.NewSimpleFake()
+	plugin := &fakePlugin{}
+	admitter := newTestAdmitter(client, plugin)
+	uniqueHost := NewUniqueHost(admitter, HostForRoute, false, admitter)
+
+	older := &routeapi.Route{
+		ObjectMeta: kapi.ObjectMeta{Name: "route1", Namespace: "ns1", CreationTimestamp: unversioned.NewTime(time.Unix(1, 0))},
+		Spec:       routeapi.RouteSpec{Host: "route.test.local"},
+	}
+	newer := &routeapi.Route{
+		ObjectMeta: kapi.ObjectMeta{Name: "route2", Namespace: "ns2", CreationTimestamp: unversioned.NewTime(time.Unix(2, 0))},
+		Spec:       routeapi.RouteSpec{Host: "route.test.local"},
+	}
+
+	if err := uniqueHost.HandleRoute(watch.Added, older); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	client.ClearActions()
+	if err := uniqueHost.HandleRoute(watch.Added, newer); err == nil {
+		t.Fatalf("expected the newer route to be rejected")
+	}
+
+	updates := statusUpdates(client.Actions())
+	if len(updates) != 1 || updates[0].Name != "route2" {
+		t.Fatalf("expected a status update for the rejected route, got %#v", client.Actions())
+	}
+	condition := admittedCondition(updates[0], "test")
+	if condition == nil || condition.Status != kapi.ConditionFalse || condition.Reason != "HostAlreadyClaimed" || len(condition.Message) == 0 {
+		t.Errorf("unexpected condition: %#v", condition)
+	}
+	if plugin.route != older {
+		t.Errorf("expected the rejected route not to be passed to the plugin")
+	}
+
+	// a repeated rejection should not be written again
+	client.ClearActions()
+	uniqueHost.HandleRoute(watch.Modified, updates[0])
+	if updates := statusUpdates(client.Actions()); len(updates) != 0 {
+		t.Errorf("expected no status update, got %#v", updates)
+	}
+}
@@ -2,6 +2,8 @@ package controller
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/golang/glog"
 	kapi "k8s.io/kubernetes/pkg/api"
@@ -26,8 +28,10 @@ type RouteToHostMap map[string]string
 // UniqueHost implements the router.Plugin interface to provide
 // a template based, backend-agnostic router.
 type UniqueHost struct {
-	plugin       router.Plugin
-	hostForRoute RouteHostFunc
+	plugin              router.Plugin
+	hostForRoute        RouteHostFunc
+	allowWildcardRoutes bool
+	recorder            RejectionRecorder
 
 	hostToRoute HostToRouteMap
 	routeToHost RouteToHostMap
@@ -36,18 +40,23 @@ type UniqueHost struct {
 }
 
 // NewUniqueHost creates a plugin wrapper that ensures only unique routes are passed into
-// the underlying plugin.
-func NewUniqueHost(plugin router.Plugin, fn RouteHostFunc) *UniqueHost {
+// the underlying plugin. Routes that are rejected because another route holds their host
+// are reported to the recorder. Routes with a wildcard policy of Subdomain are rejected
+// unless allowWildcardRoutes is true.
+func NewUniqueHost(plugin router.Plugin, fn RouteHostFunc, allowWildcardRoutes bool, recorder RejectionRecorder) *UniqueHost {
 	return &UniqueHost{
-		plugin:       plugin,
-		hostForRoute: fn,
+		plugin:              plugin,
+		hostForRoute:        fn,
+		allowWildcardRoutes: allowWildcardRoutes,
+		recorder:            recorder,
 
 		hostToRoute: make(HostToRouteMap),
 		routeToHost: make(RouteToHostMap),
 	}
 }
 
-// RoutesForHost is a helper that allows routes to be retrieved.
+// RoutesForHost is a helper that allows routes to be retrieved. Wildcard routes are
+// tracked under the host *.<subdomain>.
 func (p *UniqueHost) RoutesForHost(host string) ([]*routeapi.Route, bool) {
 	routes, ok := p.hostToRoute[host]
 	return routes, ok
@@ -81,10 +90,36 @@ func (p *UniqueHost) HandleRoute(eventType watch.EventType, route *routeapi.Rout
 	host := p.hostForRoute(route)
 	if len(host) == 0 {
 		glog.V(4).Infof("Route %s has no host value", routeName)
+		if eventType != watch.Deleted {
+			p.recorder.RecordRouteRejection(route, "NoHostValue", "no host value was defined for the route")
+		}
 		return nil
 	}
 	route.Spec.Host = host
 
+	// a wildcard route claims every host in its subdomain
+	if route.Spec.WildcardPolicy == routeapi.WildcardPolicySubdomain {
+		if !p.allowWildcardRoutes {
+			glog.V(4).Infof("Route %s is a wildcard route, which are not allowed", routeName)
+			if eventType != watch.Deleted {
+				p.recorder.RecordRouteRejection(route, "WildcardPolicyNotAllowed", "wildcard routes are not allowed by this router")
+			}
+			return nil
+		}
+		host = "*." + routeapi.WildcardSubdomain(host)
+	}
+
+	// a deleted route releases its host, it never claims one
+	if eventType == watch.Deleted {
+		return p.deleteRoute(route, host)
+	}
+
+	// hosts covered by a wildcard route can only be claimed by the namespace of
+	// the wildcard route, and the other way around
+	if err := p.claimWildcardConflicts(route, host); err != nil {
+		return err
+	}
+
 	// ensure hosts can only be claimed by one namespace at a time
 	// TODO: this could be abstracted above this layer?
 	if old, ok := p.hostToRoute[host]; ok {
@@ -97,9 +132,14 @@ func (p *UniqueHost) HandleRoute(eventType watch.EventType, route *routeapi.Rout
 				if old[i].Spec.Path == route.Spec.Path {
 					if old[i].CreationTimestamp.Before(route.CreationTimestamp) {
 						glog.V(4).Infof("Route %s cannot take %s from %s", routeName, host, routeNameKey(oldest))
-						return fmt.Errorf("route %s holds %s and is older than %s", routeNameKey(oldest), host, key)
+						err := fmt.Errorf("route %s already exposes %s and is older", routeNameKey(old[i]), host)
+						p.recorder.RecordRouteRejection(route, "HostAlreadyClaimed", err.Error())
+						return err
 					}
 					glog.V(4).Infof("Route %s will replace path %s from %s because it is older", routeName, route.Spec.Path, routeNameKey(old[i]))
+					if old[i].Name != route.Name {
+						p.recorder.RecordRouteRejection(old[i], "HostAlreadyClaimed", fmt.Sprintf("replaced by older route %s", routeName))
+					}
 					p.plugin.HandleRoute(watch.Deleted, old[i])
 					old[i] = route
 					added = true
@@ -115,11 +155,14 @@ func (p *UniqueHost) HandleRoute(eventType watch.EventType, route *routeapi.Rout
 		} else {
 			if oldest.CreationTimestamp.Before(route.CreationTimestamp) {
 				glog.V(4).Infof("Route %s cannot take %s from %s", routeName, host, routeNameKey(oldest))
-				return fmt.Errorf("route %s holds %s and is older than %s", routeNameKey(oldest), host, key)
+				err := fmt.Errorf("route %s already exposes %s and is older", routeNameKey(oldest), host)
+				p.recorder.RecordRouteRejection(route, "HostAlreadyClaimed", err.Error())
+				return err
 			}
 
 			glog.V(4).Infof("Route %s is reclaiming %s from namespace %s", routeName, host, oldest.Namespace)
 			for i := range old {
+				p.recorder.RecordRouteRejection(old[i], "HostAlreadyClaimed", fmt.Sprintf("namespace %s owns hostname %s", route.Namespace, host))
 				p.plugin.HandleRoute(watch.Deleted, old[i])
 			}
 			p.hostToRoute[host] = []*routeapi.Route{route}
@@ -129,39 +172,95 @@ func (p *UniqueHost) HandleRoute(eventType watch.EventType, route *routeapi.Rout
 		p.hostToRoute[host] = []*routeapi.Route{route}
 	}
 
-	switch eventType {
-	case watch.Added, watch.Modified:
-		if old, ok := p.routeToHost[routeName]; ok {
-			if old != host {
-				glog.V(4).Infof("Route %s changed from serving host %s to host %s", key, old, host)
-				delete(p.hostToRoute, old)
-			}
+	if old, ok := p.routeToHost[routeName]; ok {
+		if old != host {
+			glog.V(4).Infof("Route %s changed from serving host %s to host %s", key, old, host)
+			delete(p.hostToRoute, old)
 		}
-		p.routeToHost[routeName] = host
-		return p.plugin.HandleRoute(eventType, route)
-
-	case watch.Deleted:
-		glog.V(4).Infof("Deleting routes for %s", key)
-		if old, ok := p.hostToRoute[host]; ok {
-			switch len(old) {
-			case 1, 0:
-				delete(p.hostToRoute, host)
-			default:
-				next := []*routeapi.Route{}
-				for i := range old {
-					if old[i].Name != route.Name {
-						next = append(next, old[i])
-					}
-				}
-				p.hostToRoute[host] = next
+	}
+	p.routeToHost[routeName] = host
+	return p.plugin.HandleRoute(eventType, route)
+}
+
+// deleteRoute releases the host held by a deleted route. Routes that do not hold
+// their host were rejected and never passed to the underlying plugin, so they are
+// not passed on, and the deletion is not recorded as another rejection.
+func (p *UniqueHost) deleteRoute(route *routeapi.Route, host string) error {
+	routeName := routeNameKey(route)
+	glog.V(4).Infof("Deleting routes for %s", routeKey(route))
+	if old, ok := p.hostToRoute[host]; ok {
+		next := []*routeapi.Route{}
+		for i := range old {
+			if routeNameKey(old[i]) != routeName {
+				next = append(next, old[i])
 			}
 		}
-		delete(p.routeToHost, routeName)
-		return p.plugin.HandleRoute(eventType, route)
+		if len(next) == len(old) {
+			glog.V(4).Infof("Route %s does not hold %s", routeName, host)
+			return fmt.Errorf("route %s does not hold %s", routeName, host)
+		}
+		if len(next) == 0 {
+			delete(p.hostToRoute, host)
+		} else {
+			p.hostToRoute[host] = next
+		}
+	}
+	delete(p.routeToHost, routeName)
+	return p.plugin.HandleRoute(watch.Deleted, route)
+}
+
+// claimWildcardConflicts rejects the route if a host overlapping with its host is
+// claimed by an older route in another namespace. Otherwise, routes from other
+// namespaces that claim overlapping hosts are rejected in favor of the route.
+func (p *UniqueHost) claimWildcardConflicts(route *routeapi.Route, host string) error {
+	conflicts := p.wildcardConflicts(host, route.Namespace)
+	for _, conflict := range conflicts {
+		oldest := p.hostToRoute[conflict][0]
+		if oldest.CreationTimestamp.Before(route.CreationTimestamp) {
+			glog.V(4).Infof("Route %s cannot take %s from %s", routeNameKey(route), host, routeNameKey(oldest))
+			err := fmt.Errorf("route %s already exposes %s and is older", routeNameKey(oldest), conflict)
+			p.recorder.RecordRouteRejection(route, "HostAlreadyClaimed", err.Error())
+			return err
+		}
+	}
+	for _, conflict := range conflicts {
+		glog.V(4).Infof("Route %s is reclaiming %s from namespace %s", routeNameKey(route), conflict, p.hostToRoute[conflict][0].Namespace)
+		for _, old := range p.hostToRoute[conflict] {
+			p.recorder.RecordRouteRejection(old, "HostAlreadyClaimed", fmt.Sprintf("namespace %s owns hostname %s", route.Namespace, host))
+			p.plugin.HandleRoute(watch.Deleted, old)
+			delete(p.routeToHost, routeNameKey(old))
+		}
+		delete(p.hostToRoute, conflict)
 	}
 	return nil
 }
 
+// wildcardConflicts returns the hosts claimed by other namespaces that overlap with
+// the given host: the hosts in the subdomain of a wildcard host, or the wildcard
+// host covering a host.
+func (p *UniqueHost) wildcardConflicts(host, namespace string) []string {
+	conflicts := []string{}
+	if strings.HasPrefix(host, "*.") {
+		subdomain := host[2:]
+		for claimed, routes := range p.hostToRoute {
+			if len(routes) == 0 || routes[0].Namespace == namespace || strings.HasPrefix(claimed, "*.") {
+				continue
+			}
+			if routeapi.WildcardSubdomain(claimed) == subdomain {
+				conflicts = append(conflicts, claimed)
+			}
+		}
+		sort.Strings(conflicts)
+		return conflicts
+	}
+
+	wildcard := "*." + routeapi.WildcardSubdomain(host)
+	if routes := p.hostToRoute[wildcard]; len(routes) > 0 && routes[0].Namespace != namespace {
+		conflicts = append(conflicts, wildcard)
+	}
+	return conflicts
+}
+
 // HandleAllowedNamespaces limits the scope of valid routes to only those that match
 // the provided namespace list.
 func (p *UniqueHost) HandleNamespaces(namespaces sets.String) error {
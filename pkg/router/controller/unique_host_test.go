@@ -0,0 +1,172 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/util/sets"
+	"k8s.io/kubernetes/pkg/watch"
+
+	routeapi "github.com/openshift/origin/pkg/route/api"
+)
+
+type routeEvent struct {
+	t    watch.EventType
+	name string
+}
+
+type recordingPlugin struct {
+	events []routeEvent
+}
+
+func (p *recordingPlugin) HandleRoute(t watch.EventType, route *routeapi.Route) error {
+	p.events = append(p.events, routeEvent{t, routeNameKey(route)})
+	return nil
+}
+
+func (p *recordingPlugin) HandleEndpoints(watch.EventType, *kapi.Endpoints) error {
+	return nil
+}
+
+func (p *recordingPlugin) HandleNamespaces(namespaces sets.String) error {
+	return nil
+}
+
+type recordingRejections struct {
+	reasons map[string]string
+}
+
+func (r *recordingRejections) RecordRouteRejection(route *routeapi.Route, reason, message string) {
+	r.reasons[routeNameKey(route)] = reason
+}
+
+func newRoute(namespace, name, host string, created int64, policy routeapi.WildcardPolicyType) *routeapi.Route {
+	return &routeapi.Route{
+		ObjectMeta: kapi.ObjectMeta{
+			Namespace:         namespace,
+			Name:              name,
+			CreationTimestamp: unversioned.NewTime(time.Unix(created, 0)),
+		},
+		Spec: routeapi.RouteSpec{
+			Host:           host,
+			To:             routeapi.RouteTargetReference{Name: name},
+			WildcardPolicy: policy,
+		},
+	}
+}
+
+func TestUniqueHostRejectsWildcardRoutes(t *testing.T) {
+	plugin := &recordingPlugin{}
+	rejections := &recordingRejections{reasons: map[string]string{}}
+	uniqueHost := NewUniqueHost(plugin, HostForRoute, false, rejections)
+
+	route := newRoute("ns1", "wildcard", "www.example.com", 1, routeapi.WildcardPolicySubdomain)
+	if err := uniqueHost.HandleRoute(watch.Added, route); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(plugin.events) != 0 {
+		t.Errorf("expected the wildcard route not to be passed to the plugin: %#v", plugin.events)
+	}
+	if reason := rejections.reasons["ns1/wildcard"]; reason != "WildcardPolicyNotAllowed" {
+		t.Errorf("unexpected rejection reason: %q", reason)
+	}
+}
+
+func TestUniqueHostWildcardRoutes(t *testing.T) {
+	plugin := &recordingPlugin{}
+	rejections := &recordingRejections{reasons: map[string]string{}}
+	uniqueHost := NewUniqueHost(plugin, HostForRoute, true, rejections)
+
+	wildcard := newRoute("ns1", "wildcard", "www.example.com", 2, routeapi.WildcardPolicySubdomain)
+	if err := uniqueHost.HandleRoute(watch.Added, wildcard); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if routes, ok := uniqueHost.RoutesForHost("*.example.com"); !ok || routes[0] != wildcard {
+		t.Fatalf("expected the wildcard route to claim the subdomain: %#v", routes)
+	}
+
+	// hosts in the subdomain may be claimed from the same namespace
+	if err := uniqueHost.HandleRoute(watch.Added, newRoute("ns1", "exact", "app.example.com", 3, routeapi.WildcardPolicyNone)); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	// but not by newer routes from other namespaces
+	if err := uniqueHost.HandleRoute(watch.Added, newRoute("ns2", "newer", "other.example.com", 3, routeapi.WildcardPolicyNone)); err == nil {
+		t.Errorf("expected the route to be rejected")
+	}
+	if reason := rejections.reasons["ns2/newer"]; reason != "HostAlreadyClaimed" {
+		t.Errorf("unexpected rejection reason: %q", reason)
+	}
+	// nested subdomains are not covered by the wildcard
+	if err := uniqueHost.HandleRoute(watch.Added, newRoute("ns2", "nested", "app.nested.example.com", 3, routeapi.WildcardPolicyNone)); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	// an older route in another namespace takes the subdomain from the wildcard route
+	plugin.events = nil
+	older := newRoute("ns2", "older", "other.example.com", 1, routeapi.WildcardPolicyNone)
+	if err := uniqueHost.HandleRoute(watch.Added, older); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := uniqueHost.RoutesForHost("*.example.com"); ok {
+		t.Errorf("expected the wildcard route to lose its claim")
+	}
+	if reason := rejections.reasons["ns1/wildcard"]; reason != "HostAlreadyClaimed" {
+		t.Errorf("unexpected rejection reason: %q", reason)
+	}
+	expected := []routeEvent{{watch.Deleted, "ns1/wildcard"}, {watch.Added, "ns2/older"}}
+	if len(plugin.events) != len(expected) || plugin.events[0] != expected[0] || plugin.events[1] != expected[1] {
+		t.Errorf("expected events %v, got %v", expected, plugin.events)
+	}
+
+	// a newer wildcard route cannot claim a subdomain with hosts of other namespaces
+	if err := uniqueHost.HandleRoute(watch.Added, newRoute("ns3", "wildcard", "www.example.com", 4, routeapi.WildcardPolicySubdomain)); err == nil {
+		t.Errorf("expected the wildcard route to be rejected")
+	}
+}
+
+func TestUniqueHostDeletedRoutes(t *testing.T) {
+	plugin := &recordingPlugin{}
+	rejections := &recordingRejections{reasons: map[string]string{}}
+	uniqueHost := NewUniqueHost(plugin, HostForRoute, false, rejections)
+
+	owner := newRoute("ns1", "owner", "www.example.com", 1, routeapi.WildcardPolicyNone)
+	if err := uniqueHost.HandleRoute(watch.Added, owner); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	plugin.events = nil
+
+	// deleting routes that never held their host is not a rejection
+	if err := uniqueHost.HandleRoute(watch.Deleted, newRoute("ns2", "newer", "www.example.com", 2, routeapi.WildcardPolicyNone)); err == nil {
+		t.Errorf("expected an error deleting a route that does not hold its host")
+	}
+	for _, route := range []*routeapi.Route{
+		newRoute("ns2", "nohost", "", 2, routeapi.WildcardPolicyNone),
+		newRoute("ns2", "wildcard", "www.example.com", 2, routeapi.WildcardPolicySubdomain),
+	} {
+		if err := uniqueHost.HandleRoute(watch.Deleted, route); err != nil {
+			t.Errorf("unexpected error deleting %s: %v", routeNameKey(route), err)
+		}
+	}
+	if len(rejections.reasons) != 0 {
+		t.Errorf("expected no rejections, got %v", rejections.reasons)
+	}
+	if len(plugin.events) != 0 {
+		t.Errorf("expected no events, got %v", plugin.events)
+	}
+	if routes, ok := uniqueHost.RoutesForHost("www.example.com"); !ok || routes[0] != owner {
+		t.Errorf("expected the owner to keep the host: %#v", routes)
+	}
+
+	if err := uniqueHost.HandleRoute(watch.Deleted, owner); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := uniqueHost.RoutesForHost("www.example.com"); ok {
+		t.Errorf("expected the host to be released")
+	}
+	expected := []routeEvent{{watch.Deleted, "ns1/owner"}}
+	if len(plugin.events) != 1 || plugin.events[0] != expected[0] {
+		t.Errorf("expected events %v, got %v", expected, plugin.events)
+	}
+}
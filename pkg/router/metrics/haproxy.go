@@ -0,0 +1,287 @@
+package metrics
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	namespace = "haproxy"
+
+	// scrapeTimeout bounds the time spent reading the stats of HAProxy.
+	scrapeTimeout = 5 * time.Second
+
+	// typeBackend and typeServer are the values of the type column for backends and
+	// the servers of a backend.
+	typeBackend = "1"
+	typeServer  = "2"
+)
+
+// routeBackendPrefixes are the prefixes of the names of the HAProxy backends that serve
+// routes. The name of the route follows the prefix as <namespace>_<name>.
+var routeBackendPrefixes = []string{"be_http_", "be_edge_http_", "be_tcp_", "be_secure_"}
+
+// responseCodes maps the columns of HTTP response counts to the code label.
+var responseCodes = map[string]string{
+	"hrsp_1xx":   "1xx",
+	"hrsp_2xx":   "2xx",
+	"hrsp_3xx":   "3xx",
+	"hrsp_4xx":   "4xx",
+	"hrsp_5xx":   "5xx",
+	"hrsp_other": "other",
+}
+
+// metricDescs holds the descriptions of the metrics reported for backends or servers.
+type metricDescs struct {
+	up            *prometheus.Desc
+	currentQueue  *prometheus.Desc
+	sessionRate   *prometheus.Desc
+	sessionsTotal *prometheus.Desc
+	requestRate   *prometheus.Desc
+	requestsTotal *prometheus.Desc
+	responses     *prometheus.Desc
+}
+
+func newMetricDescs(subsystem string, labels []string) metricDescs {
+	return metricDescs{
+		up:            prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "up"), "Whether the "+subsystem+" is up (1) or not (0).", labels, nil),
+		currentQueue:  prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "current_queue"), "The number of requests queued for the "+subsystem+".", labels, nil),
+		sessionRate:   prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "current_session_rate"), "The number of sessions per second over the last elapsed second.", labels, nil),
+		sessionsTotal: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "sessions_total"), "The total number of sessions.", labels, nil),
+		requestRate:   prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "current_http_request_rate"), "The number of HTTP requests per second over the last elapsed second.", labels, nil),
+		requestsTotal: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "http_requests_total"), "The total number of HTTP requests.", labels, nil),
+		responses:     prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "http_responses_total"), "The total number of HTTP responses by class of status code.", append(labels, "code"), nil),
+	}
+}
+
+// HAProxyCollector is a Prometheus collector that reports the statistics of the HAProxy
+// backends that serve routes, labelled by the namespace and name of the route.
+type HAProxyCollector struct {
+	fetch func() (io.ReadCloser, error)
+
+	// lock serializes scrapes of HAProxy
+	lock sync.Mutex
+
+	up       prometheus.Gauge
+	failures prometheus.Counter
+	backends metricDescs
+	servers  metricDescs
+}
+
+// NewHAProxyCollector creates a collector for the HAProxy statistics at source, which is
+// either the path of the HAProxy stats socket as unix:///path, or the URL of the CSV
+// statistics page. Credentials for the statistics page may be part of the URL.
+func NewHAProxyCollector(source string) (*HAProxyCollector, error) {
+	u, err := url.Parse(source)
+	if err != nil {
+		return nil, fmt.Errorf("the HAProxy stats source %q is not a valid URL: %v", source, err)
+	}
+
+	var fetch func() (io.ReadCloser, error)
+	switch u.Scheme {
+	case "unix":
+		fetch = func() (io.ReadCloser, error) { return fetchFromSocket(u.Path) }
+	case "http", "https":
+		if !strings.HasSuffix(u.Path, ";csv") {
+			u.Path = strings.TrimSuffix(u.Path, "/") + "/;csv"
+		}
+		fetch = func() (io.ReadCloser, error) { return fetchFromURL(u.String()) }
+	default:
+		return nil, fmt.Errorf("the HAProxy stats source %q must be a unix, http or https URL", source)
+	}
+
+	return &HAProxyCollector{
+		fetch: fetch,
+		up: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "up",
+			Help:      "Whether the last scrape of HAProxy statistics was successful.",
+		}),
+		failures: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "scrape_failures_total",
+			Help:      "The number of failed scrapes of HAProxy statistics.",
+		}),
+		backends: newMetricDescs("backend", []string{"namespace", "route"}),
+		servers:  newMetricDescs("server", []string{"namespace", "route", "server"}),
+	}, nil
+}
+
+// fetchFromSocket requests the statistics from the HAProxy stats socket at path.
+func fetchFromSocket(path string) (io.ReadCloser, error) {
+	conn, err := net.DialTimeout("unix", path, scrapeTimeout)
+	if err != nil {
+		return nil, err
+	}
+	if err := conn.SetDeadline(time.Now().Add(scrapeTimeout)); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	if _, err := io.WriteString(conn, "show stat\n"); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return conn, nil
+}
+
+// fetchFromURL requests the statistics from the CSV statistics page of HAProxy.
+func fetchFromURL(u string) (io.ReadCloser, error) {
+	client := &http.Client{Timeout: scrapeTimeout}
+	resp, err := client.Get(u)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		return nil, fmt.Errorf("unexpected status reading HAProxy statistics: %s", resp.Status)
+	}
+	return resp.Body, nil
+}
+
+// Describe implements prometheus.Collector.
+func (c *HAProxyCollector) Describe(ch chan<- *prometheus.Desc) {
+	for _, descs := range []metricDescs{c.backends, c.servers} {
+		ch <- descs.up
+		ch <- descs.currentQueue
+		ch <- descs.sessionRate
+		ch <- descs.sessionsTotal
+		ch <- descs.requestRate
+		ch <- descs.requestsTotal
+		ch <- descs.responses
+	}
+	ch <- c.up.Desc()
+	ch <- c.failures.Desc()
+}
+
+// Collect implements prometheus.Collector.
+func (c *HAProxyCollector) Collect(ch chan<- prometheus.Metric) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if err := c.scrape(ch); err != nil {
+		glog.V(4).Infof("Unable to scrape HAProxy statistics: %v", err)
+		c.up.Set(0)
+		c.failures.Inc()
+	} else {
+		c.up.Set(1)
+	}
+	ch <- c.up
+	ch <- c.failures
+}
+
+// scrape reads the HAProxy statistics and reports the metrics of the route backends.
+func (c *HAProxyCollector) scrape(ch chan<- prometheus.Metric) error {
+	body, err := c.fetch()
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	reader := csv.NewReader(body)
+	reader.FieldsPerRecord = -1
+	records, err := reader.ReadAll()
+	if err != nil {
+		return err
+	}
+	if len(records) == 0 || len(records[0]) == 0 {
+		return fmt.Errorf("no statistics were returned")
+	}
+
+	// the header line is "# pxname,svname,..."
+	columns := make(map[string]int)
+	for i, name := range records[0] {
+		columns[strings.TrimSpace(strings.TrimPrefix(name, "#"))] = i
+	}
+	for _, name := range []string{"pxname", "svname", "type", "status", "qcur", "rate", "stot"} {
+		if _, ok := columns[name]; !ok {
+			return fmt.Errorf("the statistics have no %s column", name)
+		}
+	}
+
+	for _, record := range records[1:] {
+		if len(record) != len(records[0]) {
+			continue
+		}
+		routeNamespace, routeName, ok := routeForBackend(record[columns["pxname"]])
+		if !ok {
+			continue
+		}
+		switch record[columns["type"]] {
+		case typeBackend:
+			c.collectRow(ch, c.backends, columns, record, routeNamespace, routeName)
+		case typeServer:
+			c.collectRow(ch, c.servers, columns, record, routeNamespace, routeName, record[columns["svname"]])
+		}
+	}
+	return nil
+}
+
+// collectRow reports the metrics of a single backend or server.
+func (c *HAProxyCollector) collectRow(ch chan<- prometheus.Metric, descs metricDescs, columns map[string]int, record []string, labels ...string) {
+	value := func(column string) (float64, bool) {
+		i, ok := columns[column]
+		if !ok || len(record[i]) == 0 {
+			return 0, false
+		}
+		v, err := strconv.ParseFloat(record[i], 64)
+		return v, err == nil
+	}
+
+	up := 0.0
+	if strings.HasPrefix(record[columns["status"]], "UP") {
+		up = 1
+	}
+	ch <- prometheus.MustNewConstMetric(descs.up, prometheus.GaugeValue, up, labels...)
+	if v, ok := value("qcur"); ok {
+		ch <- prometheus.MustNewConstMetric(descs.currentQueue, prometheus.GaugeValue, v, labels...)
+	}
+	if v, ok := value("rate"); ok {
+		ch <- prometheus.MustNewConstMetric(descs.sessionRate, prometheus.GaugeValue, v, labels...)
+	}
+	if v, ok := value("stot"); ok {
+		ch <- prometheus.MustNewConstMetric(descs.sessionsTotal, prometheus.CounterValue, v, labels...)
+	}
+	// HTTP request counts are only reported by HAProxy versions that track them for
+	// backends, and never for servers
+	if v, ok := value("req_rate"); ok {
+		ch <- prometheus.MustNewConstMetric(descs.requestRate, prometheus.GaugeValue, v, labels...)
+	}
+	if v, ok := value("req_tot"); ok {
+		ch <- prometheus.MustNewConstMetric(descs.requestsTotal, prometheus.CounterValue, v, labels...)
+	}
+	for column, code := range responseCodes {
+		if v, ok := value(column); ok {
+			ch <- prometheus.MustNewConstMetric(descs.responses, prometheus.CounterValue, v, append(labels, code)...)
+		}
+	}
+}
+
+// routeForBackend returns the namespace and name of the route served by the named
+// HAProxy backend, or false if the backend does not serve a route.
+func routeForBackend(backend string) (string, string, bool) {
+	for _, prefix := range routeBackendPrefixes {
+		if !strings.HasPrefix(backend, prefix) {
+			continue
+		}
+		// namespaces may not contain underscores
+		parts := strings.SplitN(strings.TrimPrefix(backend, prefix), "_", 2)
+		if len(parts) != 2 || len(parts[0]) == 0 || len(parts[1]) == 0 {
+			return "", "", false
+		}
+		return parts[0], parts[1], true
+	}
+	return "", "", false
+}
@@ -0,0 +1,157 @@
+package metrics
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+const sampleStats = `# pxname,svname,qcur,qmax,scur,smax,slim,stot,bin,bout,dreq,dresp,ereq,econ,eresp,wretr,wredis,status,weight,act,bck,chkfail,chkdown,lastchg,downtime,qlimit,pid,iid,sid,throttle,lbtot,tracked,type,rate,rate_lim,rate_max,check_status,check_code,check_duration,hrsp_1xx,hrsp_2xx,hrsp_3xx,hrsp_4xx,hrsp_5xx,hrsp_other,hanafail,req_rate,req_rate_max,req_tot,cli_abrt,srv_abrt,
+public,FRONTEND,,,0,1,20000,10,0,0,0,0,0,,,,,OPEN,,,,,,,,,1,1,0,,,,0,0,0,1,,,,0,10,0,0,0,0,,0,1,10,,,
+be_http_ns1_route1,pod:frontend-1:frontend:10.1.0.2:8080,2,3,1,2,,20,100,200,,0,,0,0,0,0,UP,100,1,0,0,0,100,0,,1,4,1,,20,,2,5,,6,L4OK,,0,0,15,2,3,0,0,,,,,0,0,
+be_http_ns1_route1,pod:frontend-2:frontend:10.1.0.3:8080,0,0,0,1,,7,50,60,,0,,0,0,0,0,DOWN,100,1,0,1,1,10,10,,1,4,2,,7,,2,0,,1,L4CON,,0,0,5,2,0,0,0,,,,,0,0,
+be_http_ns1_route1,BACKEND,2,3,1,2,2000,27,150,260,0,0,,0,0,0,0,UP,200,2,0,,1,100,0,,1,4,0,,27,,1,5,,6,,,,0,20,2,3,2,0,,4,9,25,0,0,
+be_tcp_ns2_route2,BACKEND,0,0,0,0,2000,0,0,0,0,0,,0,0,0,0,DOWN,0,0,0,,1,100,100,,1,5,0,,0,,1,0,,0,,,,,,,,,,,,,,0,0,
+openshift_default,BACKEND,0,0,0,0,2000,0,0,0,0,0,,0,0,0,0,UP,0,0,0,,0,100,0,,1,6,0,,0,,1,0,,0,,,,0,0,0,0,0,0,,,,,0,0,
+`
+
+// collect gathers the metrics of the collector keyed by name and labels, which are
+// sorted by label name.
+func collect(t *testing.T, collector prometheus.Collector) map[string]float64 {
+	ch := make(chan prometheus.Metric)
+	go func() {
+		collector.Collect(ch)
+		close(ch)
+	}()
+
+	values := map[string]float64{}
+	for metric := range ch {
+		m := &dto.Metric{}
+		if err := metric.Write(m); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		key := metric.Desc().String()
+		// the fqName is the first field of the description
+		fmt.Sscanf(key, "Desc{fqName: %q", &key)
+		for _, label := range m.Label {
+			key += fmt.Sprintf(",%s=%s", label.GetName(), label.GetValue())
+		}
+		switch {
+		case m.Gauge != nil:
+			values[key] = m.Gauge.GetValue()
+		case m.Counter != nil:
+			values[key] = m.Counter.GetValue()
+		}
+	}
+	return values
+}
+
+func TestHAProxyCollector(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/;csv" {
+			t.Errorf("unexpected path: %s", req.URL.Path)
+		}
+		if user, pass, ok := req.BasicAuth(); !ok || user != "admin" || pass != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, sampleStats)
+	}))
+	defer server.Close()
+
+	collector, err := NewHAProxyCollector("http://admin:secret@" + server.Listener.Addr().String())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	values := collect(t, collector)
+
+	expected := map[string]float64{
+		"haproxy_up": 1,
+		"haproxy_backend_up,namespace=ns1,route=route1":                                                                        1,
+		"haproxy_backend_current_queue,namespace=ns1,route=route1":                                                             2,
+		"haproxy_backend_current_session_rate,namespace=ns1,route=route1":                                                      5,
+		"haproxy_backend_sessions_total,namespace=ns1,route=route1":                                                            27,
+		"haproxy_backend_current_http_request_rate,namespace=ns1,route=route1":                                                 4,
+		"haproxy_backend_http_requests_total,namespace=ns1,route=route1":                                                       25,
+		"haproxy_backend_http_responses_total,code=2xx,namespace=ns1,route=route1":                                             20,
+		"haproxy_backend_http_responses_total,code=4xx,namespace=ns1,route=route1":                                             3,
+		"haproxy_backend_up,namespace=ns2,route=route2":                                                                        0,
+		"haproxy_server_up,namespace=ns1,route=route1,server=pod:frontend-1:frontend:10.1.0.2:8080":                            1,
+		"haproxy_server_up,namespace=ns1,route=route1,server=pod:frontend-2:frontend:10.1.0.3:8080":                            0,
+		"haproxy_server_sessions_total,namespace=ns1,route=route1,server=pod:frontend-1:frontend:10.1.0.2:8080":                20,
+		"haproxy_server_http_responses_total,code=3xx,namespace=ns1,route=route1,server=pod:frontend-2:frontend:10.1.0.3:8080": 2,
+	}
+	for key, value := range expected {
+		actual, ok := values[key]
+		if !ok {
+			t.Errorf("expected metric %s, got %v", key, values)
+			continue
+		}
+		if actual != value {
+			t.Errorf("expected %s to be %v, got %v", key, value, actual)
+		}
+	}
+	for key := range values {
+		if strings.Contains(key, "openshift") || strings.Contains(key, "namespace=,") {
+			t.Errorf("unexpected metric for a backend that does not serve a route: %s", key)
+		}
+	}
+	if _, ok := values["haproxy_backend_http_responses_total,code=2xx,namespace=ns2,route=route2"]; ok {
+		t.Errorf("expected no response metrics for a TCP backend")
+	}
+	if _, ok := values["haproxy_backend_http_requests_total,namespace=ns2,route=route2"]; ok {
+		t.Errorf("expected no request metrics for a TCP backend")
+	}
+}
+
+func TestHAProxyCollectorScrapeFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	collector, err := NewHAProxyCollector(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	values := collect(t, collector)
+	if values["haproxy_up"] != 0 || values["haproxy_scrape_failures_total"] != 1 {
+		t.Errorf("expected the scrape to fail: %v", values)
+	}
+}
+
+func TestNewHAProxyCollectorInvalidSource(t *testing.T) {
+	for _, source := range []string{"/var/lib/haproxy/run/haproxy.sock", "ftp://localhost/stats", "%zz"} {
+		if _, err := NewHAProxyCollector(source); err == nil {
+			t.Errorf("expected an error for source %q", source)
+		}
+	}
+}
+
+func TestRouteForBackend(t *testing.T) {
+	tests := []struct {
+		backend   string
+		namespace string
+		name      string
+		ok        bool
+	}{
+		{backend: "be_http_ns1_route1", namespace: "ns1", name: "route1", ok: true},
+		{backend: "be_edge_http_my-ns_my-route", namespace: "my-ns", name: "my-route", ok: true},
+		{backend: "be_secure_ns1_route1", namespace: "ns1", name: "route1", ok: true},
+		{backend: "be_tcp_ns1_route1", namespace: "ns1", name: "route1", ok: true},
+		{backend: "be_sni"},
+		{backend: "be_http_ns1"},
+		{backend: "openshift_default"},
+	}
+	for _, test := range tests {
+		namespace, name, ok := routeForBackend(test.backend)
+		if namespace != test.namespace || name != test.name || ok != test.ok {
+			t.Errorf("%s: expected (%q, %q, %t), got (%q, %q, %t)", test.backend, test.namespace, test.name, test.ok, namespace, name, ok)
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package oauthclient
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/RangelReale/osin"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kapierrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/auth/user"
+	"k8s.io/kubernetes/pkg/controller/serviceaccount"
+
+	"github.com/openshift/origin/pkg/auth/api"
+	"github.com/openshift/origin/pkg/auth/oauth/handlers"
+	authorizerscope "github.com/openshift/origin/pkg/authorization/authorizer/scope"
+	oauthapi "github.com/openshift/origin/pkg/oauth/api"
+	"github.com/openshift/origin/pkg/oauth/registry/oauthclient"
+	"github.com/openshift/origin/pkg/oauth/scope"
+	"github.com/openshift/origin/pkg/oauth/server/osinserver"
+)
+
+const (
+	// OAuthRedirectURIAnnotationPrefix is the prefix of the service account annotations whose values are
+	// the redirect URIs of the service account as an OAuth client, for instance
+	// serviceaccounts.openshift.io/oauth-redirecturi.dashboard: https://dashboard.example.com/callback
+	OAuthRedirectURIAnnotationPrefix = "serviceaccounts.openshift.io/oauth-redirecturi."
+)
+
+type saOAuthClientAdapter struct {
+	oauthclient.Registry
+
+	tokens serviceaccount.ServiceAccountTokenGetter
+}
+
+// NewServiceAccountOAuthClientRegistry returns an oauthclient.Registry that serves the service accounts
+// which have redirect URI annotations as OAuth clients, named after their user name
+// (system:serviceaccount:<namespace>:<name>). Other clients are read from delegate.
+func NewServiceAccountOAuthClientRegistry(delegate oauthclient.Registry, tokens serviceaccount.ServiceAccountTokenGetter) oauthclient.Registry {
+	return &saOAuthClientAdapter{Registry: delegate, tokens: tokens}
+}
+
+// GetClient returns the OAuth client for a service account. Its secret is the first API token of the
+// service account.
+func (a *saOAuthClientAdapter) GetClient(ctx kapi.Context, name string) (*oauthapi.OAuthClient, error) {
+	namespace, saName, err := serviceaccount.SplitUsername(name)
+	if err != nil {
+		return a.Registry.GetClient(ctx, name)
+	}
+
+	sa, err := a.tokens.GetServiceAccount(namespace, saName)
+	if err != nil {
+		return nil, err
+	}
+	redirectURIs := RedirectURIs(sa)
+	if len(redirectURIs) == 0 {
+		return nil, kapierrors.NewNotFound("OAuthClient", name)
+	}
+	token, err := a.token(sa)
+	if err != nil {
+		return nil, err
+	}
+
+	return &oauthapi.OAuthClient{
+		ObjectMeta:   kapi.ObjectMeta{Name: name},
+		Secret:       token,
+		RedirectURIs: redirectURIs,
+	}, nil
+}
+
+type saOnlyOAuthClientAdapter struct {
+	oauthclient.Registry
+}
+
+// NewServiceAccountOnlyOAuthClientRegistry returns an oauthclient.Registry that only serves the service
+// account clients of delegate. Other clients are not found.
+func NewServiceAccountOnlyOAuthClientRegistry(delegate oauthclient.Registry) oauthclient.Registry {
+	return &saOnlyOAuthClientAdapter{Registry: delegate}
+}
+
+// GetClient returns the OAuth client for a service account
+func (a *saOnlyOAuthClientAdapter) GetClient(ctx kapi.Context, name string) (*oauthapi.OAuthClient, error) {
+	if _, _, err := serviceaccount.SplitUsername(name); err != nil {
+		return nil, kapierrors.NewNotFound("OAuthClient", name)
+	}
+	return a.Registry.GetClient(ctx, name)
+}
+
+// token returns the first API token of the service account
+func (a *saOAuthClientAdapter) token(sa *kapi.ServiceAccount) (string, error) {
+	for _, ref := range sa.Secrets {
+		secret, err := a.tokens.GetSecret(sa.Namespace, ref.Name)
+		if kapierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return "", err
+		}
+		if !serviceaccount.IsServiceAccountToken(secret, sa) {
+			continue
+		}
+		if token := secret.Data[kapi.ServiceAccountTokenKey]; len(token) > 0 {
+			return string(token), nil
+		}
+	}
+	return "", fmt.Errorf("service account %s/%s has no API token to use as its OAuth client secret", sa.Namespace, sa.Name)
+}
+
+// RedirectURIs returns the OAuth redirect URIs of a service account, ordered by annotation name
+func RedirectURIs(sa *kapi.ServiceAccount) []string {
+	keys := []string{}
+	for key, value := range sa.Annotations {
+		if strings.HasPrefix(key, OAuthRedirectURIAnnotationPrefix) && len(value) > 0 {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	redirectURIs := []string{}
+	for _, key := range keys {
+		redirectURIs = append(redirectURIs, sa.Annotations[key])
+	}
+	return redirectURIs
+}
+
+// ValidateScopes returns an error if a service account client requests scopes outside of its
+// namespace. Service account clients may only request user:info, user:check-access, and roles in
+// their own namespace. Other clients are not restricted.
+func ValidateScopes(clientID string, scopes []string) error {
+	namespace, _, err := serviceaccount.SplitUsername(clientID)
+	if err != nil {
+		return nil
+	}
+	if len(scopes) == 0 {
+		return fmt.Errorf("service account client %s must request scopes", clientID)
+	}
+	for _, s := range scopes {
+		switch s {
+		case authorizerscope.UserInfo, authorizerscope.UserAccessCheck:
+			continue
+		}
+		_, roleNamespace, err := authorizerscope.ParseClusterRoleScope(s)
+		if err != nil {
+			return fmt.Errorf("service account client %s may not request scope %q", clientID, s)
+		}
+		if roleNamespace != namespace {
+			return fmt.Errorf("service account client %s may only request roles in namespace %s, not %q", clientID, namespace, s)
+		}
+	}
+	return nil
+}
+
+type scopeRestriction struct{}
+
+// NewScopeRestriction returns an authorize handler that rejects the requests of service account clients
+// for scopes they may not be granted.
+func NewScopeRestriction() osinserver.AuthorizeHandler {
+	return scopeRestriction{}
+}
+
+// HandleAuthorize implements osinserver.AuthorizeHandler
+func (scopeRestriction) HandleAuthorize(ar *osin.AuthorizeRequest, w http.ResponseWriter) (bool, error) {
+	if err := ValidateScopes(ar.Client.GetId(), scope.Split(ar.Scope)); err != nil {
+		return false, osinserver.NewInvalidScopeError(err.Error())
+	}
+	return false, nil
+}
+
+type saGrantHandler struct {
+	prompt   handlers.GrantHandler
+	standard handlers.GrantHandler
+}
+
+// NewServiceAccountGrantHandler returns a grant handler that always asks the user to approve the grants
+// of service account clients with prompt, whatever the grant method of the cluster is. The grants of
+// other clients are handled by standard.
+func NewServiceAccountGrantHandler(prompt, standard handlers.GrantHandler) handlers.GrantHandler {
+	return &saGrantHandler{prompt: prompt, standard: standard}
+}
+
+// GrantNeeded implements handlers.GrantHandler
+func (h *saGrantHandler) GrantNeeded(user user.Info, grant *api.Grant, w http.ResponseWriter, req *http.Request) (bool, bool, error) {
+	if _, _, err := serviceaccount.SplitUsername(grant.Client.GetId()); err == nil {
+		return h.prompt.GrantNeeded(user, grant, w, req)
+	}
+	return h.standard.GrantNeeded(user, grant, w, req)
+}
@@ -0,0 +1,216 @@
+package oauthclient
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/RangelReale/osin"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kapierrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/auth/user"
+
+	"github.com/openshift/origin/pkg/auth/api"
+	oauthapi "github.com/openshift/origin/pkg/oauth/api"
+	"github.com/openshift/origin/pkg/oauth/registry/test"
+	"github.com/openshift/origin/pkg/oauth/server/osinserver"
+)
+
+type fakeTokenGetter struct {
+	serviceAccounts map[string]*kapi.ServiceAccount
+	secrets         map[string]*kapi.Secret
+}
+
+func (g *fakeTokenGetter) GetServiceAccount(namespace, name string) (*kapi.ServiceAccount, error) {
+	if sa, ok := g.serviceAccounts[namespace+"/"+name]; ok {
+		return sa, nil
+	}
+	return nil, kapierrors.NewNotFound("ServiceAccount", name)
+}
+
+func (g *fakeTokenGetter) GetSecret(namespace, name string) (*kapi.Secret, error) {
+	if secret, ok := g.secrets[namespace+"/"+name]; ok {
+		return secret, nil
+	}
+	return nil, kapierrors.NewNotFound("Secret", name)
+}
+
+func tokenSecret(name, saName, token string) *kapi.Secret {
+	return &kapi.Secret{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:        name,
+			Namespace:   "myproject",
+			Annotations: map[string]string{kapi.ServiceAccountNameKey: saName},
+		},
+		Type: kapi.SecretTypeServiceAccountToken,
+		Data: map[string][]byte{kapi.ServiceAccountTokenKey: []byte(token)},
+	}
+}
+
+func TestGetClient(t *testing.T) {
+	getter := &fakeTokenGetter{
+		serviceAccounts: map[string]*kapi.ServiceAccount{
+			"myproject/dashboard": {
+				ObjectMeta: kapi.ObjectMeta{
+					Name:      "dashboard",
+					Namespace: "myproject",
+					Annotations: map[string]string{
+						OAuthRedirectURIAnnotationPrefix + "b": "https://b.example.com/callback",
+						OAuthRedirectURIAnnotationPrefix + "a": "https://a.example.com/callback",
+						"other":                                "https://other.example.com",
+					},
+				},
+				Secrets: []kapi.ObjectReference{{Name: "missing"}, {Name: "dockercfg"}, {Name: "dashboard-token"}},
+			},
+			"myproject/plain": {
+				ObjectMeta: kapi.ObjectMeta{Name: "plain", Namespace: "myproject"},
+				Secrets:    []kapi.ObjectReference{{Name: "plain-token"}},
+			},
+			"myproject/tokenless": {
+				ObjectMeta: kapi.ObjectMeta{
+					Name:        "tokenless",
+					Namespace:   "myproject",
+					Annotations: map[string]string{OAuthRedirectURIAnnotationPrefix + "a": "https://a.example.com/callback"},
+				},
+			},
+		},
+		secrets: map[string]*kapi.Secret{
+			"myproject/dockercfg":       {ObjectMeta: kapi.ObjectMeta{Name: "dockercfg", Namespace: "myproject"}, Type: kapi.SecretTypeDockercfg},
+			"myproject/dashboard-token": tokenSecret("dashboard-token", "dashboard", "dashboard-secret"),
+			"myproject/plain-token":     tokenSecret("plain-token", "plain", "plain-secret"),
+		},
+	}
+	delegate := &test.ClientRegistry{Client: &oauthapi.OAuthClient{ObjectMeta: kapi.ObjectMeta{Name: "regular"}}}
+	registry := NewServiceAccountOAuthClientRegistry(delegate, getter)
+	ctx := kapi.NewContext()
+
+	client, err := registry.GetClient(ctx, "system:serviceaccount:myproject:dashboard")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if client.Name != "system:serviceaccount:myproject:dashboard" {
+		t.Errorf("Expected the service account user name as the client name, got %s", client.Name)
+	}
+	if client.Secret != "dashboard-secret" {
+		t.Errorf("Expected the service account token as the secret, got %q", client.Secret)
+	}
+	if expected := []string{"https://a.example.com/callback", "https://b.example.com/callback"}; !reflect.DeepEqual(client.RedirectURIs, expected) {
+		t.Errorf("Expected redirect URIs %v, got %v", expected, client.RedirectURIs)
+	}
+
+	if _, err := registry.GetClient(ctx, "system:serviceaccount:myproject:plain"); !kapierrors.IsNotFound(err) {
+		t.Errorf("Expected a service account without redirect URIs not to be found, got %v", err)
+	}
+	if _, err := registry.GetClient(ctx, "system:serviceaccount:myproject:missing"); !kapierrors.IsNotFound(err) {
+		t.Errorf("Expected a missing service account not to be found, got %v", err)
+	}
+	if _, err := registry.GetClient(ctx, "system:serviceaccount:myproject:tokenless"); err == nil {
+		t.Errorf("Expected an error for a service account without a token")
+	}
+
+	client, err = registry.GetClient(ctx, "regular")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if client.Name != "regular" {
+		t.Errorf("Expected the delegate client, got %#v", client)
+	}
+}
+
+func TestValidateScopes(t *testing.T) {
+	tests := []struct {
+		clientID  string
+		scopes    []string
+		expectErr bool
+	}{
+		{clientID: "regular", scopes: []string{}},
+		{clientID: "regular", scopes: []string{"role:admin:*"}},
+		{clientID: "system:serviceaccount:myproject:dashboard", scopes: []string{"user:info", "user:check-access", "role:view:myproject"}},
+		{clientID: "system:serviceaccount:myproject:dashboard", scopes: []string{}, expectErr: true},
+		{clientID: "system:serviceaccount:myproject:dashboard", scopes: []string{"user:full"}, expectErr: true},
+		{clientID: "system:serviceaccount:myproject:dashboard", scopes: []string{"role:view:other"}, expectErr: true},
+		{clientID: "system:serviceaccount:myproject:dashboard", scopes: []string{"role:view:*"}, expectErr: true},
+	}
+
+	for _, tc := range tests {
+		err := ValidateScopes(tc.clientID, tc.scopes)
+		if (err != nil) != tc.expectErr {
+			t.Errorf("%s %v: expected error %v, got %v", tc.clientID, tc.scopes, tc.expectErr, err)
+		}
+	}
+}
+
+func TestServiceAccountOnlyOAuthClientRegistry(t *testing.T) {
+	delegate := &test.ClientRegistry{Client: &oauthapi.OAuthClient{ObjectMeta: kapi.ObjectMeta{Name: "client"}}}
+	registry := NewServiceAccountOnlyOAuthClientRegistry(delegate)
+	ctx := kapi.NewContext()
+
+	if _, err := registry.GetClient(ctx, "regular"); !kapierrors.IsNotFound(err) {
+		t.Errorf("Expected a regular client not to be found, got %v", err)
+	}
+	if _, err := registry.GetClient(ctx, "system:serviceaccount:myproject:dashboard"); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestScopeRestriction(t *testing.T) {
+	tests := []struct {
+		clientID  string
+		scope     string
+		expectErr bool
+	}{
+		{clientID: "regular", scope: "user:full"},
+		{clientID: "system:serviceaccount:myproject:dashboard", scope: "user:info role:view:myproject"},
+		{clientID: "system:serviceaccount:myproject:dashboard", scope: "user:full", expectErr: true},
+		{clientID: "system:serviceaccount:myproject:dashboard", scope: "role:view:other", expectErr: true},
+	}
+
+	for _, tc := range tests {
+		ar := &osin.AuthorizeRequest{Client: &osin.DefaultClient{Id: tc.clientID}, Scope: tc.scope}
+		handled, err := NewScopeRestriction().HandleAuthorize(ar, httptest.NewRecorder())
+		if handled {
+			t.Errorf("%s %q: unexpected handled request", tc.clientID, tc.scope)
+		}
+		if !tc.expectErr {
+			if err != nil {
+				t.Errorf("%s %q: unexpected error: %v", tc.clientID, tc.scope, err)
+			}
+			continue
+		}
+		if authorizeErr, ok := err.(*osinserver.AuthorizeError); !ok || authorizeErr.Code != osin.E_INVALID_SCOPE {
+			t.Errorf("%s %q: expected an invalid_scope error, got %#v", tc.clientID, tc.scope, err)
+		}
+	}
+}
+
+type fakeGrantHandler struct {
+	called bool
+}
+
+func (h *fakeGrantHandler) GrantNeeded(user user.Info, grant *api.Grant, w http.ResponseWriter, req *http.Request) (bool, bool, error) {
+	h.called = true
+	return false, true, nil
+}
+
+func TestServiceAccountGrantHandler(t *testing.T) {
+	tests := []struct {
+		clientID     string
+		expectPrompt bool
+	}{
+		{clientID: "regular", expectPrompt: false},
+		{clientID: "system:serviceaccount:myproject:dashboard", expectPrompt: true},
+	}
+
+	for _, tc := range tests {
+		prompt, standard := &fakeGrantHandler{}, &fakeGrantHandler{}
+		grant := &api.Grant{Client: &osin.DefaultClient{Id: tc.clientID}}
+		if _, _, err := NewServiceAccountGrantHandler(prompt, standard).GrantNeeded(&user.DefaultInfo{Name: "user"}, grant, httptest.NewRecorder(), &http.Request{}); err != nil {
+			t.Errorf("%s: unexpected error: %v", tc.clientID, err)
+		}
+		if prompt.called != tc.expectPrompt || standard.called == tc.expectPrompt {
+			t.Errorf("%s: expected prompt %v, got prompt %v and standard %v", tc.clientID, tc.expectPrompt, prompt.called, standard.called)
+		}
+	}
+}
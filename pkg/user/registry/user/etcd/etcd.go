@@ -5,6 +5,7 @@ import (
 
 	kapi "k8s.io/kubernetes/pkg/api"
 	kerrs "k8s.io/kubernetes/pkg/api/errors"
+	kuser "k8s.io/kubernetes/pkg/auth/user"
 	"k8s.io/kubernetes/pkg/fields"
 	"k8s.io/kubernetes/pkg/labels"
 	"k8s.io/kubernetes/pkg/registry/generic"
@@ -14,7 +15,9 @@ import (
 	"k8s.io/kubernetes/pkg/util/sets"
 	"k8s.io/kubernetes/pkg/util/validation/field"
 
+	authapi "github.com/openshift/origin/pkg/auth/api"
 	"github.com/openshift/origin/pkg/cmd/server/bootstrappolicy"
+	"github.com/openshift/origin/pkg/oauth/scope"
 	"github.com/openshift/origin/pkg/user/api"
 	"github.com/openshift/origin/pkg/user/api/validation"
 	"github.com/openshift/origin/pkg/user/registry/user"
@@ -73,19 +76,19 @@ func (r *REST) Get(ctx kapi.Context, name string) (runtime.Object, error) {
 		if ok, _ := validation.ValidateUserName(name, false); !ok {
 			// The user the authentication layer has identified cannot possibly be a persisted user
 			// Return an API representation of the virtual user
-			return &api.User{ObjectMeta: kapi.ObjectMeta{Name: name}, Groups: contextGroups.List()}, nil
+			return withScopes(&api.User{ObjectMeta: kapi.ObjectMeta{Name: name}, Groups: contextGroups.List()}, user), nil
 		}
 
 		obj, err := r.Etcd.Get(ctx, name)
 		if err == nil {
-			return obj, nil
+			return withScopes(obj.(*api.User), user), nil
 		}
 
 		if !kerrs.IsNotFound(err) {
 			return nil, err
 		}
 
-		return &api.User{ObjectMeta: kapi.ObjectMeta{Name: name}, Groups: contextGroups.List()}, nil
+		return withScopes(&api.User{ObjectMeta: kapi.ObjectMeta{Name: name}, Groups: contextGroups.List()}, user), nil
 	}
 
 	if ok, details := validation.ValidateUserName(name, false); !ok {
@@ -94,3 +97,26 @@ func (r *REST) Get(ctx kapi.Context, name string) (runtime.Object, error) {
 
 	return r.Etcd.Get(ctx, name)
 }
+
+// withScopes records the scopes of the token the user authenticated with on a copy of the user, so that
+// servers that authenticate tokens by fetching users/~ can enforce them.
+func withScopes(u *api.User, info kuser.Info) *api.User {
+	scoped, ok := info.(authapi.ScopedUserInfo)
+	if !ok || len(scoped.GetScopes()) == 0 {
+		if _, exists := u.Annotations[authapi.ScopesAnnotation]; !exists {
+			return u
+		}
+	}
+
+	copied := *u
+	copied.Annotations = map[string]string{}
+	for k, v := range u.Annotations {
+		if k != authapi.ScopesAnnotation {
+			copied.Annotations[k] = v
+		}
+	}
+	if ok && len(scoped.GetScopes()) > 0 {
+		copied.Annotations[authapi.ScopesAnnotation] = scope.Join(scoped.GetScopes())
+	}
+	return &copied
+}
@@ -0,0 +1,63 @@
+package etcd
+
+import (
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kuser "k8s.io/kubernetes/pkg/auth/user"
+
+	authapi "github.com/openshift/origin/pkg/auth/api"
+	"github.com/openshift/origin/pkg/user/api"
+)
+
+func TestGetCurrentUserScopes(t *testing.T) {
+	storage := NewREST(nil)
+
+	tests := []struct {
+		name     string
+		user     kuser.Info
+		expected string
+	}{
+		{
+			name: "unscoped",
+			user: &kuser.DefaultInfo{Name: "system:admin"},
+		},
+		{
+			name:     "scoped",
+			user:     &authapi.DefaultScopedUserInfo{DefaultInfo: kuser.DefaultInfo{Name: "system:admin"}, Scopes: []string{"user:info", "role:view:ns"}},
+			expected: "user:info role:view:ns",
+		},
+	}
+
+	for _, tc := range tests {
+		obj, err := storage.Get(kapi.WithUser(kapi.NewContext(), tc.user), "~")
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tc.name, err)
+			continue
+		}
+		user := obj.(*api.User)
+		if user.Name != "system:admin" {
+			t.Errorf("%s: expected the current user, got %#v", tc.name, user)
+		}
+		if scopes, ok := user.Annotations[authapi.ScopesAnnotation]; scopes != tc.expected || ok != (len(tc.expected) > 0) {
+			t.Errorf("%s: expected scopes %q, got %v", tc.name, tc.expected, user.Annotations)
+		}
+	}
+}
+
+func TestWithScopesReplacesStoredScopes(t *testing.T) {
+	stored := &api.User{ObjectMeta: kapi.ObjectMeta{Name: "bob", Annotations: map[string]string{authapi.ScopesAnnotation: "user:full", "other": "value"}}}
+
+	user := withScopes(stored, &kuser.DefaultInfo{Name: "bob"})
+	if _, ok := user.Annotations[authapi.ScopesAnnotation]; ok || user.Annotations["other"] != "value" {
+		t.Errorf("Expected only the stored scopes to be removed, got %v", user.Annotations)
+	}
+
+	user = withScopes(stored, &authapi.DefaultScopedUserInfo{DefaultInfo: kuser.DefaultInfo{Name: "bob"}, Scopes: []string{"user:info"}})
+	if user.Annotations[authapi.ScopesAnnotation] != "user:info" {
+		t.Errorf("Expected the scopes of the token, got %v", user.Annotations)
+	}
+	if stored.Annotations[authapi.ScopesAnnotation] != "user:full" {
+		t.Errorf("Expected the stored user not to be modified, got %v", stored.Annotations)
+	}
+}
@@ -48,6 +48,16 @@ type passthroughRoute struct {
 	poolname string
 }
 
+// wildcardRoute represents a wildcard route for the F5 router's internal state.
+// The wildcard iRule looks the subdomain and path of requests up in a
+// data-group that maps them to poolnames; as for passthrough routes, a second
+// data-group maps routename to that key so that we can reconstruct the state
+// when initializing the router.
+type wildcardRoute struct {
+	key      string
+	poolname string
+}
+
 // f5LTM represents an F5 BIG-IP instance.
 type f5LTM struct {
 	// f5LTMCfg contains the configuration parameters for an F5 BIG-IP instance.
@@ -64,6 +74,10 @@ type f5LTM struct {
 
 	// passthroughRoutes maps routename to passthroughroute{hostname, poolname}.
 	passthroughRoutes map[string]passthroughRoute
+
+	// wildcardRoutes maps policy name to a map of routename to
+	// wildcardRoute{key, poolname}.
+	wildcardRoutes map[string]map[string]wildcardRoute
 }
 
 // f5LTMCfg holds configuration for connecting to and issueing iControl
@@ -126,6 +140,64 @@ const (
 	// iRule.
 	sslPassthroughIRuleName = "openshift_passthrough_irule"
 
+	// wildcardInsecureRoutesDataGroupName and wildcardSecureRoutesDataGroupName
+	// are the names of the datagroups that map the names of insecure and secure
+	// wildcard routes to the keys used by wildcardIRule.
+	wildcardInsecureRoutesDataGroupName = "wildcard_insecure_route_dg"
+	wildcardSecureRoutesDataGroupName   = "wildcard_secure_route_dg"
+
+	// wildcardInsecureHostsDataGroupName and wildcardSecureHostsDataGroupName
+	// are the names of the datagroups that will be used by wildcardIRule for
+	// routing insecure and secure wildcard routes.
+	wildcardInsecureHostsDataGroupName = "wildcard_insecure_servername_dg"
+	wildcardSecureHostsDataGroupName   = "wildcard_secure_servername_dg"
+
+	// wildcardIRuleName is the name assigned to the wildcardIRule iRule.
+	wildcardIRuleName = "openshift_wildcard_irule"
+
+	// wildcardIRule is an iRule that routes requests that no policy rule
+	// matched to the pool of the wildcard route for the subdomain of the
+	// requested host, if one exists.  A wildcard route for *.example.com
+	// matches hosts with exactly one label in front of example.com; policy
+	// conditions can only compare prefixes and suffixes, which would also
+	// match a.b.example.com.  The datagroups are keyed by the subdomain
+	// followed by the path of the route, and the longest matching path wins.
+	//
+	// As for sslPassthroughIRule, the code must not use the <, >, and &
+	// characters.
+	wildcardIRule = `
+when HTTP_REQUEST {
+  # Routes for exact hostnames are matched by the policies and take
+  # precedence over wildcard routes.
+  if { [llength [POLICY::names matched]] != 0 } {
+    return
+  }
+
+  # The port is not part of the hostname.
+  set host [string tolower [lindex [split [HTTP::host] ":"] 0]]
+  set labels [split $host "."]
+  if { [llength $labels] == 1 } {
+    return
+  }
+  set subdomain [join [lrange $labels 1 end] "."]
+
+  if { [PROFILE::exists clientssl] } {
+    set datagroup wildcard_secure_servername_dg
+  } else {
+    set datagroup wildcard_insecure_servername_dg
+  }
+
+  set segments [split [HTTP::path] "/"]
+  for { set i [llength $segments] } { $i != 0 } { incr i -1 } {
+    set key "$subdomain[join [lrange $segments 0 [expr {$i - 1}]] "/"]"
+    if { [class match $key equals $datagroup] } {
+      pool [class match -value $key equals $datagroup]
+      return
+    }
+  }
+}
+`
+
 	// sslPassthroughIRule is an iRule that examines the servername in TLS
 	// connections and routes requests to the corresponding pool if one exists.
 	//
@@ -337,8 +409,9 @@ func newF5LTM(cfg f5LTMCfg) (*f5LTM, error) {
 			insecure:      cfg.insecure,
 			partitionPath: partitionPath,
 		},
-		poolMembers: map[string]map[string]bool{},
-		routes:      map[string]map[string]bool{},
+		poolMembers:    map[string]map[string]bool{},
+		routes:         map[string]map[string]bool{},
+		wildcardRoutes: map[string]map[string]wildcardRoute{},
 	}
 
 	return router, nil
@@ -670,7 +743,7 @@ func (f5 *f5LTM) ensureVserverHasIRule(vserverName, iRuleName string) error {
 	glog.V(4).Infof("Adding iRule %s to vserver %s...", iRuleName, vserverName)
 
 	vserverRulesPayload := f5VserverIRules{
-		Rules: []string{sslPassthroughIRuleName},
+		Rules: append(res.Rules, commonIRuleName),
 	}
 
 	err = f5.patch(vserverUrl, vserverRulesPayload, nil)
@@ -775,8 +848,8 @@ func (f5 *f5LTM) ensurePartitionPathExists(pathName string) error {
 
 // Initialize ensures that OpenShift-specific configuration is in place on the
 // F5 BIG-IP host.  In particular, Initialize creates policies for HTTP and
-// HTTPS traffic, as well as an iRule and data-groups for passthrough routes,
-// and associates these objects with the appropriate vservers, if necessary.
+// HTTPS traffic, as well as iRules and data-groups for passthrough and wildcard
+// routes, and associates these objects with the appropriate vservers, if necessary.
 func (f5 *f5LTM) Initialize() error {
 	err := f5.ensurePartitionPathExists(f5.partitionPath)
 	if err != nil {
@@ -827,6 +900,32 @@ func (f5 *f5LTM) Initialize() error {
 		}
 	}
 
+	for _, datagroupName := range []string{
+		wildcardInsecureRoutesDataGroupName, wildcardInsecureHostsDataGroupName,
+		wildcardSecureRoutesDataGroupName, wildcardSecureHostsDataGroupName,
+	} {
+		err = f5.ensureDatagroupExists(datagroupName)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = f5.ensureIRuleExists(wildcardIRuleName, wildcardIRule)
+	if err != nil {
+		return err
+	}
+
+	for _, vserverName := range []string{f5.httpVserver, f5.httpsVserver} {
+		if vserverName == "" {
+			continue
+		}
+
+		err = f5.ensureVserverHasIRule(vserverName, wildcardIRuleName)
+		if err != nil {
+			return err
+		}
+	}
+
 	glog.V(4).Infof("F5 initialization is complete.")
 
 	return nil
@@ -834,13 +933,25 @@ func (f5 *f5LTM) Initialize() error {
 
 // CreatePool creates a pool named poolname on F5 BIG-IP.
 func (f5 *f5LTM) CreatePool(poolname string) error {
+	return f5.createPool(poolname, "round-robin")
+}
+
+// CreateRatioPool creates a pool named poolname on F5 BIG-IP that distributes
+// traffic over its members according to their ratios.
+func (f5 *f5LTM) CreateRatioPool(poolname string) error {
+	return f5.createPool(poolname, "ratio-member")
+}
+
+// createPool creates a pool named poolname with the given load balancing mode
+// on F5 BIG-IP.
+func (f5 *f5LTM) createPool(poolname, mode string) error {
 	url := fmt.Sprintf("https://%s/mgmt/tm/ltm/pool", f5.host)
 
 	// The http monitor is still used from the /Common partition.
 	// From @Miciah: In the future, we should allow the administrator
 	// to specify a different monitor to use.
 	payload := f5Pool{
-		Mode:    "round-robin",
+		Mode:    mode,
 		Monitor: "/Common/http",
 		Name:    poolname,
 	}
@@ -977,6 +1088,58 @@ func (f5 *f5LTM) AddPoolMember(poolname, member string) error {
 	return nil
 }
 
+// SetPoolMemberRatio adds the given member with the given ratio to the
+// specified pool on F5 BIG-IP, or updates the ratio of the member if the pool
+// already has it, and updates f5.poolMembers[poolname].
+func (f5 *f5LTM) SetPoolMemberRatio(poolname, member string, ratio int32) error {
+	hasMember, err := f5.PoolHasMember(poolname, member)
+	if err != nil {
+		return err
+	}
+
+	if hasMember {
+		glog.V(4).Infof("Setting ratio of pool member %s in pool %s to %d.",
+			member, poolname, ratio)
+
+		url := fmt.Sprintf("https://%s/mgmt/tm/ltm/pool/%s/members/%s",
+			f5.host, poolname, member)
+
+		payload := f5PoolMemberRatio{
+			Ratio: ratio,
+		}
+
+		return f5.patch(url, payload, nil)
+	}
+
+	glog.V(4).Infof("Adding pool member %s with ratio %d to pool %s.",
+		member, ratio, poolname)
+
+	url := fmt.Sprintf("https://%s/mgmt/tm/ltm/pool/%s/members",
+		f5.host, poolname)
+
+	payload := f5PoolMember{
+		Name:  member,
+		Ratio: ratio,
+	}
+
+	err = f5.post(url, payload, nil)
+	if err != nil {
+		return err
+	}
+
+	members, err := f5.GetPoolMembers(poolname)
+	if err != nil {
+		return err
+	}
+
+	members[member] = true
+
+	glog.V(4).Infof("Added pool member %s to pool %s.",
+		member, poolname)
+
+	return nil
+}
+
 // DeletePoolMember deletes the given member from the specified pool on F5
 // BIG-IP, and updates f5.poolMembers[poolname].
 func (f5 *f5LTM) DeletePoolMember(poolname, member string) error {
@@ -1039,6 +1202,15 @@ func (f5 *f5LTM) getRoutes(policyname string) (map[string]bool, error) {
 // route.  Note that routeExists assumes that the route name will be the same
 // as the rule name.
 func (f5 *f5LTM) routeExists(policyname, routename string) (bool, error) {
+	wildcardRoutes, err := f5.getWildcardRoutes(policyname)
+	if err != nil {
+		return false, err
+	}
+
+	if _, ok := wildcardRoutes[routename]; ok {
+		return true, nil
+	}
+
 	routes, err := f5.getRoutes(policyname)
 	if err != nil {
 		return false, err
@@ -1072,7 +1244,8 @@ func (f5 *f5LTM) PassthroughRouteExists(routename string) (bool, error) {
 // addRoute adds a new rule to the specified F5 policy.  This rule will compare
 // the virtual host and URL path of incoming requests against the given hostname
 // and pathname (if one is specified).  When the rule matches a request, it will
-// route the request to the specified pool.
+// route the request to the specified pool.  Wildcard hostnames of the form
+// *.example.com are added to the data-groups of the wildcard iRule instead.
 //
 // addRoute re-uses the name of the OpenShift route as the name of the F5
 // policy rule.  The rule name must be safe to use in JSON and in URLs (for
@@ -1083,6 +1256,11 @@ func (f5 *f5LTM) PassthroughRouteExists(routename string) (bool, error) {
 // /^[a-z]([-a-z0-9]+)?$/.
 func (f5 *f5LTM) addRoute(policyname, routename, poolname, hostname,
 	pathname string) error {
+	if strings.HasPrefix(hostname, "*.") {
+		return f5.addWildcardRoute(policyname, routename, poolname,
+			hostname[2:], pathname)
+	}
+
 	success := false
 
 	rulesUrl := fmt.Sprintf("https://%s/mgmt/tm/ltm/policy/%s/rules",
@@ -1199,6 +1377,135 @@ func (f5 *f5LTM) AddSecureRoute(routename, poolname, hostname,
 	return f5.addRoute(httpsPolicyName, routename, poolname, hostname, pathname)
 }
 
+// wildcardDatagroupNames returns the names of the data-groups that map
+// routename to key and key to poolname for the wildcard routes of the specified
+// policy.
+func wildcardDatagroupNames(policyname string) (string, string) {
+	if policyname == httpsPolicyName {
+		return wildcardSecureRoutesDataGroupName, wildcardSecureHostsDataGroupName
+	}
+	return wildcardInsecureRoutesDataGroupName, wildcardInsecureHostsDataGroupName
+}
+
+// getWildcardRoutes returns f5.wildcardRoutes[policyname], first initializing
+// it from F5 if it is zero.
+func (f5 *f5LTM) getWildcardRoutes(policyname string) (map[string]wildcardRoute, error) {
+	routes, ok := f5.wildcardRoutes[policyname]
+	if ok {
+		return routes, nil
+	}
+
+	routesDatagroupName, hostsDatagroupName := wildcardDatagroupNames(policyname)
+
+	hostsUrl := fmt.Sprintf("https://%s/mgmt/tm/ltm/data-group/internal/%s",
+		f5.host, hostsDatagroupName)
+
+	hostsRes := f5Datagroup{}
+
+	err := f5.get(hostsUrl, &hostsRes)
+	if err != nil {
+		return nil, err
+	}
+
+	routesUrl := fmt.Sprintf("https://%s/mgmt/tm/ltm/data-group/internal/%s",
+		f5.host, routesDatagroupName)
+
+	routesRes := f5Datagroup{}
+
+	err = f5.get(routesUrl, &routesRes)
+	if err != nil {
+		return nil, err
+	}
+
+	hosts := map[string]string{}
+
+	for _, hostRecord := range hostsRes.Records {
+		hosts[hostRecord.Key] = hostRecord.Value
+	}
+
+	routes = map[string]wildcardRoute{}
+
+	for _, routeRecord := range routesRes.Records {
+		routename := routeRecord.Key
+		key := routeRecord.Value
+
+		poolname, foundPoolname := hosts[key]
+		if !foundPoolname {
+			glog.Warningf("%s datagroup maps route %s to %s, but %s datagroup"+
+				" does not have an entry for it to map it to a pool.  Dropping"+
+				" route %s from datagroup %s...",
+				routesDatagroupName, routename, key, hostsDatagroupName,
+				routename, routesDatagroupName)
+			continue
+		}
+
+		routes[routename] = wildcardRoute{key: key, poolname: poolname}
+	}
+
+	f5.wildcardRoutes[policyname] = routes
+
+	return routes, nil
+}
+
+// updateWildcardRoutes updates the data-groups for the wildcard routes of the
+// specified policy using the internal object's state.
+func (f5 *f5LTM) updateWildcardRoutes(policyname string) error {
+	routes, err := f5.getWildcardRoutes(policyname)
+	if err != nil {
+		return err
+	}
+
+	routesDatagroupName, hostsDatagroupName := wildcardDatagroupNames(policyname)
+
+	hostsRecords := []f5DatagroupRecord{}
+	routesRecords := []f5DatagroupRecord{}
+	for routename, route := range routes {
+		hostsRecords = append(hostsRecords,
+			f5DatagroupRecord{Key: route.key, Value: route.poolname})
+		routesRecords = append(routesRecords,
+			f5DatagroupRecord{Key: routename, Value: route.key})
+	}
+
+	hostsDatagroupUrl := fmt.Sprintf("https://%s/mgmt/tm/ltm/data-group/internal/%s",
+		f5.host, hostsDatagroupName)
+
+	err = f5.patch(hostsDatagroupUrl, f5Datagroup{Records: hostsRecords}, nil)
+	if err != nil {
+		return err
+	}
+
+	glog.V(4).Infof("Datagroup %s updated.", hostsDatagroupName)
+
+	routesDatagroupUrl := fmt.Sprintf("https://%s/mgmt/tm/ltm/data-group/internal/%s",
+		f5.host, routesDatagroupName)
+
+	err = f5.patch(routesDatagroupUrl, f5Datagroup{Records: routesRecords}, nil)
+	if err != nil {
+		return err
+	}
+
+	glog.V(4).Infof("Datagroup %s updated.", routesDatagroupName)
+
+	return nil
+}
+
+// addWildcardRoute adds the data-group records for the specified wildcard route
+// of the specified policy to F5 BIG-IP, so that requests to a host with exactly
+// one label in front of the given subdomain and to the given pathname will be
+// routed to the specified pool.
+func (f5 *f5LTM) addWildcardRoute(policyname, routename, poolname, subdomain,
+	pathname string) error {
+	routes, err := f5.getWildcardRoutes(policyname)
+	if err != nil {
+		return err
+	}
+
+	key := strings.ToLower(subdomain) + strings.TrimRight(pathname, "/")
+	routes[routename] = wildcardRoute{key: key, poolname: poolname}
+
+	return f5.updateWildcardRoutes(policyname)
+}
+
 // getPassthroughRoutes returns f5.passthroughRoutes, first initializing it from
 // F5 if it is zero.
 func (f5 *f5LTM) getPassthroughRoutes() (map[string]passthroughRoute, error) {
@@ -1344,13 +1651,24 @@ func (f5 *f5LTM) DeletePassthroughRoute(routename string) error {
 	return f5.updatePassthroughRoutes()
 }
 
-// deleteRoute deletes the F5 policy rule for the given routename from the given
-// policy.
+// deleteRoute deletes the F5 policy rule, or the data-group records of the
+// wildcard route, for the given routename from the given policy.
 func (f5 *f5LTM) deleteRoute(policyname, routename string) error {
+	wildcardRoutes, err := f5.getWildcardRoutes(policyname)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := wildcardRoutes[routename]; ok {
+		delete(wildcardRoutes, routename)
+
+		return f5.updateWildcardRoutes(policyname)
+	}
+
 	ruleUrl := fmt.Sprintf("https://%s/mgmt/tm/ltm/policy/%s/rules/%s",
 		f5.host, policyname, routename)
 
-	err := f5.delete(ruleUrl, nil)
+	err = f5.delete(ruleUrl, nil)
 	if err != nil {
 		return err
 	}
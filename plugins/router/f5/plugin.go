@@ -16,6 +16,15 @@ type F5Plugin struct {
 	// F5Client is the object that represents the F5 BIG-IP host, holds state,
 	// and provides an interface to manipulate F5 BIG-IP.
 	F5Client *f5LTM
+
+	// endpoints caches the endpoints of each service, keyed by the name of the
+	// service's pool, so that the pools of weighted routes can be rebuilt when
+	// the endpoints of any of their backends change.
+	endpoints map[string]*kapi.Endpoints
+
+	// weightedRoutes caches the routes that have alternate backends, keyed by
+	// route name.  Each of these routes has its own pool in F5 BIG-IP.
+	weightedRoutes map[string]*routeapi.Route
 }
 
 // F5PluginConfig holds configuration for the f5 plugin.
@@ -70,7 +79,12 @@ func NewF5Plugin(cfg F5PluginConfig) (*F5Plugin, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &F5Plugin{f5}, f5.Initialize()
+	plugin := &F5Plugin{
+		F5Client:       f5,
+		endpoints:      map[string]*kapi.Endpoints{},
+		weightedRoutes: map[string]*routeapi.Route{},
+	}
+	return plugin, f5.Initialize()
 }
 
 // ensurePoolExists checks whether the named pool already exists in F5 BIG-IP
@@ -169,6 +183,137 @@ func (p *F5Plugin) updatePool(poolname string, endpoints *kapi.Endpoints) error
 	return nil
 }
 
+// ensureWeightedPoolExists checks whether the pool for the given weighted
+// route already exists in F5 BIG-IP and creates it if it does not, then
+// updates the members of the pool from the route's backends.
+func (p *F5Plugin) ensureWeightedPoolExists(poolname string,
+	route *routeapi.Route) error {
+	poolExists, err := p.F5Client.PoolExists(poolname)
+	if err != nil {
+		glog.V(4).Infof("F5Client.PoolExists failed: %v", err)
+		return err
+	}
+
+	if !poolExists {
+		err = p.F5Client.CreateRatioPool(poolname)
+		if err != nil {
+			glog.V(4).Infof("Error creating pool %s: %v", poolname, err)
+			return err
+		}
+	}
+
+	p.weightedRoutes[poolname] = route
+
+	return p.updateWeightedPool(poolname, route)
+}
+
+// updateWeightedPool updates the named pool (which must already exist in F5
+// BIG-IP) with the cached endpoints of each backend of the given route, using
+// the weight of each backend as the ratio of its pool members.  Backends with
+// a weight of 0 receive no pool members.
+func (p *F5Plugin) updateWeightedPool(poolname string,
+	route *routeapi.Route) error {
+	members, err := p.F5Client.GetPoolMembers(poolname)
+	if err != nil {
+		glog.V(4).Infof("F5Client.GetPoolMembers failed: %v", err)
+		return err
+	}
+
+	// As in updatePool, assume that every pool member needs to be deleted until
+	// we find the endpoint that corresponds to it.
+	needToDelete := map[string]bool{}
+	for member := range members {
+		if members[member] {
+			needToDelete[member] = true
+		}
+	}
+
+	backends := append([]routeapi.RouteTargetReference{route.Spec.To},
+		route.Spec.AlternateBackends...)
+	for _, backend := range backends {
+		ratio := routeapi.DefaultRouteTargetWeight
+		if backend.Weight != nil {
+			ratio = *backend.Weight
+		}
+		if ratio == 0 {
+			continue
+		}
+
+		endpoints, ok := p.endpoints[poolName(route.Namespace, backend.Name)]
+		if !ok {
+			continue
+		}
+
+		for _, subset := range endpoints.Subsets {
+			for _, addr := range subset.Addresses {
+				for _, port := range subset.Ports {
+					dest := fmt.Sprintf("%s:%d", addr.IP, port.Port)
+					needToDelete[dest] = false
+					glog.V(4).Infof("  Setting %s with ratio %d...", dest, ratio)
+					err = p.F5Client.SetPoolMemberRatio(poolname, dest, ratio)
+					if err != nil {
+						glog.V(4).Infof("  Error setting endpoint %s in pool %s: %v",
+							dest, poolname, err)
+					}
+				}
+			}
+		}
+	}
+
+	for member := range needToDelete {
+		if needToDelete[member] {
+			glog.V(4).Infof("  Deleting %s...", member)
+			err = p.F5Client.DeletePoolMember(poolname, member)
+			if err != nil {
+				glog.V(4).Infof("  Error deleting endpoint %s from pool %s: %v",
+					member, poolname, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// updateWeightedRoutes updates the pools of the weighted routes that have the
+// given endpoints as one of their backends.
+func (p *F5Plugin) updateWeightedRoutes(endpoints *kapi.Endpoints) error {
+	for poolname, route := range p.weightedRoutes {
+		if route.Namespace != endpoints.Namespace {
+			continue
+		}
+
+		backends := append([]routeapi.RouteTargetReference{route.Spec.To},
+			route.Spec.AlternateBackends...)
+		for _, backend := range backends {
+			if backend.Name != endpoints.Name {
+				continue
+			}
+
+			glog.V(4).Infof("Updating endpoints for weighted pool %s", poolname)
+
+			err := p.updateWeightedPool(poolname, route)
+			if err != nil {
+				return err
+			}
+			break
+		}
+	}
+
+	return nil
+}
+
+// deleteWeightedPool deletes the pool of the named route from F5 BIG-IP if the
+// route was previously handled as a weighted route.
+func (p *F5Plugin) deleteWeightedPool(poolname string) error {
+	if _, ok := p.weightedRoutes[poolname]; !ok {
+		return nil
+	}
+
+	delete(p.weightedRoutes, poolname)
+
+	return p.deletePool(poolname)
+}
+
 // deletePool delete the named pool from F5 BIG-IP.
 func (p *F5Plugin) deletePool(poolname string) error {
 	poolExists, err := p.F5Client.PoolExists(poolname)
@@ -241,6 +386,17 @@ func (p *F5Plugin) HandleEndpoints(eventType watch.EventType,
 		// Name of the pool in F5.
 		poolname := poolName(endpoints.Namespace, endpoints.Name)
 
+		if len(endpoints.Subsets) == 0 {
+			delete(p.endpoints, poolname)
+		} else {
+			p.endpoints[poolname] = endpoints
+		}
+
+		err := p.updateWeightedRoutes(endpoints)
+		if err != nil {
+			return err
+		}
+
 		if len(endpoints.Subsets) == 0 {
 			// F5 does not permit us to delete a pool if it has a rule associated with
 			// it.  However, a pool does not necessarily have a rule associated with
@@ -480,8 +636,12 @@ func (p *F5Plugin) HandleRoute(eventType watch.EventType,
 	// Name of the pool in F5.
 	poolname := poolName(route.Namespace, route.Spec.To.Name)
 
-	// Virtual hostname for policy rule in F5.
+	// Virtual hostname for policy rule in F5.  A wildcard route matches every
+	// vhost in the subdomain of its host.
 	hostname := route.Spec.Host
+	if route.Spec.WildcardPolicy == routeapi.WildcardPolicySubdomain {
+		hostname = "*." + routeapi.WildcardSubdomain(hostname)
+	}
 
 	// Pathname for the policy rule in F5.
 	pathname := route.Spec.Path
@@ -489,6 +649,13 @@ func (p *F5Plugin) HandleRoute(eventType watch.EventType,
 	// Name for the route in F5.
 	routename := routeName(*route)
 
+	// A route with alternate backends gets its own pool, named after the route,
+	// that balances traffic over the endpoints of all of its backends.
+	weighted := len(route.Spec.AlternateBackends) > 0
+	if weighted {
+		poolname = routename
+	}
+
 	switch eventType {
 	case watch.Modified:
 		glog.V(4).Infof("Updating route %s...", routename)
@@ -500,7 +667,15 @@ func (p *F5Plugin) HandleRoute(eventType watch.EventType,
 
 		// Ensure the pool exists in case we have been told to modify a route that
 		// did not already exist.
-		err = p.ensurePoolExists(poolname)
+		if weighted {
+			err = p.ensureWeightedPoolExists(poolname, route)
+		} else {
+			// The route may have had alternate backends before this modification.
+			err = p.deleteWeightedPool(routename)
+			if err == nil {
+				err = p.ensurePoolExists(poolname)
+			}
+		}
 		if err != nil {
 			return err
 		}
@@ -517,7 +692,12 @@ func (p *F5Plugin) HandleRoute(eventType watch.EventType,
 			return err
 		}
 
-		err = p.deletePoolIfEmpty(poolname)
+		if weighted {
+			delete(p.weightedRoutes, poolname)
+			err = p.deletePool(poolname)
+		} else {
+			err = p.deletePoolIfEmpty(poolname)
+		}
 		if err != nil {
 			return err
 		}
@@ -527,7 +707,12 @@ func (p *F5Plugin) HandleRoute(eventType watch.EventType,
 		// F5 does not permit us to create a rule without a pool, so we need to
 		// create the pool here in HandleRoute if it does not already exist.
 		// However, the pool may have already been created by HandleEndpoints.
-		err := p.ensurePoolExists(poolname)
+		var err error
+		if weighted {
+			err = p.ensureWeightedPoolExists(poolname, route)
+		} else {
+			err = p.ensurePoolExists(poolname)
+		}
 		if err != nil {
 			return err
 		}
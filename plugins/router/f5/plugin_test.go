@@ -73,6 +73,14 @@ type (
 
 		// pools represents the pools that exist on the mock F5 host.
 		pools map[string]pool
+
+		// poolModes represents the load balancing modes of the pools that exist
+		// on the mock F5 host.
+		poolModes map[string]string
+
+		// poolMemberRatios represents the ratios of the members of the pools that
+		// exist on the mock F5 host.
+		poolMemberRatios map[string]map[string]int32
 	}
 
 	mockF5 struct {
@@ -90,6 +98,7 @@ type (
 		HttpUri     bool     `json:"httpUri,omitempty"`
 		PathSegment bool     `json:"pathSegment,omitempty"`
 		Index       int      `json:"index"`
+		Equals      bool     `json:"equals"`
 		Host        bool     `json:"host,omitempty"`
 		Values      []string `json:"values"`
 	}
@@ -153,6 +162,7 @@ var f5Routes = []Route{
 	{"deletePool", "DELETE", "/mgmt/tm/ltm/pool/{poolName}", deletePoolHandler},
 	{"getPoolMembers", "GET", "/mgmt/tm/ltm/pool/{poolName}/members", getPoolMembersHandler},
 	{"postPoolMember", "POST", "/mgmt/tm/ltm/pool/{poolName}/members", postPoolMemberHandler},
+	{"patchPoolMember", "PATCH", "/mgmt/tm/ltm/pool/{poolName}/members/{memberName}", patchPoolMemberHandler},
 	{"deletePoolMember", "DELETE", "/mgmt/tm/ltm/pool/{poolName}/members/{memberName}", deletePoolMemberHandler},
 	{"getRules", "GET", "/mgmt/tm/ltm/policy/{policyName}/rules", getRulesHandler},
 	{"postCondition", "POST", "/mgmt/tm/ltm/policy/{policyName}/rules/{ruleName}/conditions", postConditionHandler},
@@ -252,6 +262,9 @@ func newTestRouter(partitionPath string) (*F5Plugin, *mockF5, error) {
 		// Add the default /Common partition path.
 		partitionPaths: map[string]string{pathKey: partitionPath},
 		pools:          map[string]pool{},
+
+		poolModes:        map[string]string{},
+		poolMemberRatios: map[string]map[string]int32{},
 	}
 
 	return newTestRouterWithState(state, partitionPath)
@@ -629,7 +642,10 @@ func patchVserverHandler(f5state mockF5State) http.HandlerFunc {
 		decoder := json.NewDecoder(request.Body)
 		decoder.Decode(&payload)
 
-		iRules := []string(payload.Rules)
+		iRules := []string{}
+		for _, rule := range payload.Rules {
+			iRules = append(iRules, strings.TrimPrefix(rule, "/Common/"))
+		}
 
 		f5state.vserverIRules[vserverName] = iRules
 
@@ -694,6 +710,7 @@ func postPartitionPathHandler(f5state mockF5State) http.HandlerFunc {
 func postPoolHandler(f5state mockF5State) http.HandlerFunc {
 	return func(response http.ResponseWriter, request *http.Request) {
 		payload := struct {
+			Mode string `json:"loadBalancingMode"`
 			Name string `json:"name"`
 		}{}
 		decoder := json.NewDecoder(request.Body)
@@ -711,6 +728,8 @@ func postPoolHandler(f5state mockF5State) http.HandlerFunc {
 		}
 
 		f5state.pools[poolName] = pool{}
+		f5state.poolModes[poolName] = payload.Mode
+		f5state.poolMemberRatios[poolName] = map[string]int32{}
 
 		OK(response)
 	}
@@ -742,6 +761,8 @@ func deletePoolHandler(f5state mockF5State) http.HandlerFunc {
 		// TODO: Validate that no rule references the pool.
 
 		delete(f5state.pools, poolName)
+		delete(f5state.poolModes, poolName)
+		delete(f5state.poolMemberRatios, poolName)
 
 		OK(response)
 	}
@@ -789,6 +810,7 @@ func postPoolMemberHandler(f5state mockF5State) http.HandlerFunc {
 
 		payload := struct {
 			Member string `json:"name"`
+			Ratio  int32  `json:"ratio"`
 		}{}
 		decoder := json.NewDecoder(request.Body)
 		decoder.Decode(&payload)
@@ -805,6 +827,40 @@ func postPoolMemberHandler(f5state mockF5State) http.HandlerFunc {
 		}
 
 		f5state.pools[poolName][memberName] = true
+		if payload.Ratio != 0 {
+			f5state.poolMemberRatios[poolName][memberName] = payload.Ratio
+		}
+
+		OK(response)
+	}
+}
+
+func patchPoolMemberHandler(f5state mockF5State) http.HandlerFunc {
+	return func(response http.ResponseWriter, request *http.Request) {
+		vars := mux.Vars(request)
+		poolName := vars["poolName"]
+		memberName := vars["memberName"]
+
+		if !validatePoolName(response, request, f5state, poolName) {
+			return
+		}
+
+		_, foundMember := f5state.pools[poolName][memberName]
+		if !foundMember {
+			response.WriteHeader(http.StatusNotFound)
+			fmt.Fprintf(response,
+				`{"code":404,"message":"01020036:3: The requested Pool Member (/Common/%s /Common/%s) was not found.","errorStack":[]}`,
+				poolName, strings.Replace(memberName, ":", " ", 1))
+			return
+		}
+
+		payload := struct {
+			Ratio int32 `json:"ratio"`
+		}{}
+		decoder := json.NewDecoder(request.Body)
+		decoder.Decode(&payload)
+
+		f5state.poolMemberRatios[poolName][memberName] = payload.Ratio
 
 		OK(response)
 	}
@@ -828,6 +884,7 @@ func deletePoolMemberHandler(f5state mockF5State) http.HandlerFunc {
 		}
 
 		delete(f5state.pools[poolName], memberName)
+		delete(f5state.poolMemberRatios[poolName], memberName)
 
 		OK(response)
 	}
@@ -1330,6 +1387,16 @@ func TestInitializeF5Plugin(t *testing.T) {
 					"iRule name: %s\nDatagroup name: %s\niRule code: %s",
 					iRuleName, passthroughIRuleDatagroupName, iRuleCode)
 			}
+		} else if iRuleName == wildcardIRuleName {
+			for _, datagroupName := range []string{wildcardInsecureHostsDataGroupName, wildcardSecureHostsDataGroupName} {
+				if _, ok := mockF5.state.datagroups[datagroupName]; !ok {
+					t.Errorf("%s datagroup was not created.", datagroupName)
+				}
+				if !strings.Contains(string(iRuleCode), datagroupName) {
+					t.Errorf("iRule for wildcard routes does not reference the"+
+						" datagroup %s.\niRule code: %s", datagroupName, iRuleCode)
+				}
+			}
 		} else {
 			t.Errorf("Encountered unexpected iRule: %s", iRuleName)
 		}
@@ -1338,12 +1405,13 @@ func TestInitializeF5Plugin(t *testing.T) {
 		t.Errorf("%s iRule was not created.", passthroughIRuleName)
 	}
 
-	// The HTTPS vserver should have the passthrough iRule associated.
+	// The HTTPS vserver should have the passthrough and wildcard iRules
+	// associated.
 	foundPassthroughIRuleUnderVserver := false
 	for _, iRuleName := range mockF5.state.vserverIRules[httpsVserverName] {
 		if iRuleName == passthroughIRuleName {
 			foundPassthroughIRuleUnderVserver = true
-		} else {
+		} else if iRuleName != wildcardIRuleName {
 			t.Errorf("Encountered unexpected iRule associated with vserver %s: %s",
 				httpsVserverName, iRuleName)
 		}
@@ -1353,9 +1421,21 @@ func TestInitializeF5Plugin(t *testing.T) {
 			passthroughIRuleName, httpsVserverName)
 	}
 
-	// The HTTP vserver should have no iRules associated.
-	if len(mockF5.state.vserverIRules[httpVserverName]) != 0 {
-		t.Errorf("Vserver %s has iRules associated: %v",
+	// The HTTP and HTTPS vservers should have the wildcard iRule associated.
+	for _, vserverName := range []string{httpVserverName, httpsVserverName} {
+		found := false
+		for _, iRuleName := range mockF5.state.vserverIRules[vserverName] {
+			if iRuleName == wildcardIRuleName {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("%s iRule was not associated with vserver %s: %v",
+				wildcardIRuleName, vserverName, mockF5.state.vserverIRules[vserverName])
+		}
+	}
+	if len(mockF5.state.vserverIRules[httpVserverName]) != 1 {
+		t.Errorf("Vserver %s has unexpected iRules associated: %v",
 			httpVserverName, mockF5.state.vserverIRules[httpVserverName])
 	}
 
@@ -1603,7 +1683,7 @@ func TestHandleRoute(t *testing.T) {
 				},
 				Spec: routeapi.RouteSpec{
 					Host: "www.example.com",
-					To: kapi.ObjectReference{
+					To: routeapi.RouteTargetReference{
 						Name: "TestService",
 					},
 				},
@@ -1657,7 +1737,7 @@ func TestHandleRoute(t *testing.T) {
 				},
 				Spec: routeapi.RouteSpec{
 					Host: "www.example2.com",
-					To: kapi.ObjectReference{
+					To: routeapi.RouteTargetReference{
 						Name: "TestService",
 					},
 					Path: "/foo/bar",
@@ -1710,7 +1790,7 @@ func TestHandleRoute(t *testing.T) {
 				},
 				Spec: routeapi.RouteSpec{
 					Host: "www.example2.com",
-					To: kapi.ObjectReference{
+					To: routeapi.RouteTargetReference{
 						Name: "TestService",
 					},
 				},
@@ -1739,7 +1819,7 @@ func TestHandleRoute(t *testing.T) {
 				},
 				Spec: routeapi.RouteSpec{
 					Host: "www.example.com",
-					To: kapi.ObjectReference{
+					To: routeapi.RouteTargetReference{
 						Name: "TestService",
 					},
 					TLS: &routeapi.TLSConfig{
@@ -1805,7 +1885,7 @@ func TestHandleRoute(t *testing.T) {
 				},
 				Spec: routeapi.RouteSpec{
 					Host: "www.example.com",
-					To: kapi.ObjectReference{
+					To: routeapi.RouteTargetReference{
 						Name: "TestService",
 					},
 					TLS: &routeapi.TLSConfig{
@@ -1871,7 +1951,7 @@ func TestHandleRoute(t *testing.T) {
 				},
 				Spec: routeapi.RouteSpec{
 					Host: "www.example3.com",
-					To: kapi.ObjectReference{
+					To: routeapi.RouteTargetReference{
 						Name: "TestService",
 					},
 					TLS: &routeapi.TLSConfig{
@@ -1902,7 +1982,7 @@ func TestHandleRoute(t *testing.T) {
 				},
 				Spec: routeapi.RouteSpec{
 					Host: "www.example3.com",
-					To: kapi.ObjectReference{
+					To: routeapi.RouteTargetReference{
 						Name: "TestService",
 					},
 					TLS: &routeapi.TLSConfig{
@@ -1935,7 +2015,7 @@ func TestHandleRoute(t *testing.T) {
 				},
 				Spec: routeapi.RouteSpec{
 					Host: "www.example3.com",
-					To: kapi.ObjectReference{
+					To: routeapi.RouteTargetReference{
 						Name: "TestService",
 					},
 				},
@@ -1963,7 +2043,7 @@ func TestHandleRoute(t *testing.T) {
 				},
 				Spec: routeapi.RouteSpec{
 					Host: "www.example3.com",
-					To: kapi.ObjectReference{
+					To: routeapi.RouteTargetReference{
 						Name: "TestService",
 					},
 				},
@@ -1991,7 +2071,7 @@ func TestHandleRoute(t *testing.T) {
 				},
 				Spec: routeapi.RouteSpec{
 					Host: "www.example3.com",
-					To: kapi.ObjectReference{
+					To: routeapi.RouteTargetReference{
 						Name: "TestService",
 					},
 					TLS: &routeapi.TLSConfig{
@@ -2022,7 +2102,7 @@ func TestHandleRoute(t *testing.T) {
 				},
 				Spec: routeapi.RouteSpec{
 					Host: "www.example4.com",
-					To: kapi.ObjectReference{
+					To: routeapi.RouteTargetReference{
 						Name: "TestService",
 					},
 					TLS: &routeapi.TLSConfig{
@@ -2106,7 +2186,7 @@ func TestHandleRoute(t *testing.T) {
 				},
 				Spec: routeapi.RouteSpec{
 					Host: "www.example4.com",
-					To: kapi.ObjectReference{
+					To: routeapi.RouteTargetReference{
 						Name: "TestService",
 					},
 					TLS: &routeapi.TLSConfig{
@@ -2202,7 +2282,7 @@ func TestHandleRouteModifications(t *testing.T) {
 		},
 		Spec: routeapi.RouteSpec{
 			Host: "www.example.com",
-			To: kapi.ObjectReference{
+			To: routeapi.RouteTargetReference{
 				Name: "testendpoint",
 			},
 		},
@@ -2254,6 +2334,185 @@ func TestHandleRouteModifications(t *testing.T) {
 	}
 }
 
+// TestHandleWeightedRoute verifies that a route with alternate backends gets
+// its own pool that balances traffic over the endpoints of its backends
+// according to their weights, and that the pool follows changes to the
+// endpoints and to the route.
+func TestHandleWeightedRoute(t *testing.T) {
+	router, mockF5, err := newTestRouter(F5DefaultPartitionPath)
+	if err != nil {
+		t.Fatalf("Failed to initialize test router: %v", err)
+	}
+	defer mockF5.close()
+
+	newEndpoints := func(name, ip string) *kapi.Endpoints {
+		return &kapi.Endpoints{
+			ObjectMeta: kapi.ObjectMeta{
+				Namespace: "foo",
+				Name:      name,
+			},
+			Subsets: []kapi.EndpointSubset{{
+				Addresses: []kapi.EndpointAddress{{IP: ip}},
+				Ports:     []kapi.EndpointPort{{Port: 80}},
+			}},
+		}
+	}
+	for _, endpoints := range []*kapi.Endpoints{
+		newEndpoints("primary", "1.1.1.1"),
+		newEndpoints("secondary", "2.2.2.2"),
+		newEndpoints("tertiary", "3.3.3.3"),
+	} {
+		err = router.HandleEndpoints(watch.Added, endpoints)
+		if err != nil {
+			t.Fatalf("HandleEndpoints failed on adding endpoints %s: %v",
+				endpoints.Name, err)
+		}
+	}
+
+	primaryWeight := int32(30)
+	noWeight := int32(0)
+	testRoute := &routeapi.Route{
+		ObjectMeta: kapi.ObjectMeta{
+			Namespace: "foo",
+			Name:      "weighted",
+		},
+		Spec: routeapi.RouteSpec{
+			Host: "www.example.com",
+			To: routeapi.RouteTargetReference{
+				Name:   "primary",
+				Weight: &primaryWeight,
+			},
+			AlternateBackends: []routeapi.RouteTargetReference{
+				{Name: "secondary"},
+				{Name: "tertiary", Weight: &noWeight},
+			},
+		},
+	}
+	poolName := "openshift_route_foo_weighted"
+
+	validateRatios := func(expected map[string]int32) {
+		if mode := mockF5.state.poolModes[poolName]; mode != "ratio-member" {
+			t.Errorf("Expected pool %s to use ratio-member mode, got %q",
+				poolName, mode)
+		}
+		if !reflect.DeepEqual(expected, mockF5.state.poolMemberRatios[poolName]) {
+			t.Errorf("Expected pool %s to have member ratios %v, got %v",
+				poolName, expected, mockF5.state.poolMemberRatios[poolName])
+		}
+	}
+
+	err = router.HandleRoute(watch.Added, testRoute)
+	if err != nil {
+		t.Fatalf("HandleRoute failed on adding weighted route: %v", err)
+	}
+	validateRatios(map[string]int32{
+		"1.1.1.1:80": 30,
+		"2.2.2.2:80": routeapi.DefaultRouteTargetWeight,
+	})
+
+	rule, ok := mockF5.state.policies[insecureRoutesPolicyName][poolName]
+	if !ok {
+		t.Fatalf("Expected policy rule %s to exist", poolName)
+	}
+	if len(rule.conditions) == 0 {
+		t.Errorf("Expected policy rule %s to have conditions", poolName)
+	}
+
+	// Verify that the pool follows the endpoints of an alternate backend.
+	err = router.HandleEndpoints(watch.Modified,
+		newEndpoints("secondary", "4.4.4.4"))
+	if err != nil {
+		t.Fatalf("HandleEndpoints failed on modifying endpoints: %v", err)
+	}
+	validateRatios(map[string]int32{
+		"1.1.1.1:80": 30,
+		"4.4.4.4:80": routeapi.DefaultRouteTargetWeight,
+	})
+
+	// Verify that changing the weights updates the ratios of the pool members.
+	primaryWeight = 5
+	noWeight = 10
+	err = router.HandleRoute(watch.Modified, testRoute)
+	if err != nil {
+		t.Fatalf("HandleRoute failed on modifying weighted route: %v", err)
+	}
+	validateRatios(map[string]int32{
+		"1.1.1.1:80": 5,
+		"4.4.4.4:80": routeapi.DefaultRouteTargetWeight,
+		"3.3.3.3:80": 10,
+	})
+
+	// Verify that removing the alternate backends removes the weighted pool.
+	testRoute.Spec.AlternateBackends = nil
+	err = router.HandleRoute(watch.Modified, testRoute)
+	if err != nil {
+		t.Fatalf("HandleRoute failed on modifying weighted route: %v", err)
+	}
+	if _, ok := mockF5.state.pools[poolName]; ok {
+		t.Errorf("Expected pool %s to be deleted", poolName)
+	}
+	if _, ok := mockF5.state.pools["openshift_foo_primary"]; !ok {
+		t.Errorf("Expected pool openshift_foo_primary to exist")
+	}
+}
+
+// TestHandleWildcardRoute verifies that a wildcard route is added to the
+// datagroups of the wildcard iRule, keyed by its subdomain and path, instead
+// of as a policy rule, and removed from them when the route is deleted.
+func TestHandleWildcardRoute(t *testing.T) {
+	router, mockF5, err := newTestRouter(F5DefaultPartitionPath)
+	if err != nil {
+		t.Fatalf("Failed to initialize test router: %v", err)
+	}
+	defer mockF5.close()
+
+	route := &routeapi.Route{
+		ObjectMeta: kapi.ObjectMeta{
+			Namespace: "foo",
+			Name:      "wildcardtest",
+		},
+		Spec: routeapi.RouteSpec{
+			Host:           "www.Example.com",
+			Path:           "/foo/",
+			WildcardPolicy: routeapi.WildcardPolicySubdomain,
+			To: routeapi.RouteTargetReference{
+				Name: "TestService",
+			},
+		},
+	}
+	err = router.HandleRoute(watch.Added, route)
+	if err != nil {
+		t.Fatalf("HandleRoute failed on adding wildcard route: %v", err)
+	}
+
+	rulename := routeName(*route)
+	if _, ok := mockF5.state.policies[insecureRoutesPolicyName][rulename]; ok {
+		t.Errorf("Wildcard route should not have a policy rule: %v",
+			mockF5.state.policies[insecureRoutesPolicyName])
+	}
+
+	poolname := poolName(route.Namespace, route.Spec.To.Name)
+	if pool := mockF5.state.datagroups[wildcardInsecureHostsDataGroupName]["example.com/foo"]; pool != poolname {
+		t.Errorf("Datagroup %s should map example.com/foo to pool %s: %v",
+			wildcardInsecureHostsDataGroupName, poolname,
+			mockF5.state.datagroups[wildcardInsecureHostsDataGroupName])
+	}
+	if len(mockF5.state.datagroups[wildcardSecureHostsDataGroupName]) != 0 {
+		t.Errorf("Datagroup %s should be empty: %v", wildcardSecureHostsDataGroupName,
+			mockF5.state.datagroups[wildcardSecureHostsDataGroupName])
+	}
+
+	err = router.HandleRoute(watch.Deleted, route)
+	if err != nil {
+		t.Fatalf("HandleRoute failed on deleting wildcard route: %v", err)
+	}
+	if len(mockF5.state.datagroups[wildcardInsecureHostsDataGroupName]) != 0 {
+		t.Errorf("Datagroup %s should be empty after the route is deleted: %v",
+			wildcardInsecureHostsDataGroupName,
+			mockF5.state.datagroups[wildcardInsecureHostsDataGroupName])
+	}
+}
+
 // TestF5RouterSuccessiveInstances creates an F5 router instance, creates
 // a service and a route, creates a new F5 router instance, and verifies that
 // the new instance behaves correctly picking up the state from the first
@@ -2271,7 +2530,7 @@ func TestF5RouterSuccessiveInstances(t *testing.T) {
 		},
 		Spec: routeapi.RouteSpec{
 			Host: "www.example.com",
-			To: kapi.ObjectReference{
+			To: routeapi.RouteTargetReference{
 				Name: "testendpoint",
 			},
 			TLS: &routeapi.TLSConfig{
@@ -2291,7 +2550,7 @@ func TestF5RouterSuccessiveInstances(t *testing.T) {
 		},
 		Spec: routeapi.RouteSpec{
 			Host: "www.example2.com",
-			To: kapi.ObjectReference{
+			To: routeapi.RouteTargetReference{
 				Name: "testhttpsendpoint",
 			},
 			TLS: &routeapi.TLSConfig{
@@ -90,6 +90,19 @@ type f5PoolMember struct {
 	// Name is the name of the pool member.  The F5 router uses names of the form
 	// ipaddr:port.
 	Name string `json:"name"`
+
+	// Ratio is the weight of the pool member when the pool uses the ratio-member
+	// load balancing mode.  The F5 router sets it to the weight of the route
+	// backend that the member belongs to.
+	Ratio int32 `json:"ratio,omitempty"`
+}
+
+// f5PoolMemberRatio describes the payload for a PATCH request by which the F5
+// router updates the ratio of an existing pool member.
+type f5PoolMemberRatio struct {
+	// Ratio is the weight of the pool member when the pool uses the ratio-member
+	// load balancing mode.
+	Ratio int32 `json:"ratio"`
 }
 
 // f5PoolMemberset represents an F5 BIG-IP LTM pool.  The F5 router uses it to
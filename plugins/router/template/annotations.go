@@ -0,0 +1,118 @@
+package templaterouter
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	// ipWhitelistAnnotation is a space separated list of IP addresses and CIDR ranges that
+	// are allowed to access the route. All sources are allowed if it is not set.
+	ipWhitelistAnnotation = "haproxy.router.openshift.io/ip_whitelist"
+
+	// rateLimitConnectionsAnnotation enables the rate limits below if set to true.
+	rateLimitConnectionsAnnotation = "haproxy.router.openshift.io/rate-limit-connections"
+	// rateLimitConcurrentTCPAnnotation is the number of concurrent connections a client IP
+	// may open to the route.
+	rateLimitConcurrentTCPAnnotation = "haproxy.router.openshift.io/rate-limit-connections.concurrent-tcp"
+	// rateLimitTCPRateAnnotation is the number of connections a client IP may open to the
+	// route within 3 seconds.
+	rateLimitTCPRateAnnotation = "haproxy.router.openshift.io/rate-limit-connections.rate-tcp"
+	// rateLimitHTTPRateAnnotation is the number of HTTP requests a client IP may make to the
+	// route within 10 seconds.
+	rateLimitHTTPRateAnnotation = "haproxy.router.openshift.io/rate-limit-connections.rate-http"
+
+	// timeoutAnnotation is the server timeout of the route, e.g. 5s or 500ms.
+	timeoutAnnotation = "haproxy.router.openshift.io/timeout"
+)
+
+// haproxyTimeout matches a time in the format HAProxy accepts for timeouts.
+var haproxyTimeout = regexp.MustCompile(`^[0-9]+(us|ms|s|m|h|d)?$`)
+
+// RateLimit holds the limits on the traffic of each client IP to a route. A limit
+// of zero is not enforced.
+type RateLimit struct {
+	// ConcurrentTCP is the number of concurrent connections a client IP may open.
+	ConcurrentTCP int
+	// TCPRate is the number of connections a client IP may open within 3 seconds.
+	TCPRate int
+	// HTTPRate is the number of HTTP requests a client IP may make within 10 seconds.
+	HTTPRate int
+}
+
+// routeAnnotations are the per-route options understood by the template router.
+type routeAnnotations struct {
+	IPWhitelist []string
+	RateLimit   *RateLimit
+	Timeout     string
+}
+
+// parseRouteAnnotations returns the router options set by the annotations of a route.
+// Annotations with invalid values are ignored, and an error is returned for each.
+func parseRouteAnnotations(annotations map[string]string) (routeAnnotations, []error) {
+	options := routeAnnotations{}
+	errs := []error{}
+
+	if value, ok := annotations[ipWhitelistAnnotation]; ok {
+		whitelist := []string{}
+		valid := true
+		for _, source := range strings.Fields(value) {
+			if net.ParseIP(source) == nil {
+				if _, _, err := net.ParseCIDR(source); err != nil {
+					errs = append(errs, fmt.Errorf("%s: %q is not an IP address or CIDR range", ipWhitelistAnnotation, source))
+					valid = false
+					continue
+				}
+			}
+			whitelist = append(whitelist, source)
+		}
+		// a partial whitelist would deny sources the user meant to allow
+		if valid && len(whitelist) > 0 {
+			options.IPWhitelist = whitelist
+		}
+	}
+
+	if value, ok := annotations[rateLimitConnectionsAnnotation]; ok {
+		enabled, err := strconv.ParseBool(value)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %q is not a boolean", rateLimitConnectionsAnnotation, value))
+		}
+		if enabled {
+			limit := &RateLimit{}
+			limit.ConcurrentTCP = parseRateLimit(annotations, rateLimitConcurrentTCPAnnotation, &errs)
+			limit.TCPRate = parseRateLimit(annotations, rateLimitTCPRateAnnotation, &errs)
+			limit.HTTPRate = parseRateLimit(annotations, rateLimitHTTPRateAnnotation, &errs)
+			if limit.ConcurrentTCP > 0 || limit.TCPRate > 0 || limit.HTTPRate > 0 {
+				options.RateLimit = limit
+			}
+		}
+	}
+
+	if value, ok := annotations[timeoutAnnotation]; ok {
+		if haproxyTimeout.MatchString(value) {
+			options.Timeout = value
+		} else {
+			errs = append(errs, fmt.Errorf("%s: %q is not a valid timeout, expected a number with an optional unit of us, ms, s, m, h or d", timeoutAnnotation, value))
+		}
+	}
+
+	return options, errs
+}
+
+// parseRateLimit returns the positive integer value of the named annotation, or zero
+// if it is not set or invalid.
+func parseRateLimit(annotations map[string]string, name string, errs *[]error) int {
+	value, ok := annotations[name]
+	if !ok {
+		return 0
+	}
+	limit, err := strconv.Atoi(value)
+	if err != nil || limit < 1 {
+		*errs = append(*errs, fmt.Errorf("%s: %q is not a positive integer", name, value))
+		return 0
+	}
+	return limit
+}
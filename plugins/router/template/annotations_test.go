@@ -0,0 +1,78 @@
+package templaterouter
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseRouteAnnotations(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		expected    routeAnnotations
+		errors      int
+	}{
+		{
+			name: "no annotations",
+		},
+		{
+			name: "valid annotations",
+			annotations: map[string]string{
+				ipWhitelistAnnotation:            "10.0.0.0/8  192.168.1.1",
+				rateLimitConnectionsAnnotation:   "true",
+				rateLimitConcurrentTCPAnnotation: "5",
+				rateLimitHTTPRateAnnotation:      "100",
+				timeoutAnnotation:                "500ms",
+			},
+			expected: routeAnnotations{
+				IPWhitelist: []string{"10.0.0.0/8", "192.168.1.1"},
+				RateLimit:   &RateLimit{ConcurrentTCP: 5, HTTPRate: 100},
+				Timeout:     "500ms",
+			},
+		},
+		{
+			name: "rate limits are not enabled",
+			annotations: map[string]string{
+				rateLimitTCPRateAnnotation: "5",
+			},
+		},
+		{
+			name: "invalid whitelist entries discard the whitelist",
+			annotations: map[string]string{
+				ipWhitelistAnnotation: "10.0.0.0/8 10.0.0.0/33 example.com",
+			},
+			errors: 2,
+		},
+		{
+			name: "invalid rate limits are ignored",
+			annotations: map[string]string{
+				rateLimitConnectionsAnnotation:   "true",
+				rateLimitConcurrentTCPAnnotation: "-1",
+				rateLimitTCPRateAnnotation:       "many",
+				rateLimitHTTPRateAnnotation:      "10",
+			},
+			expected: routeAnnotations{
+				RateLimit: &RateLimit{HTTPRate: 10},
+			},
+			errors: 2,
+		},
+		{
+			name: "invalid values",
+			annotations: map[string]string{
+				rateLimitConnectionsAnnotation: "yes",
+				timeoutAnnotation:              "5 seconds",
+			},
+			errors: 2,
+		},
+	}
+
+	for _, tc := range tests {
+		options, errs := parseRouteAnnotations(tc.annotations)
+		if len(errs) != tc.errors {
+			t.Errorf("%s: expected %d errors, got %v", tc.name, tc.errors, errs)
+		}
+		if !reflect.DeepEqual(tc.expected, options) {
+			t.Errorf("%s: expected %#v, got %#v", tc.name, tc.expected, options)
+		}
+	}
+}
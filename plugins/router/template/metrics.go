@@ -0,0 +1,58 @@
+package templaterouter
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	reloadsTotal = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "template_router_reloads_total",
+			Help: "Counter of the times the router has run its reload script",
+		},
+	)
+	reloadFailuresTotal = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "template_router_reload_failures_total",
+			Help: "Counter of the commits that failed to write the router configuration or reload the router",
+		},
+	)
+	secondsSinceLastCommit = prometheus.NewGaugeFunc(
+		prometheus.GaugeOpts{
+			Name: "template_router_seconds_since_last_commit",
+			Help: "The number of seconds since the router last committed its configuration successfully, or since it started if it has not",
+		},
+		func() float64 {
+			return time.Since(lastCommit.get()).Seconds()
+		},
+	)
+
+	lastCommit = &commitTime{time: time.Now()}
+)
+
+func init() {
+	prometheus.MustRegister(reloadsTotal)
+	prometheus.MustRegister(reloadFailuresTotal)
+	prometheus.MustRegister(secondsSinceLastCommit)
+}
+
+// commitTime records the time of the last successful commit.
+type commitTime struct {
+	lock sync.Mutex
+	time time.Time
+}
+
+func (c *commitTime) get() time.Time {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.time
+}
+
+func (c *commitTime) set(t time.Time) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.time = t
+}
@@ -3,12 +3,14 @@ package templaterouter
 import (
 	"fmt"
 	"path/filepath"
+	"reflect"
 	"strconv"
 	"text/template"
 	"time"
 
 	"github.com/golang/glog"
 	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/client/record"
 	ktypes "k8s.io/kubernetes/pkg/types"
 	"k8s.io/kubernetes/pkg/util/sets"
 	"k8s.io/kubernetes/pkg/watch"
@@ -21,12 +23,23 @@ import (
 type TemplatePlugin struct {
 	Router     routerInterface
 	IncludeUDP bool
+	// Recorder, if set, receives an event for each route with invalid annotations
+	Recorder record.EventRecorder
+
+	// alternateBackends holds the keys of the alternate backend service units of each
+	// route, keyed by route namespace and name
+	alternateBackends map[string]sets.String
+	// invalidAnnotations holds the last reported annotation errors of each route, keyed
+	// by route namespace and name
+	invalidAnnotations map[string][]string
 }
 
 func newDefaultTemplatePlugin(router routerInterface, includeUDP bool) *TemplatePlugin {
 	return &TemplatePlugin{
-		Router:     router,
-		IncludeUDP: includeUDP,
+		Router:             router,
+		IncludeUDP:         includeUDP,
+		alternateBackends:  make(map[string]sets.String),
+		invalidAnnotations: make(map[string][]string),
 	}
 }
 
@@ -41,6 +54,7 @@ type TemplatePluginConfig struct {
 	StatsPassword      string
 	IncludeUDP         bool
 	PeerService        *ktypes.NamespacedName
+	EventRecorder      record.EventRecorder
 }
 
 // routerInterface controls the interaction of the plugin with the underlying router implementation
@@ -54,6 +68,8 @@ type routerInterface interface {
 	CreateServiceUnit(id string)
 	// FindServiceUnit finds the service with the given id.
 	FindServiceUnit(id string) (v ServiceUnit, ok bool)
+	// DeleteServiceUnit deletes the service with the given id.
+	DeleteServiceUnit(id string)
 
 	// AddEndpoints adds new Endpoints for the given id. Returns true if a change was made
 	// and the state should be stored with Commit().
@@ -61,9 +77,10 @@ type routerInterface interface {
 	// DeleteEndpoints deletes the endpoints for the frontend with the given id.
 	DeleteEndpoints(id string)
 
-	// AddRoute adds a route for the given id and the calculated host.  Returns true if a
-	// change was made and the state should be stored with Commit().
-	AddRoute(id string, route *routeapi.Route, host string) bool
+	// AddRoute adds a route for the given id and the calculated host, with the options
+	// parsed from its annotations.  Returns true if a change was made and the state
+	// should be stored with Commit().
+	AddRoute(id string, route *routeapi.Route, host string, options routeAnnotations) bool
 	// RemoveRoute removes the given route for the given id.
 	RemoveRoute(id string, route *routeapi.Route)
 	// Reduce the list of routes to only these namespaces
@@ -77,7 +94,9 @@ type routerInterface interface {
 func NewTemplatePlugin(cfg TemplatePluginConfig) (*TemplatePlugin, error) {
 	templateBaseName := filepath.Base(cfg.TemplatePath)
 	globalFuncs := template.FuncMap{
-		"endpointsForAlias": endpointsForAlias,
+		"endpointsForAlias":   endpointsForAlias,
+		"wildcardAliases":     wildcardAliases,
+		"wildcardAliasRegexp": wildcardAliasRegexp,
 	}
 	masterTemplate, err := template.New("config").Funcs(globalFuncs).ParseFiles(cfg.TemplatePath)
 	if err != nil {
@@ -110,7 +129,9 @@ func NewTemplatePlugin(cfg TemplatePluginConfig) (*TemplatePlugin, error) {
 		peerEndpointsKey:   peerKey,
 	}
 	router, err := newTemplateRouter(templateRouterCfg)
-	return newDefaultTemplatePlugin(router, cfg.IncludeUDP), err
+	plugin := newDefaultTemplatePlugin(router, cfg.IncludeUDP)
+	plugin.Recorder = cfg.EventRecorder
+	return plugin, err
 }
 
 // HandleEndpoints processes watch events on the Endpoints resource.
@@ -156,20 +177,95 @@ func (p *TemplatePlugin) HandleRoute(eventType watch.EventType, route *routeapi.
 			glog.V(4).Infof("Creating new frontend for key: %v", key)
 			p.Router.CreateServiceUnit(key)
 		}
+		backends := sets.NewString()
+		for _, backend := range route.Spec.AlternateBackends {
+			backendKey := fmt.Sprintf("%s/%s", route.Namespace, backend.Name)
+			backends.Insert(backendKey)
+			if _, ok := p.Router.FindServiceUnit(backendKey); !ok {
+				glog.V(4).Infof("Creating new backend for key: %v", backendKey)
+				p.Router.CreateServiceUnit(backendKey)
+			}
+		}
+		p.updateAlternateBackends(route, backends)
+
+		options, errs := parseRouteAnnotations(route.Annotations)
+		p.reportInvalidAnnotations(route, errs)
 
 		glog.V(4).Infof("Modifying routes for %s", key)
-		commit := p.Router.AddRoute(key, route, host)
+		commit := p.Router.AddRoute(key, route, host, options)
 		if commit {
 			p.Router.Commit()
 		}
 	case watch.Deleted:
 		glog.V(4).Infof("Deleting routes for %s", key)
 		p.Router.RemoveRoute(key, route)
+		p.updateAlternateBackends(route, sets.NewString())
+		delete(p.invalidAnnotations, fmt.Sprintf("%s/%s", route.Namespace, route.Name))
 		p.Router.Commit()
 	}
 	return nil
 }
 
+// updateAlternateBackends records the alternate backend service units of the route and deletes
+// the units it no longer references, unless another route still uses them.
+func (p *TemplatePlugin) updateAlternateBackends(route *routeapi.Route, backends sets.String) {
+	name := fmt.Sprintf("%s/%s", route.Namespace, route.Name)
+	removed := p.alternateBackends[name].Difference(backends)
+	if backends.Len() > 0 {
+		p.alternateBackends[name] = backends
+	} else {
+		delete(p.alternateBackends, name)
+	}
+
+	for _, backendKey := range removed.List() {
+		if p.isAlternateBackend(backendKey) {
+			continue
+		}
+		// the unit is still needed if it is the primary backend of a route
+		if serviceUnit, ok := p.Router.FindServiceUnit(backendKey); !ok || len(serviceUnit.ServiceAliasConfigs) > 0 {
+			continue
+		}
+		glog.V(4).Infof("Deleting unused backend for key: %v", backendKey)
+		p.Router.DeleteServiceUnit(backendKey)
+	}
+}
+
+// isAlternateBackend returns true if a route uses the service unit as an alternate backend.
+func (p *TemplatePlugin) isAlternateBackend(backendKey string) bool {
+	for _, backends := range p.alternateBackends {
+		if backends.Has(backendKey) {
+			return true
+		}
+	}
+	return false
+}
+
+// reportInvalidAnnotations records an event for each router annotation of the route with an
+// invalid value. The router ignores these annotations. Errors are only reported when they
+// differ from the ones last reported for the route, so that resyncs do not repeat them.
+func (p *TemplatePlugin) reportInvalidAnnotations(route *routeapi.Route, errs []error) {
+	name := fmt.Sprintf("%s/%s", route.Namespace, route.Name)
+	messages := []string{}
+	for _, err := range errs {
+		messages = append(messages, err.Error())
+	}
+	if reflect.DeepEqual(p.invalidAnnotations[name], messages) {
+		return
+	}
+	if len(messages) > 0 {
+		p.invalidAnnotations[name] = messages
+	} else {
+		delete(p.invalidAnnotations, name)
+	}
+
+	for _, err := range errs {
+		glog.V(4).Infof("Route %s/%s has an invalid annotation: %v", route.Namespace, route.Name, err)
+		if p.Recorder != nil {
+			p.Recorder.Eventf(route, kapi.EventTypeWarning, "InvalidRouteAnnotation", "The annotation was ignored by the router: %v", err)
+		}
+	}
+}
+
 // HandleAllowedNamespaces limits the scope of valid routes to only those that match
 // the provided namespace list.
 func (p *TemplatePlugin) HandleNamespaces(namespaces sets.String) error {
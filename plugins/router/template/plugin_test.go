@@ -9,6 +9,7 @@ import (
 
 	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/client/record"
 	"k8s.io/kubernetes/pkg/util/sets"
 	"k8s.io/kubernetes/pkg/watch"
 
@@ -47,6 +48,11 @@ func (r *TestRouter) FindServiceUnit(id string) (v ServiceUnit, ok bool) {
 	return
 }
 
+// DeleteServiceUnit removes the service unit identified by id
+func (r *TestRouter) DeleteServiceUnit(id string) {
+	delete(r.State, id)
+}
+
 // AddEndpoints adds the endpoints to the service unit identified by id
 func (r *TestRouter) AddEndpoints(id string, endpoints []Endpoint) bool {
 	r.Committed = false //expect any call to this method to subsequently call commit
@@ -73,7 +79,7 @@ func (r *TestRouter) DeleteEndpoints(id string) {
 }
 
 // AddRoute adds a ServiceAliasConfig for the route to the ServiceUnit identified by id
-func (r *TestRouter) AddRoute(id string, route *routeapi.Route, host string) bool {
+func (r *TestRouter) AddRoute(id string, route *routeapi.Route, host string, options routeAnnotations) bool {
 	r.Committed = false //expect any call to this method to subsequently call commit
 	su, _ := r.FindServiceUnit(id)
 	routeKey := r.routeKey(route)
@@ -204,7 +210,7 @@ func TestHandleEndpoints(t *testing.T) {
 	templatePlugin := newDefaultTemplatePlugin(router, true)
 	// TODO: move tests that rely on unique hosts to pkg/router/controller and remove them from
 	// here
-	plugin := controller.NewUniqueHost(templatePlugin, controller.HostForRoute)
+	plugin := controller.NewUniqueHost(templatePlugin, controller.HostForRoute, false, controller.LogRejections)
 
 	for _, tc := range testCases {
 		plugin.HandleEndpoints(tc.eventType, tc.endpoints)
@@ -315,7 +321,7 @@ func TestHandleTCPEndpoints(t *testing.T) {
 	templatePlugin := newDefaultTemplatePlugin(router, false)
 	// TODO: move tests that rely on unique hosts to pkg/router/controller and remove them from
 	// here
-	plugin := controller.NewUniqueHost(templatePlugin, controller.HostForRoute)
+	plugin := controller.NewUniqueHost(templatePlugin, controller.HostForRoute, false, controller.LogRejections)
 
 	for _, tc := range testCases {
 		plugin.HandleEndpoints(tc.eventType, tc.endpoints)
@@ -346,7 +352,7 @@ func TestHandleRoute(t *testing.T) {
 	templatePlugin := newDefaultTemplatePlugin(router, true)
 	// TODO: move tests that rely on unique hosts to pkg/router/controller and remove them from
 	// here
-	plugin := controller.NewUniqueHost(templatePlugin, controller.HostForRoute)
+	plugin := controller.NewUniqueHost(templatePlugin, controller.HostForRoute, false, controller.LogRejections)
 
 	original := unversioned.Time{Time: time.Now()}
 
@@ -359,7 +365,7 @@ func TestHandleRoute(t *testing.T) {
 		},
 		Spec: routeapi.RouteSpec{
 			Host: "www.example.com",
-			To: kapi.ObjectReference{
+			To: routeapi.RouteTargetReference{
 				Name: "TestService",
 			},
 		},
@@ -397,7 +403,7 @@ func TestHandleRoute(t *testing.T) {
 		},
 		Spec: routeapi.RouteSpec{
 			Host: "www.example.com",
-			To: kapi.ObjectReference{
+			To: routeapi.RouteTargetReference{
 				Name: "TestService2",
 			},
 		},
@@ -490,12 +496,153 @@ func TestHandleRoute(t *testing.T) {
 	}
 }
 
+// TestHandleRouteAlternateBackends ensures service units are created for the alternate backends of a route
+func TestHandleRouteAlternateBackends(t *testing.T) {
+	router := newTestRouter(make(map[string]ServiceUnit))
+	plugin := newDefaultTemplatePlugin(router, true)
+
+	route := &routeapi.Route{
+		ObjectMeta: kapi.ObjectMeta{
+			Namespace: "foo",
+			Name:      "test",
+		},
+		Spec: routeapi.RouteSpec{
+			Host: "www.example.com",
+			To: routeapi.RouteTargetReference{
+				Name: "TestService",
+			},
+			AlternateBackends: []routeapi.RouteTargetReference{
+				{Name: "OtherService"},
+			},
+		},
+	}
+
+	plugin.HandleRoute(watch.Added, route)
+
+	if !router.Committed {
+		t.Errorf("Expected router to be committed after HandleRoute call")
+	}
+	for _, key := range []string{"foo/TestService", "foo/OtherService"} {
+		if _, ok := router.FindServiceUnit(key); !ok {
+			t.Errorf("Expected service unit %s to be created", key)
+		}
+	}
+}
+
+// TestHandleRouteRemovesAlternateBackends ensures service units of alternate backends are deleted
+// once no route references them
+func TestHandleRouteRemovesAlternateBackends(t *testing.T) {
+	router := newTestRouter(make(map[string]ServiceUnit))
+	plugin := newDefaultTemplatePlugin(router, true)
+
+	newRoute := func(name string, backends ...string) *routeapi.Route {
+		route := &routeapi.Route{
+			ObjectMeta: kapi.ObjectMeta{
+				Namespace: "foo",
+				Name:      name,
+			},
+			Spec: routeapi.RouteSpec{
+				Host: name + ".example.com",
+				To: routeapi.RouteTargetReference{
+					Name: "TestService",
+				},
+			},
+		}
+		for _, backend := range backends {
+			route.Spec.AlternateBackends = append(route.Spec.AlternateBackends, routeapi.RouteTargetReference{Name: backend})
+		}
+		return route
+	}
+
+	plugin.HandleRoute(watch.Added, newRoute("first", "OtherService", "SharedService"))
+	plugin.HandleRoute(watch.Added, newRoute("second", "SharedService", "TestService"))
+
+	// dropping a backend deletes its unit
+	plugin.HandleRoute(watch.Modified, newRoute("first", "SharedService"))
+	if _, ok := router.FindServiceUnit("foo/OtherService"); ok {
+		t.Errorf("Expected the unit of the dropped backend to be deleted")
+	}
+
+	// a unit still used by another route is kept
+	plugin.HandleRoute(watch.Deleted, newRoute("first", "SharedService"))
+	if _, ok := router.FindServiceUnit("foo/SharedService"); !ok {
+		t.Errorf("Expected the unit of the shared backend to be kept")
+	}
+
+	// the primary backend of a route is kept
+	plugin.HandleRoute(watch.Modified, newRoute("second", "SharedService"))
+	if _, ok := router.FindServiceUnit("foo/TestService"); !ok {
+		t.Errorf("Expected the unit of the primary backend to be kept")
+	}
+
+	plugin.HandleRoute(watch.Deleted, newRoute("second", "SharedService"))
+	if _, ok := router.FindServiceUnit("foo/SharedService"); ok {
+		t.Errorf("Expected the unit of the shared backend to be deleted with the last route using it")
+	}
+	if !router.Committed {
+		t.Errorf("Expected router to be committed after HandleRoute call")
+	}
+}
+
+// TestHandleRouteInvalidAnnotations tests that invalid route annotations are reported as events
+func TestHandleRouteInvalidAnnotations(t *testing.T) {
+	router := newTestRouter(make(map[string]ServiceUnit))
+	plugin := newDefaultTemplatePlugin(router, true)
+	recorder := &record.FakeRecorder{}
+	plugin.Recorder = recorder
+
+	route := &routeapi.Route{
+		ObjectMeta: kapi.ObjectMeta{
+			Namespace: "foo",
+			Name:      "test",
+			Annotations: map[string]string{
+				timeoutAnnotation:     "soon",
+				ipWhitelistAnnotation: "10.0.0.1",
+			},
+		},
+		Spec: routeapi.RouteSpec{
+			Host: "www.example.com",
+			To: routeapi.RouteTargetReference{
+				Name: "TestService",
+			},
+		},
+	}
+
+	plugin.HandleRoute(watch.Added, route)
+
+	if !router.Committed {
+		t.Errorf("Expected router to be committed after HandleRoute call")
+	}
+	if len(recorder.Events) != 1 || !strings.Contains(recorder.Events[0], "InvalidRouteAnnotation") || !strings.Contains(recorder.Events[0], timeoutAnnotation) {
+		t.Errorf("Expected an event for the invalid timeout annotation, got %v", recorder.Events)
+	}
+
+	// resyncs of the unchanged route are not reported again
+	plugin.HandleRoute(watch.Modified, route)
+	if len(recorder.Events) != 1 {
+		t.Errorf("Expected no event for the unchanged route, got %v", recorder.Events)
+	}
+
+	route.Annotations[rateLimitConnectionsAnnotation] = "maybe"
+	plugin.HandleRoute(watch.Modified, route)
+	if len(recorder.Events) != 3 || !strings.Contains(strings.Join(recorder.Events[1:], "\n"), rateLimitConnectionsAnnotation) {
+		t.Errorf("Expected the changed annotations to be reported, got %v", recorder.Events)
+	}
+
+	// a recreated route is reported again
+	plugin.HandleRoute(watch.Deleted, route)
+	plugin.HandleRoute(watch.Added, route)
+	if len(recorder.Events) != 5 {
+		t.Errorf("Expected the recreated route to be reported, got %v", recorder.Events)
+	}
+}
+
 func TestNamespaceScopingFromEmpty(t *testing.T) {
 	router := newTestRouter(make(map[string]ServiceUnit))
 	templatePlugin := newDefaultTemplatePlugin(router, true)
 	// TODO: move tests that rely on unique hosts to pkg/router/controller and remove them from
 	// here
-	plugin := controller.NewUniqueHost(templatePlugin, controller.HostForRoute)
+	plugin := controller.NewUniqueHost(templatePlugin, controller.HostForRoute, false, controller.LogRejections)
 
 	// no namespaces allowed
 	plugin.HandleNamespaces(sets.String{})
@@ -505,7 +652,7 @@ func TestNamespaceScopingFromEmpty(t *testing.T) {
 		ObjectMeta: kapi.ObjectMeta{Namespace: "foo", Name: "test"},
 		Spec: routeapi.RouteSpec{
 			Host: "www.example.com",
-			To: kapi.ObjectReference{
+			To: routeapi.RouteTargetReference{
 				Name: "TestService",
 			},
 		},
@@ -8,6 +8,8 @@ import (
 	"os/exec"
 	"path/filepath"
 	"reflect"
+	"regexp"
+	"sort"
 	"strings"
 	"sync"
 	"text/template"
@@ -183,6 +185,50 @@ func endpointsForAlias(alias ServiceAliasConfig, svc ServiceUnit) []Endpoint {
 	return endpoints
 }
 
+// wildcardAlias is the config of a wildcard route along with its key in the service unit.
+type wildcardAlias struct {
+	Key    string
+	Config ServiceAliasConfig
+}
+
+// byPathLength sorts wildcard aliases so that the longest paths come first.
+type byPathLength []wildcardAlias
+
+func (a byPathLength) Len() int      { return len(a) }
+func (a byPathLength) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a byPathLength) Less(i, j int) bool {
+	if len(a[i].Config.Path) != len(a[j].Config.Path) {
+		return len(a[i].Config.Path) > len(a[j].Config.Path)
+	}
+	return a[i].Key < a[j].Key
+}
+
+// wildcardAliases returns the configs of the wildcard routes in the order they must be
+// written to a regular expression map, where the first matching entry wins.
+func wildcardAliases(state map[string]ServiceUnit) []wildcardAlias {
+	aliases := []wildcardAlias{}
+	for _, serviceUnit := range state {
+		for key, cfg := range serviceUnit.ServiceAliasConfigs {
+			if cfg.IsWildcard {
+				aliases = append(aliases, wildcardAlias{Key: key, Config: cfg})
+			}
+		}
+	}
+	sort.Sort(byPathLength(aliases))
+	return aliases
+}
+
+// wildcardAliasRegexp returns a regular expression that matches the host and path of
+// requests to any host in the subdomain of a wildcard route. The host header of a
+// request may carry a port, which is ignored.
+func wildcardAliasRegexp(cfg ServiceAliasConfig) string {
+	expr := `^[^\.:]+\.` + regexp.QuoteMeta(routeapi.WildcardSubdomain(cfg.Host)) + `(:[0-9]+)?`
+	if len(cfg.Path) == 0 {
+		return expr + `(/.*)?$`
+	}
+	return expr + regexp.QuoteMeta(cfg.Path)
+}
+
 // writeDefaultCert is called a single time during init to write out the default certificate
 func (r *templateRouter) writeDefaultCert() error {
 	if len(r.defaultCertificate) == 0 {
@@ -223,19 +269,24 @@ func (r *templateRouter) commitAndReload() error {
 
 	glog.V(4).Infof("Writing the router state")
 	if err := r.writeState(); err != nil {
+		reloadFailuresTotal.Inc()
 		return err
 	}
 
 	glog.V(4).Infof("Writing the router config")
 	if err := r.writeConfig(); err != nil {
+		reloadFailuresTotal.Inc()
 		return err
 	}
 
 	glog.V(4).Infof("Reloading the router")
+	reloadsTotal.Inc()
 	if err := r.reloadRouter(); err != nil {
+		reloadFailuresTotal.Inc()
 		return err
 	}
 
+	lastCommit.set(time.Now())
 	return nil
 }
 
@@ -387,20 +438,31 @@ func (r *templateRouter) routeKey(route *routeapi.Route) string {
 }
 
 // AddRoute adds a route for the given id
-func (r *templateRouter) AddRoute(id string, route *routeapi.Route, host string) bool {
+func (r *templateRouter) AddRoute(id string, route *routeapi.Route, host string, options routeAnnotations) bool {
 	frontend, _ := r.FindServiceUnit(id)
 
 	backendKey := r.routeKey(route)
 
 	config := ServiceAliasConfig{
-		Host: host,
-		Path: route.Spec.Path,
+		Host:             host,
+		Path:             route.Spec.Path,
+		ServiceUnitNames: make(map[string]int32),
+		IsWildcard:       route.Spec.WildcardPolicy == routeapi.WildcardPolicySubdomain,
+	}
+
+	config.ServiceUnitNames[id] = backendWeight(route.Spec.To)
+	for _, backend := range route.Spec.AlternateBackends {
+		config.ServiceUnitNames[fmt.Sprintf("%s/%s", route.Namespace, backend.Name)] = backendWeight(backend)
 	}
 
 	if route.Spec.Port != nil {
 		config.PreferPort = route.Spec.Port.TargetPort.String()
 	}
 
+	config.IPWhitelist = options.IPWhitelist
+	config.RateLimit = options.RateLimit
+	config.Timeout = options.Timeout
+
 	tls := route.Spec.TLS
 	if tls != nil && len(tls.Termination) > 0 {
 		config.TLSTermination = tls.Termination
@@ -452,6 +514,14 @@ func (r *templateRouter) AddRoute(id string, route *routeapi.Route, host string)
 	return true
 }
 
+// backendWeight returns the weight of traffic a route backend should receive
+func backendWeight(backend routeapi.RouteTargetReference) int32 {
+	if backend.Weight == nil {
+		return routeapi.DefaultRouteTargetWeight
+	}
+	return *backend.Weight
+}
+
 // cleanUpdates ensures the route is only under a single service key.  Backends are keyed
 // by route namespace and name.  Frontends are keyed by service namespace name.  This accounts
 // for times when someone updates the service name on a route which leaves the existing old service
@@ -2,6 +2,8 @@ package templaterouter
 
 import (
 	"fmt"
+	"reflect"
+	"regexp"
 	"testing"
 
 	routeapi "github.com/openshift/origin/pkg/route/api"
@@ -259,7 +261,7 @@ func TestRouteKey(t *testing.T) {
 		}
 
 		// add route always returns true
-		added := router.AddRoute(suKey, route, route.Spec.Host)
+		added := router.AddRoute(suKey, route, route.Spec.Host, routeAnnotations{})
 		if !added {
 			t.Fatalf("expected AddRoute to return true but got false")
 		}
@@ -303,7 +305,7 @@ func TestAddRoute(t *testing.T) {
 	router.CreateServiceUnit(suKey)
 
 	// add route always returns true
-	added := router.AddRoute(suKey, route, route.Spec.Host)
+	added := router.AddRoute(suKey, route, route.Spec.Host, routeAnnotations{})
 	if !added {
 		t.Fatalf("expected AddRoute to return true but got false")
 	}
@@ -326,6 +328,150 @@ func TestAddRoute(t *testing.T) {
 	}
 }
 
+// TestAddRouteAlternateBackends ensures the weights of the primary and alternate backends of a route
+// are recorded on its service alias config
+func TestAddRouteAlternateBackends(t *testing.T) {
+	router := newFakeTemplateRouter()
+	weight := int32(20)
+	noWeight := int32(0)
+	route := &routeapi.Route{
+		ObjectMeta: kapi.ObjectMeta{
+			Namespace: "foo",
+			Name:      "bar",
+		},
+		Spec: routeapi.RouteSpec{
+			Host: "host",
+			To: routeapi.RouteTargetReference{
+				Name:   "primary",
+				Weight: &weight,
+			},
+			AlternateBackends: []routeapi.RouteTargetReference{
+				{Name: "secondary"},
+				{Name: "tertiary", Weight: &noWeight},
+			},
+		},
+	}
+	suKey := "foo/primary"
+	router.CreateServiceUnit(suKey)
+	router.AddRoute(suKey, route, route.Spec.Host, routeAnnotations{})
+
+	su, ok := router.FindServiceUnit(suKey)
+	if !ok {
+		t.Fatalf("Unable to find created service unit %s", suKey)
+	}
+	saCfg, ok := su.ServiceAliasConfigs[router.routeKey(route)]
+	if !ok {
+		t.Fatalf("Unable to find created service alias config for route %s", router.routeKey(route))
+	}
+
+	expected := map[string]int32{
+		"foo/primary":   20,
+		"foo/secondary": routeapi.DefaultRouteTargetWeight,
+		"foo/tertiary":  0,
+	}
+	if !reflect.DeepEqual(expected, saCfg.ServiceUnitNames) {
+		t.Errorf("expected service unit names %v, got %v", expected, saCfg.ServiceUnitNames)
+	}
+}
+
+// TestAddRouteAnnotations tests that route annotations are applied to the service alias config
+func TestAddRouteAnnotations(t *testing.T) {
+	router := newFakeTemplateRouter()
+	route := &routeapi.Route{
+		ObjectMeta: kapi.ObjectMeta{
+			Namespace: "foo",
+			Name:      "bar",
+			Annotations: map[string]string{
+				ipWhitelistAnnotation:          "10.0.0.0/8",
+				rateLimitConnectionsAnnotation: "true",
+				rateLimitTCPRateAnnotation:     "20",
+				timeoutAnnotation:              "invalid",
+			},
+		},
+		Spec: routeapi.RouteSpec{
+			Host: "host",
+			To:   routeapi.RouteTargetReference{Name: "svc"},
+		},
+	}
+	suKey := "foo/svc"
+	router.CreateServiceUnit(suKey)
+	options, _ := parseRouteAnnotations(route.Annotations)
+	router.AddRoute(suKey, route, route.Spec.Host, options)
+
+	su, _ := router.FindServiceUnit(suKey)
+	saCfg, ok := su.ServiceAliasConfigs[router.routeKey(route)]
+	if !ok {
+		t.Fatalf("Unable to find created service alias config for route %s", router.routeKey(route))
+	}
+	if !reflect.DeepEqual(saCfg.IPWhitelist, []string{"10.0.0.0/8"}) {
+		t.Errorf("Unexpected IP whitelist %v", saCfg.IPWhitelist)
+	}
+	if !reflect.DeepEqual(saCfg.RateLimit, &RateLimit{TCPRate: 20}) {
+		t.Errorf("Unexpected rate limit %#v", saCfg.RateLimit)
+	}
+	if len(saCfg.Timeout) != 0 {
+		t.Errorf("Expected the invalid timeout to be ignored, got %s", saCfg.Timeout)
+	}
+}
+
+// TestWildcardAliases tests the ordering and regular expressions of wildcard route map entries
+func TestWildcardAliases(t *testing.T) {
+	router := newFakeTemplateRouter()
+	suKey := "foo/svc"
+	router.CreateServiceUnit(suKey)
+	for name, path := range map[string]string{"root": "", "api": "/api", "apiv1": "/api/v1"} {
+		route := &routeapi.Route{
+			ObjectMeta: kapi.ObjectMeta{Namespace: "foo", Name: name},
+			Spec: routeapi.RouteSpec{
+				Host:           "www.example.com",
+				Path:           path,
+				To:             routeapi.RouteTargetReference{Name: "svc"},
+				WildcardPolicy: routeapi.WildcardPolicySubdomain,
+			},
+		}
+		router.AddRoute(suKey, route, route.Spec.Host, routeAnnotations{})
+	}
+	router.AddRoute(suKey, &routeapi.Route{
+		ObjectMeta: kapi.ObjectMeta{Namespace: "foo", Name: "exact"},
+		Spec:       routeapi.RouteSpec{Host: "exact.example.com", To: routeapi.RouteTargetReference{Name: "svc"}},
+	}, "exact.example.com", routeAnnotations{})
+
+	aliases := wildcardAliases(router.state)
+	expected := []struct {
+		key    string
+		regexp string
+	}{
+		{"foo_apiv1", `^[^\.:]+\.example\.com(:[0-9]+)?/api/v1`},
+		{"foo_api", `^[^\.:]+\.example\.com(:[0-9]+)?/api`},
+		{"foo_root", `^[^\.:]+\.example\.com(:[0-9]+)?(/.*)?$`},
+	}
+	if len(aliases) != len(expected) {
+		t.Fatalf("expected %d wildcard aliases, got %#v", len(expected), aliases)
+	}
+	for i := range expected {
+		if aliases[i].Key != expected[i].key {
+			t.Errorf("expected alias %d to be %s, got %s", i, expected[i].key, aliases[i].Key)
+		}
+		if expr := wildcardAliasRegexp(aliases[i].Config); expr != expected[i].regexp {
+			t.Errorf("expected regexp %s for %s, got %s", expected[i].regexp, expected[i].key, expr)
+		}
+	}
+
+	root := regexp.MustCompile(wildcardAliasRegexp(aliases[2].Config))
+	for base, matches := range map[string]bool{
+		"app.example.com":          true,
+		"app.example.com/":         true,
+		"app.example.com:8080/foo": true,
+		"app.example.com:/foo":     false,
+		"a.b.example.com/":         false,
+		"example.com/":             false,
+	} {
+		if root.MatchString(base) != matches {
+			t.Errorf("expected %s to match %s: %t", root, base, matches)
+		}
+	}
+}
+
 // compareTLS is a utility to help compare cert contents between an route and a config
 func compareTLS(route *routeapi.Route, saCfg ServiceAliasConfig, t *testing.T) bool {
 	return findCert(route.Spec.TLS.DestinationCACertificate, saCfg.Certificates, false, t) &&
@@ -383,8 +529,8 @@ func TestRemoveRoute(t *testing.T) {
 	suKey := "test"
 
 	router.CreateServiceUnit(suKey)
-	router.AddRoute(suKey, route, route.Spec.Host)
-	router.AddRoute(suKey, route2, route2.Spec.Host)
+	router.AddRoute(suKey, route, route.Spec.Host, routeAnnotations{})
+	router.AddRoute(suKey, route2, route2.Spec.Host, routeAnnotations{})
 
 	su, ok := router.FindServiceUnit(suKey)
 	if !ok {
@@ -530,7 +676,7 @@ func TestRouteExistsUnderOneServiceOnly(t *testing.T) {
 		Spec: routeapi.RouteSpec{
 			Host: "host",
 			Path: "path",
-			To: kapi.ObjectReference{
+			To: routeapi.RouteTargetReference{
 				Name: "bad-service",
 			},
 		},
@@ -543,7 +689,7 @@ func TestRouteExistsUnderOneServiceOnly(t *testing.T) {
 		Spec: routeapi.RouteSpec{
 			Host: "host",
 			Path: "path",
-			To: kapi.ObjectReference{
+			To: routeapi.RouteTargetReference{
 				Name: "good-service",
 			},
 		},
@@ -556,7 +702,7 @@ func TestRouteExistsUnderOneServiceOnly(t *testing.T) {
 		Spec: routeapi.RouteSpec{
 			Host: "host",
 			Path: "path",
-			To: kapi.ObjectReference{
+			To: routeapi.RouteTargetReference{
 				Name: "good-service",
 			},
 		},
@@ -575,7 +721,7 @@ func TestRouteExistsUnderOneServiceOnly(t *testing.T) {
 	router.CreateServiceUnit(routeWithGoodServiceDifferentNamespaceKey)
 
 	// add the route with the bad service name, it should add fine
-	router.AddRoute(routeWithBadServiceKey, routeWithBadService, routeWithBadService.Spec.Host)
+	router.AddRoute(routeWithBadServiceKey, routeWithBadService, routeWithBadService.Spec.Host, routeAnnotations{})
 	route, ok := router.FindServiceUnit(routeWithBadServiceKey)
 
 	if !ok {
@@ -588,7 +734,7 @@ func TestRouteExistsUnderOneServiceOnly(t *testing.T) {
 
 	// now add the same route with a modified service name, it should exists under the new service
 	// and no longer exist under the old service
-	router.AddRoute(routeWithGoodServiceKey, routeWithGoodService, routeWithGoodService.Spec.Host)
+	router.AddRoute(routeWithGoodServiceKey, routeWithGoodService, routeWithGoodService.Spec.Host, routeAnnotations{})
 	route, ok = router.FindServiceUnit(routeWithGoodServiceKey)
 	if !ok {
 		t.Fatalf("unable to find route %s after adding", routeWithGoodServiceKey)
@@ -608,7 +754,7 @@ func TestRouteExistsUnderOneServiceOnly(t *testing.T) {
 	}
 
 	// add a route with the same name but under a different namespace.
-	router.AddRoute(routeWithGoodServiceDifferentNamespaceKey, routeWithGoodServiceDifferentNamespace, routeWithGoodServiceDifferentNamespace.Spec.Host)
+	router.AddRoute(routeWithGoodServiceDifferentNamespaceKey, routeWithGoodServiceDifferentNamespace, routeWithGoodServiceDifferentNamespace.Spec.Host, routeAnnotations{})
 	route, ok = router.FindServiceUnit(routeWithGoodServiceDifferentNamespaceKey)
 	if !ok {
 		t.Fatalf("unable to find route %s after adding", routeWithGoodServiceDifferentNamespaceKey)
@@ -683,7 +829,7 @@ func TestAddRouteEdgeTerminationInsecurePolicy(t *testing.T) {
 		router.CreateServiceUnit(suKey)
 
 		// add route always returns true
-		added := router.AddRoute(suKey, route, route.Spec.Host)
+		added := router.AddRoute(suKey, route, route.Spec.Host, routeAnnotations{})
 		if !added {
 			t.Fatalf("InsecureEdgeTerminationPolicy test %s: expected AddRoute to return true but got false", tc.Name)
 		}
@@ -37,6 +37,18 @@ type ServiceAliasConfig struct {
 	// insecure connections to an edge-terminated route:
 	//   none (or disable), allow or redirect
 	InsecureEdgeTerminationPolicy routeapi.InsecureEdgeTerminationPolicyType
+	// ServiceUnitNames maps the keys of the service units that back this route (the primary
+	// service and any alternate backends) to the weight of traffic each should receive
+	ServiceUnitNames map[string]int32
+	// IsWildcard indicates the route also matches every host in the subdomain of Host
+	IsWildcard bool
+	// IPWhitelist is the list of IP addresses and CIDR ranges allowed to access the route.
+	// All sources are allowed if it is empty.
+	IPWhitelist []string
+	// RateLimit limits the connections and requests of each client IP, if set
+	RateLimit *RateLimit
+	// Timeout is the server timeout of the route in HAProxy time format, if set
+	Timeout string
 }
 
 type ServiceAliasConfigStatus string
@@ -324,7 +324,7 @@ func TestRouter(t *testing.T) {
 				Spec: routeapi.RouteSpec{
 					Host: tc.routeAlias,
 					Path: tc.routePath,
-					To: kapi.ObjectReference{
+					To: routeapi.RouteTargetReference{
 						Name: tc.serviceName,
 					},
 					TLS: tc.routeTLS,
@@ -457,7 +457,7 @@ func TestRouterPathSpecificity(t *testing.T) {
 			Spec: routeapi.RouteSpec{
 				Host: "www.example.com",
 				Path: "/test",
-				To: kapi.ObjectReference{
+				To: routeapi.RouteTargetReference{
 					Name: "myService",
 				},
 			},
@@ -497,7 +497,7 @@ func TestRouterPathSpecificity(t *testing.T) {
 			Spec: routeapi.RouteSpec{
 				Host: "www.example.com",
 				Path: "/test",
-				To: kapi.ObjectReference{
+				To: routeapi.RouteTargetReference{
 					Name: "altService",
 				},
 			},
@@ -521,7 +521,7 @@ func TestRouterPathSpecificity(t *testing.T) {
 			},
 			Spec: routeapi.RouteSpec{
 				Host: "www.example.com",
-				To: kapi.ObjectReference{
+				To: routeapi.RouteTargetReference{
 					Name: "myService",
 				},
 			},
@@ -548,7 +548,7 @@ func TestRouterPathSpecificity(t *testing.T) {
 			Spec: routeapi.RouteSpec{
 				Host: "www.example.com",
 				Path: "/test",
-				To: kapi.ObjectReference{
+				To: routeapi.RouteTargetReference{
 					Name: "myService",
 				},
 			},
@@ -580,7 +580,7 @@ func TestRouterPathSpecificity(t *testing.T) {
 			},
 			Spec: routeapi.RouteSpec{
 				Host: "www.example.com",
-				To: kapi.ObjectReference{
+				To: routeapi.RouteTargetReference{
 					Name: "altService",
 				},
 			},
@@ -606,7 +606,7 @@ func TestRouterPathSpecificity(t *testing.T) {
 			},
 			Spec: routeapi.RouteSpec{
 				Host: "www.example.com",
-				To: kapi.ObjectReference{
+				To: routeapi.RouteTargetReference{
 					Name: "altService",
 				},
 			},
@@ -631,7 +631,7 @@ func TestRouterPathSpecificity(t *testing.T) {
 			},
 			Spec: routeapi.RouteSpec{
 				Host: "www.example.com",
-				To: kapi.ObjectReference{
+				To: routeapi.RouteTargetReference{
 					Name: "myService",
 				},
 			},
@@ -703,7 +703,7 @@ func TestRouterDuplications(t *testing.T) {
 			},
 			Spec: routeapi.RouteSpec{
 				Host: "www.example.com",
-				To: kapi.ObjectReference{
+				To: routeapi.RouteTargetReference{
 					Name: "myService",
 				},
 			},
@@ -718,7 +718,7 @@ func TestRouterDuplications(t *testing.T) {
 			},
 			Spec: routeapi.RouteSpec{
 				Host: "www.example2.com",
-				To: kapi.ObjectReference{
+				To: routeapi.RouteTargetReference{
 					Name: "myService",
 				},
 			},
@@ -759,7 +759,7 @@ func TestRouterDuplications(t *testing.T) {
 			},
 			Spec: routeapi.RouteSpec{
 				Host: "www.example2.com",
-				To: kapi.ObjectReference{
+				To: routeapi.RouteTargetReference{
 					Name: "myService",
 				},
 			},
@@ -775,7 +775,7 @@ func TestRouterDuplications(t *testing.T) {
 			},
 			Spec: routeapi.RouteSpec{
 				Host: "www.example.com",
-				To: kapi.ObjectReference{
+				To: routeapi.RouteTargetReference{
 					Name: "myService",
 				},
 			},
@@ -1300,7 +1300,7 @@ func generateTestEvents(fakeMasterAndPod *tr.TestHttpService, flag bool, service
 			Spec: routeapi.RouteSpec{
 				Host: routeAlias,
 				Path: "",
-				To: kapi.ObjectReference{
+				To: routeapi.RouteTargetReference{
 					Name: serviceName,
 				},
 				TLS: nil,